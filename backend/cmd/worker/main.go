@@ -8,13 +8,16 @@ import (
 	"runtime"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/mickamy/go-sqs-worker/consumer"
 	sjob "github.com/mickamy/go-sqs-worker/job"
 
 	"github.com/mickamy/sampay/config"
 	"github.com/mickamy/sampay/internal/di"
+	ousecase "github.com/mickamy/sampay/internal/domain/outbox/usecase"
 	"github.com/mickamy/sampay/internal/infra/aws/sqs"
+	"github.com/mickamy/sampay/internal/job"
 	"github.com/mickamy/sampay/internal/lib/logger"
 )
 
@@ -48,6 +51,8 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize SQS client: %w", err)
 	}
 
+	jobs := job.NewJobs(infra)
+
 	redisURL := fmt.Sprintf("redis://:%s@%s", kvsCfg.Password, kvsCfg.Address())
 
 	c, err := consumer.New(consumer.Config{
@@ -55,7 +60,7 @@ func run(ctx context.Context) error {
 		DeadLetterQueueURL: awsCfg.SQSWorkerDLQURL,
 		RedisURL:           redisURL,
 	}, sqsClient, nil, func(jobType string) (sjob.Job, error) {
-		return nil, fmt.Errorf("unknown job type: [%s]", jobType)
+		return job.Get(jobType, jobs)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize consumer: %w", err)
@@ -74,7 +79,56 @@ func run(ctx context.Context) error {
 		}(i)
 	}
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		schedulePaymentReminders(ctx, infra, config.Reminder().Interval)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		relayOutbox(ctx, infra, config.Outbox())
+	}()
+
 	wg.Wait()
 
 	return nil
 }
+
+// schedulePaymentReminders enqueues a reminder sweep on every tick. Running it on every worker
+// is harmless: a reminder step is recorded at most once per event, so duplicate sweeps are no-ops.
+func schedulePaymentReminders(ctx context.Context, infra *di.Infra, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := infra.Queue.Enqueue(ctx, job.SendPaymentReminders, job.SendPaymentRemindersPayload{}); err != nil {
+				logger.Error(ctx, "failed to enqueue payment reminders", "err", err)
+			}
+		}
+	}
+}
+
+// relayOutbox forwards outbox messages to the queue on every tick. Relays on other workers
+// skip the messages this one has locked, so each pass handles a disjoint batch.
+func relayOutbox(ctx context.Context, infra *di.Infra, cfg config.OutboxConfig) {
+	uc := ousecase.NewRelayOutboxMessages(infra)
+	ticker := time.NewTicker(cfg.RelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.Do(ctx, ousecase.RelayOutboxMessagesInput{Limit: cfg.RelayBatchSize}); err != nil {
+				logger.Error(ctx, "failed to relay outbox messages", "err", err)
+			}
+		}
+	}
+}
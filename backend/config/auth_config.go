@@ -12,6 +12,8 @@ import (
 
 type AuthConfig struct {
 	SigningSecret string `env:"JWT_SIGNING_SECRET" validate:"required"`
+	// SSRSecret is shared with the SSR server, so that the browser details it relays on requests can be trusted.
+	SSRSecret string `env:"SSR_SECRET"`
 }
 
 func (c AuthConfig) SigningSecretBytes() []byte {
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/mickamy/sampay/internal/lib/validator"
+)
+
+type NotifierKind string
+
+const (
+	// NotifierKindLog writes notifications to the application log, for local runs.
+	NotifierKindLog NotifierKind = "log"
+	// NotifierKindFile appends notifications to NotifierConfig.FilePath as JSON lines, for local runs.
+	NotifierKindFile NotifierKind = "file"
+	// NotifierKindSend delivers notifications through the official LINE account and email.
+	NotifierKindSend NotifierKind = "send"
+)
+
+type NotifierConfig struct {
+	Kind     NotifierKind `env:"NOTIFIER"           envDefault:"log" validate:"required,oneof=log file send"`
+	FilePath string       `env:"NOTIFIER_FILE_PATH" validate:"required_if=Kind file"`
+	// LINEChannelAccessToken authenticates pushes from the official account's Messaging API channel,
+	// which is a different channel from the LINE Login one in OAuthConfig.
+	LINEChannelAccessToken string `env:"LINE_MESSAGING_CHANNEL_ACCESS_TOKEN" validate:"required_if=Kind send"`
+	// LINEChannelSecret is the same channel's secret, which its webhooks are signed with.
+	LINEChannelSecret string `env:"LINE_MESSAGING_CHANNEL_SECRET" validate:"required_if=Kind send"`
+	// SMTPAddr is the host:port of the SMTP server email goes out through.
+	SMTPAddr     string `env:"SMTP_ADDR"     validate:"required_if=Kind send"`
+	SMTPUsername string `env:"SMTP_USERNAME"`
+	SMTPPassword string `env:"SMTP_PASSWORD"`
+	EmailFrom    string `env:"EMAIL_FROM"    validate:"required_if=Kind send"`
+	// WebURL is the frontend origin that notifications link to.
+	WebURL string `env:"WEB_URL" envDefault:"https://sampay.lvh.me" validate:"required,url"`
+}
+
+var (
+	notifierOnce sync.Once
+	notifier     NotifierConfig
+)
+
+func Notifier() NotifierConfig {
+	notifierOnce.Do(func() {
+		if err := env.Parse(&notifier); err != nil {
+			panic(err)
+		}
+
+		if err := validator.Struct(context.Background(), &notifier); err != nil {
+			panic(fmt.Errorf("invalid notifier config: %+v", err))
+		}
+	})
+
+	return notifier
+}
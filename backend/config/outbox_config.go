@@ -0,0 +1,38 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/mickamy/sampay/internal/lib/validator"
+)
+
+type OutboxConfig struct {
+	// RelayInterval is how often the worker forwards pending outbox messages to the queue.
+	RelayInterval time.Duration `env:"OUTBOX_RELAY_INTERVAL"   envDefault:"1s"  validate:"gt=0"`
+	// RelayBatchSize caps how many messages one relay pass forwards.
+	RelayBatchSize int `env:"OUTBOX_RELAY_BATCH_SIZE" envDefault:"100" validate:"gt=0"`
+}
+
+var (
+	outboxOnce sync.Once
+	outbox     OutboxConfig
+)
+
+func Outbox() OutboxConfig {
+	outboxOnce.Do(func() {
+		if err := env.Parse(&outbox); err != nil {
+			panic(err)
+		}
+
+		if err := validator.Struct(context.Background(), &outbox); err != nil {
+			panic(fmt.Errorf("invalid outbox config: %+v", err))
+		}
+	})
+
+	return outbox
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/mickamy/sampay/internal/lib/validator"
+)
+
+type ReminderConfig struct {
+	// Offsets are how long after an event is held each payment reminder goes out.
+	Offsets []time.Duration `env:"PAYMENT_REMINDER_OFFSETS"  envDefault:"24h,72h,168h" envSeparator:"," validate:"required,dive,gt=0"` //nolint:lll // tagalign pads for envDefault alignment
+	// Interval is how often the worker looks for reminders that are due.
+	Interval time.Duration `env:"PAYMENT_REMINDER_INTERVAL" envDefault:"15m"          validate:"gt=0"`
+}
+
+var (
+	reminderOnce sync.Once
+	reminder     ReminderConfig
+)
+
+func Reminder() ReminderConfig {
+	reminderOnce.Do(func() {
+		if err := env.Parse(&reminder); err != nil {
+			panic(err)
+		}
+
+		if err := validator.Struct(context.Background(), &reminder); err != nil {
+			panic(fmt.Errorf("invalid reminder config: %+v", err))
+		}
+		slices.Sort(reminder.Offsets)
+	})
+
+	return reminder
+}
@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE events
+    ADD COLUMN remainder_policy TEXT NOT NULL DEFAULT 'organizer';
+
+-- migrate:down
+ALTER TABLE events
+    DROP COLUMN IF EXISTS remainder_policy;
@@ -0,0 +1,15 @@
+-- migrate:up
+ALTER TABLE event_tiers
+    ADD COLUMN name   TEXT NOT NULL DEFAULT '',
+    -- in thousandths, e.g. 1500 for 1.5x
+    ADD COLUMN weight INT  NOT NULL DEFAULT 0;
+
+-- the tier number used to be the weight
+UPDATE event_tiers
+SET weight = tier * 1000
+WHERE weight = 0;
+
+-- migrate:down
+ALTER TABLE event_tiers
+    DROP COLUMN IF EXISTS weight,
+    DROP COLUMN IF EXISTS name;
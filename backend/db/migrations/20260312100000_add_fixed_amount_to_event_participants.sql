@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE event_participants
+    ADD COLUMN fixed_amount INT CHECK (fixed_amount >= 0);
+
+-- migrate:down
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS fixed_amount;
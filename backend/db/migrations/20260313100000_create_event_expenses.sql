@@ -0,0 +1,30 @@
+-- migrate:up
+CREATE TABLE event_expenses
+(
+    id         CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id   CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    payer_id   CHAR(26)    NOT NULL REFERENCES event_participants (id) ON DELETE CASCADE,
+    title      TEXT        NOT NULL,
+    amount     INT         NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_event_expenses_event_id ON event_expenses (event_id);
+
+CREATE TABLE event_expense_shares
+(
+    id             CHAR(26)    NOT NULL PRIMARY KEY,
+    expense_id     CHAR(26)    NOT NULL REFERENCES event_expenses (id) ON DELETE CASCADE,
+    participant_id CHAR(26)    NOT NULL REFERENCES event_participants (id) ON DELETE CASCADE,
+    amount         INT         NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (expense_id, participant_id)
+);
+
+CREATE INDEX idx_event_expense_shares_expense_id ON event_expense_shares (expense_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS event_expense_shares;
+DROP TABLE IF EXISTS event_expenses;
@@ -0,0 +1,16 @@
+-- migrate:up
+ALTER TABLE events
+    ADD COLUMN currency                TEXT        NOT NULL DEFAULT 'JPY',
+    ADD COLUMN settlement_currency     TEXT        NOT NULL DEFAULT '',
+    -- in millionths of the settlement currency per unit of currency, e.g. 151250000 for 151.25
+    ADD COLUMN exchange_rate           BIGINT      NOT NULL DEFAULT 0,
+    ADD COLUMN exchange_rate_at        TIMESTAMPTZ,
+    ADD COLUMN settlement_total_amount INT         NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE events
+    DROP COLUMN IF EXISTS settlement_total_amount,
+    DROP COLUMN IF EXISTS exchange_rate_at,
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS settlement_currency,
+    DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE event_participants
+    ADD COLUMN token_hash TEXT;
+
+-- migrate:down
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS token_hash;
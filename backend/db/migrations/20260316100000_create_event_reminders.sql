@@ -0,0 +1,20 @@
+-- migrate:up
+ALTER TABLE event_participants
+    ADD COLUMN end_user_id CHAR(26) REFERENCES end_users (user_id) ON DELETE SET NULL;
+
+CREATE INDEX idx_event_participants_end_user_id ON event_participants (end_user_id);
+
+CREATE TABLE event_reminders
+(
+    id         CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id   CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    step       INT         NOT NULL CHECK (step >= 1),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (event_id, step)
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS event_reminders;
+
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS end_user_id;
@@ -0,0 +1,14 @@
+-- migrate:up
+CREATE TABLE outbox_messages
+(
+    id           CHAR(26)    NOT NULL PRIMARY KEY,
+    type         TEXT        NOT NULL,
+    payload      JSONB       NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_messages_unpublished ON outbox_messages (id) WHERE published_at IS NULL;
+
+-- migrate:down
+DROP TABLE IF EXISTS outbox_messages;
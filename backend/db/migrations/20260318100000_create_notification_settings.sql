@@ -0,0 +1,13 @@
+-- migrate:up
+CREATE TABLE notification_settings
+(
+    end_user_id   CHAR(26)    NOT NULL PRIMARY KEY REFERENCES end_users (user_id) ON DELETE CASCADE,
+    line_enabled  BOOLEAN     NOT NULL DEFAULT TRUE,
+    email_enabled BOOLEAN     NOT NULL DEFAULT FALSE,
+    email         TEXT,
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (NOT email_enabled OR email IS NOT NULL)
+);
+
+-- migrate:down
+DROP TABLE IF EXISTS notification_settings;
@@ -0,0 +1,34 @@
+-- migrate:up
+ALTER TABLE event_participants
+    ADD COLUMN claimed_at   TIMESTAMPTZ,
+    ADD COLUMN confirmed_at TIMESTAMPTZ;
+
+UPDATE event_participants
+SET claimed_at = updated_at
+WHERE status = 'claimed';
+
+UPDATE event_participants
+SET confirmed_at = updated_at
+WHERE status = 'confirmed';
+
+CREATE TABLE event_participant_status_changes
+(
+    id             CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id       CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    participant_id CHAR(26)    NOT NULL REFERENCES event_participants (id) ON DELETE CASCADE,
+    from_status    TEXT,
+    to_status      TEXT        NOT NULL,
+    actor          TEXT        NOT NULL CHECK (actor IN ('organizer', 'participant', 'system')),
+    actor_user_id  CHAR(26)    REFERENCES end_users (user_id) ON DELETE SET NULL,
+    reason         TEXT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_event_participant_status_changes_event_id ON event_participant_status_changes (event_id, created_at);
+
+-- migrate:down
+DROP TABLE IF EXISTS event_participant_status_changes;
+
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS confirmed_at,
+    DROP COLUMN IF EXISTS claimed_at;
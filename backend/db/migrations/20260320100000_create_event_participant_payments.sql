@@ -0,0 +1,29 @@
+-- migrate:up
+CREATE TABLE event_participant_payments
+(
+    id                  CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id            CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    participant_id      CHAR(26)    NOT NULL REFERENCES event_participants (id) ON DELETE CASCADE,
+    amount              INTEGER     NOT NULL CHECK (amount > 0),
+    payment_method      TEXT,
+    note                TEXT,
+    recorded_by_user_id CHAR(26)    REFERENCES end_users (user_id) ON DELETE SET NULL,
+    paid_at             TIMESTAMPTZ NOT NULL,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_event_participant_payments_event_id ON event_participant_payments (event_id, paid_at);
+
+ALTER TABLE event_participants
+    ADD COLUMN paid_amount INTEGER NOT NULL DEFAULT 0;
+
+-- payments confirmed before the ledger have no entries, so they are taken as paid in full
+UPDATE event_participants
+SET paid_amount = amount
+WHERE status = 'confirmed';
+
+-- migrate:down
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS paid_amount;
+
+DROP TABLE IF EXISTS event_participant_payments;
@@ -0,0 +1,22 @@
+-- migrate:up
+CREATE TABLE event_participant_adjustments
+(
+    id                  CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id            CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    participant_id      CHAR(26)    NOT NULL REFERENCES event_participants (id) ON DELETE CASCADE,
+    amount              INTEGER     NOT NULL CHECK (amount <> 0),
+    note                TEXT,
+    recorded_by_user_id CHAR(26)    REFERENCES end_users (user_id) ON DELETE SET NULL,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_event_participant_adjustments_event_id ON event_participant_adjustments (event_id, created_at);
+
+ALTER TABLE event_participants
+    ADD COLUMN adjustment_amount INTEGER NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS adjustment_amount;
+
+DROP TABLE IF EXISTS event_participant_adjustments;
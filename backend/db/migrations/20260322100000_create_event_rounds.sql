@@ -0,0 +1,44 @@
+-- migrate:up
+CREATE TABLE event_rounds
+(
+    id           CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id     CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    round        INTEGER     NOT NULL CHECK (round >= 2),
+    title        TEXT        NOT NULL,
+    total_amount INTEGER     NOT NULL CHECK (total_amount > 0),
+    remainder    INTEGER     NOT NULL DEFAULT 0,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (event_id, round)
+);
+
+CREATE TABLE event_round_tiers
+(
+    id         CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id   CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    round_id   CHAR(26)    NOT NULL REFERENCES event_rounds (id) ON DELETE CASCADE,
+    tier       INTEGER     NOT NULL,
+    weight     INTEGER     NOT NULL CHECK (weight > 0),
+    count      INTEGER     NOT NULL CHECK (count > 0),
+    amount     INTEGER     NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (round_id, tier)
+);
+
+CREATE TABLE event_round_participants
+(
+    id             CHAR(26)    NOT NULL PRIMARY KEY,
+    event_id       CHAR(26)    NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    round_id       CHAR(26)    NOT NULL REFERENCES event_rounds (id) ON DELETE CASCADE,
+    participant_id CHAR(26)    NOT NULL REFERENCES event_participants (id) ON DELETE CASCADE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (round_id, participant_id)
+);
+
+CREATE INDEX idx_event_round_participants_participant_id ON event_round_participants (participant_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS event_round_participants;
+DROP TABLE IF EXISTS event_round_tiers;
+DROP TABLE IF EXISTS event_rounds;
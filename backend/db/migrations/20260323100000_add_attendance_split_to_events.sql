@@ -0,0 +1,19 @@
+-- migrate:up
+ALTER TABLE events
+    ADD COLUMN split_mode TEXT NOT NULL DEFAULT 'tier',
+    ADD COLUMN ends_at    TIMESTAMPTZ;
+
+ALTER TABLE event_participants
+    ADD COLUMN arrived_at TIMESTAMPTZ,
+    ADD COLUMN left_at    TIMESTAMPTZ,
+    ADD COLUMN attendance INTEGER CHECK (attendance BETWEEN 0 AND 1000);
+
+-- migrate:down
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS attendance,
+    DROP COLUMN IF EXISTS left_at,
+    DROP COLUMN IF EXISTS arrived_at;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS ends_at,
+    DROP COLUMN IF EXISTS split_mode;
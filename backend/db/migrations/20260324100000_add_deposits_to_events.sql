@@ -0,0 +1,18 @@
+-- migrate:up
+ALTER TABLE events
+    ADD COLUMN deposit_amount     INTEGER CHECK (deposit_amount > 0),
+    ADD COLUMN deposit_settled_at TIMESTAMPTZ;
+
+-- refunds paid back to participants are entered in the payments ledger as negative amounts
+ALTER TABLE event_participant_payments
+    DROP CONSTRAINT IF EXISTS event_participant_payments_amount_check,
+    ADD CONSTRAINT event_participant_payments_amount_check CHECK (amount <> 0);
+
+-- migrate:down
+ALTER TABLE event_participant_payments
+    DROP CONSTRAINT IF EXISTS event_participant_payments_amount_check,
+    ADD CONSTRAINT event_participant_payments_amount_check CHECK (amount > 0);
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS deposit_settled_at,
+    DROP COLUMN IF EXISTS deposit_amount;
@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE events
+    ADD COLUMN estimated_total_amount INTEGER CHECK (estimated_total_amount > 0),
+    ADD COLUMN finalized_at           TIMESTAMPTZ;
+
+-- migrate:down
+ALTER TABLE events
+    DROP COLUMN IF EXISTS finalized_at,
+    DROP COLUMN IF EXISTS estimated_total_amount;
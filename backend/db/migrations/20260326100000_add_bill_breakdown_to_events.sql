@@ -0,0 +1,22 @@
+-- migrate:up
+-- rates are in hundredths of a percent, e.g. 1000 for 10%
+ALTER TABLE events
+    ADD COLUMN subtotal_amount       INTEGER CHECK (subtotal_amount > 0),
+    ADD COLUMN tax_rate              INTEGER NOT NULL DEFAULT 0 CHECK (tax_rate BETWEEN 0 AND 10000),
+    ADD COLUMN tax_included          BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN service_charge_rate   INTEGER NOT NULL DEFAULT 0 CHECK (service_charge_rate BETWEEN 0 AND 10000),
+    ADD COLUMN tip_rate              INTEGER NOT NULL DEFAULT 0 CHECK (tip_rate BETWEEN 0 AND 10000),
+    ADD COLUMN service_charge_amount INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN tax_amount            INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN tip_amount            INTEGER NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE events
+    DROP COLUMN IF EXISTS tip_amount,
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS service_charge_amount,
+    DROP COLUMN IF EXISTS tip_rate,
+    DROP COLUMN IF EXISTS service_charge_rate,
+    DROP COLUMN IF EXISTS tax_included,
+    DROP COLUMN IF EXISTS tax_rate,
+    DROP COLUMN IF EXISTS subtotal_amount;
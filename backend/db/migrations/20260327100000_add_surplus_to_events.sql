@@ -0,0 +1,15 @@
+-- migrate:up
+-- surplus is what shares rounded up by the remainder policy come to beyond the total, a negative remainder
+ALTER TABLE events
+    ADD COLUMN surplus INTEGER NOT NULL DEFAULT 0 CHECK (surplus >= 0);
+ALTER TABLE event_rounds
+    ADD COLUMN surplus INTEGER NOT NULL DEFAULT 0 CHECK (surplus >= 0);
+
+UPDATE events SET surplus = -remainder WHERE remainder < 0;
+UPDATE event_rounds SET surplus = -remainder WHERE remainder < 0;
+
+-- migrate:down
+ALTER TABLE event_rounds
+    DROP COLUMN IF EXISTS surplus;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS surplus;
@@ -0,0 +1,9 @@
+-- migrate:up
+-- settlement_amount is the participant's share of the event's settlement_total_amount, zero without one
+-- until the event is recalculated, which SettlementDue makes up for by converting amount on its own
+ALTER TABLE event_participants
+    ADD COLUMN settlement_amount INTEGER NOT NULL DEFAULT 0;
+
+-- migrate:down
+ALTER TABLE event_participants
+    DROP COLUMN IF EXISTS settlement_amount;
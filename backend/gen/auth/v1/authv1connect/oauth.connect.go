@@ -44,6 +44,7 @@ const (
 // OAuthServiceClient is a client for the auth.v1.OAuthService service.
 type OAuthServiceClient interface {
 	// GetOAuthURL returns the OAuth authorization URL.
+	// The issued state is also set as an HttpOnly cookie to bind it to the browser.
 	GetOAuthURL(context.Context, *connect.Request[v1.GetOAuthURLRequest]) (*connect.Response[v1.GetOAuthURLResponse], error)
 	// OAuthCallback exchanges an OAuth authorization code for tokens.
 	// Tokens are set as HttpOnly cookies (not in the response body).
@@ -95,6 +96,7 @@ func (c *oAuthServiceClient) OAuthCallback(ctx context.Context, req *connect.Req
 // OAuthServiceHandler is an implementation of the auth.v1.OAuthService service.
 type OAuthServiceHandler interface {
 	// GetOAuthURL returns the OAuth authorization URL.
+	// The issued state is also set as an HttpOnly cookie to bind it to the browser.
 	GetOAuthURL(context.Context, *connect.Request[v1.GetOAuthURLRequest]) (*connect.Response[v1.GetOAuthURLResponse], error)
 	// OAuthCallback exchanges an OAuth authorization code for tokens.
 	// Tokens are set as HttpOnly cookies (not in the response body).
@@ -158,10 +158,12 @@ func (x *GetOAuthURLResponse) GetUrl() string {
 }
 
 type OAuthCallbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      OAuthProvider          `protobuf:"varint,1,opt,name=provider,proto3,enum=auth.v1.OAuthProvider" json:"provider,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider OAuthProvider          `protobuf:"varint,1,opt,name=provider,proto3,enum=auth.v1.OAuthProvider" json:"provider,omitempty"`
+	Code     string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// The state echoed back by the provider. It must match the one issued by GetOAuthURL
+	// and can be used only once.
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7,6 +7,7 @@
 package eventv1
 
 import (
+	v1 "github.com/mickamy/sampay/gen/user/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +23,127 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RemainderPolicy decides who covers the yen left over when the total does not split evenly.
+// Participant amounts plus the event remainder always add up to the total amount.
+type RemainderPolicy int32
+
+const (
+	RemainderPolicy_REMAINDER_POLICY_UNSPECIFIED RemainderPolicy = 0
+	// ORGANIZER absorbs the leftover as the event remainder.
+	RemainderPolicy_REMAINDER_POLICY_ORGANIZER RemainderPolicy = 1
+	// ROUND_UP_* round each share up; what they come to beyond the total goes to the organizer, reported as
+	// the surplus and as a negative remainder.
+	RemainderPolicy_REMAINDER_POLICY_ROUND_UP_10  RemainderPolicy = 2
+	RemainderPolicy_REMAINDER_POLICY_ROUND_UP_100 RemainderPolicy = 3
+	RemainderPolicy_REMAINDER_POLICY_ROUND_UP_500 RemainderPolicy = 4
+	// DISTRIBUTE spreads the leftover one yen at a time, heavier tiers first and then in join order.
+	RemainderPolicy_REMAINDER_POLICY_DISTRIBUTE RemainderPolicy = 5
+)
+
+// Enum value maps for RemainderPolicy.
+var (
+	RemainderPolicy_name = map[int32]string{
+		0: "REMAINDER_POLICY_UNSPECIFIED",
+		1: "REMAINDER_POLICY_ORGANIZER",
+		2: "REMAINDER_POLICY_ROUND_UP_10",
+		3: "REMAINDER_POLICY_ROUND_UP_100",
+		4: "REMAINDER_POLICY_ROUND_UP_500",
+		5: "REMAINDER_POLICY_DISTRIBUTE",
+	}
+	RemainderPolicy_value = map[string]int32{
+		"REMAINDER_POLICY_UNSPECIFIED":  0,
+		"REMAINDER_POLICY_ORGANIZER":    1,
+		"REMAINDER_POLICY_ROUND_UP_10":  2,
+		"REMAINDER_POLICY_ROUND_UP_100": 3,
+		"REMAINDER_POLICY_ROUND_UP_500": 4,
+		"REMAINDER_POLICY_DISTRIBUTE":   5,
+	}
+)
+
+func (x RemainderPolicy) Enum() *RemainderPolicy {
+	p := new(RemainderPolicy)
+	*p = x
+	return p
+}
+
+func (x RemainderPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RemainderPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_v1_event_proto_enumTypes[0].Descriptor()
+}
+
+func (RemainderPolicy) Type() protoreflect.EnumType {
+	return &file_event_v1_event_proto_enumTypes[0]
+}
+
+func (x RemainderPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RemainderPolicy.Descriptor instead.
+func (RemainderPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+// SplitMode decides how the total is split among participants.
+type SplitMode int32
+
+const (
+	SplitMode_SPLIT_MODE_UNSPECIFIED SplitMode = 0
+	// TIER splits by tier weight over every tier's capacity, so a join does not change what others owe.
+	SplitMode_SPLIT_MODE_TIER SplitMode = 1
+	// ATTENDANCE splits among the participants in proportion to how much of the event they attended.
+	// Everyone's amount changes when someone joins, leaves or has their attendance changed.
+	SplitMode_SPLIT_MODE_ATTENDANCE SplitMode = 2
+	// ATTENDANCE_TIER weighs each participant's attendance by their tier's weight as well.
+	SplitMode_SPLIT_MODE_ATTENDANCE_TIER SplitMode = 3
+)
+
+// Enum value maps for SplitMode.
+var (
+	SplitMode_name = map[int32]string{
+		0: "SPLIT_MODE_UNSPECIFIED",
+		1: "SPLIT_MODE_TIER",
+		2: "SPLIT_MODE_ATTENDANCE",
+		3: "SPLIT_MODE_ATTENDANCE_TIER",
+	}
+	SplitMode_value = map[string]int32{
+		"SPLIT_MODE_UNSPECIFIED":     0,
+		"SPLIT_MODE_TIER":            1,
+		"SPLIT_MODE_ATTENDANCE":      2,
+		"SPLIT_MODE_ATTENDANCE_TIER": 3,
+	}
+)
+
+func (x SplitMode) Enum() *SplitMode {
+	p := new(SplitMode)
+	*p = x
+	return p
+}
+
+func (x SplitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_v1_event_proto_enumTypes[1].Descriptor()
+}
+
+func (SplitMode) Type() protoreflect.EnumType {
+	return &file_event_v1_event_proto_enumTypes[1]
+}
+
+func (x SplitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitMode.Descriptor instead.
+func (SplitMode) EnumDescriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{1}
+}
+
 type ParticipantStatus int32
 
 const (
@@ -29,6 +151,10 @@ const (
 	ParticipantStatus_PARTICIPANT_STATUS_UNPAID      ParticipantStatus = 1
 	ParticipantStatus_PARTICIPANT_STATUS_CLAIMED     ParticipantStatus = 2
 	ParticipantStatus_PARTICIPANT_STATUS_CONFIRMED   ParticipantStatus = 3
+	// WAITLISTED participants joined a full tier. They are promoted to UNPAID when a spot frees up.
+	ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED ParticipantStatus = 4
+	// PARTIALLY_PAID participants paid some but not all of their amount. It follows from the recorded payments.
+	ParticipantStatus_PARTICIPANT_STATUS_PARTIALLY_PAID ParticipantStatus = 5
 )
 
 // Enum value maps for ParticipantStatus.
@@ -38,12 +164,16 @@ var (
 		1: "PARTICIPANT_STATUS_UNPAID",
 		2: "PARTICIPANT_STATUS_CLAIMED",
 		3: "PARTICIPANT_STATUS_CONFIRMED",
+		4: "PARTICIPANT_STATUS_WAITLISTED",
+		5: "PARTICIPANT_STATUS_PARTIALLY_PAID",
 	}
 	ParticipantStatus_value = map[string]int32{
-		"PARTICIPANT_STATUS_UNSPECIFIED": 0,
-		"PARTICIPANT_STATUS_UNPAID":      1,
-		"PARTICIPANT_STATUS_CLAIMED":     2,
-		"PARTICIPANT_STATUS_CONFIRMED":   3,
+		"PARTICIPANT_STATUS_UNSPECIFIED":    0,
+		"PARTICIPANT_STATUS_UNPAID":         1,
+		"PARTICIPANT_STATUS_CLAIMED":        2,
+		"PARTICIPANT_STATUS_CONFIRMED":      3,
+		"PARTICIPANT_STATUS_WAITLISTED":     4,
+		"PARTICIPANT_STATUS_PARTIALLY_PAID": 5,
 	}
 )
 
@@ -58,11 +188,11 @@ func (x ParticipantStatus) String() string {
 }
 
 func (ParticipantStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_event_v1_event_proto_enumTypes[0].Descriptor()
+	return file_event_v1_event_proto_enumTypes[2].Descriptor()
 }
 
 func (ParticipantStatus) Type() protoreflect.EnumType {
-	return &file_event_v1_event_proto_enumTypes[0]
+	return &file_event_v1_event_proto_enumTypes[2]
 }
 
 func (x ParticipantStatus) Number() protoreflect.EnumNumber {
@@ -71,21 +201,111 @@ func (x ParticipantStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ParticipantStatus.Descriptor instead.
 func (ParticipantStatus) EnumDescriptor() ([]byte, []int) {
-	return file_event_v1_event_proto_rawDescGZIP(), []int{0}
+	return file_event_v1_event_proto_rawDescGZIP(), []int{2}
+}
+
+type ParticipantStatusActor int32
+
+const (
+	ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_UNSPECIFIED ParticipantStatusActor = 0
+	ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_ORGANIZER   ParticipantStatusActor = 1
+	ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_PARTICIPANT ParticipantStatusActor = 2
+	// SYSTEM is for changes nobody asked for directly, such as promotion from the waitlist.
+	ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_SYSTEM ParticipantStatusActor = 3
+)
+
+// Enum value maps for ParticipantStatusActor.
+var (
+	ParticipantStatusActor_name = map[int32]string{
+		0: "PARTICIPANT_STATUS_ACTOR_UNSPECIFIED",
+		1: "PARTICIPANT_STATUS_ACTOR_ORGANIZER",
+		2: "PARTICIPANT_STATUS_ACTOR_PARTICIPANT",
+		3: "PARTICIPANT_STATUS_ACTOR_SYSTEM",
+	}
+	ParticipantStatusActor_value = map[string]int32{
+		"PARTICIPANT_STATUS_ACTOR_UNSPECIFIED": 0,
+		"PARTICIPANT_STATUS_ACTOR_ORGANIZER":   1,
+		"PARTICIPANT_STATUS_ACTOR_PARTICIPANT": 2,
+		"PARTICIPANT_STATUS_ACTOR_SYSTEM":      3,
+	}
+)
+
+func (x ParticipantStatusActor) Enum() *ParticipantStatusActor {
+	p := new(ParticipantStatusActor)
+	*p = x
+	return p
+}
+
+func (x ParticipantStatusActor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParticipantStatusActor) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_v1_event_proto_enumTypes[3].Descriptor()
+}
+
+func (ParticipantStatusActor) Type() protoreflect.EnumType {
+	return &file_event_v1_event_proto_enumTypes[3]
+}
+
+func (x ParticipantStatusActor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParticipantStatusActor.Descriptor instead.
+func (ParticipantStatusActor) EnumDescriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{3}
 }
 
 type Event struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	TotalAmount   int32                  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Remainder     int32                  `protobuf:"varint,6,opt,name=remainder,proto3" json:"remainder,omitempty"`
-	TierCount     int32                  `protobuf:"varint,7,opt,name=tier_count,json=tierCount,proto3" json:"tier_count,omitempty"`
-	HeldAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"`
-	Tiers         []*EventTier           `protobuf:"bytes,9,rep,name=tiers,proto3" json:"tiers,omitempty"`
-	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TotalAmount int32                  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// remainder is what the organizer covers on top of the participant amounts, negative when a ROUND_UP_* policy
+	// has them come to more; surplus reports that excess.
+	Remainder       int32                  `protobuf:"varint,6,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	TierCount       int32                  `protobuf:"varint,7,opt,name=tier_count,json=tierCount,proto3" json:"tier_count,omitempty"`
+	HeldAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"`
+	Tiers           []*EventTier           `protobuf:"bytes,9,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	ArchivedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	RemainderPolicy RemainderPolicy        `protobuf:"varint,11,opt,name=remainder_policy,json=remainderPolicy,proto3,enum=event.v1.RemainderPolicy" json:"remainder_policy,omitempty"`
+	// currency is an ISO 4217 code. Every amount of the event is in its minor unit, e.g. cents for USD.
+	Currency string `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	// settlement_currency is what participants pay in, empty when it is currency itself.
+	SettlementCurrency string `protobuf:"bytes,13,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	// exchange_rate is the snapshotted number of settlement_currency units per currency unit, e.g. "151.25".
+	ExchangeRate   string                 `protobuf:"bytes,14,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	ExchangeRateAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=exchange_rate_at,json=exchangeRateAt,proto3,oneof" json:"exchange_rate_at,omitempty"`
+	// settlement_total_amount is the grand total of all rounds converted into settlement_currency, zero without one.
+	SettlementTotalAmount int32     `protobuf:"varint,16,opt,name=settlement_total_amount,json=settlementTotalAmount,proto3" json:"settlement_total_amount,omitempty"`
+	SplitMode             SplitMode `protobuf:"varint,17,opt,name=split_mode,json=splitMode,proto3,enum=event.v1.SplitMode" json:"split_mode,omitempty"`
+	// ends_at is when the event ends, which turns participants' arrival and departure times into attendance.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
+	// deposit_amount is what each participant pays up front while total_amount is an estimate, unset without one.
+	DepositAmount *int32 `protobuf:"varint,19,opt,name=deposit_amount,json=depositAmount,proto3,oneof" json:"deposit_amount,omitempty"`
+	// deposit_settled_at is when the actual total was entered and the deposits were settled up against it.
+	DepositSettledAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=deposit_settled_at,json=depositSettledAt,proto3,oneof" json:"deposit_settled_at,omitempty"`
+	// estimated_total_amount is what the organizer announced before the bill arrived, unset when total_amount
+	// was known from the start. total_amount holds the estimate until finalized_at.
+	EstimatedTotalAmount *int32 `protobuf:"varint,21,opt,name=estimated_total_amount,json=estimatedTotalAmount,proto3,oneof" json:"estimated_total_amount,omitempty"`
+	// finalized_at is when FinalizeEvent entered the actual total.
+	FinalizedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=finalized_at,json=finalizedAt,proto3,oneof" json:"finalized_at,omitempty"`
+	// subtotal_amount is the bill total_amount was computed from, unset when total_amount was entered as is.
+	// The fields below break total_amount down along with it; rates are percentages such as "10".
+	SubtotalAmount      *int32 `protobuf:"varint,23,opt,name=subtotal_amount,json=subtotalAmount,proto3,oneof" json:"subtotal_amount,omitempty"`
+	TaxRate             string `protobuf:"bytes,24,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxIncluded         bool   `protobuf:"varint,25,opt,name=tax_included,json=taxIncluded,proto3" json:"tax_included,omitempty"`
+	ServiceChargeRate   string `protobuf:"bytes,26,opt,name=service_charge_rate,json=serviceChargeRate,proto3" json:"service_charge_rate,omitempty"`
+	TipRate             string `protobuf:"bytes,27,opt,name=tip_rate,json=tipRate,proto3" json:"tip_rate,omitempty"`
+	ServiceChargeAmount int32  `protobuf:"varint,28,opt,name=service_charge_amount,json=serviceChargeAmount,proto3" json:"service_charge_amount,omitempty"`
+	// tax_amount is the tax added to the bill, or the tax it includes when tax_included.
+	TaxAmount int32 `protobuf:"varint,29,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	TipAmount int32 `protobuf:"varint,30,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`
+	// surplus is what the participant amounts come to beyond total_amount when rounded up, zero otherwise.
+	Surplus       int32 `protobuf:"varint,31,opt,name=surplus,proto3" json:"surplus,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -190,13 +410,163 @@ func (x *Event) GetArchivedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Event) GetRemainderPolicy() RemainderPolicy {
+	if x != nil {
+		return x.RemainderPolicy
+	}
+	return RemainderPolicy_REMAINDER_POLICY_UNSPECIFIED
+}
+
+func (x *Event) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Event) GetSettlementCurrency() string {
+	if x != nil {
+		return x.SettlementCurrency
+	}
+	return ""
+}
+
+func (x *Event) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *Event) GetExchangeRateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateAt
+	}
+	return nil
+}
+
+func (x *Event) GetSettlementTotalAmount() int32 {
+	if x != nil {
+		return x.SettlementTotalAmount
+	}
+	return 0
+}
+
+func (x *Event) GetSplitMode() SplitMode {
+	if x != nil {
+		return x.SplitMode
+	}
+	return SplitMode_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *Event) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Event) GetDepositAmount() int32 {
+	if x != nil && x.DepositAmount != nil {
+		return *x.DepositAmount
+	}
+	return 0
+}
+
+func (x *Event) GetDepositSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepositSettledAt
+	}
+	return nil
+}
+
+func (x *Event) GetEstimatedTotalAmount() int32 {
+	if x != nil && x.EstimatedTotalAmount != nil {
+		return *x.EstimatedTotalAmount
+	}
+	return 0
+}
+
+func (x *Event) GetFinalizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalizedAt
+	}
+	return nil
+}
+
+func (x *Event) GetSubtotalAmount() int32 {
+	if x != nil && x.SubtotalAmount != nil {
+		return *x.SubtotalAmount
+	}
+	return 0
+}
+
+func (x *Event) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *Event) GetTaxIncluded() bool {
+	if x != nil {
+		return x.TaxIncluded
+	}
+	return false
+}
+
+func (x *Event) GetServiceChargeRate() string {
+	if x != nil {
+		return x.ServiceChargeRate
+	}
+	return ""
+}
+
+func (x *Event) GetTipRate() string {
+	if x != nil {
+		return x.TipRate
+	}
+	return ""
+}
+
+func (x *Event) GetServiceChargeAmount() int32 {
+	if x != nil {
+		return x.ServiceChargeAmount
+	}
+	return 0
+}
+
+func (x *Event) GetTaxAmount() int32 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+func (x *Event) GetTipAmount() int32 {
+	if x != nil {
+		return x.TipAmount
+	}
+	return 0
+}
+
+func (x *Event) GetSurplus() int32 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
 type EventTier struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Tier          int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
-	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Amount        int32                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Tier    int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Count   int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Amount  int32                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Name    string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// weight is a decimal such as "2" or "0.5", relative to the other tiers.
+	Weight        string `protobuf:"bytes,7,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -266,10 +636,29 @@ func (x *EventTier) GetAmount() int32 {
 	return 0
 }
 
+func (x *EventTier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventTier) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
 type TierConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tier          int32                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tier  int32                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	Count int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// name labels the tier, e.g. "students".
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// weight is a decimal such as "2" or "0.5" with up to 3 fractional digits.
+	// It defaults to the tier number when empty.
+	Weight        string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -318,16 +707,60 @@ func (x *TierConfig) GetCount() int32 {
 	return 0
 }
 
+func (x *TierConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TierConfig) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
 type EventInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	TotalAmount   int32                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	TierCount     int32                  `protobuf:"varint,4,opt,name=tier_count,json=tierCount,proto3" json:"tier_count,omitempty"`
-	HeldAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"`
-	Tiers         []*TierConfig          `protobuf:"bytes,6,rep,name=tiers,proto3" json:"tiers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	TotalAmount int32                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TierCount   int32                  `protobuf:"varint,4,opt,name=tier_count,json=tierCount,proto3" json:"tier_count,omitempty"`
+	HeldAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=held_at,json=heldAt,proto3" json:"held_at,omitempty"`
+	Tiers       []*TierConfig          `protobuf:"bytes,6,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// remainder_policy defaults to ORGANIZER when creating an event, and stays as it is on update when unspecified.
+	RemainderPolicy RemainderPolicy `protobuf:"varint,7,opt,name=remainder_policy,json=remainderPolicy,proto3,enum=event.v1.RemainderPolicy" json:"remainder_policy,omitempty"`
+	// currency defaults to JPY when creating an event. total_amount is in its minor unit.
+	// On update, an empty currency stays as it is, and so does the settlement unless another one is set.
+	Currency string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	// settlement_currency and exchange_rate are set together to have participants pay in another currency.
+	// Resending the event's rate keeps when it was quoted.
+	SettlementCurrency string `protobuf:"bytes,9,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	ExchangeRate       string `protobuf:"bytes,10,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// exchange_rate_at is when an imported rate was quoted. It defaults to now.
+	ExchangeRateAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=exchange_rate_at,json=exchangeRateAt,proto3,oneof" json:"exchange_rate_at,omitempty"`
+	// rounds are the later rounds, e.g. the second venue, numbered from 2 in order. The event itself is round 1.
+	Rounds []*RoundConfig `protobuf:"bytes,12,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	// split_mode defaults to TIER when unspecified.
+	SplitMode SplitMode              `protobuf:"varint,13,opt,name=split_mode,json=splitMode,proto3,enum=event.v1.SplitMode" json:"split_mode,omitempty"`
+	EndsAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=ends_at,json=endsAt,proto3,oneof" json:"ends_at,omitempty"`
+	// deposit_amount has participants pay a fixed amount up front until FinalizeEvent enters the actual total.
+	DepositAmount *int32 `protobuf:"varint,15,opt,name=deposit_amount,json=depositAmount,proto3,oneof" json:"deposit_amount,omitempty"`
+	// total_estimated marks total_amount as an estimate until FinalizeEvent enters the actual total.
+	// Only CreateEvent reads it; UpdateEvent revises the estimate of an estimated event instead.
+	TotalEstimated bool `protobuf:"varint,16,opt,name=total_estimated,json=totalEstimated,proto3" json:"total_estimated,omitempty"`
+	// subtotal_amount itemizes the bill: when set, total_amount is ignored and computed from it with
+	// the service charge on the subtotal, tax on both, and the tip on the subtotal, each rounded down.
+	// Rates are percentages such as "10" or "8.25", empty for none.
+	SubtotalAmount *int32 `protobuf:"varint,17,opt,name=subtotal_amount,json=subtotalAmount,proto3,oneof" json:"subtotal_amount,omitempty"`
+	TaxRate        string `protobuf:"bytes,18,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	// tax_included is true when subtotal_amount already includes tax, as prices in Japan usually do.
+	TaxIncluded       bool   `protobuf:"varint,19,opt,name=tax_included,json=taxIncluded,proto3" json:"tax_included,omitempty"`
+	ServiceChargeRate string `protobuf:"bytes,20,opt,name=service_charge_rate,json=serviceChargeRate,proto3" json:"service_charge_rate,omitempty"`
+	TipRate           string `protobuf:"bytes,21,opt,name=tip_rate,json=tipRate,proto3" json:"tip_rate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *EventInput) Reset() {
@@ -402,103 +835,1212 @@ func (x *EventInput) GetTiers() []*TierConfig {
 	return nil
 }
 
-type EventParticipant struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Tier          int32                  `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
-	Status        ParticipantStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=event.v1.ParticipantStatus" json:"status,omitempty"`
-	Amount        int32                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *EventParticipant) Reset() {
-	*x = EventParticipant{}
-	mi := &file_event_v1_event_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *EventParticipant) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EventParticipant) ProtoMessage() {}
-
-func (x *EventParticipant) ProtoReflect() protoreflect.Message {
-	mi := &file_event_v1_event_proto_msgTypes[4]
+func (x *EventInput) GetRemainderPolicy() RemainderPolicy {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RemainderPolicy
 	}
-	return mi.MessageOf(x)
+	return RemainderPolicy_REMAINDER_POLICY_UNSPECIFIED
 }
 
-// Deprecated: Use EventParticipant.ProtoReflect.Descriptor instead.
-func (*EventParticipant) Descriptor() ([]byte, []int) {
-	return file_event_v1_event_proto_rawDescGZIP(), []int{4}
+func (x *EventInput) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *EventParticipant) GetId() string {
+func (x *EventInput) GetSettlementCurrency() string {
 	if x != nil {
-		return x.Id
+		return x.SettlementCurrency
 	}
 	return ""
 }
 
-func (x *EventParticipant) GetEventId() string {
+func (x *EventInput) GetExchangeRate() string {
 	if x != nil {
-		return x.EventId
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *EventInput) GetExchangeRateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExchangeRateAt
+	}
+	return nil
+}
+
+func (x *EventInput) GetRounds() []*RoundConfig {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *EventInput) GetSplitMode() SplitMode {
+	if x != nil {
+		return x.SplitMode
+	}
+	return SplitMode_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *EventInput) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *EventInput) GetDepositAmount() int32 {
+	if x != nil && x.DepositAmount != nil {
+		return *x.DepositAmount
+	}
+	return 0
+}
+
+func (x *EventInput) GetTotalEstimated() bool {
+	if x != nil {
+		return x.TotalEstimated
+	}
+	return false
+}
+
+func (x *EventInput) GetSubtotalAmount() int32 {
+	if x != nil && x.SubtotalAmount != nil {
+		return *x.SubtotalAmount
+	}
+	return 0
+}
+
+func (x *EventInput) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *EventInput) GetTaxIncluded() bool {
+	if x != nil {
+		return x.TaxIncluded
+	}
+	return false
+}
+
+func (x *EventInput) GetServiceChargeRate() string {
+	if x != nil {
+		return x.ServiceChargeRate
+	}
+	return ""
+}
+
+func (x *EventInput) GetTipRate() string {
+	if x != nil {
+		return x.TipRate
+	}
+	return ""
+}
+
+type RoundConfig struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Round       int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TotalAmount int32                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// tiers configure every tier of the event for this round; count is how many seats it has.
+	Tiers         []*TierConfig `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundConfig) Reset() {
+	*x = RoundConfig{}
+	mi := &file_event_v1_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundConfig) ProtoMessage() {}
+
+func (x *RoundConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundConfig.ProtoReflect.Descriptor instead.
+func (*RoundConfig) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoundConfig) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *RoundConfig) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RoundConfig) GetTotalAmount() int32 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *RoundConfig) GetTiers() []*TierConfig {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+// EventRound is one round of the event split among those who took part in it.
+type EventRound struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TotalAmount   int32                  `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Remainder     int32                  `protobuf:"varint,4,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	Tiers         []*EventRoundTier      `protobuf:"bytes,5,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	Shares        []*RoundShare          `protobuf:"bytes,6,rep,name=shares,proto3" json:"shares,omitempty"`
+	Surplus       int32                  `protobuf:"varint,7,opt,name=surplus,proto3" json:"surplus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRound) Reset() {
+	*x = EventRound{}
+	mi := &file_event_v1_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRound) ProtoMessage() {}
+
+func (x *EventRound) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRound.ProtoReflect.Descriptor instead.
+func (*EventRound) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventRound) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *EventRound) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EventRound) GetTotalAmount() int32 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *EventRound) GetRemainder() int32 {
+	if x != nil {
+		return x.Remainder
+	}
+	return 0
+}
+
+func (x *EventRound) GetTiers() []*EventRoundTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *EventRound) GetShares() []*RoundShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *EventRound) GetSurplus() int32 {
+	if x != nil {
+		return x.Surplus
+	}
+	return 0
+}
+
+type EventRoundTier struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tier   int32                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Weight string                 `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Count  int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// amount is what a seat of the tier costs in this round, before fixed amounts.
+	Amount        int32 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventRoundTier) Reset() {
+	*x = EventRoundTier{}
+	mi := &file_event_v1_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventRoundTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRoundTier) ProtoMessage() {}
+
+func (x *EventRoundTier) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRoundTier.ProtoReflect.Descriptor instead.
+func (*EventRoundTier) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventRoundTier) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *EventRoundTier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventRoundTier) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+func (x *EventRoundTier) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventRoundTier) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// RoundShare is what a participant owes for a round, which all add up to their amount.
+type RoundShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundShare) Reset() {
+	*x = RoundShare{}
+	mi := &file_event_v1_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundShare) ProtoMessage() {}
+
+func (x *RoundShare) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundShare.ProtoReflect.Descriptor instead.
+func (*RoundShare) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RoundShare) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RoundShare) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type EventParticipant struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId   string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Tier      int32                  `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Status    ParticipantStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=event.v1.ParticipantStatus" json:"status,omitempty"`
+	Amount    int32                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// fixed_amount is set when the organizer pinned what this participant pays.
+	FixedAmount *int32 `protobuf:"varint,8,opt,name=fixed_amount,json=fixedAmount,proto3,oneof" json:"fixed_amount,omitempty"`
+	// claimed_at is when the participant said they paid. It is cleared if the organizer sends the claim back.
+	ClaimedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=claimed_at,json=claimedAt,proto3,oneof" json:"claimed_at,omitempty"`
+	ConfirmedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=confirmed_at,json=confirmedAt,proto3,oneof" json:"confirmed_at,omitempty"`
+	// paid_amount is the sum of the recorded payments.
+	PaidAmount int32 `protobuf:"varint,11,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	// adjustment_amount is what the bill changed by after the participant paid or claimed, the sum of their
+	// adjustments. amount plus adjustment_amount minus paid_amount is what is still owed, or a refund owed
+	// when negative.
+	AdjustmentAmount int32 `protobuf:"varint,12,opt,name=adjustment_amount,json=adjustmentAmount,proto3" json:"adjustment_amount,omitempty"`
+	// arrived_at and left_at are set when the participant came late or left early.
+	ArrivedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=arrived_at,json=arrivedAt,proto3,oneof" json:"arrived_at,omitempty"`
+	LeftAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=left_at,json=leftAt,proto3,oneof" json:"left_at,omitempty"`
+	// attendance is a decimal from "0" to "1" set instead of the times, empty when they apply.
+	Attendance string `protobuf:"bytes,15,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	// settlement_amount is amount in the event's settlement_currency, the participant's share of
+	// settlement_total_amount; zero without a settlement currency.
+	SettlementAmount int32 `protobuf:"varint,16,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EventParticipant) Reset() {
+	*x = EventParticipant{}
+	mi := &file_event_v1_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventParticipant) ProtoMessage() {}
+
+func (x *EventParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventParticipant.ProtoReflect.Descriptor instead.
+func (*EventParticipant) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventParticipant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventParticipant) GetEventId() string {
+	if x != nil {
+		return x.EventId
 	}
 	return ""
 }
 
 func (x *EventParticipant) GetName() string {
 	if x != nil {
-		return x.Name
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventParticipant) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *EventParticipant) GetStatus() ParticipantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
+}
+
+func (x *EventParticipant) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EventParticipant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventParticipant) GetFixedAmount() int32 {
+	if x != nil && x.FixedAmount != nil {
+		return *x.FixedAmount
+	}
+	return 0
+}
+
+func (x *EventParticipant) GetClaimedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+func (x *EventParticipant) GetConfirmedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *EventParticipant) GetPaidAmount() int32 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *EventParticipant) GetAdjustmentAmount() int32 {
+	if x != nil {
+		return x.AdjustmentAmount
+	}
+	return 0
+}
+
+func (x *EventParticipant) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *EventParticipant) GetLeftAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeftAt
+	}
+	return nil
+}
+
+func (x *EventParticipant) GetAttendance() string {
+	if x != nil {
+		return x.Attendance
+	}
+	return ""
+}
+
+func (x *EventParticipant) GetSettlementAmount() int32 {
+	if x != nil {
+		return x.SettlementAmount
+	}
+	return 0
+}
+
+// ParticipantStatusChange is an entry of a participant's status history.
+type ParticipantStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// from_status is UNSPECIFIED for the status the participant joined with.
+	FromStatus    ParticipantStatus      `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=event.v1.ParticipantStatus" json:"from_status,omitempty"`
+	ToStatus      ParticipantStatus      `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=event.v1.ParticipantStatus" json:"to_status,omitempty"`
+	Actor         ParticipantStatusActor `protobuf:"varint,5,opt,name=actor,proto3,enum=event.v1.ParticipantStatusActor" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantStatusChange) Reset() {
+	*x = ParticipantStatusChange{}
+	mi := &file_event_v1_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantStatusChange) ProtoMessage() {}
+
+func (x *ParticipantStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantStatusChange.ProtoReflect.Descriptor instead.
+func (*ParticipantStatusChange) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ParticipantStatusChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParticipantStatusChange) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantStatusChange) GetFromStatus() ParticipantStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
+}
+
+func (x *ParticipantStatusChange) GetToStatus() ParticipantStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
+}
+
+func (x *ParticipantStatusChange) GetActor() ParticipantStatusActor {
+	if x != nil {
+		return x.Actor
+	}
+	return ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_UNSPECIFIED
+}
+
+func (x *ParticipantStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ParticipantStatusChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ParticipantPayment is money the organizer received from a participant, in the currency of their amount.
+// A negative amount is a refund the organizer paid back.
+type ParticipantPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// payment_method_type is UNSPECIFIED when the money came outside the organizer's payment methods, e.g. in cash.
+	PaymentMethodType v1.PaymentMethodType   `protobuf:"varint,4,opt,name=payment_method_type,json=paymentMethodType,proto3,enum=user.v1.PaymentMethodType" json:"payment_method_type,omitempty"`
+	Note              string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	PaidAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ParticipantPayment) Reset() {
+	*x = ParticipantPayment{}
+	mi := &file_event_v1_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantPayment) ProtoMessage() {}
+
+func (x *ParticipantPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParticipantPayment.ProtoReflect.Descriptor instead.
+func (*ParticipantPayment) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ParticipantPayment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParticipantPayment) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantPayment) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ParticipantPayment) GetPaymentMethodType() v1.PaymentMethodType {
+	if x != nil {
+		return x.PaymentMethodType
+	}
+	return v1.PaymentMethodType(0)
+}
+
+func (x *ParticipantPayment) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ParticipantPayment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+// Refund is money the organizer owes back to a participant, e.g. what their deposit covered beyond the actual cost.
+type Refund struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventTitle string                 `protobuf:"bytes,2,opt,name=event_title,json=eventTitle,proto3" json:"event_title,omitempty"`
+	// currency is the event's, which amount is in.
+	Currency    string            `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Participant *EventParticipant `protobuf:"bytes,4,opt,name=participant,proto3" json:"participant,omitempty"`
+	Amount      int32             `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// settlement_currency and settlement_amount are the refund in the currency the participant paid in,
+	// empty and zero when it is currency itself.
+	SettlementCurrency string `protobuf:"bytes,6,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	SettlementAmount   int32  `protobuf:"varint,7,opt,name=settlement_amount,json=settlementAmount,proto3" json:"settlement_amount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_event_v1_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Refund) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Refund) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *Refund) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Refund) GetParticipant() *EventParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *Refund) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Refund) GetSettlementCurrency() string {
+	if x != nil {
+		return x.SettlementCurrency
 	}
 	return ""
 }
 
-func (x *EventParticipant) GetTier() int32 {
+func (x *Refund) GetSettlementAmount() int32 {
 	if x != nil {
-		return x.Tier
+		return x.SettlementAmount
 	}
 	return 0
 }
 
-func (x *EventParticipant) GetStatus() ParticipantStatus {
+// ParticipantAdjustment is a change to what a participant owes made by editing the event after they paid
+// or claimed: an additional charge when amount is positive and a refund owed when negative.
+type ParticipantAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParticipantAdjustment) Reset() {
+	*x = ParticipantAdjustment{}
+	mi := &file_event_v1_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParticipantAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantAdjustment) ProtoMessage() {}
+
+func (x *ParticipantAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[12]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *EventParticipant) GetAmount() int32 {
+// Deprecated: Use ParticipantAdjustment.ProtoReflect.Descriptor instead.
+func (*ParticipantAdjustment) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ParticipantAdjustment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ParticipantAdjustment) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ParticipantAdjustment) GetAmount() int32 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *EventParticipant) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ParticipantAdjustment) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ParticipantAdjustment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Expense is something one participant paid for on behalf of others.
+type Expense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PayerId       string                 `protobuf:"bytes,3,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Amount        int32                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Shares        []*ExpenseShare        `protobuf:"bytes,6,rep,name=shares,proto3" json:"shares,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_event_v1_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Expense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Expense) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Expense) GetPayerId() string {
+	if x != nil {
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *Expense) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Expense) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Expense) GetShares() []*ExpenseShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
+type ExpenseShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseShare) Reset() {
+	*x = ExpenseShare{}
+	mi := &file_event_v1_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseShare) ProtoMessage() {}
+
+func (x *ExpenseShare) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseShare.ProtoReflect.Descriptor instead.
+func (*ExpenseShare) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExpenseShare) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ExpenseShare) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Balance is where a participant stands across all expenses. A positive net means others owe them.
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Paid          int32                  `protobuf:"varint,2,opt,name=paid,proto3" json:"paid,omitempty"`
+	Owed          int32                  `protobuf:"varint,3,opt,name=owed,proto3" json:"owed,omitempty"`
+	Net           int32                  `protobuf:"varint,4,opt,name=net,proto3" json:"net,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_event_v1_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Balance) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *Balance) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *Balance) GetOwed() int32 {
+	if x != nil {
+		return x.Owed
+	}
+	return 0
+}
+
+func (x *Balance) GetNet() int32 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+// Transfer is a payment that settles up the expenses.
+type Transfer struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FromParticipantId string                 `protobuf:"bytes,1,opt,name=from_participant_id,json=fromParticipantId,proto3" json:"from_participant_id,omitempty"`
+	ToParticipantId   string                 `protobuf:"bytes,2,opt,name=to_participant_id,json=toParticipantId,proto3" json:"to_participant_id,omitempty"`
+	Amount            int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_event_v1_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Transfer) GetFromParticipantId() string {
+	if x != nil {
+		return x.FromParticipantId
+	}
+	return ""
+}
+
+func (x *Transfer) GetToParticipantId() string {
+	if x != nil {
+		return x.ToParticipantId
+	}
+	return ""
+}
+
+func (x *Transfer) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 var File_event_v1_event_proto protoreflect.FileDescriptor
 
 const file_event_v1_event_proto_rawDesc = "" +
 	"\n" +
-	"\x14event/v1/event.proto\x12\bevent.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x02\n" +
+	"\x14event/v1/event.proto\x12\bevent.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cuser/v1/payment_method.proto\"\xeb\v\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -512,18 +2054,54 @@ const file_event_v1_event_proto_rawDesc = "" +
 	"\x05tiers\x18\t \x03(\v2\x13.event.v1.EventTierR\x05tiers\x12@\n" +
 	"\varchived_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
-	"archivedAt\x88\x01\x01B\x0e\n" +
-	"\f_archived_at\"x\n" +
+	"archivedAt\x88\x01\x01\x12D\n" +
+	"\x10remainder_policy\x18\v \x01(\x0e2\x19.event.v1.RemainderPolicyR\x0fremainderPolicy\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x12/\n" +
+	"\x13settlement_currency\x18\r \x01(\tR\x12settlementCurrency\x12#\n" +
+	"\rexchange_rate\x18\x0e \x01(\tR\fexchangeRate\x12I\n" +
+	"\x10exchange_rate_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0eexchangeRateAt\x88\x01\x01\x126\n" +
+	"\x17settlement_total_amount\x18\x10 \x01(\x05R\x15settlementTotalAmount\x122\n" +
+	"\n" +
+	"split_mode\x18\x11 \x01(\x0e2\x13.event.v1.SplitModeR\tsplitMode\x128\n" +
+	"\aends_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\x06endsAt\x88\x01\x01\x12*\n" +
+	"\x0edeposit_amount\x18\x13 \x01(\x05H\x03R\rdepositAmount\x88\x01\x01\x12M\n" +
+	"\x12deposit_settled_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x10depositSettledAt\x88\x01\x01\x129\n" +
+	"\x16estimated_total_amount\x18\x15 \x01(\x05H\x05R\x14estimatedTotalAmount\x88\x01\x01\x12B\n" +
+	"\ffinalized_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\vfinalizedAt\x88\x01\x01\x12,\n" +
+	"\x0fsubtotal_amount\x18\x17 \x01(\x05H\aR\x0esubtotalAmount\x88\x01\x01\x12\x19\n" +
+	"\btax_rate\x18\x18 \x01(\tR\ataxRate\x12!\n" +
+	"\ftax_included\x18\x19 \x01(\bR\vtaxIncluded\x12.\n" +
+	"\x13service_charge_rate\x18\x1a \x01(\tR\x11serviceChargeRate\x12\x19\n" +
+	"\btip_rate\x18\x1b \x01(\tR\atipRate\x122\n" +
+	"\x15service_charge_amount\x18\x1c \x01(\x05R\x13serviceChargeAmount\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\x1d \x01(\x05R\ttaxAmount\x12\x1d\n" +
+	"\n" +
+	"tip_amount\x18\x1e \x01(\x05R\ttipAmount\x12\x18\n" +
+	"\asurplus\x18\x1f \x01(\x05R\asurplusB\x0e\n" +
+	"\f_archived_atB\x13\n" +
+	"\x11_exchange_rate_atB\n" +
+	"\n" +
+	"\b_ends_atB\x11\n" +
+	"\x0f_deposit_amountB\x15\n" +
+	"\x13_deposit_settled_atB\x19\n" +
+	"\x17_estimated_total_amountB\x0f\n" +
+	"\r_finalized_atB\x12\n" +
+	"\x10_subtotal_amount\"\xa4\x01\n" +
 	"\tEventTier\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
 	"\x04tier\x18\x03 \x01(\x05R\x04tier\x12\x14\n" +
 	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x16\n" +
-	"\x06amount\x18\x05 \x01(\x05R\x06amount\"6\n" +
+	"\x06amount\x18\x05 \x01(\x05R\x06amount\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\a \x01(\tR\x06weight\"b\n" +
 	"\n" +
 	"TierConfig\x12\x12\n" +
 	"\x04tier\x18\x01 \x01(\x05R\x04tier\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"\xe7\x01\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\tR\x06weight\"\xdb\a\n" +
 	"\n" +
 	"EventInput\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
@@ -532,7 +2110,53 @@ const file_event_v1_event_proto_rawDesc = "" +
 	"\n" +
 	"tier_count\x18\x04 \x01(\x05R\ttierCount\x123\n" +
 	"\aheld_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06heldAt\x12*\n" +
-	"\x05tiers\x18\x06 \x03(\v2\x14.event.v1.TierConfigR\x05tiers\"\xed\x01\n" +
+	"\x05tiers\x18\x06 \x03(\v2\x14.event.v1.TierConfigR\x05tiers\x12D\n" +
+	"\x10remainder_policy\x18\a \x01(\x0e2\x19.event.v1.RemainderPolicyR\x0fremainderPolicy\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12/\n" +
+	"\x13settlement_currency\x18\t \x01(\tR\x12settlementCurrency\x12#\n" +
+	"\rexchange_rate\x18\n" +
+	" \x01(\tR\fexchangeRate\x12I\n" +
+	"\x10exchange_rate_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x0eexchangeRateAt\x88\x01\x01\x12-\n" +
+	"\x06rounds\x18\f \x03(\v2\x15.event.v1.RoundConfigR\x06rounds\x122\n" +
+	"\n" +
+	"split_mode\x18\r \x01(\x0e2\x13.event.v1.SplitModeR\tsplitMode\x128\n" +
+	"\aends_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06endsAt\x88\x01\x01\x12*\n" +
+	"\x0edeposit_amount\x18\x0f \x01(\x05H\x02R\rdepositAmount\x88\x01\x01\x12'\n" +
+	"\x0ftotal_estimated\x18\x10 \x01(\bR\x0etotalEstimated\x12,\n" +
+	"\x0fsubtotal_amount\x18\x11 \x01(\x05H\x03R\x0esubtotalAmount\x88\x01\x01\x12\x19\n" +
+	"\btax_rate\x18\x12 \x01(\tR\ataxRate\x12!\n" +
+	"\ftax_included\x18\x13 \x01(\bR\vtaxIncluded\x12.\n" +
+	"\x13service_charge_rate\x18\x14 \x01(\tR\x11serviceChargeRate\x12\x19\n" +
+	"\btip_rate\x18\x15 \x01(\tR\atipRateB\x13\n" +
+	"\x11_exchange_rate_atB\n" +
+	"\n" +
+	"\b_ends_atB\x11\n" +
+	"\x0f_deposit_amountB\x12\n" +
+	"\x10_subtotal_amount\"\x88\x01\n" +
+	"\vRoundConfig\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\ftotal_amount\x18\x03 \x01(\x05R\vtotalAmount\x12*\n" +
+	"\x05tiers\x18\x04 \x03(\v2\x14.event.v1.TierConfigR\x05tiers\"\xf1\x01\n" +
+	"\n" +
+	"EventRound\x12\x14\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12!\n" +
+	"\ftotal_amount\x18\x03 \x01(\x05R\vtotalAmount\x12\x1c\n" +
+	"\tremainder\x18\x04 \x01(\x05R\tremainder\x12.\n" +
+	"\x05tiers\x18\x05 \x03(\v2\x18.event.v1.EventRoundTierR\x05tiers\x12,\n" +
+	"\x06shares\x18\x06 \x03(\v2\x14.event.v1.RoundShareR\x06shares\x12\x18\n" +
+	"\asurplus\x18\a \x01(\x05R\asurplus\"~\n" +
+	"\x0eEventRoundTier\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\x05R\x04tier\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\tR\x06weight\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x05R\x06amount\"K\n" +
+	"\n" +
+	"RoundShare\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\"\xfa\x05\n" +
 	"\x10EventParticipant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
@@ -541,12 +2165,106 @@ const file_event_v1_event_proto_rawDesc = "" +
 	"\x06status\x18\x05 \x01(\x0e2\x1b.event.v1.ParticipantStatusR\x06status\x12\x16\n" +
 	"\x06amount\x18\x06 \x01(\x05R\x06amount\x129\n" +
 	"\n" +
-	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*\x98\x01\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12&\n" +
+	"\ffixed_amount\x18\b \x01(\x05H\x00R\vfixedAmount\x88\x01\x01\x12>\n" +
+	"\n" +
+	"claimed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tclaimedAt\x88\x01\x01\x12B\n" +
+	"\fconfirmed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vconfirmedAt\x88\x01\x01\x12\x1f\n" +
+	"\vpaid_amount\x18\v \x01(\x05R\n" +
+	"paidAmount\x12+\n" +
+	"\x11adjustment_amount\x18\f \x01(\x05R\x10adjustmentAmount\x12>\n" +
+	"\n" +
+	"arrived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tarrivedAt\x88\x01\x01\x128\n" +
+	"\aleft_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x04R\x06leftAt\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"attendance\x18\x0f \x01(\tR\n" +
+	"attendance\x12+\n" +
+	"\x11settlement_amount\x18\x10 \x01(\x05R\x10settlementAmountB\x0f\n" +
+	"\r_fixed_amountB\r\n" +
+	"\v_claimed_atB\x0f\n" +
+	"\r_confirmed_atB\r\n" +
+	"\v_arrived_atB\n" +
+	"\n" +
+	"\b_left_at\"\xd3\x02\n" +
+	"\x17ParticipantStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12<\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x1b.event.v1.ParticipantStatusR\n" +
+	"fromStatus\x128\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x1b.event.v1.ParticipantStatusR\btoStatus\x126\n" +
+	"\x05actor\x18\x05 \x01(\x0e2 .event.v1.ParticipantStatusActorR\x05actor\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf8\x01\n" +
+	"\x12ParticipantPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12J\n" +
+	"\x13payment_method_type\x18\x04 \x01(\x0e2\x1a.user.v1.PaymentMethodTypeR\x11paymentMethodType\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x123\n" +
+	"\apaid_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\"\x94\x02\n" +
+	"\x06Refund\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vevent_title\x18\x02 \x01(\tR\n" +
+	"eventTitle\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12<\n" +
+	"\vparticipant\x18\x04 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x05R\x06amount\x12/\n" +
+	"\x13settlement_currency\x18\x06 \x01(\tR\x12settlementCurrency\x12+\n" +
+	"\x11settlement_amount\x18\a \x01(\x05R\x10settlementAmount\"\xb5\x01\n" +
+	"\x15ParticipantAdjustment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe8\x01\n" +
+	"\aExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x19\n" +
+	"\bpayer_id\x18\x03 \x01(\tR\apayerId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x05R\x06amount\x12.\n" +
+	"\x06shares\x18\x06 \x03(\v2\x16.event.v1.ExpenseShareR\x06shares\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\fExpenseShare\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\"j\n" +
+	"\aBalance\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12\x12\n" +
+	"\x04paid\x18\x02 \x01(\x05R\x04paid\x12\x12\n" +
+	"\x04owed\x18\x03 \x01(\x05R\x04owed\x12\x10\n" +
+	"\x03net\x18\x04 \x01(\x05R\x03net\"~\n" +
+	"\bTransfer\x12.\n" +
+	"\x13from_participant_id\x18\x01 \x01(\tR\x11fromParticipantId\x12*\n" +
+	"\x11to_participant_id\x18\x02 \x01(\tR\x0ftoParticipantId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount*\xdc\x01\n" +
+	"\x0fRemainderPolicy\x12 \n" +
+	"\x1cREMAINDER_POLICY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aREMAINDER_POLICY_ORGANIZER\x10\x01\x12 \n" +
+	"\x1cREMAINDER_POLICY_ROUND_UP_10\x10\x02\x12!\n" +
+	"\x1dREMAINDER_POLICY_ROUND_UP_100\x10\x03\x12!\n" +
+	"\x1dREMAINDER_POLICY_ROUND_UP_500\x10\x04\x12\x1f\n" +
+	"\x1bREMAINDER_POLICY_DISTRIBUTE\x10\x05*w\n" +
+	"\tSplitMode\x12\x1a\n" +
+	"\x16SPLIT_MODE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSPLIT_MODE_TIER\x10\x01\x12\x19\n" +
+	"\x15SPLIT_MODE_ATTENDANCE\x10\x02\x12\x1e\n" +
+	"\x1aSPLIT_MODE_ATTENDANCE_TIER\x10\x03*\xe2\x01\n" +
 	"\x11ParticipantStatus\x12\"\n" +
 	"\x1ePARTICIPANT_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19PARTICIPANT_STATUS_UNPAID\x10\x01\x12\x1e\n" +
 	"\x1aPARTICIPANT_STATUS_CLAIMED\x10\x02\x12 \n" +
-	"\x1cPARTICIPANT_STATUS_CONFIRMED\x10\x03B\x8b\x01\n" +
+	"\x1cPARTICIPANT_STATUS_CONFIRMED\x10\x03\x12!\n" +
+	"\x1dPARTICIPANT_STATUS_WAITLISTED\x10\x04\x12%\n" +
+	"!PARTICIPANT_STATUS_PARTIALLY_PAID\x10\x05*\xb9\x01\n" +
+	"\x16ParticipantStatusActor\x12(\n" +
+	"$PARTICIPANT_STATUS_ACTOR_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"PARTICIPANT_STATUS_ACTOR_ORGANIZER\x10\x01\x12(\n" +
+	"$PARTICIPANT_STATUS_ACTOR_PARTICIPANT\x10\x02\x12#\n" +
+	"\x1fPARTICIPANT_STATUS_ACTOR_SYSTEM\x10\x03B\x8b\x01\n" +
 	"\fcom.event.v1B\n" +
 	"EventProtoP\x01Z.github.com/mickamy/sampay/gen/event/v1;eventv1\xa2\x02\x03EXX\xaa\x02\bEvent.V1\xca\x02\bEvent\\V1\xe2\x02\x14Event\\V1\\GPBMetadata\xea\x02\tEvent::V1b\x06proto3"
 
@@ -562,30 +2280,74 @@ func file_event_v1_event_proto_rawDescGZIP() []byte {
 	return file_event_v1_event_proto_rawDescData
 }
 
-var file_event_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_event_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_event_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_event_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_event_v1_event_proto_goTypes = []any{
-	(ParticipantStatus)(0),        // 0: event.v1.ParticipantStatus
-	(*Event)(nil),                 // 1: event.v1.Event
-	(*EventTier)(nil),             // 2: event.v1.EventTier
-	(*TierConfig)(nil),            // 3: event.v1.TierConfig
-	(*EventInput)(nil),            // 4: event.v1.EventInput
-	(*EventParticipant)(nil),      // 5: event.v1.EventParticipant
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(RemainderPolicy)(0),            // 0: event.v1.RemainderPolicy
+	(SplitMode)(0),                  // 1: event.v1.SplitMode
+	(ParticipantStatus)(0),          // 2: event.v1.ParticipantStatus
+	(ParticipantStatusActor)(0),     // 3: event.v1.ParticipantStatusActor
+	(*Event)(nil),                   // 4: event.v1.Event
+	(*EventTier)(nil),               // 5: event.v1.EventTier
+	(*TierConfig)(nil),              // 6: event.v1.TierConfig
+	(*EventInput)(nil),              // 7: event.v1.EventInput
+	(*RoundConfig)(nil),             // 8: event.v1.RoundConfig
+	(*EventRound)(nil),              // 9: event.v1.EventRound
+	(*EventRoundTier)(nil),          // 10: event.v1.EventRoundTier
+	(*RoundShare)(nil),              // 11: event.v1.RoundShare
+	(*EventParticipant)(nil),        // 12: event.v1.EventParticipant
+	(*ParticipantStatusChange)(nil), // 13: event.v1.ParticipantStatusChange
+	(*ParticipantPayment)(nil),      // 14: event.v1.ParticipantPayment
+	(*Refund)(nil),                  // 15: event.v1.Refund
+	(*ParticipantAdjustment)(nil),   // 16: event.v1.ParticipantAdjustment
+	(*Expense)(nil),                 // 17: event.v1.Expense
+	(*ExpenseShare)(nil),            // 18: event.v1.ExpenseShare
+	(*Balance)(nil),                 // 19: event.v1.Balance
+	(*Transfer)(nil),                // 20: event.v1.Transfer
+	(*timestamppb.Timestamp)(nil),   // 21: google.protobuf.Timestamp
+	(v1.PaymentMethodType)(0),       // 22: user.v1.PaymentMethodType
 }
 var file_event_v1_event_proto_depIdxs = []int32{
-	6, // 0: event.v1.Event.held_at:type_name -> google.protobuf.Timestamp
-	2, // 1: event.v1.Event.tiers:type_name -> event.v1.EventTier
-	6, // 2: event.v1.Event.archived_at:type_name -> google.protobuf.Timestamp
-	6, // 3: event.v1.EventInput.held_at:type_name -> google.protobuf.Timestamp
-	3, // 4: event.v1.EventInput.tiers:type_name -> event.v1.TierConfig
-	0, // 5: event.v1.EventParticipant.status:type_name -> event.v1.ParticipantStatus
-	6, // 6: event.v1.EventParticipant.created_at:type_name -> google.protobuf.Timestamp
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	21, // 0: event.v1.Event.held_at:type_name -> google.protobuf.Timestamp
+	5,  // 1: event.v1.Event.tiers:type_name -> event.v1.EventTier
+	21, // 2: event.v1.Event.archived_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: event.v1.Event.remainder_policy:type_name -> event.v1.RemainderPolicy
+	21, // 4: event.v1.Event.exchange_rate_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: event.v1.Event.split_mode:type_name -> event.v1.SplitMode
+	21, // 6: event.v1.Event.ends_at:type_name -> google.protobuf.Timestamp
+	21, // 7: event.v1.Event.deposit_settled_at:type_name -> google.protobuf.Timestamp
+	21, // 8: event.v1.Event.finalized_at:type_name -> google.protobuf.Timestamp
+	21, // 9: event.v1.EventInput.held_at:type_name -> google.protobuf.Timestamp
+	6,  // 10: event.v1.EventInput.tiers:type_name -> event.v1.TierConfig
+	0,  // 11: event.v1.EventInput.remainder_policy:type_name -> event.v1.RemainderPolicy
+	21, // 12: event.v1.EventInput.exchange_rate_at:type_name -> google.protobuf.Timestamp
+	8,  // 13: event.v1.EventInput.rounds:type_name -> event.v1.RoundConfig
+	1,  // 14: event.v1.EventInput.split_mode:type_name -> event.v1.SplitMode
+	21, // 15: event.v1.EventInput.ends_at:type_name -> google.protobuf.Timestamp
+	6,  // 16: event.v1.RoundConfig.tiers:type_name -> event.v1.TierConfig
+	10, // 17: event.v1.EventRound.tiers:type_name -> event.v1.EventRoundTier
+	11, // 18: event.v1.EventRound.shares:type_name -> event.v1.RoundShare
+	2,  // 19: event.v1.EventParticipant.status:type_name -> event.v1.ParticipantStatus
+	21, // 20: event.v1.EventParticipant.created_at:type_name -> google.protobuf.Timestamp
+	21, // 21: event.v1.EventParticipant.claimed_at:type_name -> google.protobuf.Timestamp
+	21, // 22: event.v1.EventParticipant.confirmed_at:type_name -> google.protobuf.Timestamp
+	21, // 23: event.v1.EventParticipant.arrived_at:type_name -> google.protobuf.Timestamp
+	21, // 24: event.v1.EventParticipant.left_at:type_name -> google.protobuf.Timestamp
+	2,  // 25: event.v1.ParticipantStatusChange.from_status:type_name -> event.v1.ParticipantStatus
+	2,  // 26: event.v1.ParticipantStatusChange.to_status:type_name -> event.v1.ParticipantStatus
+	3,  // 27: event.v1.ParticipantStatusChange.actor:type_name -> event.v1.ParticipantStatusActor
+	21, // 28: event.v1.ParticipantStatusChange.created_at:type_name -> google.protobuf.Timestamp
+	22, // 29: event.v1.ParticipantPayment.payment_method_type:type_name -> user.v1.PaymentMethodType
+	21, // 30: event.v1.ParticipantPayment.paid_at:type_name -> google.protobuf.Timestamp
+	12, // 31: event.v1.Refund.participant:type_name -> event.v1.EventParticipant
+	21, // 32: event.v1.ParticipantAdjustment.created_at:type_name -> google.protobuf.Timestamp
+	18, // 33: event.v1.Expense.shares:type_name -> event.v1.ExpenseShare
+	21, // 34: event.v1.Expense.created_at:type_name -> google.protobuf.Timestamp
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_event_v1_event_proto_init() }
@@ -594,13 +2356,15 @@ func file_event_v1_event_proto_init() {
 		return
 	}
 	file_event_v1_event_proto_msgTypes[0].OneofWrappers = []any{}
+	file_event_v1_event_proto_msgTypes[3].OneofWrappers = []any{}
+	file_event_v1_event_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_v1_event_proto_rawDesc), len(file_event_v1_event_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
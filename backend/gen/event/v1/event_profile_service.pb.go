@@ -72,8 +72,10 @@ type GetEventResponse struct {
 	User           *v1.User               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	PaymentMethods []*v1.PaymentMethod    `protobuf:"bytes,3,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
 	Participants   []*EventParticipant    `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// rounds break down what everyone owes round by round, starting with the event itself as round 1.
+	Rounds        []*EventRound `protobuf:"bytes,5,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEventResponse) Reset() {
@@ -134,11 +136,22 @@ func (x *GetEventResponse) GetParticipants() []*EventParticipant {
 	return nil
 }
 
+func (x *GetEventResponse) GetRounds() []*EventRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
 type JoinEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Tier          int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tier    int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	// waitlist joins the waitlist when the tier is full. Otherwise, joining a full tier fails.
+	Waitlist bool `protobuf:"varint,4,opt,name=waitlist,proto3" json:"waitlist,omitempty"`
+	// rounds are the later rounds to take part in. They are ignored when joining the waitlist.
+	Rounds        []int32 `protobuf:"varint,5,rep,packed,name=rounds,proto3" json:"rounds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -194,9 +207,28 @@ func (x *JoinEventRequest) GetTier() int32 {
 	return 0
 }
 
+func (x *JoinEventRequest) GetWaitlist() bool {
+	if x != nil {
+		return x.Waitlist
+	}
+	return false
+}
+
+func (x *JoinEventRequest) GetRounds() []int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
 type JoinEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Participant *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// participant_token is the secret for acting as this participant later, e.g. ClaimPayment.
+	// It is returned only here and by ReissueParticipantToken, so the client has to keep it.
+	ParticipantToken string `protobuf:"bytes,2,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	// estimated is true while the event's total is an estimate, so the participant's amount may still change.
+	Estimated     bool `protobuf:"varint,3,opt,name=estimated,proto3" json:"estimated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -238,11 +270,31 @@ func (x *JoinEventResponse) GetParticipant() *EventParticipant {
 	return nil
 }
 
+func (x *JoinEventResponse) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+func (x *JoinEventResponse) GetEstimated() bool {
+	if x != nil {
+		return x.Estimated
+	}
+	return false
+}
+
 type ClaimPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ParticipantId string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// participant_token is the one JoinEvent returned, as on the requests below. Participants who joined
+	// before tokens were issued have none and are refused until the organizer reissues one.
+	ParticipantToken string `protobuf:"bytes,2,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	// payment_method_type is how the participant says they paid, passed on to the organizer.
+	// Leave it unspecified if they did not say.
+	PaymentMethodType v1.PaymentMethodType `protobuf:"varint,3,opt,name=payment_method_type,json=paymentMethodType,proto3,enum=user.v1.PaymentMethodType" json:"payment_method_type,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ClaimPaymentRequest) Reset() {
@@ -282,6 +334,20 @@ func (x *ClaimPaymentRequest) GetParticipantId() string {
 	return ""
 }
 
+func (x *ClaimPaymentRequest) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+func (x *ClaimPaymentRequest) GetPaymentMethodType() v1.PaymentMethodType {
+	if x != nil {
+		return x.PaymentMethodType
+	}
+	return v1.PaymentMethodType(0)
+}
+
 type ClaimPaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
@@ -326,32 +392,357 @@ func (x *ClaimPaymentResponse) GetParticipant() *EventParticipant {
 	return nil
 }
 
+type ChangeTierRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId    string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ParticipantToken string                 `protobuf:"bytes,2,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	Tier             int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChangeTierRequest) Reset() {
+	*x = ChangeTierRequest{}
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTierRequest) ProtoMessage() {}
+
+func (x *ChangeTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTierRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTierRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_profile_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangeTierRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ChangeTierRequest) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+func (x *ChangeTierRequest) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type ChangeTierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTierResponse) Reset() {
+	*x = ChangeTierResponse{}
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTierResponse) ProtoMessage() {}
+
+func (x *ChangeTierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTierResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTierResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_profile_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangeTierResponse) GetParticipant() *EventParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type LeaveEventRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId    string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ParticipantToken string                 `protobuf:"bytes,2,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeaveEventRequest) Reset() {
+	*x = LeaveEventRequest{}
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveEventRequest) ProtoMessage() {}
+
+func (x *LeaveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveEventRequest.ProtoReflect.Descriptor instead.
+func (*LeaveEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_profile_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LeaveEventRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *LeaveEventRequest) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+type LeaveEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveEventResponse) Reset() {
+	*x = LeaveEventResponse{}
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveEventResponse) ProtoMessage() {}
+
+func (x *LeaveEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveEventResponse.ProtoReflect.Descriptor instead.
+func (*LeaveEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_profile_service_proto_rawDescGZIP(), []int{9}
+}
+
+type ChangeRoundsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantId    string                 `protobuf:"bytes,1,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	ParticipantToken string                 `protobuf:"bytes,2,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	// rounds are the later rounds to take part in; the ones left out are dropped.
+	Rounds        []int32 `protobuf:"varint,3,rep,packed,name=rounds,proto3" json:"rounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeRoundsRequest) Reset() {
+	*x = ChangeRoundsRequest{}
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRoundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRoundsRequest) ProtoMessage() {}
+
+func (x *ChangeRoundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRoundsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRoundsRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_profile_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChangeRoundsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ChangeRoundsRequest) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+func (x *ChangeRoundsRequest) GetRounds() []int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+type ChangeRoundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeRoundsResponse) Reset() {
+	*x = ChangeRoundsResponse{}
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRoundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRoundsResponse) ProtoMessage() {}
+
+func (x *ChangeRoundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_profile_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRoundsResponse.ProtoReflect.Descriptor instead.
+func (*ChangeRoundsResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_profile_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChangeRoundsResponse) GetParticipant() *EventParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
 var File_event_v1_event_profile_service_proto protoreflect.FileDescriptor
 
 const file_event_v1_event_profile_service_proto_rawDesc = "" +
 	"\n" +
 	"$event/v1/event_profile_service.proto\x12\bevent.v1\x1a\x14event/v1/event.proto\x1a\x12user/v1/user.proto\x1a\x1cuser/v1/payment_method.proto\"!\n" +
 	"\x0fGetEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\xdd\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x02\n" +
 	"\x10GetEventResponse\x12%\n" +
 	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12!\n" +
 	"\x04user\x18\x02 \x01(\v2\r.user.v1.UserR\x04user\x12?\n" +
 	"\x0fpayment_methods\x18\x03 \x03(\v2\x16.user.v1.PaymentMethodR\x0epaymentMethods\x12>\n" +
-	"\fparticipants\x18\x04 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"U\n" +
+	"\fparticipants\x18\x04 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\x12,\n" +
+	"\x06rounds\x18\x05 \x03(\v2\x14.event.v1.EventRoundR\x06rounds\"\x89\x01\n" +
 	"\x10JoinEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04tier\x18\x03 \x01(\x05R\x04tier\"Q\n" +
+	"\x04tier\x18\x03 \x01(\x05R\x04tier\x12\x1a\n" +
+	"\bwaitlist\x18\x04 \x01(\bR\bwaitlist\x12\x16\n" +
+	"\x06rounds\x18\x05 \x03(\x05R\x06rounds\"\x9c\x01\n" +
 	"\x11JoinEventResponse\x12<\n" +
-	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\"<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\x12+\n" +
+	"\x11participant_token\x18\x02 \x01(\tR\x10participantToken\x12\x1c\n" +
+	"\testimated\x18\x03 \x01(\bR\testimated\"\xb5\x01\n" +
 	"\x13ClaimPaymentRequest\x12%\n" +
-	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\"T\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12+\n" +
+	"\x11participant_token\x18\x02 \x01(\tR\x10participantToken\x12J\n" +
+	"\x13payment_method_type\x18\x03 \x01(\x0e2\x1a.user.v1.PaymentMethodTypeR\x11paymentMethodType\"T\n" +
 	"\x14ClaimPaymentResponse\x12<\n" +
-	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant2\xed\x01\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\"{\n" +
+	"\x11ChangeTierRequest\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12+\n" +
+	"\x11participant_token\x18\x02 \x01(\tR\x10participantToken\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x05R\x04tier\"R\n" +
+	"\x12ChangeTierResponse\x12<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\"g\n" +
+	"\x11LeaveEventRequest\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12+\n" +
+	"\x11participant_token\x18\x02 \x01(\tR\x10participantToken\"\x14\n" +
+	"\x12LeaveEventResponse\"\x81\x01\n" +
+	"\x13ChangeRoundsRequest\x12%\n" +
+	"\x0eparticipant_id\x18\x01 \x01(\tR\rparticipantId\x12+\n" +
+	"\x11participant_token\x18\x02 \x01(\tR\x10participantToken\x12\x16\n" +
+	"\x06rounds\x18\x03 \x03(\x05R\x06rounds\"T\n" +
+	"\x14ChangeRoundsResponse\x12<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant2\xce\x03\n" +
 	"\x13EventProfileService\x12A\n" +
 	"\bGetEvent\x12\x19.event.v1.GetEventRequest\x1a\x1a.event.v1.GetEventResponse\x12D\n" +
 	"\tJoinEvent\x12\x1a.event.v1.JoinEventRequest\x1a\x1b.event.v1.JoinEventResponse\x12M\n" +
-	"\fClaimPayment\x12\x1d.event.v1.ClaimPaymentRequest\x1a\x1e.event.v1.ClaimPaymentResponseB\x99\x01\n" +
+	"\fClaimPayment\x12\x1d.event.v1.ClaimPaymentRequest\x1a\x1e.event.v1.ClaimPaymentResponse\x12G\n" +
+	"\n" +
+	"ChangeTier\x12\x1b.event.v1.ChangeTierRequest\x1a\x1c.event.v1.ChangeTierResponse\x12G\n" +
+	"\n" +
+	"LeaveEvent\x12\x1b.event.v1.LeaveEventRequest\x1a\x1c.event.v1.LeaveEventResponse\x12M\n" +
+	"\fChangeRounds\x12\x1d.event.v1.ChangeRoundsRequest\x1a\x1e.event.v1.ChangeRoundsResponseB\x99\x01\n" +
 	"\fcom.event.v1B\x18EventProfileServiceProtoP\x01Z.github.com/mickamy/sampay/gen/event/v1;eventv1\xa2\x02\x03EXX\xaa\x02\bEvent.V1\xca\x02\bEvent\\V1\xe2\x02\x14Event\\V1\\GPBMetadata\xea\x02\tEvent::V1b\x06proto3"
 
 var (
@@ -366,7 +757,7 @@ func file_event_v1_event_profile_service_proto_rawDescGZIP() []byte {
 	return file_event_v1_event_profile_service_proto_rawDescData
 }
 
-var file_event_v1_event_profile_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_event_v1_event_profile_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_event_v1_event_profile_service_proto_goTypes = []any{
 	(*GetEventRequest)(nil),      // 0: event.v1.GetEventRequest
 	(*GetEventResponse)(nil),     // 1: event.v1.GetEventResponse
@@ -374,29 +765,47 @@ var file_event_v1_event_profile_service_proto_goTypes = []any{
 	(*JoinEventResponse)(nil),    // 3: event.v1.JoinEventResponse
 	(*ClaimPaymentRequest)(nil),  // 4: event.v1.ClaimPaymentRequest
 	(*ClaimPaymentResponse)(nil), // 5: event.v1.ClaimPaymentResponse
-	(*Event)(nil),                // 6: event.v1.Event
-	(*v1.User)(nil),              // 7: user.v1.User
-	(*v1.PaymentMethod)(nil),     // 8: user.v1.PaymentMethod
-	(*EventParticipant)(nil),     // 9: event.v1.EventParticipant
+	(*ChangeTierRequest)(nil),    // 6: event.v1.ChangeTierRequest
+	(*ChangeTierResponse)(nil),   // 7: event.v1.ChangeTierResponse
+	(*LeaveEventRequest)(nil),    // 8: event.v1.LeaveEventRequest
+	(*LeaveEventResponse)(nil),   // 9: event.v1.LeaveEventResponse
+	(*ChangeRoundsRequest)(nil),  // 10: event.v1.ChangeRoundsRequest
+	(*ChangeRoundsResponse)(nil), // 11: event.v1.ChangeRoundsResponse
+	(*Event)(nil),                // 12: event.v1.Event
+	(*v1.User)(nil),              // 13: user.v1.User
+	(*v1.PaymentMethod)(nil),     // 14: user.v1.PaymentMethod
+	(*EventParticipant)(nil),     // 15: event.v1.EventParticipant
+	(*EventRound)(nil),           // 16: event.v1.EventRound
+	(v1.PaymentMethodType)(0),    // 17: user.v1.PaymentMethodType
 }
 var file_event_v1_event_profile_service_proto_depIdxs = []int32{
-	6, // 0: event.v1.GetEventResponse.event:type_name -> event.v1.Event
-	7, // 1: event.v1.GetEventResponse.user:type_name -> user.v1.User
-	8, // 2: event.v1.GetEventResponse.payment_methods:type_name -> user.v1.PaymentMethod
-	9, // 3: event.v1.GetEventResponse.participants:type_name -> event.v1.EventParticipant
-	9, // 4: event.v1.JoinEventResponse.participant:type_name -> event.v1.EventParticipant
-	9, // 5: event.v1.ClaimPaymentResponse.participant:type_name -> event.v1.EventParticipant
-	0, // 6: event.v1.EventProfileService.GetEvent:input_type -> event.v1.GetEventRequest
-	2, // 7: event.v1.EventProfileService.JoinEvent:input_type -> event.v1.JoinEventRequest
-	4, // 8: event.v1.EventProfileService.ClaimPayment:input_type -> event.v1.ClaimPaymentRequest
-	1, // 9: event.v1.EventProfileService.GetEvent:output_type -> event.v1.GetEventResponse
-	3, // 10: event.v1.EventProfileService.JoinEvent:output_type -> event.v1.JoinEventResponse
-	5, // 11: event.v1.EventProfileService.ClaimPayment:output_type -> event.v1.ClaimPaymentResponse
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	12, // 0: event.v1.GetEventResponse.event:type_name -> event.v1.Event
+	13, // 1: event.v1.GetEventResponse.user:type_name -> user.v1.User
+	14, // 2: event.v1.GetEventResponse.payment_methods:type_name -> user.v1.PaymentMethod
+	15, // 3: event.v1.GetEventResponse.participants:type_name -> event.v1.EventParticipant
+	16, // 4: event.v1.GetEventResponse.rounds:type_name -> event.v1.EventRound
+	15, // 5: event.v1.JoinEventResponse.participant:type_name -> event.v1.EventParticipant
+	17, // 6: event.v1.ClaimPaymentRequest.payment_method_type:type_name -> user.v1.PaymentMethodType
+	15, // 7: event.v1.ClaimPaymentResponse.participant:type_name -> event.v1.EventParticipant
+	15, // 8: event.v1.ChangeTierResponse.participant:type_name -> event.v1.EventParticipant
+	15, // 9: event.v1.ChangeRoundsResponse.participant:type_name -> event.v1.EventParticipant
+	0,  // 10: event.v1.EventProfileService.GetEvent:input_type -> event.v1.GetEventRequest
+	2,  // 11: event.v1.EventProfileService.JoinEvent:input_type -> event.v1.JoinEventRequest
+	4,  // 12: event.v1.EventProfileService.ClaimPayment:input_type -> event.v1.ClaimPaymentRequest
+	6,  // 13: event.v1.EventProfileService.ChangeTier:input_type -> event.v1.ChangeTierRequest
+	8,  // 14: event.v1.EventProfileService.LeaveEvent:input_type -> event.v1.LeaveEventRequest
+	10, // 15: event.v1.EventProfileService.ChangeRounds:input_type -> event.v1.ChangeRoundsRequest
+	1,  // 16: event.v1.EventProfileService.GetEvent:output_type -> event.v1.GetEventResponse
+	3,  // 17: event.v1.EventProfileService.JoinEvent:output_type -> event.v1.JoinEventResponse
+	5,  // 18: event.v1.EventProfileService.ClaimPayment:output_type -> event.v1.ClaimPaymentResponse
+	7,  // 19: event.v1.EventProfileService.ChangeTier:output_type -> event.v1.ChangeTierResponse
+	9,  // 20: event.v1.EventProfileService.LeaveEvent:output_type -> event.v1.LeaveEventResponse
+	11, // 21: event.v1.EventProfileService.ChangeRounds:output_type -> event.v1.ChangeRoundsResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_event_v1_event_profile_service_proto_init() }
@@ -411,7 +820,7 @@ func file_event_v1_event_profile_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_v1_event_profile_service_proto_rawDesc), len(file_event_v1_event_profile_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7,8 +7,10 @@
 package eventv1
 
 import (
+	v1 "github.com/mickamy/sampay/gen/user/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -198,11 +200,14 @@ func (x *CreateEventResponse) GetEvent() *Event {
 }
 
 type UpdateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Input         *EventInput            `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Input *EventInput            `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// adjustment_note says why the bill changed, e.g. a forgotten dessert.
+	// It is kept on the adjustments made for participants who already paid or claimed.
+	AdjustmentNote string `protobuf:"bytes,3,opt,name=adjustment_note,json=adjustmentNote,proto3" json:"adjustment_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateEventRequest) Reset() {
@@ -249,9 +254,18 @@ func (x *UpdateEventRequest) GetInput() *EventInput {
 	return nil
 }
 
+func (x *UpdateEventRequest) GetAdjustmentNote() string {
+	if x != nil {
+		return x.AdjustmentNote
+	}
+	return ""
+}
+
 type UpdateEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// adjustments are the additional charges and refunds the update made, if any.
+	Adjustments   []*ParticipantAdjustment `protobuf:"bytes,2,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -293,6 +307,13 @@ func (x *UpdateEventResponse) GetEvent() *Event {
 	return nil
 }
 
+func (x *UpdateEventResponse) GetAdjustments() []*ParticipantAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
 type DeleteEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -418,8 +439,14 @@ func (x *ListEventParticipantsRequest) GetEventId() string {
 }
 
 type ListEventParticipantsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Participants  []*EventParticipant    `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Participants []*EventParticipant    `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	// status_changes is the status history of all the participants, oldest first.
+	StatusChanges []*ParticipantStatusChange `protobuf:"bytes,2,rep,name=status_changes,json=statusChanges,proto3" json:"status_changes,omitempty"`
+	// payments are the recorded payments of all the participants, oldest first.
+	Payments []*ParticipantPayment `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+	// adjustments are the additional charges and refunds of all the participants, oldest first.
+	Adjustments   []*ParticipantAdjustment `protobuf:"bytes,4,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -461,11 +488,34 @@ func (x *ListEventParticipantsResponse) GetParticipants() []*EventParticipant {
 	return nil
 }
 
+func (x *ListEventParticipantsResponse) GetStatusChanges() []*ParticipantStatusChange {
+	if x != nil {
+		return x.StatusChanges
+	}
+	return nil
+}
+
+func (x *ListEventParticipantsResponse) GetPayments() []*ParticipantPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *ListEventParticipantsResponse) GetAdjustments() []*ParticipantAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
 type UpdateParticipantStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
 	Status        ParticipantStatus      `protobuf:"varint,3,opt,name=status,proto3,enum=event.v1.ParticipantStatus" json:"status,omitempty"`
+	// reason is kept in the status history, e.g. why a claim was sent back.
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -521,6 +571,13 @@ func (x *UpdateParticipantStatusRequest) GetStatus() ParticipantStatus {
 	return ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateParticipantStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type UpdateParticipantStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
@@ -565,27 +622,34 @@ func (x *UpdateParticipantStatusResponse) GetParticipant() *EventParticipant {
 	return nil
 }
 
-type ArchiveEventRequest struct {
+type RecordPaymentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// payment_method_type must be one of the organizer's payment methods. Leave it unspecified for cash.
+	PaymentMethodType v1.PaymentMethodType `protobuf:"varint,4,opt,name=payment_method_type,json=paymentMethodType,proto3,enum=user.v1.PaymentMethodType" json:"payment_method_type,omitempty"`
+	// paid_at defaults to now.
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=paid_at,json=paidAt,proto3,oneof" json:"paid_at,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveEventRequest) Reset() {
-	*x = ArchiveEventRequest{}
+func (x *RecordPaymentRequest) Reset() {
+	*x = RecordPaymentRequest{}
 	mi := &file_event_v1_event_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveEventRequest) String() string {
+func (x *RecordPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveEventRequest) ProtoMessage() {}
+func (*RecordPaymentRequest) ProtoMessage() {}
 
-func (x *ArchiveEventRequest) ProtoReflect() protoreflect.Message {
+func (x *RecordPaymentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_v1_event_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,39 +661,75 @@ func (x *ArchiveEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveEventRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordPaymentRequest) Descriptor() ([]byte, []int) {
 	return file_event_v1_event_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ArchiveEventRequest) GetId() string {
+func (x *RecordPaymentRequest) GetEventId() string {
 	if x != nil {
-		return x.Id
+		return x.EventId
 	}
 	return ""
 }
 
-type ArchiveEventResponse struct {
+func (x *RecordPaymentRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RecordPaymentRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordPaymentRequest) GetPaymentMethodType() v1.PaymentMethodType {
+	if x != nil {
+		return x.PaymentMethodType
+	}
+	return v1.PaymentMethodType(0)
+}
+
+func (x *RecordPaymentRequest) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *RecordPaymentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RecordPaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Payment       *ParticipantPayment    `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArchiveEventResponse) Reset() {
-	*x = ArchiveEventResponse{}
+func (x *RecordPaymentResponse) Reset() {
+	*x = RecordPaymentResponse{}
 	mi := &file_event_v1_event_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArchiveEventResponse) String() string {
+func (x *RecordPaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveEventResponse) ProtoMessage() {}
+func (*RecordPaymentResponse) ProtoMessage() {}
 
-func (x *ArchiveEventResponse) ProtoReflect() protoreflect.Message {
+func (x *RecordPaymentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_event_v1_event_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,39 +741,50 @@ func (x *ArchiveEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveEventResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RecordPaymentResponse) Descriptor() ([]byte, []int) {
 	return file_event_v1_event_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ArchiveEventResponse) GetEvent() *Event {
+func (x *RecordPaymentResponse) GetParticipant() *EventParticipant {
 	if x != nil {
-		return x.Event
+		return x.Participant
 	}
 	return nil
 }
 
-type UnarchiveEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RecordPaymentResponse) GetPayment() *ParticipantPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
 }
 
-func (x *UnarchiveEventRequest) Reset() {
-	*x = UnarchiveEventRequest{}
+type SettleDepositsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// total_amount is the actual cost of the first round, replacing the estimate.
+	TotalAmount int32 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// adjustment_note is kept on the adjustments made for participants who paid their deposit.
+	AdjustmentNote string `protobuf:"bytes,3,opt,name=adjustment_note,json=adjustmentNote,proto3" json:"adjustment_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SettleDepositsRequest) Reset() {
+	*x = SettleDepositsRequest{}
 	mi := &file_event_v1_event_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnarchiveEventRequest) String() string {
+func (x *SettleDepositsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnarchiveEventRequest) ProtoMessage() {}
+func (*SettleDepositsRequest) ProtoMessage() {}
 
-func (x *UnarchiveEventRequest) ProtoReflect() protoreflect.Message {
+func (x *SettleDepositsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_v1_event_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,39 +796,55 @@ func (x *UnarchiveEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnarchiveEventRequest.ProtoReflect.Descriptor instead.
-func (*UnarchiveEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SettleDepositsRequest.ProtoReflect.Descriptor instead.
+func (*SettleDepositsRequest) Descriptor() ([]byte, []int) {
 	return file_event_v1_event_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UnarchiveEventRequest) GetId() string {
+func (x *SettleDepositsRequest) GetEventId() string {
 	if x != nil {
-		return x.Id
+		return x.EventId
 	}
 	return ""
 }
 
-type UnarchiveEventResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+func (x *SettleDepositsRequest) GetTotalAmount() int32 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *SettleDepositsRequest) GetAdjustmentNote() string {
+	if x != nil {
+		return x.AdjustmentNote
+	}
+	return ""
+}
+
+type SettleDepositsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Event         *Event                   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Participants  []*EventParticipant      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Adjustments   []*ParticipantAdjustment `protobuf:"bytes,3,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnarchiveEventResponse) Reset() {
-	*x = UnarchiveEventResponse{}
+func (x *SettleDepositsResponse) Reset() {
+	*x = SettleDepositsResponse{}
 	mi := &file_event_v1_event_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnarchiveEventResponse) String() string {
+func (x *SettleDepositsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnarchiveEventResponse) ProtoMessage() {}
+func (*SettleDepositsResponse) ProtoMessage() {}
 
-func (x *UnarchiveEventResponse) ProtoReflect() protoreflect.Message {
+func (x *SettleDepositsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_event_v1_event_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -729,64 +856,2068 @@ func (x *UnarchiveEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnarchiveEventResponse.ProtoReflect.Descriptor instead.
-func (*UnarchiveEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SettleDepositsResponse.ProtoReflect.Descriptor instead.
+func (*SettleDepositsResponse) Descriptor() ([]byte, []int) {
 	return file_event_v1_event_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UnarchiveEventResponse) GetEvent() *Event {
+func (x *SettleDepositsResponse) GetEvent() *Event {
 	if x != nil {
 		return x.Event
 	}
 	return nil
 }
 
-var File_event_v1_event_service_proto protoreflect.FileDescriptor
+func (x *SettleDepositsResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
 
-const file_event_v1_event_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1cevent/v1/event_service.proto\x12\bevent.v1\x1a\x14event/v1/event.proto\"@\n" +
-	"\x13ListMyEventsRequest\x12)\n" +
-	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"?\n" +
-	"\x14ListMyEventsResponse\x12'\n" +
-	"\x06events\x18\x01 \x03(\v2\x0f.event.v1.EventR\x06events\"@\n" +
-	"\x12CreateEventRequest\x12*\n" +
-	"\x05input\x18\x01 \x01(\v2\x14.event.v1.EventInputR\x05input\"<\n" +
-	"\x13CreateEventResponse\x12%\n" +
-	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\"P\n" +
-	"\x12UpdateEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
-	"\x05input\x18\x02 \x01(\v2\x14.event.v1.EventInputR\x05input\"<\n" +
-	"\x13UpdateEventResponse\x12%\n" +
-	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\"$\n" +
-	"\x12DeleteEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13DeleteEventResponse\"9\n" +
-	"\x1cListEventParticipantsRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\"_\n" +
-	"\x1dListEventParticipantsResponse\x12>\n" +
-	"\fparticipants\x18\x01 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"\x97\x01\n" +
-	"\x1eUpdateParticipantStatusRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
-	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x123\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x1b.event.v1.ParticipantStatusR\x06status\"_\n" +
-	"\x1fUpdateParticipantStatusResponse\x12<\n" +
-	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\"%\n" +
-	"\x13ArchiveEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
-	"\x14ArchiveEventResponse\x12%\n" +
-	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\"'\n" +
-	"\x15UnarchiveEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
-	"\x16UnarchiveEventResponse\x12%\n" +
-	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event2\xbf\x05\n" +
-	"\fEventService\x12M\n" +
-	"\fListMyEvents\x12\x1d.event.v1.ListMyEventsRequest\x1a\x1e.event.v1.ListMyEventsResponse\x12J\n" +
-	"\vCreateEvent\x12\x1c.event.v1.CreateEventRequest\x1a\x1d.event.v1.CreateEventResponse\x12J\n" +
-	"\vUpdateEvent\x12\x1c.event.v1.UpdateEventRequest\x1a\x1d.event.v1.UpdateEventResponse\x12J\n" +
-	"\vDeleteEvent\x12\x1c.event.v1.DeleteEventRequest\x1a\x1d.event.v1.DeleteEventResponse\x12h\n" +
-	"\x15ListEventParticipants\x12&.event.v1.ListEventParticipantsRequest\x1a'.event.v1.ListEventParticipantsResponse\x12n\n" +
-	"\x17UpdateParticipantStatus\x12(.event.v1.UpdateParticipantStatusRequest\x1a).event.v1.UpdateParticipantStatusResponse\x12M\n" +
+func (x *SettleDepositsResponse) GetAdjustments() []*ParticipantAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type FinalizeEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// total_amount is the actual cost of the first round, replacing the estimate.
+	TotalAmount int32 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// adjustment_note is kept on the adjustments made for participants who already paid.
+	AdjustmentNote string `protobuf:"bytes,3,opt,name=adjustment_note,json=adjustmentNote,proto3" json:"adjustment_note,omitempty"`
+	// subtotal_amount itemizes the actual bill as on EventInput: when set, total_amount is ignored and
+	// computed from it and the rates below.
+	SubtotalAmount    *int32 `protobuf:"varint,4,opt,name=subtotal_amount,json=subtotalAmount,proto3,oneof" json:"subtotal_amount,omitempty"`
+	TaxRate           string `protobuf:"bytes,5,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxIncluded       bool   `protobuf:"varint,6,opt,name=tax_included,json=taxIncluded,proto3" json:"tax_included,omitempty"`
+	ServiceChargeRate string `protobuf:"bytes,7,opt,name=service_charge_rate,json=serviceChargeRate,proto3" json:"service_charge_rate,omitempty"`
+	TipRate           string `protobuf:"bytes,8,opt,name=tip_rate,json=tipRate,proto3" json:"tip_rate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *FinalizeEventRequest) Reset() {
+	*x = FinalizeEventRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeEventRequest) ProtoMessage() {}
+
+func (x *FinalizeEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeEventRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FinalizeEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FinalizeEventRequest) GetTotalAmount() int32 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *FinalizeEventRequest) GetAdjustmentNote() string {
+	if x != nil {
+		return x.AdjustmentNote
+	}
+	return ""
+}
+
+func (x *FinalizeEventRequest) GetSubtotalAmount() int32 {
+	if x != nil && x.SubtotalAmount != nil {
+		return *x.SubtotalAmount
+	}
+	return 0
+}
+
+func (x *FinalizeEventRequest) GetTaxRate() string {
+	if x != nil {
+		return x.TaxRate
+	}
+	return ""
+}
+
+func (x *FinalizeEventRequest) GetTaxIncluded() bool {
+	if x != nil {
+		return x.TaxIncluded
+	}
+	return false
+}
+
+func (x *FinalizeEventRequest) GetServiceChargeRate() string {
+	if x != nil {
+		return x.ServiceChargeRate
+	}
+	return ""
+}
+
+func (x *FinalizeEventRequest) GetTipRate() string {
+	if x != nil {
+		return x.TipRate
+	}
+	return ""
+}
+
+type FinalizeEventResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Event         *Event                   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Participants  []*EventParticipant      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Adjustments   []*ParticipantAdjustment `protobuf:"bytes,3,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeEventResponse) Reset() {
+	*x = FinalizeEventResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeEventResponse) ProtoMessage() {}
+
+func (x *FinalizeEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeEventResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FinalizeEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *FinalizeEventResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *FinalizeEventResponse) GetAdjustments() []*ParticipantAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type ListRefundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{18}
+}
+
+type ListRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+type RecordRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// refunded_at defaults to now.
+	RefundedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refunded_at,json=refundedAt,proto3,oneof" json:"refunded_at,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRefundRequest) Reset() {
+	*x = RecordRefundRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRefundRequest) ProtoMessage() {}
+
+func (x *RecordRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRefundRequest.ProtoReflect.Descriptor instead.
+func (*RecordRefundRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RecordRefundRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RecordRefundRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *RecordRefundRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordRefundRequest) GetRefundedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return nil
+}
+
+func (x *RecordRefundRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RecordRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Payment       *ParticipantPayment    `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRefundResponse) Reset() {
+	*x = RecordRefundResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRefundResponse) ProtoMessage() {}
+
+func (x *RecordRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRefundResponse.ProtoReflect.Descriptor instead.
+func (*RecordRefundResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecordRefundResponse) GetParticipant() *EventParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *RecordRefundResponse) GetPayment() *ParticipantPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type SetParticipantFixedAmountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// fixed_amount pins the amount, zero exempting the participant. Unset goes back to the tier's share.
+	FixedAmount   *int32 `protobuf:"varint,3,opt,name=fixed_amount,json=fixedAmount,proto3,oneof" json:"fixed_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantFixedAmountRequest) Reset() {
+	*x = SetParticipantFixedAmountRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantFixedAmountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantFixedAmountRequest) ProtoMessage() {}
+
+func (x *SetParticipantFixedAmountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantFixedAmountRequest.ProtoReflect.Descriptor instead.
+func (*SetParticipantFixedAmountRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetParticipantFixedAmountRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetParticipantFixedAmountRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetParticipantFixedAmountRequest) GetFixedAmount() int32 {
+	if x != nil && x.FixedAmount != nil {
+		return *x.FixedAmount
+	}
+	return 0
+}
+
+type SetParticipantFixedAmountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantFixedAmountResponse) Reset() {
+	*x = SetParticipantFixedAmountResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantFixedAmountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantFixedAmountResponse) ProtoMessage() {}
+
+func (x *SetParticipantFixedAmountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantFixedAmountResponse.ProtoReflect.Descriptor instead.
+func (*SetParticipantFixedAmountResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetParticipantFixedAmountResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SetParticipantFixedAmountResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type SetParticipantRoundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// rounds are the later rounds to take part in; the ones left out are dropped.
+	Rounds []int32 `protobuf:"varint,3,rep,packed,name=rounds,proto3" json:"rounds,omitempty"`
+	// adjustment_note is kept on the adjustments made for participants who already paid or claimed.
+	AdjustmentNote string `protobuf:"bytes,4,opt,name=adjustment_note,json=adjustmentNote,proto3" json:"adjustment_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetParticipantRoundsRequest) Reset() {
+	*x = SetParticipantRoundsRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantRoundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantRoundsRequest) ProtoMessage() {}
+
+func (x *SetParticipantRoundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantRoundsRequest.ProtoReflect.Descriptor instead.
+func (*SetParticipantRoundsRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetParticipantRoundsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetParticipantRoundsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetParticipantRoundsRequest) GetRounds() []int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *SetParticipantRoundsRequest) GetAdjustmentNote() string {
+	if x != nil {
+		return x.AdjustmentNote
+	}
+	return ""
+}
+
+type SetParticipantRoundsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Adjustments   []*ParticipantAdjustment `protobuf:"bytes,3,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantRoundsResponse) Reset() {
+	*x = SetParticipantRoundsResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantRoundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantRoundsResponse) ProtoMessage() {}
+
+func (x *SetParticipantRoundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantRoundsResponse.ProtoReflect.Descriptor instead.
+func (*SetParticipantRoundsResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetParticipantRoundsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SetParticipantRoundsResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *SetParticipantRoundsResponse) GetAdjustments() []*ParticipantAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type SetParticipantAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// arrived_at and left_at are unset for the event's start and end.
+	ArrivedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrived_at,json=arrivedAt,proto3,oneof" json:"arrived_at,omitempty"`
+	LeftAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=left_at,json=leftAt,proto3,oneof" json:"left_at,omitempty"`
+	// attendance is a decimal from "0" to "1" overriding the times, e.g. "0.5". Leave it empty to use them.
+	Attendance string `protobuf:"bytes,5,opt,name=attendance,proto3" json:"attendance,omitempty"`
+	// adjustment_note is kept on the adjustments made for participants who already paid or claimed.
+	AdjustmentNote string `protobuf:"bytes,6,opt,name=adjustment_note,json=adjustmentNote,proto3" json:"adjustment_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetParticipantAttendanceRequest) Reset() {
+	*x = SetParticipantAttendanceRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantAttendanceRequest) ProtoMessage() {}
+
+func (x *SetParticipantAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetParticipantAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetParticipantAttendanceRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetParticipantAttendanceRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *SetParticipantAttendanceRequest) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *SetParticipantAttendanceRequest) GetLeftAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeftAt
+	}
+	return nil
+}
+
+func (x *SetParticipantAttendanceRequest) GetAttendance() string {
+	if x != nil {
+		return x.Attendance
+	}
+	return ""
+}
+
+func (x *SetParticipantAttendanceRequest) GetAdjustmentNote() string {
+	if x != nil {
+		return x.AdjustmentNote
+	}
+	return ""
+}
+
+type SetParticipantAttendanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Adjustments   []*ParticipantAdjustment `protobuf:"bytes,3,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantAttendanceResponse) Reset() {
+	*x = SetParticipantAttendanceResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantAttendanceResponse) ProtoMessage() {}
+
+func (x *SetParticipantAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*SetParticipantAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetParticipantAttendanceResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SetParticipantAttendanceResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *SetParticipantAttendanceResponse) GetAdjustments() []*ParticipantAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type ReissueParticipantTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReissueParticipantTokenRequest) Reset() {
+	*x = ReissueParticipantTokenRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReissueParticipantTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReissueParticipantTokenRequest) ProtoMessage() {}
+
+func (x *ReissueParticipantTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReissueParticipantTokenRequest.ProtoReflect.Descriptor instead.
+func (*ReissueParticipantTokenRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReissueParticipantTokenRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReissueParticipantTokenRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type ReissueParticipantTokenResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Participant      *EventParticipant      `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	ParticipantToken string                 `protobuf:"bytes,2,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReissueParticipantTokenResponse) Reset() {
+	*x = ReissueParticipantTokenResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReissueParticipantTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReissueParticipantTokenResponse) ProtoMessage() {}
+
+func (x *ReissueParticipantTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReissueParticipantTokenResponse.ProtoReflect.Descriptor instead.
+func (*ReissueParticipantTokenResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReissueParticipantTokenResponse) GetParticipant() *EventParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *ReissueParticipantTokenResponse) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+type AddParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tier          int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddParticipantRequest) Reset() {
+	*x = AddParticipantRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddParticipantRequest) ProtoMessage() {}
+
+func (x *AddParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddParticipantRequest.ProtoReflect.Descriptor instead.
+func (*AddParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddParticipantRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AddParticipantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddParticipantRequest) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type AddParticipantResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Event       *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Participant *EventParticipant      `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	// participant_token is for the organizer to pass on if the participant later wants to act on their own.
+	ParticipantToken string `protobuf:"bytes,3,opt,name=participant_token,json=participantToken,proto3" json:"participant_token,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddParticipantResponse) Reset() {
+	*x = AddParticipantResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddParticipantResponse) ProtoMessage() {}
+
+func (x *AddParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddParticipantResponse.ProtoReflect.Descriptor instead.
+func (*AddParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddParticipantResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *AddParticipantResponse) GetParticipant() *EventParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+func (x *AddParticipantResponse) GetParticipantToken() string {
+	if x != nil {
+		return x.ParticipantToken
+	}
+	return ""
+}
+
+func (x *AddParticipantResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type UpdateParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Tier          int32                  `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParticipantRequest) Reset() {
+	*x = UpdateParticipantRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParticipantRequest) ProtoMessage() {}
+
+func (x *UpdateParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParticipantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateParticipantRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateParticipantRequest) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+type UpdateParticipantResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateParticipantResponse) Reset() {
+	*x = UpdateParticipantResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateParticipantResponse) ProtoMessage() {}
+
+func (x *UpdateParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateParticipantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateParticipantResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateParticipantResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type RemoveParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveParticipantRequest) Reset() {
+	*x = RemoveParticipantRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantRequest) ProtoMessage() {}
+
+func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveParticipantRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RemoveParticipantRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+type RemoveParticipantResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveParticipantResponse) Reset() {
+	*x = RemoveParticipantResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantResponse) ProtoMessage() {}
+
+func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantResponse.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RemoveParticipantResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *RemoveParticipantResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type MergeParticipantsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// participant_id is the participant to keep.
+	ParticipantId string `protobuf:"bytes,2,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"`
+	// duplicate_id is the participant merged into them and removed.
+	DuplicateId   string `protobuf:"bytes,3,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeParticipantsRequest) Reset() {
+	*x = MergeParticipantsRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeParticipantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeParticipantsRequest) ProtoMessage() {}
+
+func (x *MergeParticipantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*MergeParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MergeParticipantsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MergeParticipantsRequest) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *MergeParticipantsRequest) GetDuplicateId() string {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return ""
+}
+
+type MergeParticipantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// participants all have their amounts recalculated.
+	Participants  []*EventParticipant `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeParticipantsResponse) Reset() {
+	*x = MergeParticipantsResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeParticipantsResponse) ProtoMessage() {}
+
+func (x *MergeParticipantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*MergeParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MergeParticipantsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *MergeParticipantsResponse) GetParticipants() []*EventParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type AddExpenseRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PayerId string                 `protobuf:"bytes,2,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	Title   string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Amount  int32                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// participant_ids are the participants the expense covers, which may include the payer.
+	ParticipantIds []string `protobuf:"bytes,5,rep,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddExpenseRequest) Reset() {
+	*x = AddExpenseRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseRequest) ProtoMessage() {}
+
+func (x *AddExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseRequest.ProtoReflect.Descriptor instead.
+func (*AddExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddExpenseRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetPayerId() string {
+	if x != nil {
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddExpenseRequest) GetParticipantIds() []string {
+	if x != nil {
+		return x.ParticipantIds
+	}
+	return nil
+}
+
+type AddExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *Expense               `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExpenseResponse) Reset() {
+	*x = AddExpenseResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseResponse) ProtoMessage() {}
+
+func (x *AddExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseResponse.ProtoReflect.Descriptor instead.
+func (*AddExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddExpenseResponse) GetExpense() *Expense {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+type DeleteExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseRequest) Reset() {
+	*x = DeleteExpenseRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteExpenseRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeleteExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type DeleteExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseResponse) Reset() {
+	*x = DeleteExpenseResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{41}
+}
+
+type ListExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesRequest) Reset() {
+	*x = ListExpensesRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesRequest) ProtoMessage() {}
+
+func (x *ListExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListExpensesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*Expense             `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Balances      []*Balance             `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	Transfers     []*Transfer            `protobuf:"bytes,3,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpensesResponse) Reset() {
+	*x = ListExpensesResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpensesResponse) ProtoMessage() {}
+
+func (x *ListExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListExpensesResponse) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *ListExpensesResponse) GetBalances() []*Balance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *ListExpensesResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type ArchiveEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveEventRequest) Reset() {
+	*x = ArchiveEventRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveEventRequest) ProtoMessage() {}
+
+func (x *ArchiveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveEventRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ArchiveEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ArchiveEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveEventResponse) Reset() {
+	*x = ArchiveEventResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveEventResponse) ProtoMessage() {}
+
+func (x *ArchiveEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveEventResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ArchiveEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type UnarchiveEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveEventRequest) Reset() {
+	*x = UnarchiveEventRequest{}
+	mi := &file_event_v1_event_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveEventRequest) ProtoMessage() {}
+
+func (x *UnarchiveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveEventRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UnarchiveEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnarchiveEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveEventResponse) Reset() {
+	*x = UnarchiveEventResponse{}
+	mi := &file_event_v1_event_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveEventResponse) ProtoMessage() {}
+
+func (x *UnarchiveEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_v1_event_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveEventResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_v1_event_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnarchiveEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+var File_event_v1_event_service_proto protoreflect.FileDescriptor
+
+const file_event_v1_event_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1cevent/v1/event_service.proto\x12\bevent.v1\x1a\x14event/v1/event.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cuser/v1/payment_method.proto\"@\n" +
+	"\x13ListMyEventsRequest\x12)\n" +
+	"\x10include_archived\x18\x01 \x01(\bR\x0fincludeArchived\"?\n" +
+	"\x14ListMyEventsResponse\x12'\n" +
+	"\x06events\x18\x01 \x03(\v2\x0f.event.v1.EventR\x06events\"@\n" +
+	"\x12CreateEventRequest\x12*\n" +
+	"\x05input\x18\x01 \x01(\v2\x14.event.v1.EventInputR\x05input\"<\n" +
+	"\x13CreateEventResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\"y\n" +
+	"\x12UpdateEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x05input\x18\x02 \x01(\v2\x14.event.v1.EventInputR\x05input\x12'\n" +
+	"\x0fadjustment_note\x18\x03 \x01(\tR\x0eadjustmentNote\"\x7f\n" +
+	"\x13UpdateEventResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12A\n" +
+	"\vadjustments\x18\x02 \x03(\v2\x1f.event.v1.ParticipantAdjustmentR\vadjustments\"$\n" +
+	"\x12DeleteEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13DeleteEventResponse\"9\n" +
+	"\x1cListEventParticipantsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xa6\x02\n" +
+	"\x1dListEventParticipantsResponse\x12>\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\x12H\n" +
+	"\x0estatus_changes\x18\x02 \x03(\v2!.event.v1.ParticipantStatusChangeR\rstatusChanges\x128\n" +
+	"\bpayments\x18\x03 \x03(\v2\x1c.event.v1.ParticipantPaymentR\bpayments\x12A\n" +
+	"\vadjustments\x18\x04 \x03(\v2\x1f.event.v1.ParticipantAdjustmentR\vadjustments\"\xaf\x01\n" +
+	"\x1eUpdateParticipantStatusRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.event.v1.ParticipantStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"_\n" +
+	"\x1fUpdateParticipantStatusResponse\x12<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\"\x96\x02\n" +
+	"\x14RecordPaymentRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12J\n" +
+	"\x13payment_method_type\x18\x04 \x01(\x0e2\x1a.user.v1.PaymentMethodTypeR\x11paymentMethodType\x128\n" +
+	"\apaid_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x06paidAt\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04noteB\n" +
+	"\n" +
+	"\b_paid_at\"\x8d\x01\n" +
+	"\x15RecordPaymentResponse\x12<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\x126\n" +
+	"\apayment\x18\x02 \x01(\v2\x1c.event.v1.ParticipantPaymentR\apayment\"~\n" +
+	"\x15SettleDepositsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x05R\vtotalAmount\x12'\n" +
+	"\x0fadjustment_note\x18\x03 \x01(\tR\x0eadjustmentNote\"\xc2\x01\n" +
+	"\x16SettleDepositsResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\x12A\n" +
+	"\vadjustments\x18\x03 \x03(\v2\x1f.event.v1.ParticipantAdjustmentR\vadjustments\"\xc8\x02\n" +
+	"\x14FinalizeEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x05R\vtotalAmount\x12'\n" +
+	"\x0fadjustment_note\x18\x03 \x01(\tR\x0eadjustmentNote\x12,\n" +
+	"\x0fsubtotal_amount\x18\x04 \x01(\x05H\x00R\x0esubtotalAmount\x88\x01\x01\x12\x19\n" +
+	"\btax_rate\x18\x05 \x01(\tR\ataxRate\x12!\n" +
+	"\ftax_included\x18\x06 \x01(\bR\vtaxIncluded\x12.\n" +
+	"\x13service_charge_rate\x18\a \x01(\tR\x11serviceChargeRate\x12\x19\n" +
+	"\btip_rate\x18\b \x01(\tR\atipRateB\x12\n" +
+	"\x10_subtotal_amount\"\xc1\x01\n" +
+	"\x15FinalizeEventResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\x12A\n" +
+	"\vadjustments\x18\x03 \x03(\v2\x1f.event.v1.ParticipantAdjustmentR\vadjustments\"\x14\n" +
+	"\x12ListRefundsRequest\"A\n" +
+	"\x13ListRefundsResponse\x12*\n" +
+	"\arefunds\x18\x01 \x03(\v2\x10.event.v1.RefundR\arefunds\"\xd5\x01\n" +
+	"\x13RecordRefundRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12@\n" +
+	"\vrefunded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"refundedAt\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04noteB\x0e\n" +
+	"\f_refunded_at\"\x8c\x01\n" +
+	"\x14RecordRefundResponse\x12<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\x126\n" +
+	"\apayment\x18\x02 \x01(\v2\x1c.event.v1.ParticipantPaymentR\apayment\"\x9d\x01\n" +
+	" SetParticipantFixedAmountRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12&\n" +
+	"\ffixed_amount\x18\x03 \x01(\x05H\x00R\vfixedAmount\x88\x01\x01B\x0f\n" +
+	"\r_fixed_amount\"\x8a\x01\n" +
+	"!SetParticipantFixedAmountResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"\xa0\x01\n" +
+	"\x1bSetParticipantRoundsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x16\n" +
+	"\x06rounds\x18\x03 \x03(\x05R\x06rounds\x12'\n" +
+	"\x0fadjustment_note\x18\x04 \x01(\tR\x0eadjustmentNote\"\xc8\x01\n" +
+	"\x1cSetParticipantRoundsResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\x12A\n" +
+	"\vadjustments\x18\x03 \x03(\v2\x1f.event.v1.ParticipantAdjustmentR\vadjustments\"\xc1\x02\n" +
+	"\x1fSetParticipantAttendanceRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12>\n" +
+	"\n" +
+	"arrived_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tarrivedAt\x88\x01\x01\x128\n" +
+	"\aleft_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06leftAt\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"attendance\x18\x05 \x01(\tR\n" +
+	"attendance\x12'\n" +
+	"\x0fadjustment_note\x18\x06 \x01(\tR\x0eadjustmentNoteB\r\n" +
+	"\v_arrived_atB\n" +
+	"\n" +
+	"\b_left_at\"\xcc\x01\n" +
+	" SetParticipantAttendanceResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\x12A\n" +
+	"\vadjustments\x18\x03 \x03(\v2\x1f.event.v1.ParticipantAdjustmentR\vadjustments\"b\n" +
+	"\x1eReissueParticipantTokenRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\"\x8c\x01\n" +
+	"\x1fReissueParticipantTokenResponse\x12<\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\x12+\n" +
+	"\x11participant_token\x18\x02 \x01(\tR\x10participantToken\"Z\n" +
+	"\x15AddParticipantRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x05R\x04tier\"\xea\x01\n" +
+	"\x16AddParticipantResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12<\n" +
+	"\vparticipant\x18\x02 \x01(\v2\x1a.event.v1.EventParticipantR\vparticipant\x12+\n" +
+	"\x11participant_token\x18\x03 \x01(\tR\x10participantToken\x12>\n" +
+	"\fparticipants\x18\x04 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"\x84\x01\n" +
+	"\x18UpdateParticipantRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04tier\x18\x04 \x01(\x05R\x04tier\"\x82\x01\n" +
+	"\x19UpdateParticipantResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"\\\n" +
+	"\x18RemoveParticipantRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\"\x82\x01\n" +
+	"\x19RemoveParticipantResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"\x7f\n" +
+	"\x18MergeParticipantsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0eparticipant_id\x18\x02 \x01(\tR\rparticipantId\x12!\n" +
+	"\fduplicate_id\x18\x03 \x01(\tR\vduplicateId\"\x82\x01\n" +
+	"\x19MergeParticipantsResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\x12>\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x1a.event.v1.EventParticipantR\fparticipants\"\xa0\x01\n" +
+	"\x11AddExpenseRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x19\n" +
+	"\bpayer_id\x18\x02 \x01(\tR\apayerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x12'\n" +
+	"\x0fparticipant_ids\x18\x05 \x03(\tR\x0eparticipantIds\"A\n" +
+	"\x12AddExpenseResponse\x12+\n" +
+	"\aexpense\x18\x01 \x01(\v2\x11.event.v1.ExpenseR\aexpense\"P\n" +
+	"\x14DeleteExpenseRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\"\x17\n" +
+	"\x15DeleteExpenseResponse\"0\n" +
+	"\x13ListExpensesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xa6\x01\n" +
+	"\x14ListExpensesResponse\x12-\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x11.event.v1.ExpenseR\bexpenses\x12-\n" +
+	"\bbalances\x18\x02 \x03(\v2\x11.event.v1.BalanceR\bbalances\x120\n" +
+	"\ttransfers\x18\x03 \x03(\v2\x12.event.v1.TransferR\ttransfers\"%\n" +
+	"\x13ArchiveEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x14ArchiveEventResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event\"'\n" +
+	"\x15UnarchiveEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x16UnarchiveEventResponse\x12%\n" +
+	"\x05event\x18\x01 \x01(\v2\x0f.event.v1.EventR\x05event2\xec\x10\n" +
+	"\fEventService\x12M\n" +
+	"\fListMyEvents\x12\x1d.event.v1.ListMyEventsRequest\x1a\x1e.event.v1.ListMyEventsResponse\x12J\n" +
+	"\vCreateEvent\x12\x1c.event.v1.CreateEventRequest\x1a\x1d.event.v1.CreateEventResponse\x12J\n" +
+	"\vUpdateEvent\x12\x1c.event.v1.UpdateEventRequest\x1a\x1d.event.v1.UpdateEventResponse\x12J\n" +
+	"\vDeleteEvent\x12\x1c.event.v1.DeleteEventRequest\x1a\x1d.event.v1.DeleteEventResponse\x12h\n" +
+	"\x15ListEventParticipants\x12&.event.v1.ListEventParticipantsRequest\x1a'.event.v1.ListEventParticipantsResponse\x12n\n" +
+	"\x17UpdateParticipantStatus\x12(.event.v1.UpdateParticipantStatusRequest\x1a).event.v1.UpdateParticipantStatusResponse\x12P\n" +
+	"\rRecordPayment\x12\x1e.event.v1.RecordPaymentRequest\x1a\x1f.event.v1.RecordPaymentResponse\x12S\n" +
+	"\x0eSettleDeposits\x12\x1f.event.v1.SettleDepositsRequest\x1a .event.v1.SettleDepositsResponse\x12P\n" +
+	"\rFinalizeEvent\x12\x1e.event.v1.FinalizeEventRequest\x1a\x1f.event.v1.FinalizeEventResponse\x12J\n" +
+	"\vListRefunds\x12\x1c.event.v1.ListRefundsRequest\x1a\x1d.event.v1.ListRefundsResponse\x12M\n" +
+	"\fRecordRefund\x12\x1d.event.v1.RecordRefundRequest\x1a\x1e.event.v1.RecordRefundResponse\x12t\n" +
+	"\x19SetParticipantFixedAmount\x12*.event.v1.SetParticipantFixedAmountRequest\x1a+.event.v1.SetParticipantFixedAmountResponse\x12e\n" +
+	"\x14SetParticipantRounds\x12%.event.v1.SetParticipantRoundsRequest\x1a&.event.v1.SetParticipantRoundsResponse\x12q\n" +
+	"\x18SetParticipantAttendance\x12).event.v1.SetParticipantAttendanceRequest\x1a*.event.v1.SetParticipantAttendanceResponse\x12n\n" +
+	"\x17ReissueParticipantToken\x12(.event.v1.ReissueParticipantTokenRequest\x1a).event.v1.ReissueParticipantTokenResponse\x12S\n" +
+	"\x0eAddParticipant\x12\x1f.event.v1.AddParticipantRequest\x1a .event.v1.AddParticipantResponse\x12\\\n" +
+	"\x11UpdateParticipant\x12\".event.v1.UpdateParticipantRequest\x1a#.event.v1.UpdateParticipantResponse\x12\\\n" +
+	"\x11RemoveParticipant\x12\".event.v1.RemoveParticipantRequest\x1a#.event.v1.RemoveParticipantResponse\x12\\\n" +
+	"\x11MergeParticipants\x12\".event.v1.MergeParticipantsRequest\x1a#.event.v1.MergeParticipantsResponse\x12G\n" +
+	"\n" +
+	"AddExpense\x12\x1b.event.v1.AddExpenseRequest\x1a\x1c.event.v1.AddExpenseResponse\x12P\n" +
+	"\rDeleteExpense\x12\x1e.event.v1.DeleteExpenseRequest\x1a\x1f.event.v1.DeleteExpenseResponse\x12M\n" +
+	"\fListExpenses\x12\x1d.event.v1.ListExpensesRequest\x1a\x1e.event.v1.ListExpensesResponse\x12M\n" +
 	"\fArchiveEvent\x12\x1d.event.v1.ArchiveEventRequest\x1a\x1e.event.v1.ArchiveEventResponse\x12S\n" +
 	"\x0eUnarchiveEvent\x12\x1f.event.v1.UnarchiveEventRequest\x1a .event.v1.UnarchiveEventResponseB\x92\x01\n" +
 	"\fcom.event.v1B\x11EventServiceProtoP\x01Z.github.com/mickamy/sampay/gen/event/v1;eventv1\xa2\x02\x03EXX\xaa\x02\bEvent.V1\xca\x02\bEvent\\V1\xe2\x02\x14Event\\V1\\GPBMetadata\xea\x02\tEvent::V1b\x06proto3"
@@ -803,61 +2934,176 @@ func file_event_v1_event_service_proto_rawDescGZIP() []byte {
 	return file_event_v1_event_service_proto_rawDescData
 }
 
-var file_event_v1_event_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_event_v1_event_service_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_event_v1_event_service_proto_goTypes = []any{
-	(*ListMyEventsRequest)(nil),             // 0: event.v1.ListMyEventsRequest
-	(*ListMyEventsResponse)(nil),            // 1: event.v1.ListMyEventsResponse
-	(*CreateEventRequest)(nil),              // 2: event.v1.CreateEventRequest
-	(*CreateEventResponse)(nil),             // 3: event.v1.CreateEventResponse
-	(*UpdateEventRequest)(nil),              // 4: event.v1.UpdateEventRequest
-	(*UpdateEventResponse)(nil),             // 5: event.v1.UpdateEventResponse
-	(*DeleteEventRequest)(nil),              // 6: event.v1.DeleteEventRequest
-	(*DeleteEventResponse)(nil),             // 7: event.v1.DeleteEventResponse
-	(*ListEventParticipantsRequest)(nil),    // 8: event.v1.ListEventParticipantsRequest
-	(*ListEventParticipantsResponse)(nil),   // 9: event.v1.ListEventParticipantsResponse
-	(*UpdateParticipantStatusRequest)(nil),  // 10: event.v1.UpdateParticipantStatusRequest
-	(*UpdateParticipantStatusResponse)(nil), // 11: event.v1.UpdateParticipantStatusResponse
-	(*ArchiveEventRequest)(nil),             // 12: event.v1.ArchiveEventRequest
-	(*ArchiveEventResponse)(nil),            // 13: event.v1.ArchiveEventResponse
-	(*UnarchiveEventRequest)(nil),           // 14: event.v1.UnarchiveEventRequest
-	(*UnarchiveEventResponse)(nil),          // 15: event.v1.UnarchiveEventResponse
-	(*Event)(nil),                           // 16: event.v1.Event
-	(*EventInput)(nil),                      // 17: event.v1.EventInput
-	(*EventParticipant)(nil),                // 18: event.v1.EventParticipant
-	(ParticipantStatus)(0),                  // 19: event.v1.ParticipantStatus
+	(*ListMyEventsRequest)(nil),               // 0: event.v1.ListMyEventsRequest
+	(*ListMyEventsResponse)(nil),              // 1: event.v1.ListMyEventsResponse
+	(*CreateEventRequest)(nil),                // 2: event.v1.CreateEventRequest
+	(*CreateEventResponse)(nil),               // 3: event.v1.CreateEventResponse
+	(*UpdateEventRequest)(nil),                // 4: event.v1.UpdateEventRequest
+	(*UpdateEventResponse)(nil),               // 5: event.v1.UpdateEventResponse
+	(*DeleteEventRequest)(nil),                // 6: event.v1.DeleteEventRequest
+	(*DeleteEventResponse)(nil),               // 7: event.v1.DeleteEventResponse
+	(*ListEventParticipantsRequest)(nil),      // 8: event.v1.ListEventParticipantsRequest
+	(*ListEventParticipantsResponse)(nil),     // 9: event.v1.ListEventParticipantsResponse
+	(*UpdateParticipantStatusRequest)(nil),    // 10: event.v1.UpdateParticipantStatusRequest
+	(*UpdateParticipantStatusResponse)(nil),   // 11: event.v1.UpdateParticipantStatusResponse
+	(*RecordPaymentRequest)(nil),              // 12: event.v1.RecordPaymentRequest
+	(*RecordPaymentResponse)(nil),             // 13: event.v1.RecordPaymentResponse
+	(*SettleDepositsRequest)(nil),             // 14: event.v1.SettleDepositsRequest
+	(*SettleDepositsResponse)(nil),            // 15: event.v1.SettleDepositsResponse
+	(*FinalizeEventRequest)(nil),              // 16: event.v1.FinalizeEventRequest
+	(*FinalizeEventResponse)(nil),             // 17: event.v1.FinalizeEventResponse
+	(*ListRefundsRequest)(nil),                // 18: event.v1.ListRefundsRequest
+	(*ListRefundsResponse)(nil),               // 19: event.v1.ListRefundsResponse
+	(*RecordRefundRequest)(nil),               // 20: event.v1.RecordRefundRequest
+	(*RecordRefundResponse)(nil),              // 21: event.v1.RecordRefundResponse
+	(*SetParticipantFixedAmountRequest)(nil),  // 22: event.v1.SetParticipantFixedAmountRequest
+	(*SetParticipantFixedAmountResponse)(nil), // 23: event.v1.SetParticipantFixedAmountResponse
+	(*SetParticipantRoundsRequest)(nil),       // 24: event.v1.SetParticipantRoundsRequest
+	(*SetParticipantRoundsResponse)(nil),      // 25: event.v1.SetParticipantRoundsResponse
+	(*SetParticipantAttendanceRequest)(nil),   // 26: event.v1.SetParticipantAttendanceRequest
+	(*SetParticipantAttendanceResponse)(nil),  // 27: event.v1.SetParticipantAttendanceResponse
+	(*ReissueParticipantTokenRequest)(nil),    // 28: event.v1.ReissueParticipantTokenRequest
+	(*ReissueParticipantTokenResponse)(nil),   // 29: event.v1.ReissueParticipantTokenResponse
+	(*AddParticipantRequest)(nil),             // 30: event.v1.AddParticipantRequest
+	(*AddParticipantResponse)(nil),            // 31: event.v1.AddParticipantResponse
+	(*UpdateParticipantRequest)(nil),          // 32: event.v1.UpdateParticipantRequest
+	(*UpdateParticipantResponse)(nil),         // 33: event.v1.UpdateParticipantResponse
+	(*RemoveParticipantRequest)(nil),          // 34: event.v1.RemoveParticipantRequest
+	(*RemoveParticipantResponse)(nil),         // 35: event.v1.RemoveParticipantResponse
+	(*MergeParticipantsRequest)(nil),          // 36: event.v1.MergeParticipantsRequest
+	(*MergeParticipantsResponse)(nil),         // 37: event.v1.MergeParticipantsResponse
+	(*AddExpenseRequest)(nil),                 // 38: event.v1.AddExpenseRequest
+	(*AddExpenseResponse)(nil),                // 39: event.v1.AddExpenseResponse
+	(*DeleteExpenseRequest)(nil),              // 40: event.v1.DeleteExpenseRequest
+	(*DeleteExpenseResponse)(nil),             // 41: event.v1.DeleteExpenseResponse
+	(*ListExpensesRequest)(nil),               // 42: event.v1.ListExpensesRequest
+	(*ListExpensesResponse)(nil),              // 43: event.v1.ListExpensesResponse
+	(*ArchiveEventRequest)(nil),               // 44: event.v1.ArchiveEventRequest
+	(*ArchiveEventResponse)(nil),              // 45: event.v1.ArchiveEventResponse
+	(*UnarchiveEventRequest)(nil),             // 46: event.v1.UnarchiveEventRequest
+	(*UnarchiveEventResponse)(nil),            // 47: event.v1.UnarchiveEventResponse
+	(*Event)(nil),                             // 48: event.v1.Event
+	(*EventInput)(nil),                        // 49: event.v1.EventInput
+	(*ParticipantAdjustment)(nil),             // 50: event.v1.ParticipantAdjustment
+	(*EventParticipant)(nil),                  // 51: event.v1.EventParticipant
+	(*ParticipantStatusChange)(nil),           // 52: event.v1.ParticipantStatusChange
+	(*ParticipantPayment)(nil),                // 53: event.v1.ParticipantPayment
+	(ParticipantStatus)(0),                    // 54: event.v1.ParticipantStatus
+	(v1.PaymentMethodType)(0),                 // 55: user.v1.PaymentMethodType
+	(*timestamppb.Timestamp)(nil),             // 56: google.protobuf.Timestamp
+	(*Refund)(nil),                            // 57: event.v1.Refund
+	(*Expense)(nil),                           // 58: event.v1.Expense
+	(*Balance)(nil),                           // 59: event.v1.Balance
+	(*Transfer)(nil),                          // 60: event.v1.Transfer
 }
 var file_event_v1_event_service_proto_depIdxs = []int32{
-	16, // 0: event.v1.ListMyEventsResponse.events:type_name -> event.v1.Event
-	17, // 1: event.v1.CreateEventRequest.input:type_name -> event.v1.EventInput
-	16, // 2: event.v1.CreateEventResponse.event:type_name -> event.v1.Event
-	17, // 3: event.v1.UpdateEventRequest.input:type_name -> event.v1.EventInput
-	16, // 4: event.v1.UpdateEventResponse.event:type_name -> event.v1.Event
-	18, // 5: event.v1.ListEventParticipantsResponse.participants:type_name -> event.v1.EventParticipant
-	19, // 6: event.v1.UpdateParticipantStatusRequest.status:type_name -> event.v1.ParticipantStatus
-	18, // 7: event.v1.UpdateParticipantStatusResponse.participant:type_name -> event.v1.EventParticipant
-	16, // 8: event.v1.ArchiveEventResponse.event:type_name -> event.v1.Event
-	16, // 9: event.v1.UnarchiveEventResponse.event:type_name -> event.v1.Event
-	0,  // 10: event.v1.EventService.ListMyEvents:input_type -> event.v1.ListMyEventsRequest
-	2,  // 11: event.v1.EventService.CreateEvent:input_type -> event.v1.CreateEventRequest
-	4,  // 12: event.v1.EventService.UpdateEvent:input_type -> event.v1.UpdateEventRequest
-	6,  // 13: event.v1.EventService.DeleteEvent:input_type -> event.v1.DeleteEventRequest
-	8,  // 14: event.v1.EventService.ListEventParticipants:input_type -> event.v1.ListEventParticipantsRequest
-	10, // 15: event.v1.EventService.UpdateParticipantStatus:input_type -> event.v1.UpdateParticipantStatusRequest
-	12, // 16: event.v1.EventService.ArchiveEvent:input_type -> event.v1.ArchiveEventRequest
-	14, // 17: event.v1.EventService.UnarchiveEvent:input_type -> event.v1.UnarchiveEventRequest
-	1,  // 18: event.v1.EventService.ListMyEvents:output_type -> event.v1.ListMyEventsResponse
-	3,  // 19: event.v1.EventService.CreateEvent:output_type -> event.v1.CreateEventResponse
-	5,  // 20: event.v1.EventService.UpdateEvent:output_type -> event.v1.UpdateEventResponse
-	7,  // 21: event.v1.EventService.DeleteEvent:output_type -> event.v1.DeleteEventResponse
-	9,  // 22: event.v1.EventService.ListEventParticipants:output_type -> event.v1.ListEventParticipantsResponse
-	11, // 23: event.v1.EventService.UpdateParticipantStatus:output_type -> event.v1.UpdateParticipantStatusResponse
-	13, // 24: event.v1.EventService.ArchiveEvent:output_type -> event.v1.ArchiveEventResponse
-	15, // 25: event.v1.EventService.UnarchiveEvent:output_type -> event.v1.UnarchiveEventResponse
-	18, // [18:26] is the sub-list for method output_type
-	10, // [10:18] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	48, // 0: event.v1.ListMyEventsResponse.events:type_name -> event.v1.Event
+	49, // 1: event.v1.CreateEventRequest.input:type_name -> event.v1.EventInput
+	48, // 2: event.v1.CreateEventResponse.event:type_name -> event.v1.Event
+	49, // 3: event.v1.UpdateEventRequest.input:type_name -> event.v1.EventInput
+	48, // 4: event.v1.UpdateEventResponse.event:type_name -> event.v1.Event
+	50, // 5: event.v1.UpdateEventResponse.adjustments:type_name -> event.v1.ParticipantAdjustment
+	51, // 6: event.v1.ListEventParticipantsResponse.participants:type_name -> event.v1.EventParticipant
+	52, // 7: event.v1.ListEventParticipantsResponse.status_changes:type_name -> event.v1.ParticipantStatusChange
+	53, // 8: event.v1.ListEventParticipantsResponse.payments:type_name -> event.v1.ParticipantPayment
+	50, // 9: event.v1.ListEventParticipantsResponse.adjustments:type_name -> event.v1.ParticipantAdjustment
+	54, // 10: event.v1.UpdateParticipantStatusRequest.status:type_name -> event.v1.ParticipantStatus
+	51, // 11: event.v1.UpdateParticipantStatusResponse.participant:type_name -> event.v1.EventParticipant
+	55, // 12: event.v1.RecordPaymentRequest.payment_method_type:type_name -> user.v1.PaymentMethodType
+	56, // 13: event.v1.RecordPaymentRequest.paid_at:type_name -> google.protobuf.Timestamp
+	51, // 14: event.v1.RecordPaymentResponse.participant:type_name -> event.v1.EventParticipant
+	53, // 15: event.v1.RecordPaymentResponse.payment:type_name -> event.v1.ParticipantPayment
+	48, // 16: event.v1.SettleDepositsResponse.event:type_name -> event.v1.Event
+	51, // 17: event.v1.SettleDepositsResponse.participants:type_name -> event.v1.EventParticipant
+	50, // 18: event.v1.SettleDepositsResponse.adjustments:type_name -> event.v1.ParticipantAdjustment
+	48, // 19: event.v1.FinalizeEventResponse.event:type_name -> event.v1.Event
+	51, // 20: event.v1.FinalizeEventResponse.participants:type_name -> event.v1.EventParticipant
+	50, // 21: event.v1.FinalizeEventResponse.adjustments:type_name -> event.v1.ParticipantAdjustment
+	57, // 22: event.v1.ListRefundsResponse.refunds:type_name -> event.v1.Refund
+	56, // 23: event.v1.RecordRefundRequest.refunded_at:type_name -> google.protobuf.Timestamp
+	51, // 24: event.v1.RecordRefundResponse.participant:type_name -> event.v1.EventParticipant
+	53, // 25: event.v1.RecordRefundResponse.payment:type_name -> event.v1.ParticipantPayment
+	48, // 26: event.v1.SetParticipantFixedAmountResponse.event:type_name -> event.v1.Event
+	51, // 27: event.v1.SetParticipantFixedAmountResponse.participants:type_name -> event.v1.EventParticipant
+	48, // 28: event.v1.SetParticipantRoundsResponse.event:type_name -> event.v1.Event
+	51, // 29: event.v1.SetParticipantRoundsResponse.participants:type_name -> event.v1.EventParticipant
+	50, // 30: event.v1.SetParticipantRoundsResponse.adjustments:type_name -> event.v1.ParticipantAdjustment
+	56, // 31: event.v1.SetParticipantAttendanceRequest.arrived_at:type_name -> google.protobuf.Timestamp
+	56, // 32: event.v1.SetParticipantAttendanceRequest.left_at:type_name -> google.protobuf.Timestamp
+	48, // 33: event.v1.SetParticipantAttendanceResponse.event:type_name -> event.v1.Event
+	51, // 34: event.v1.SetParticipantAttendanceResponse.participants:type_name -> event.v1.EventParticipant
+	50, // 35: event.v1.SetParticipantAttendanceResponse.adjustments:type_name -> event.v1.ParticipantAdjustment
+	51, // 36: event.v1.ReissueParticipantTokenResponse.participant:type_name -> event.v1.EventParticipant
+	48, // 37: event.v1.AddParticipantResponse.event:type_name -> event.v1.Event
+	51, // 38: event.v1.AddParticipantResponse.participant:type_name -> event.v1.EventParticipant
+	51, // 39: event.v1.AddParticipantResponse.participants:type_name -> event.v1.EventParticipant
+	48, // 40: event.v1.UpdateParticipantResponse.event:type_name -> event.v1.Event
+	51, // 41: event.v1.UpdateParticipantResponse.participants:type_name -> event.v1.EventParticipant
+	48, // 42: event.v1.RemoveParticipantResponse.event:type_name -> event.v1.Event
+	51, // 43: event.v1.RemoveParticipantResponse.participants:type_name -> event.v1.EventParticipant
+	48, // 44: event.v1.MergeParticipantsResponse.event:type_name -> event.v1.Event
+	51, // 45: event.v1.MergeParticipantsResponse.participants:type_name -> event.v1.EventParticipant
+	58, // 46: event.v1.AddExpenseResponse.expense:type_name -> event.v1.Expense
+	58, // 47: event.v1.ListExpensesResponse.expenses:type_name -> event.v1.Expense
+	59, // 48: event.v1.ListExpensesResponse.balances:type_name -> event.v1.Balance
+	60, // 49: event.v1.ListExpensesResponse.transfers:type_name -> event.v1.Transfer
+	48, // 50: event.v1.ArchiveEventResponse.event:type_name -> event.v1.Event
+	48, // 51: event.v1.UnarchiveEventResponse.event:type_name -> event.v1.Event
+	0,  // 52: event.v1.EventService.ListMyEvents:input_type -> event.v1.ListMyEventsRequest
+	2,  // 53: event.v1.EventService.CreateEvent:input_type -> event.v1.CreateEventRequest
+	4,  // 54: event.v1.EventService.UpdateEvent:input_type -> event.v1.UpdateEventRequest
+	6,  // 55: event.v1.EventService.DeleteEvent:input_type -> event.v1.DeleteEventRequest
+	8,  // 56: event.v1.EventService.ListEventParticipants:input_type -> event.v1.ListEventParticipantsRequest
+	10, // 57: event.v1.EventService.UpdateParticipantStatus:input_type -> event.v1.UpdateParticipantStatusRequest
+	12, // 58: event.v1.EventService.RecordPayment:input_type -> event.v1.RecordPaymentRequest
+	14, // 59: event.v1.EventService.SettleDeposits:input_type -> event.v1.SettleDepositsRequest
+	16, // 60: event.v1.EventService.FinalizeEvent:input_type -> event.v1.FinalizeEventRequest
+	18, // 61: event.v1.EventService.ListRefunds:input_type -> event.v1.ListRefundsRequest
+	20, // 62: event.v1.EventService.RecordRefund:input_type -> event.v1.RecordRefundRequest
+	22, // 63: event.v1.EventService.SetParticipantFixedAmount:input_type -> event.v1.SetParticipantFixedAmountRequest
+	24, // 64: event.v1.EventService.SetParticipantRounds:input_type -> event.v1.SetParticipantRoundsRequest
+	26, // 65: event.v1.EventService.SetParticipantAttendance:input_type -> event.v1.SetParticipantAttendanceRequest
+	28, // 66: event.v1.EventService.ReissueParticipantToken:input_type -> event.v1.ReissueParticipantTokenRequest
+	30, // 67: event.v1.EventService.AddParticipant:input_type -> event.v1.AddParticipantRequest
+	32, // 68: event.v1.EventService.UpdateParticipant:input_type -> event.v1.UpdateParticipantRequest
+	34, // 69: event.v1.EventService.RemoveParticipant:input_type -> event.v1.RemoveParticipantRequest
+	36, // 70: event.v1.EventService.MergeParticipants:input_type -> event.v1.MergeParticipantsRequest
+	38, // 71: event.v1.EventService.AddExpense:input_type -> event.v1.AddExpenseRequest
+	40, // 72: event.v1.EventService.DeleteExpense:input_type -> event.v1.DeleteExpenseRequest
+	42, // 73: event.v1.EventService.ListExpenses:input_type -> event.v1.ListExpensesRequest
+	44, // 74: event.v1.EventService.ArchiveEvent:input_type -> event.v1.ArchiveEventRequest
+	46, // 75: event.v1.EventService.UnarchiveEvent:input_type -> event.v1.UnarchiveEventRequest
+	1,  // 76: event.v1.EventService.ListMyEvents:output_type -> event.v1.ListMyEventsResponse
+	3,  // 77: event.v1.EventService.CreateEvent:output_type -> event.v1.CreateEventResponse
+	5,  // 78: event.v1.EventService.UpdateEvent:output_type -> event.v1.UpdateEventResponse
+	7,  // 79: event.v1.EventService.DeleteEvent:output_type -> event.v1.DeleteEventResponse
+	9,  // 80: event.v1.EventService.ListEventParticipants:output_type -> event.v1.ListEventParticipantsResponse
+	11, // 81: event.v1.EventService.UpdateParticipantStatus:output_type -> event.v1.UpdateParticipantStatusResponse
+	13, // 82: event.v1.EventService.RecordPayment:output_type -> event.v1.RecordPaymentResponse
+	15, // 83: event.v1.EventService.SettleDeposits:output_type -> event.v1.SettleDepositsResponse
+	17, // 84: event.v1.EventService.FinalizeEvent:output_type -> event.v1.FinalizeEventResponse
+	19, // 85: event.v1.EventService.ListRefunds:output_type -> event.v1.ListRefundsResponse
+	21, // 86: event.v1.EventService.RecordRefund:output_type -> event.v1.RecordRefundResponse
+	23, // 87: event.v1.EventService.SetParticipantFixedAmount:output_type -> event.v1.SetParticipantFixedAmountResponse
+	25, // 88: event.v1.EventService.SetParticipantRounds:output_type -> event.v1.SetParticipantRoundsResponse
+	27, // 89: event.v1.EventService.SetParticipantAttendance:output_type -> event.v1.SetParticipantAttendanceResponse
+	29, // 90: event.v1.EventService.ReissueParticipantToken:output_type -> event.v1.ReissueParticipantTokenResponse
+	31, // 91: event.v1.EventService.AddParticipant:output_type -> event.v1.AddParticipantResponse
+	33, // 92: event.v1.EventService.UpdateParticipant:output_type -> event.v1.UpdateParticipantResponse
+	35, // 93: event.v1.EventService.RemoveParticipant:output_type -> event.v1.RemoveParticipantResponse
+	37, // 94: event.v1.EventService.MergeParticipants:output_type -> event.v1.MergeParticipantsResponse
+	39, // 95: event.v1.EventService.AddExpense:output_type -> event.v1.AddExpenseResponse
+	41, // 96: event.v1.EventService.DeleteExpense:output_type -> event.v1.DeleteExpenseResponse
+	43, // 97: event.v1.EventService.ListExpenses:output_type -> event.v1.ListExpensesResponse
+	45, // 98: event.v1.EventService.ArchiveEvent:output_type -> event.v1.ArchiveEventResponse
+	47, // 99: event.v1.EventService.UnarchiveEvent:output_type -> event.v1.UnarchiveEventResponse
+	76, // [76:100] is the sub-list for method output_type
+	52, // [52:76] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_event_v1_event_service_proto_init() }
@@ -866,13 +3112,18 @@ func file_event_v1_event_service_proto_init() {
 		return
 	}
 	file_event_v1_event_proto_init()
+	file_event_v1_event_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_event_v1_event_service_proto_msgTypes[16].OneofWrappers = []any{}
+	file_event_v1_event_service_proto_msgTypes[20].OneofWrappers = []any{}
+	file_event_v1_event_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_event_v1_event_service_proto_msgTypes[26].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_v1_event_service_proto_rawDesc), len(file_event_v1_event_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -42,6 +42,15 @@ const (
 	// EventProfileServiceClaimPaymentProcedure is the fully-qualified name of the EventProfileService's
 	// ClaimPayment RPC.
 	EventProfileServiceClaimPaymentProcedure = "/event.v1.EventProfileService/ClaimPayment"
+	// EventProfileServiceChangeTierProcedure is the fully-qualified name of the EventProfileService's
+	// ChangeTier RPC.
+	EventProfileServiceChangeTierProcedure = "/event.v1.EventProfileService/ChangeTier"
+	// EventProfileServiceLeaveEventProcedure is the fully-qualified name of the EventProfileService's
+	// LeaveEvent RPC.
+	EventProfileServiceLeaveEventProcedure = "/event.v1.EventProfileService/LeaveEvent"
+	// EventProfileServiceChangeRoundsProcedure is the fully-qualified name of the EventProfileService's
+	// ChangeRounds RPC.
+	EventProfileServiceChangeRoundsProcedure = "/event.v1.EventProfileService/ChangeRounds"
 )
 
 // EventProfileServiceClient is a client for the event.v1.EventProfileService service.
@@ -49,6 +58,12 @@ type EventProfileServiceClient interface {
 	GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error)
 	JoinEvent(context.Context, *connect.Request[v1.JoinEventRequest]) (*connect.Response[v1.JoinEventResponse], error)
 	ClaimPayment(context.Context, *connect.Request[v1.ClaimPaymentRequest]) (*connect.Response[v1.ClaimPaymentResponse], error)
+	// ChangeTier moves the participant to another tier, which they can do until they pay.
+	ChangeTier(context.Context, *connect.Request[v1.ChangeTierRequest]) (*connect.Response[v1.ChangeTierResponse], error)
+	// LeaveEvent withdraws the participant, which they can do until they pay.
+	LeaveEvent(context.Context, *connect.Request[v1.LeaveEventRequest]) (*connect.Response[v1.LeaveEventResponse], error)
+	// ChangeRounds sets the later rounds the participant takes part in, which they can do until they pay.
+	ChangeRounds(context.Context, *connect.Request[v1.ChangeRoundsRequest]) (*connect.Response[v1.ChangeRoundsResponse], error)
 }
 
 // NewEventProfileServiceClient constructs a client for the event.v1.EventProfileService service. By
@@ -80,6 +95,24 @@ func NewEventProfileServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(eventProfileServiceMethods.ByName("ClaimPayment")),
 			connect.WithClientOptions(opts...),
 		),
+		changeTier: connect.NewClient[v1.ChangeTierRequest, v1.ChangeTierResponse](
+			httpClient,
+			baseURL+EventProfileServiceChangeTierProcedure,
+			connect.WithSchema(eventProfileServiceMethods.ByName("ChangeTier")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveEvent: connect.NewClient[v1.LeaveEventRequest, v1.LeaveEventResponse](
+			httpClient,
+			baseURL+EventProfileServiceLeaveEventProcedure,
+			connect.WithSchema(eventProfileServiceMethods.ByName("LeaveEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		changeRounds: connect.NewClient[v1.ChangeRoundsRequest, v1.ChangeRoundsResponse](
+			httpClient,
+			baseURL+EventProfileServiceChangeRoundsProcedure,
+			connect.WithSchema(eventProfileServiceMethods.ByName("ChangeRounds")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -88,6 +121,9 @@ type eventProfileServiceClient struct {
 	getEvent     *connect.Client[v1.GetEventRequest, v1.GetEventResponse]
 	joinEvent    *connect.Client[v1.JoinEventRequest, v1.JoinEventResponse]
 	claimPayment *connect.Client[v1.ClaimPaymentRequest, v1.ClaimPaymentResponse]
+	changeTier   *connect.Client[v1.ChangeTierRequest, v1.ChangeTierResponse]
+	leaveEvent   *connect.Client[v1.LeaveEventRequest, v1.LeaveEventResponse]
+	changeRounds *connect.Client[v1.ChangeRoundsRequest, v1.ChangeRoundsResponse]
 }
 
 // GetEvent calls event.v1.EventProfileService.GetEvent.
@@ -105,11 +141,32 @@ func (c *eventProfileServiceClient) ClaimPayment(ctx context.Context, req *conne
 	return c.claimPayment.CallUnary(ctx, req)
 }
 
+// ChangeTier calls event.v1.EventProfileService.ChangeTier.
+func (c *eventProfileServiceClient) ChangeTier(ctx context.Context, req *connect.Request[v1.ChangeTierRequest]) (*connect.Response[v1.ChangeTierResponse], error) {
+	return c.changeTier.CallUnary(ctx, req)
+}
+
+// LeaveEvent calls event.v1.EventProfileService.LeaveEvent.
+func (c *eventProfileServiceClient) LeaveEvent(ctx context.Context, req *connect.Request[v1.LeaveEventRequest]) (*connect.Response[v1.LeaveEventResponse], error) {
+	return c.leaveEvent.CallUnary(ctx, req)
+}
+
+// ChangeRounds calls event.v1.EventProfileService.ChangeRounds.
+func (c *eventProfileServiceClient) ChangeRounds(ctx context.Context, req *connect.Request[v1.ChangeRoundsRequest]) (*connect.Response[v1.ChangeRoundsResponse], error) {
+	return c.changeRounds.CallUnary(ctx, req)
+}
+
 // EventProfileServiceHandler is an implementation of the event.v1.EventProfileService service.
 type EventProfileServiceHandler interface {
 	GetEvent(context.Context, *connect.Request[v1.GetEventRequest]) (*connect.Response[v1.GetEventResponse], error)
 	JoinEvent(context.Context, *connect.Request[v1.JoinEventRequest]) (*connect.Response[v1.JoinEventResponse], error)
 	ClaimPayment(context.Context, *connect.Request[v1.ClaimPaymentRequest]) (*connect.Response[v1.ClaimPaymentResponse], error)
+	// ChangeTier moves the participant to another tier, which they can do until they pay.
+	ChangeTier(context.Context, *connect.Request[v1.ChangeTierRequest]) (*connect.Response[v1.ChangeTierResponse], error)
+	// LeaveEvent withdraws the participant, which they can do until they pay.
+	LeaveEvent(context.Context, *connect.Request[v1.LeaveEventRequest]) (*connect.Response[v1.LeaveEventResponse], error)
+	// ChangeRounds sets the later rounds the participant takes part in, which they can do until they pay.
+	ChangeRounds(context.Context, *connect.Request[v1.ChangeRoundsRequest]) (*connect.Response[v1.ChangeRoundsResponse], error)
 }
 
 // NewEventProfileServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -137,6 +194,24 @@ func NewEventProfileServiceHandler(svc EventProfileServiceHandler, opts ...conne
 		connect.WithSchema(eventProfileServiceMethods.ByName("ClaimPayment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventProfileServiceChangeTierHandler := connect.NewUnaryHandler(
+		EventProfileServiceChangeTierProcedure,
+		svc.ChangeTier,
+		connect.WithSchema(eventProfileServiceMethods.ByName("ChangeTier")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventProfileServiceLeaveEventHandler := connect.NewUnaryHandler(
+		EventProfileServiceLeaveEventProcedure,
+		svc.LeaveEvent,
+		connect.WithSchema(eventProfileServiceMethods.ByName("LeaveEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventProfileServiceChangeRoundsHandler := connect.NewUnaryHandler(
+		EventProfileServiceChangeRoundsProcedure,
+		svc.ChangeRounds,
+		connect.WithSchema(eventProfileServiceMethods.ByName("ChangeRounds")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/event.v1.EventProfileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EventProfileServiceGetEventProcedure:
@@ -145,6 +220,12 @@ func NewEventProfileServiceHandler(svc EventProfileServiceHandler, opts ...conne
 			eventProfileServiceJoinEventHandler.ServeHTTP(w, r)
 		case EventProfileServiceClaimPaymentProcedure:
 			eventProfileServiceClaimPaymentHandler.ServeHTTP(w, r)
+		case EventProfileServiceChangeTierProcedure:
+			eventProfileServiceChangeTierHandler.ServeHTTP(w, r)
+		case EventProfileServiceLeaveEventProcedure:
+			eventProfileServiceLeaveEventHandler.ServeHTTP(w, r)
+		case EventProfileServiceChangeRoundsProcedure:
+			eventProfileServiceChangeRoundsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -165,3 +246,15 @@ func (UnimplementedEventProfileServiceHandler) JoinEvent(context.Context, *conne
 func (UnimplementedEventProfileServiceHandler) ClaimPayment(context.Context, *connect.Request[v1.ClaimPaymentRequest]) (*connect.Response[v1.ClaimPaymentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventProfileService.ClaimPayment is not implemented"))
 }
+
+func (UnimplementedEventProfileServiceHandler) ChangeTier(context.Context, *connect.Request[v1.ChangeTierRequest]) (*connect.Response[v1.ChangeTierResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventProfileService.ChangeTier is not implemented"))
+}
+
+func (UnimplementedEventProfileServiceHandler) LeaveEvent(context.Context, *connect.Request[v1.LeaveEventRequest]) (*connect.Response[v1.LeaveEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventProfileService.LeaveEvent is not implemented"))
+}
+
+func (UnimplementedEventProfileServiceHandler) ChangeRounds(context.Context, *connect.Request[v1.ChangeRoundsRequest]) (*connect.Response[v1.ChangeRoundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventProfileService.ChangeRounds is not implemented"))
+}
@@ -51,6 +51,53 @@ const (
 	// EventServiceUpdateParticipantStatusProcedure is the fully-qualified name of the EventService's
 	// UpdateParticipantStatus RPC.
 	EventServiceUpdateParticipantStatusProcedure = "/event.v1.EventService/UpdateParticipantStatus"
+	// EventServiceRecordPaymentProcedure is the fully-qualified name of the EventService's
+	// RecordPayment RPC.
+	EventServiceRecordPaymentProcedure = "/event.v1.EventService/RecordPayment"
+	// EventServiceSettleDepositsProcedure is the fully-qualified name of the EventService's
+	// SettleDeposits RPC.
+	EventServiceSettleDepositsProcedure = "/event.v1.EventService/SettleDeposits"
+	// EventServiceFinalizeEventProcedure is the fully-qualified name of the EventService's
+	// FinalizeEvent RPC.
+	EventServiceFinalizeEventProcedure = "/event.v1.EventService/FinalizeEvent"
+	// EventServiceListRefundsProcedure is the fully-qualified name of the EventService's ListRefunds
+	// RPC.
+	EventServiceListRefundsProcedure = "/event.v1.EventService/ListRefunds"
+	// EventServiceRecordRefundProcedure is the fully-qualified name of the EventService's RecordRefund
+	// RPC.
+	EventServiceRecordRefundProcedure = "/event.v1.EventService/RecordRefund"
+	// EventServiceSetParticipantFixedAmountProcedure is the fully-qualified name of the EventService's
+	// SetParticipantFixedAmount RPC.
+	EventServiceSetParticipantFixedAmountProcedure = "/event.v1.EventService/SetParticipantFixedAmount"
+	// EventServiceSetParticipantRoundsProcedure is the fully-qualified name of the EventService's
+	// SetParticipantRounds RPC.
+	EventServiceSetParticipantRoundsProcedure = "/event.v1.EventService/SetParticipantRounds"
+	// EventServiceSetParticipantAttendanceProcedure is the fully-qualified name of the EventService's
+	// SetParticipantAttendance RPC.
+	EventServiceSetParticipantAttendanceProcedure = "/event.v1.EventService/SetParticipantAttendance"
+	// EventServiceReissueParticipantTokenProcedure is the fully-qualified name of the EventService's
+	// ReissueParticipantToken RPC.
+	EventServiceReissueParticipantTokenProcedure = "/event.v1.EventService/ReissueParticipantToken"
+	// EventServiceAddParticipantProcedure is the fully-qualified name of the EventService's
+	// AddParticipant RPC.
+	EventServiceAddParticipantProcedure = "/event.v1.EventService/AddParticipant"
+	// EventServiceUpdateParticipantProcedure is the fully-qualified name of the EventService's
+	// UpdateParticipant RPC.
+	EventServiceUpdateParticipantProcedure = "/event.v1.EventService/UpdateParticipant"
+	// EventServiceRemoveParticipantProcedure is the fully-qualified name of the EventService's
+	// RemoveParticipant RPC.
+	EventServiceRemoveParticipantProcedure = "/event.v1.EventService/RemoveParticipant"
+	// EventServiceMergeParticipantsProcedure is the fully-qualified name of the EventService's
+	// MergeParticipants RPC.
+	EventServiceMergeParticipantsProcedure = "/event.v1.EventService/MergeParticipants"
+	// EventServiceAddExpenseProcedure is the fully-qualified name of the EventService's AddExpense RPC.
+	EventServiceAddExpenseProcedure = "/event.v1.EventService/AddExpense"
+	// EventServiceDeleteExpenseProcedure is the fully-qualified name of the EventService's
+	// DeleteExpense RPC.
+	EventServiceDeleteExpenseProcedure = "/event.v1.EventService/DeleteExpense"
+	// EventServiceListExpensesProcedure is the fully-qualified name of the EventService's ListExpenses
+	// RPC.
+	EventServiceListExpensesProcedure = "/event.v1.EventService/ListExpenses"
 	// EventServiceArchiveEventProcedure is the fully-qualified name of the EventService's ArchiveEvent
 	// RPC.
 	EventServiceArchiveEventProcedure = "/event.v1.EventService/ArchiveEvent"
@@ -63,10 +110,50 @@ const (
 type EventServiceClient interface {
 	ListMyEvents(context.Context, *connect.Request[v1.ListMyEventsRequest]) (*connect.Response[v1.ListMyEventsResponse], error)
 	CreateEvent(context.Context, *connect.Request[v1.CreateEventRequest]) (*connect.Response[v1.CreateEventResponse], error)
+	// UpdateEvent recalculates what participants owe. For those who already paid or claimed, the difference is
+	// recorded as an adjustment instead of rewriting their amount.
 	UpdateEvent(context.Context, *connect.Request[v1.UpdateEventRequest]) (*connect.Response[v1.UpdateEventResponse], error)
 	DeleteEvent(context.Context, *connect.Request[v1.DeleteEventRequest]) (*connect.Response[v1.DeleteEventResponse], error)
 	ListEventParticipants(context.Context, *connect.Request[v1.ListEventParticipantsRequest]) (*connect.Response[v1.ListEventParticipantsResponse], error)
 	UpdateParticipantStatus(context.Context, *connect.Request[v1.UpdateParticipantStatusRequest]) (*connect.Response[v1.UpdateParticipantStatusResponse], error)
+	// RecordPayment adds a payment the organizer received, e.g. in cash, and updates the participant's status
+	// to partially paid or confirmed as their balance dictates.
+	RecordPayment(context.Context, *connect.Request[v1.RecordPaymentRequest]) (*connect.Response[v1.RecordPaymentResponse], error)
+	// SettleDeposits enters the actual total of an event that collected deposits. It does what FinalizeEvent does,
+	// which is where deposits are settled up, and fails for events that collect none or were settled already.
+	SettleDeposits(context.Context, *connect.Request[v1.SettleDepositsRequest]) (*connect.Response[v1.SettleDepositsResponse], error)
+	// FinalizeEvent replaces an estimated total with the actual one and recalculates what everyone owes.
+	// Participants who already paid get an adjustment, and everyone linked to an account is told their exact amount.
+	// It also settles up the deposits of an event that collected them: for participants who paid theirs,
+	// the difference from their share becomes an additional charge or a refund owed.
+	FinalizeEvent(context.Context, *connect.Request[v1.FinalizeEventRequest]) (*connect.Response[v1.FinalizeEventResponse], error)
+	// ListRefunds returns the refunds the organizer still owes across their events.
+	ListRefunds(context.Context, *connect.Request[v1.ListRefundsRequest]) (*connect.Response[v1.ListRefundsResponse], error)
+	// RecordRefund records money paid back to a participant, taking it off ListRefunds.
+	RecordRefund(context.Context, *connect.Request[v1.RecordRefundRequest]) (*connect.Response[v1.RecordRefundResponse], error)
+	// SetParticipantFixedAmount pins or unpins what a participant pays; everyone else's share is recalculated.
+	SetParticipantFixedAmount(context.Context, *connect.Request[v1.SetParticipantFixedAmountRequest]) (*connect.Response[v1.SetParticipantFixedAmountResponse], error)
+	// SetParticipantRounds sets the later rounds a participant takes part in. For those who already paid or claimed,
+	// the difference in what they owe is recorded as an adjustment.
+	SetParticipantRounds(context.Context, *connect.Request[v1.SetParticipantRoundsRequest]) (*connect.Response[v1.SetParticipantRoundsResponse], error)
+	// SetParticipantAttendance records when a participant came and left, or how much of the event they attended.
+	// Under an attendance split everyone's amount is recalculated, with adjustments for those who already paid.
+	SetParticipantAttendance(context.Context, *connect.Request[v1.SetParticipantAttendanceRequest]) (*connect.Response[v1.SetParticipantAttendanceResponse], error)
+	// ReissueParticipantToken issues a new participant token for the organizer to pass on, revoking the old one.
+	ReissueParticipantToken(context.Context, *connect.Request[v1.ReissueParticipantTokenRequest]) (*connect.Response[v1.ReissueParticipantTokenResponse], error)
+	// AddParticipant adds someone to the roster on their behalf, e.g. a guest without a phone.
+	AddParticipant(context.Context, *connect.Request[v1.AddParticipantRequest]) (*connect.Response[v1.AddParticipantResponse], error)
+	// UpdateParticipant renames a participant or moves them to another tier.
+	UpdateParticipant(context.Context, *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error)
+	// RemoveParticipant removes a participant without expenses; their spot goes to the waitlist.
+	RemoveParticipant(context.Context, *connect.Request[v1.RemoveParticipantRequest]) (*connect.Response[v1.RemoveParticipantResponse], error)
+	// MergeParticipants folds a duplicate join into another participant, who takes over its expenses.
+	MergeParticipants(context.Context, *connect.Request[v1.MergeParticipantsRequest]) (*connect.Response[v1.MergeParticipantsResponse], error)
+	// AddExpense records an expense paid by one participant for the given participants, split equally.
+	AddExpense(context.Context, *connect.Request[v1.AddExpenseRequest]) (*connect.Response[v1.AddExpenseResponse], error)
+	DeleteExpense(context.Context, *connect.Request[v1.DeleteExpenseRequest]) (*connect.Response[v1.DeleteExpenseResponse], error)
+	// ListExpenses returns the expense ledger with everyone's balance and the transfers that settle it up.
+	ListExpenses(context.Context, *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error)
 	ArchiveEvent(context.Context, *connect.Request[v1.ArchiveEventRequest]) (*connect.Response[v1.ArchiveEventResponse], error)
 	UnarchiveEvent(context.Context, *connect.Request[v1.UnarchiveEventRequest]) (*connect.Response[v1.UnarchiveEventResponse], error)
 }
@@ -118,6 +205,102 @@ func NewEventServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(eventServiceMethods.ByName("UpdateParticipantStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		recordPayment: connect.NewClient[v1.RecordPaymentRequest, v1.RecordPaymentResponse](
+			httpClient,
+			baseURL+EventServiceRecordPaymentProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("RecordPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		settleDeposits: connect.NewClient[v1.SettleDepositsRequest, v1.SettleDepositsResponse](
+			httpClient,
+			baseURL+EventServiceSettleDepositsProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("SettleDeposits")),
+			connect.WithClientOptions(opts...),
+		),
+		finalizeEvent: connect.NewClient[v1.FinalizeEventRequest, v1.FinalizeEventResponse](
+			httpClient,
+			baseURL+EventServiceFinalizeEventProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("FinalizeEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		listRefunds: connect.NewClient[v1.ListRefundsRequest, v1.ListRefundsResponse](
+			httpClient,
+			baseURL+EventServiceListRefundsProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("ListRefunds")),
+			connect.WithClientOptions(opts...),
+		),
+		recordRefund: connect.NewClient[v1.RecordRefundRequest, v1.RecordRefundResponse](
+			httpClient,
+			baseURL+EventServiceRecordRefundProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("RecordRefund")),
+			connect.WithClientOptions(opts...),
+		),
+		setParticipantFixedAmount: connect.NewClient[v1.SetParticipantFixedAmountRequest, v1.SetParticipantFixedAmountResponse](
+			httpClient,
+			baseURL+EventServiceSetParticipantFixedAmountProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("SetParticipantFixedAmount")),
+			connect.WithClientOptions(opts...),
+		),
+		setParticipantRounds: connect.NewClient[v1.SetParticipantRoundsRequest, v1.SetParticipantRoundsResponse](
+			httpClient,
+			baseURL+EventServiceSetParticipantRoundsProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("SetParticipantRounds")),
+			connect.WithClientOptions(opts...),
+		),
+		setParticipantAttendance: connect.NewClient[v1.SetParticipantAttendanceRequest, v1.SetParticipantAttendanceResponse](
+			httpClient,
+			baseURL+EventServiceSetParticipantAttendanceProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("SetParticipantAttendance")),
+			connect.WithClientOptions(opts...),
+		),
+		reissueParticipantToken: connect.NewClient[v1.ReissueParticipantTokenRequest, v1.ReissueParticipantTokenResponse](
+			httpClient,
+			baseURL+EventServiceReissueParticipantTokenProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("ReissueParticipantToken")),
+			connect.WithClientOptions(opts...),
+		),
+		addParticipant: connect.NewClient[v1.AddParticipantRequest, v1.AddParticipantResponse](
+			httpClient,
+			baseURL+EventServiceAddParticipantProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("AddParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		updateParticipant: connect.NewClient[v1.UpdateParticipantRequest, v1.UpdateParticipantResponse](
+			httpClient,
+			baseURL+EventServiceUpdateParticipantProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("UpdateParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		removeParticipant: connect.NewClient[v1.RemoveParticipantRequest, v1.RemoveParticipantResponse](
+			httpClient,
+			baseURL+EventServiceRemoveParticipantProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("RemoveParticipant")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeParticipants: connect.NewClient[v1.MergeParticipantsRequest, v1.MergeParticipantsResponse](
+			httpClient,
+			baseURL+EventServiceMergeParticipantsProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("MergeParticipants")),
+			connect.WithClientOptions(opts...),
+		),
+		addExpense: connect.NewClient[v1.AddExpenseRequest, v1.AddExpenseResponse](
+			httpClient,
+			baseURL+EventServiceAddExpenseProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("AddExpense")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteExpense: connect.NewClient[v1.DeleteExpenseRequest, v1.DeleteExpenseResponse](
+			httpClient,
+			baseURL+EventServiceDeleteExpenseProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("DeleteExpense")),
+			connect.WithClientOptions(opts...),
+		),
+		listExpenses: connect.NewClient[v1.ListExpensesRequest, v1.ListExpensesResponse](
+			httpClient,
+			baseURL+EventServiceListExpensesProcedure,
+			connect.WithSchema(eventServiceMethods.ByName("ListExpenses")),
+			connect.WithClientOptions(opts...),
+		),
 		archiveEvent: connect.NewClient[v1.ArchiveEventRequest, v1.ArchiveEventResponse](
 			httpClient,
 			baseURL+EventServiceArchiveEventProcedure,
@@ -135,14 +318,30 @@ func NewEventServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 
 // eventServiceClient implements EventServiceClient.
 type eventServiceClient struct {
-	listMyEvents            *connect.Client[v1.ListMyEventsRequest, v1.ListMyEventsResponse]
-	createEvent             *connect.Client[v1.CreateEventRequest, v1.CreateEventResponse]
-	updateEvent             *connect.Client[v1.UpdateEventRequest, v1.UpdateEventResponse]
-	deleteEvent             *connect.Client[v1.DeleteEventRequest, v1.DeleteEventResponse]
-	listEventParticipants   *connect.Client[v1.ListEventParticipantsRequest, v1.ListEventParticipantsResponse]
-	updateParticipantStatus *connect.Client[v1.UpdateParticipantStatusRequest, v1.UpdateParticipantStatusResponse]
-	archiveEvent            *connect.Client[v1.ArchiveEventRequest, v1.ArchiveEventResponse]
-	unarchiveEvent          *connect.Client[v1.UnarchiveEventRequest, v1.UnarchiveEventResponse]
+	listMyEvents              *connect.Client[v1.ListMyEventsRequest, v1.ListMyEventsResponse]
+	createEvent               *connect.Client[v1.CreateEventRequest, v1.CreateEventResponse]
+	updateEvent               *connect.Client[v1.UpdateEventRequest, v1.UpdateEventResponse]
+	deleteEvent               *connect.Client[v1.DeleteEventRequest, v1.DeleteEventResponse]
+	listEventParticipants     *connect.Client[v1.ListEventParticipantsRequest, v1.ListEventParticipantsResponse]
+	updateParticipantStatus   *connect.Client[v1.UpdateParticipantStatusRequest, v1.UpdateParticipantStatusResponse]
+	recordPayment             *connect.Client[v1.RecordPaymentRequest, v1.RecordPaymentResponse]
+	settleDeposits            *connect.Client[v1.SettleDepositsRequest, v1.SettleDepositsResponse]
+	finalizeEvent             *connect.Client[v1.FinalizeEventRequest, v1.FinalizeEventResponse]
+	listRefunds               *connect.Client[v1.ListRefundsRequest, v1.ListRefundsResponse]
+	recordRefund              *connect.Client[v1.RecordRefundRequest, v1.RecordRefundResponse]
+	setParticipantFixedAmount *connect.Client[v1.SetParticipantFixedAmountRequest, v1.SetParticipantFixedAmountResponse]
+	setParticipantRounds      *connect.Client[v1.SetParticipantRoundsRequest, v1.SetParticipantRoundsResponse]
+	setParticipantAttendance  *connect.Client[v1.SetParticipantAttendanceRequest, v1.SetParticipantAttendanceResponse]
+	reissueParticipantToken   *connect.Client[v1.ReissueParticipantTokenRequest, v1.ReissueParticipantTokenResponse]
+	addParticipant            *connect.Client[v1.AddParticipantRequest, v1.AddParticipantResponse]
+	updateParticipant         *connect.Client[v1.UpdateParticipantRequest, v1.UpdateParticipantResponse]
+	removeParticipant         *connect.Client[v1.RemoveParticipantRequest, v1.RemoveParticipantResponse]
+	mergeParticipants         *connect.Client[v1.MergeParticipantsRequest, v1.MergeParticipantsResponse]
+	addExpense                *connect.Client[v1.AddExpenseRequest, v1.AddExpenseResponse]
+	deleteExpense             *connect.Client[v1.DeleteExpenseRequest, v1.DeleteExpenseResponse]
+	listExpenses              *connect.Client[v1.ListExpensesRequest, v1.ListExpensesResponse]
+	archiveEvent              *connect.Client[v1.ArchiveEventRequest, v1.ArchiveEventResponse]
+	unarchiveEvent            *connect.Client[v1.UnarchiveEventRequest, v1.UnarchiveEventResponse]
 }
 
 // ListMyEvents calls event.v1.EventService.ListMyEvents.
@@ -175,6 +374,86 @@ func (c *eventServiceClient) UpdateParticipantStatus(ctx context.Context, req *c
 	return c.updateParticipantStatus.CallUnary(ctx, req)
 }
 
+// RecordPayment calls event.v1.EventService.RecordPayment.
+func (c *eventServiceClient) RecordPayment(ctx context.Context, req *connect.Request[v1.RecordPaymentRequest]) (*connect.Response[v1.RecordPaymentResponse], error) {
+	return c.recordPayment.CallUnary(ctx, req)
+}
+
+// SettleDeposits calls event.v1.EventService.SettleDeposits.
+func (c *eventServiceClient) SettleDeposits(ctx context.Context, req *connect.Request[v1.SettleDepositsRequest]) (*connect.Response[v1.SettleDepositsResponse], error) {
+	return c.settleDeposits.CallUnary(ctx, req)
+}
+
+// FinalizeEvent calls event.v1.EventService.FinalizeEvent.
+func (c *eventServiceClient) FinalizeEvent(ctx context.Context, req *connect.Request[v1.FinalizeEventRequest]) (*connect.Response[v1.FinalizeEventResponse], error) {
+	return c.finalizeEvent.CallUnary(ctx, req)
+}
+
+// ListRefunds calls event.v1.EventService.ListRefunds.
+func (c *eventServiceClient) ListRefunds(ctx context.Context, req *connect.Request[v1.ListRefundsRequest]) (*connect.Response[v1.ListRefundsResponse], error) {
+	return c.listRefunds.CallUnary(ctx, req)
+}
+
+// RecordRefund calls event.v1.EventService.RecordRefund.
+func (c *eventServiceClient) RecordRefund(ctx context.Context, req *connect.Request[v1.RecordRefundRequest]) (*connect.Response[v1.RecordRefundResponse], error) {
+	return c.recordRefund.CallUnary(ctx, req)
+}
+
+// SetParticipantFixedAmount calls event.v1.EventService.SetParticipantFixedAmount.
+func (c *eventServiceClient) SetParticipantFixedAmount(ctx context.Context, req *connect.Request[v1.SetParticipantFixedAmountRequest]) (*connect.Response[v1.SetParticipantFixedAmountResponse], error) {
+	return c.setParticipantFixedAmount.CallUnary(ctx, req)
+}
+
+// SetParticipantRounds calls event.v1.EventService.SetParticipantRounds.
+func (c *eventServiceClient) SetParticipantRounds(ctx context.Context, req *connect.Request[v1.SetParticipantRoundsRequest]) (*connect.Response[v1.SetParticipantRoundsResponse], error) {
+	return c.setParticipantRounds.CallUnary(ctx, req)
+}
+
+// SetParticipantAttendance calls event.v1.EventService.SetParticipantAttendance.
+func (c *eventServiceClient) SetParticipantAttendance(ctx context.Context, req *connect.Request[v1.SetParticipantAttendanceRequest]) (*connect.Response[v1.SetParticipantAttendanceResponse], error) {
+	return c.setParticipantAttendance.CallUnary(ctx, req)
+}
+
+// ReissueParticipantToken calls event.v1.EventService.ReissueParticipantToken.
+func (c *eventServiceClient) ReissueParticipantToken(ctx context.Context, req *connect.Request[v1.ReissueParticipantTokenRequest]) (*connect.Response[v1.ReissueParticipantTokenResponse], error) {
+	return c.reissueParticipantToken.CallUnary(ctx, req)
+}
+
+// AddParticipant calls event.v1.EventService.AddParticipant.
+func (c *eventServiceClient) AddParticipant(ctx context.Context, req *connect.Request[v1.AddParticipantRequest]) (*connect.Response[v1.AddParticipantResponse], error) {
+	return c.addParticipant.CallUnary(ctx, req)
+}
+
+// UpdateParticipant calls event.v1.EventService.UpdateParticipant.
+func (c *eventServiceClient) UpdateParticipant(ctx context.Context, req *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error) {
+	return c.updateParticipant.CallUnary(ctx, req)
+}
+
+// RemoveParticipant calls event.v1.EventService.RemoveParticipant.
+func (c *eventServiceClient) RemoveParticipant(ctx context.Context, req *connect.Request[v1.RemoveParticipantRequest]) (*connect.Response[v1.RemoveParticipantResponse], error) {
+	return c.removeParticipant.CallUnary(ctx, req)
+}
+
+// MergeParticipants calls event.v1.EventService.MergeParticipants.
+func (c *eventServiceClient) MergeParticipants(ctx context.Context, req *connect.Request[v1.MergeParticipantsRequest]) (*connect.Response[v1.MergeParticipantsResponse], error) {
+	return c.mergeParticipants.CallUnary(ctx, req)
+}
+
+// AddExpense calls event.v1.EventService.AddExpense.
+func (c *eventServiceClient) AddExpense(ctx context.Context, req *connect.Request[v1.AddExpenseRequest]) (*connect.Response[v1.AddExpenseResponse], error) {
+	return c.addExpense.CallUnary(ctx, req)
+}
+
+// DeleteExpense calls event.v1.EventService.DeleteExpense.
+func (c *eventServiceClient) DeleteExpense(ctx context.Context, req *connect.Request[v1.DeleteExpenseRequest]) (*connect.Response[v1.DeleteExpenseResponse], error) {
+	return c.deleteExpense.CallUnary(ctx, req)
+}
+
+// ListExpenses calls event.v1.EventService.ListExpenses.
+func (c *eventServiceClient) ListExpenses(ctx context.Context, req *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error) {
+	return c.listExpenses.CallUnary(ctx, req)
+}
+
 // ArchiveEvent calls event.v1.EventService.ArchiveEvent.
 func (c *eventServiceClient) ArchiveEvent(ctx context.Context, req *connect.Request[v1.ArchiveEventRequest]) (*connect.Response[v1.ArchiveEventResponse], error) {
 	return c.archiveEvent.CallUnary(ctx, req)
@@ -189,10 +468,50 @@ func (c *eventServiceClient) UnarchiveEvent(ctx context.Context, req *connect.Re
 type EventServiceHandler interface {
 	ListMyEvents(context.Context, *connect.Request[v1.ListMyEventsRequest]) (*connect.Response[v1.ListMyEventsResponse], error)
 	CreateEvent(context.Context, *connect.Request[v1.CreateEventRequest]) (*connect.Response[v1.CreateEventResponse], error)
+	// UpdateEvent recalculates what participants owe. For those who already paid or claimed, the difference is
+	// recorded as an adjustment instead of rewriting their amount.
 	UpdateEvent(context.Context, *connect.Request[v1.UpdateEventRequest]) (*connect.Response[v1.UpdateEventResponse], error)
 	DeleteEvent(context.Context, *connect.Request[v1.DeleteEventRequest]) (*connect.Response[v1.DeleteEventResponse], error)
 	ListEventParticipants(context.Context, *connect.Request[v1.ListEventParticipantsRequest]) (*connect.Response[v1.ListEventParticipantsResponse], error)
 	UpdateParticipantStatus(context.Context, *connect.Request[v1.UpdateParticipantStatusRequest]) (*connect.Response[v1.UpdateParticipantStatusResponse], error)
+	// RecordPayment adds a payment the organizer received, e.g. in cash, and updates the participant's status
+	// to partially paid or confirmed as their balance dictates.
+	RecordPayment(context.Context, *connect.Request[v1.RecordPaymentRequest]) (*connect.Response[v1.RecordPaymentResponse], error)
+	// SettleDeposits enters the actual total of an event that collected deposits. It does what FinalizeEvent does,
+	// which is where deposits are settled up, and fails for events that collect none or were settled already.
+	SettleDeposits(context.Context, *connect.Request[v1.SettleDepositsRequest]) (*connect.Response[v1.SettleDepositsResponse], error)
+	// FinalizeEvent replaces an estimated total with the actual one and recalculates what everyone owes.
+	// Participants who already paid get an adjustment, and everyone linked to an account is told their exact amount.
+	// It also settles up the deposits of an event that collected them: for participants who paid theirs,
+	// the difference from their share becomes an additional charge or a refund owed.
+	FinalizeEvent(context.Context, *connect.Request[v1.FinalizeEventRequest]) (*connect.Response[v1.FinalizeEventResponse], error)
+	// ListRefunds returns the refunds the organizer still owes across their events.
+	ListRefunds(context.Context, *connect.Request[v1.ListRefundsRequest]) (*connect.Response[v1.ListRefundsResponse], error)
+	// RecordRefund records money paid back to a participant, taking it off ListRefunds.
+	RecordRefund(context.Context, *connect.Request[v1.RecordRefundRequest]) (*connect.Response[v1.RecordRefundResponse], error)
+	// SetParticipantFixedAmount pins or unpins what a participant pays; everyone else's share is recalculated.
+	SetParticipantFixedAmount(context.Context, *connect.Request[v1.SetParticipantFixedAmountRequest]) (*connect.Response[v1.SetParticipantFixedAmountResponse], error)
+	// SetParticipantRounds sets the later rounds a participant takes part in. For those who already paid or claimed,
+	// the difference in what they owe is recorded as an adjustment.
+	SetParticipantRounds(context.Context, *connect.Request[v1.SetParticipantRoundsRequest]) (*connect.Response[v1.SetParticipantRoundsResponse], error)
+	// SetParticipantAttendance records when a participant came and left, or how much of the event they attended.
+	// Under an attendance split everyone's amount is recalculated, with adjustments for those who already paid.
+	SetParticipantAttendance(context.Context, *connect.Request[v1.SetParticipantAttendanceRequest]) (*connect.Response[v1.SetParticipantAttendanceResponse], error)
+	// ReissueParticipantToken issues a new participant token for the organizer to pass on, revoking the old one.
+	ReissueParticipantToken(context.Context, *connect.Request[v1.ReissueParticipantTokenRequest]) (*connect.Response[v1.ReissueParticipantTokenResponse], error)
+	// AddParticipant adds someone to the roster on their behalf, e.g. a guest without a phone.
+	AddParticipant(context.Context, *connect.Request[v1.AddParticipantRequest]) (*connect.Response[v1.AddParticipantResponse], error)
+	// UpdateParticipant renames a participant or moves them to another tier.
+	UpdateParticipant(context.Context, *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error)
+	// RemoveParticipant removes a participant without expenses; their spot goes to the waitlist.
+	RemoveParticipant(context.Context, *connect.Request[v1.RemoveParticipantRequest]) (*connect.Response[v1.RemoveParticipantResponse], error)
+	// MergeParticipants folds a duplicate join into another participant, who takes over its expenses.
+	MergeParticipants(context.Context, *connect.Request[v1.MergeParticipantsRequest]) (*connect.Response[v1.MergeParticipantsResponse], error)
+	// AddExpense records an expense paid by one participant for the given participants, split equally.
+	AddExpense(context.Context, *connect.Request[v1.AddExpenseRequest]) (*connect.Response[v1.AddExpenseResponse], error)
+	DeleteExpense(context.Context, *connect.Request[v1.DeleteExpenseRequest]) (*connect.Response[v1.DeleteExpenseResponse], error)
+	// ListExpenses returns the expense ledger with everyone's balance and the transfers that settle it up.
+	ListExpenses(context.Context, *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error)
 	ArchiveEvent(context.Context, *connect.Request[v1.ArchiveEventRequest]) (*connect.Response[v1.ArchiveEventResponse], error)
 	UnarchiveEvent(context.Context, *connect.Request[v1.UnarchiveEventRequest]) (*connect.Response[v1.UnarchiveEventResponse], error)
 }
@@ -240,6 +559,102 @@ func NewEventServiceHandler(svc EventServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(eventServiceMethods.ByName("UpdateParticipantStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	eventServiceRecordPaymentHandler := connect.NewUnaryHandler(
+		EventServiceRecordPaymentProcedure,
+		svc.RecordPayment,
+		connect.WithSchema(eventServiceMethods.ByName("RecordPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceSettleDepositsHandler := connect.NewUnaryHandler(
+		EventServiceSettleDepositsProcedure,
+		svc.SettleDeposits,
+		connect.WithSchema(eventServiceMethods.ByName("SettleDeposits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceFinalizeEventHandler := connect.NewUnaryHandler(
+		EventServiceFinalizeEventProcedure,
+		svc.FinalizeEvent,
+		connect.WithSchema(eventServiceMethods.ByName("FinalizeEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceListRefundsHandler := connect.NewUnaryHandler(
+		EventServiceListRefundsProcedure,
+		svc.ListRefunds,
+		connect.WithSchema(eventServiceMethods.ByName("ListRefunds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceRecordRefundHandler := connect.NewUnaryHandler(
+		EventServiceRecordRefundProcedure,
+		svc.RecordRefund,
+		connect.WithSchema(eventServiceMethods.ByName("RecordRefund")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceSetParticipantFixedAmountHandler := connect.NewUnaryHandler(
+		EventServiceSetParticipantFixedAmountProcedure,
+		svc.SetParticipantFixedAmount,
+		connect.WithSchema(eventServiceMethods.ByName("SetParticipantFixedAmount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceSetParticipantRoundsHandler := connect.NewUnaryHandler(
+		EventServiceSetParticipantRoundsProcedure,
+		svc.SetParticipantRounds,
+		connect.WithSchema(eventServiceMethods.ByName("SetParticipantRounds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceSetParticipantAttendanceHandler := connect.NewUnaryHandler(
+		EventServiceSetParticipantAttendanceProcedure,
+		svc.SetParticipantAttendance,
+		connect.WithSchema(eventServiceMethods.ByName("SetParticipantAttendance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceReissueParticipantTokenHandler := connect.NewUnaryHandler(
+		EventServiceReissueParticipantTokenProcedure,
+		svc.ReissueParticipantToken,
+		connect.WithSchema(eventServiceMethods.ByName("ReissueParticipantToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceAddParticipantHandler := connect.NewUnaryHandler(
+		EventServiceAddParticipantProcedure,
+		svc.AddParticipant,
+		connect.WithSchema(eventServiceMethods.ByName("AddParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceUpdateParticipantHandler := connect.NewUnaryHandler(
+		EventServiceUpdateParticipantProcedure,
+		svc.UpdateParticipant,
+		connect.WithSchema(eventServiceMethods.ByName("UpdateParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceRemoveParticipantHandler := connect.NewUnaryHandler(
+		EventServiceRemoveParticipantProcedure,
+		svc.RemoveParticipant,
+		connect.WithSchema(eventServiceMethods.ByName("RemoveParticipant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceMergeParticipantsHandler := connect.NewUnaryHandler(
+		EventServiceMergeParticipantsProcedure,
+		svc.MergeParticipants,
+		connect.WithSchema(eventServiceMethods.ByName("MergeParticipants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceAddExpenseHandler := connect.NewUnaryHandler(
+		EventServiceAddExpenseProcedure,
+		svc.AddExpense,
+		connect.WithSchema(eventServiceMethods.ByName("AddExpense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceDeleteExpenseHandler := connect.NewUnaryHandler(
+		EventServiceDeleteExpenseProcedure,
+		svc.DeleteExpense,
+		connect.WithSchema(eventServiceMethods.ByName("DeleteExpense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	eventServiceListExpensesHandler := connect.NewUnaryHandler(
+		EventServiceListExpensesProcedure,
+		svc.ListExpenses,
+		connect.WithSchema(eventServiceMethods.ByName("ListExpenses")),
+		connect.WithHandlerOptions(opts...),
+	)
 	eventServiceArchiveEventHandler := connect.NewUnaryHandler(
 		EventServiceArchiveEventProcedure,
 		svc.ArchiveEvent,
@@ -266,6 +681,38 @@ func NewEventServiceHandler(svc EventServiceHandler, opts ...connect.HandlerOpti
 			eventServiceListEventParticipantsHandler.ServeHTTP(w, r)
 		case EventServiceUpdateParticipantStatusProcedure:
 			eventServiceUpdateParticipantStatusHandler.ServeHTTP(w, r)
+		case EventServiceRecordPaymentProcedure:
+			eventServiceRecordPaymentHandler.ServeHTTP(w, r)
+		case EventServiceSettleDepositsProcedure:
+			eventServiceSettleDepositsHandler.ServeHTTP(w, r)
+		case EventServiceFinalizeEventProcedure:
+			eventServiceFinalizeEventHandler.ServeHTTP(w, r)
+		case EventServiceListRefundsProcedure:
+			eventServiceListRefundsHandler.ServeHTTP(w, r)
+		case EventServiceRecordRefundProcedure:
+			eventServiceRecordRefundHandler.ServeHTTP(w, r)
+		case EventServiceSetParticipantFixedAmountProcedure:
+			eventServiceSetParticipantFixedAmountHandler.ServeHTTP(w, r)
+		case EventServiceSetParticipantRoundsProcedure:
+			eventServiceSetParticipantRoundsHandler.ServeHTTP(w, r)
+		case EventServiceSetParticipantAttendanceProcedure:
+			eventServiceSetParticipantAttendanceHandler.ServeHTTP(w, r)
+		case EventServiceReissueParticipantTokenProcedure:
+			eventServiceReissueParticipantTokenHandler.ServeHTTP(w, r)
+		case EventServiceAddParticipantProcedure:
+			eventServiceAddParticipantHandler.ServeHTTP(w, r)
+		case EventServiceUpdateParticipantProcedure:
+			eventServiceUpdateParticipantHandler.ServeHTTP(w, r)
+		case EventServiceRemoveParticipantProcedure:
+			eventServiceRemoveParticipantHandler.ServeHTTP(w, r)
+		case EventServiceMergeParticipantsProcedure:
+			eventServiceMergeParticipantsHandler.ServeHTTP(w, r)
+		case EventServiceAddExpenseProcedure:
+			eventServiceAddExpenseHandler.ServeHTTP(w, r)
+		case EventServiceDeleteExpenseProcedure:
+			eventServiceDeleteExpenseHandler.ServeHTTP(w, r)
+		case EventServiceListExpensesProcedure:
+			eventServiceListExpensesHandler.ServeHTTP(w, r)
 		case EventServiceArchiveEventProcedure:
 			eventServiceArchiveEventHandler.ServeHTTP(w, r)
 		case EventServiceUnarchiveEventProcedure:
@@ -303,6 +750,70 @@ func (UnimplementedEventServiceHandler) UpdateParticipantStatus(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.UpdateParticipantStatus is not implemented"))
 }
 
+func (UnimplementedEventServiceHandler) RecordPayment(context.Context, *connect.Request[v1.RecordPaymentRequest]) (*connect.Response[v1.RecordPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.RecordPayment is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) SettleDeposits(context.Context, *connect.Request[v1.SettleDepositsRequest]) (*connect.Response[v1.SettleDepositsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.SettleDeposits is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) FinalizeEvent(context.Context, *connect.Request[v1.FinalizeEventRequest]) (*connect.Response[v1.FinalizeEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.FinalizeEvent is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) ListRefunds(context.Context, *connect.Request[v1.ListRefundsRequest]) (*connect.Response[v1.ListRefundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.ListRefunds is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) RecordRefund(context.Context, *connect.Request[v1.RecordRefundRequest]) (*connect.Response[v1.RecordRefundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.RecordRefund is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) SetParticipantFixedAmount(context.Context, *connect.Request[v1.SetParticipantFixedAmountRequest]) (*connect.Response[v1.SetParticipantFixedAmountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.SetParticipantFixedAmount is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) SetParticipantRounds(context.Context, *connect.Request[v1.SetParticipantRoundsRequest]) (*connect.Response[v1.SetParticipantRoundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.SetParticipantRounds is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) SetParticipantAttendance(context.Context, *connect.Request[v1.SetParticipantAttendanceRequest]) (*connect.Response[v1.SetParticipantAttendanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.SetParticipantAttendance is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) ReissueParticipantToken(context.Context, *connect.Request[v1.ReissueParticipantTokenRequest]) (*connect.Response[v1.ReissueParticipantTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.ReissueParticipantToken is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) AddParticipant(context.Context, *connect.Request[v1.AddParticipantRequest]) (*connect.Response[v1.AddParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.AddParticipant is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) UpdateParticipant(context.Context, *connect.Request[v1.UpdateParticipantRequest]) (*connect.Response[v1.UpdateParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.UpdateParticipant is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) RemoveParticipant(context.Context, *connect.Request[v1.RemoveParticipantRequest]) (*connect.Response[v1.RemoveParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.RemoveParticipant is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) MergeParticipants(context.Context, *connect.Request[v1.MergeParticipantsRequest]) (*connect.Response[v1.MergeParticipantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.MergeParticipants is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) AddExpense(context.Context, *connect.Request[v1.AddExpenseRequest]) (*connect.Response[v1.AddExpenseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.AddExpense is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) DeleteExpense(context.Context, *connect.Request[v1.DeleteExpenseRequest]) (*connect.Response[v1.DeleteExpenseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.DeleteExpense is not implemented"))
+}
+
+func (UnimplementedEventServiceHandler) ListExpenses(context.Context, *connect.Request[v1.ListExpensesRequest]) (*connect.Response[v1.ListExpensesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.ListExpenses is not implemented"))
+}
+
 func (UnimplementedEventServiceHandler) ArchiveEvent(context.Context, *connect.Request[v1.ArchiveEventRequest]) (*connect.Response[v1.ArchiveEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("event.v1.EventService.ArchiveEvent is not implemented"))
 }
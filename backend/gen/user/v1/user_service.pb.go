@@ -58,8 +58,11 @@ func (*GetMeRequest) Descriptor() ([]byte, []int) {
 }
 
 type GetMeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// line_friend tells whether the user has added the official LINE account, which we need to message them.
+	// Unset when LINE has not reported it yet.
+	LineFriend    *bool `protobuf:"varint,2,opt,name=line_friend,json=lineFriend,proto3,oneof" json:"line_friend,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -101,6 +104,13 @@ func (x *GetMeResponse) GetUser() *User {
 	return nil
 }
 
+func (x *GetMeResponse) GetLineFriend() bool {
+	if x != nil && x.LineFriend != nil {
+		return *x.LineFriend
+	}
+	return false
+}
+
 type UpdateSlugRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
@@ -277,14 +287,246 @@ func (x *CheckSlugAvailabilityResponse) GetAvailable() bool {
 	return false
 }
 
+type NotificationSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line_enabled notifies through the official LINE account. It only reaches users who added it as a friend.
+	LineEnabled   bool   `protobuf:"varint,1,opt,name=line_enabled,json=lineEnabled,proto3" json:"line_enabled,omitempty"`
+	EmailEnabled  bool   `protobuf:"varint,2,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSetting) Reset() {
+	*x = NotificationSetting{}
+	mi := &file_user_v1_user_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSetting) ProtoMessage() {}
+
+func (x *NotificationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSetting.ProtoReflect.Descriptor instead.
+func (*NotificationSetting) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NotificationSetting) GetLineEnabled() bool {
+	if x != nil {
+		return x.LineEnabled
+	}
+	return false
+}
+
+func (x *NotificationSetting) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
+}
+
+func (x *NotificationSetting) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetNotificationSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSettingRequest) Reset() {
+	*x = GetNotificationSettingRequest{}
+	mi := &file_user_v1_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingRequest) ProtoMessage() {}
+
+func (x *GetNotificationSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+type GetNotificationSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *NotificationSetting   `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSettingResponse) Reset() {
+	*x = GetNotificationSettingResponse{}
+	mi := &file_user_v1_user_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingResponse) ProtoMessage() {}
+
+func (x *GetNotificationSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNotificationSettingResponse) GetSetting() *NotificationSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdateNotificationSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *NotificationSetting   `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSettingRequest) Reset() {
+	*x = UpdateNotificationSettingRequest{}
+	mi := &file_user_v1_user_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSettingRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettingRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateNotificationSettingRequest) GetSetting() *NotificationSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type UpdateNotificationSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Setting       *NotificationSetting   `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSettingResponse) Reset() {
+	*x = UpdateNotificationSettingResponse{}
+	mi := &file_user_v1_user_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSettingResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSettingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettingResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateNotificationSettingResponse) GetSetting() *NotificationSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
 var File_user_v1_user_service_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"\x1auser/v1/user_service.proto\x12\auser.v1\x1a\x12user/v1/user.proto\"\x0e\n" +
-	"\fGetMeRequest\"2\n" +
+	"\fGetMeRequest\"h\n" +
 	"\rGetMeResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"'\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\x12$\n" +
+	"\vline_friend\x18\x02 \x01(\bH\x00R\n" +
+	"lineFriend\x88\x01\x01B\x0e\n" +
+	"\f_line_friend\"'\n" +
 	"\x11UpdateSlugRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\"7\n" +
 	"\x12UpdateSlugResponse\x12!\n" +
@@ -292,12 +534,25 @@ const file_user_v1_user_service_proto_rawDesc = "" +
 	"\x1cCheckSlugAvailabilityRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\"=\n" +
 	"\x1dCheckSlugAvailabilityResponse\x12\x1c\n" +
-	"\tavailable\x18\x01 \x01(\bR\tavailable2\xf4\x01\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\"s\n" +
+	"\x13NotificationSetting\x12!\n" +
+	"\fline_enabled\x18\x01 \x01(\bR\vlineEnabled\x12#\n" +
+	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"\x1f\n" +
+	"\x1dGetNotificationSettingRequest\"X\n" +
+	"\x1eGetNotificationSettingResponse\x126\n" +
+	"\asetting\x18\x01 \x01(\v2\x1c.user.v1.NotificationSettingR\asetting\"Z\n" +
+	" UpdateNotificationSettingRequest\x126\n" +
+	"\asetting\x18\x01 \x01(\v2\x1c.user.v1.NotificationSettingR\asetting\"[\n" +
+	"!UpdateNotificationSettingResponse\x126\n" +
+	"\asetting\x18\x01 \x01(\v2\x1c.user.v1.NotificationSettingR\asetting2\xd3\x03\n" +
 	"\vUserService\x126\n" +
 	"\x05GetMe\x12\x15.user.v1.GetMeRequest\x1a\x16.user.v1.GetMeResponse\x12E\n" +
 	"\n" +
 	"UpdateSlug\x12\x1a.user.v1.UpdateSlugRequest\x1a\x1b.user.v1.UpdateSlugResponse\x12f\n" +
-	"\x15CheckSlugAvailability\x12%.user.v1.CheckSlugAvailabilityRequest\x1a&.user.v1.CheckSlugAvailabilityResponseB\x8a\x01\n" +
+	"\x15CheckSlugAvailability\x12%.user.v1.CheckSlugAvailabilityRequest\x1a&.user.v1.CheckSlugAvailabilityResponse\x12i\n" +
+	"\x16GetNotificationSetting\x12&.user.v1.GetNotificationSettingRequest\x1a'.user.v1.GetNotificationSettingResponse\x12r\n" +
+	"\x19UpdateNotificationSetting\x12).user.v1.UpdateNotificationSettingRequest\x1a*.user.v1.UpdateNotificationSettingResponseB\x8a\x01\n" +
 	"\vcom.user.v1B\x10UserServiceProtoP\x01Z,github.com/mickamy/sampay/gen/user/v1;userv1\xa2\x02\x03UXX\xaa\x02\aUser.V1\xca\x02\aUser\\V1\xe2\x02\x13User\\V1\\GPBMetadata\xea\x02\bUser::V1b\x06proto3"
 
 var (
@@ -312,30 +567,42 @@ func file_user_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_service_proto_rawDescData
 }
 
-var file_user_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_user_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_user_v1_user_service_proto_goTypes = []any{
-	(*GetMeRequest)(nil),                  // 0: user.v1.GetMeRequest
-	(*GetMeResponse)(nil),                 // 1: user.v1.GetMeResponse
-	(*UpdateSlugRequest)(nil),             // 2: user.v1.UpdateSlugRequest
-	(*UpdateSlugResponse)(nil),            // 3: user.v1.UpdateSlugResponse
-	(*CheckSlugAvailabilityRequest)(nil),  // 4: user.v1.CheckSlugAvailabilityRequest
-	(*CheckSlugAvailabilityResponse)(nil), // 5: user.v1.CheckSlugAvailabilityResponse
-	(*User)(nil),                          // 6: user.v1.User
+	(*GetMeRequest)(nil),                      // 0: user.v1.GetMeRequest
+	(*GetMeResponse)(nil),                     // 1: user.v1.GetMeResponse
+	(*UpdateSlugRequest)(nil),                 // 2: user.v1.UpdateSlugRequest
+	(*UpdateSlugResponse)(nil),                // 3: user.v1.UpdateSlugResponse
+	(*CheckSlugAvailabilityRequest)(nil),      // 4: user.v1.CheckSlugAvailabilityRequest
+	(*CheckSlugAvailabilityResponse)(nil),     // 5: user.v1.CheckSlugAvailabilityResponse
+	(*NotificationSetting)(nil),               // 6: user.v1.NotificationSetting
+	(*GetNotificationSettingRequest)(nil),     // 7: user.v1.GetNotificationSettingRequest
+	(*GetNotificationSettingResponse)(nil),    // 8: user.v1.GetNotificationSettingResponse
+	(*UpdateNotificationSettingRequest)(nil),  // 9: user.v1.UpdateNotificationSettingRequest
+	(*UpdateNotificationSettingResponse)(nil), // 10: user.v1.UpdateNotificationSettingResponse
+	(*User)(nil),                              // 11: user.v1.User
 }
 var file_user_v1_user_service_proto_depIdxs = []int32{
-	6, // 0: user.v1.GetMeResponse.user:type_name -> user.v1.User
-	6, // 1: user.v1.UpdateSlugResponse.user:type_name -> user.v1.User
-	0, // 2: user.v1.UserService.GetMe:input_type -> user.v1.GetMeRequest
-	2, // 3: user.v1.UserService.UpdateSlug:input_type -> user.v1.UpdateSlugRequest
-	4, // 4: user.v1.UserService.CheckSlugAvailability:input_type -> user.v1.CheckSlugAvailabilityRequest
-	1, // 5: user.v1.UserService.GetMe:output_type -> user.v1.GetMeResponse
-	3, // 6: user.v1.UserService.UpdateSlug:output_type -> user.v1.UpdateSlugResponse
-	5, // 7: user.v1.UserService.CheckSlugAvailability:output_type -> user.v1.CheckSlugAvailabilityResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	11, // 0: user.v1.GetMeResponse.user:type_name -> user.v1.User
+	11, // 1: user.v1.UpdateSlugResponse.user:type_name -> user.v1.User
+	6,  // 2: user.v1.GetNotificationSettingResponse.setting:type_name -> user.v1.NotificationSetting
+	6,  // 3: user.v1.UpdateNotificationSettingRequest.setting:type_name -> user.v1.NotificationSetting
+	6,  // 4: user.v1.UpdateNotificationSettingResponse.setting:type_name -> user.v1.NotificationSetting
+	0,  // 5: user.v1.UserService.GetMe:input_type -> user.v1.GetMeRequest
+	2,  // 6: user.v1.UserService.UpdateSlug:input_type -> user.v1.UpdateSlugRequest
+	4,  // 7: user.v1.UserService.CheckSlugAvailability:input_type -> user.v1.CheckSlugAvailabilityRequest
+	7,  // 8: user.v1.UserService.GetNotificationSetting:input_type -> user.v1.GetNotificationSettingRequest
+	9,  // 9: user.v1.UserService.UpdateNotificationSetting:input_type -> user.v1.UpdateNotificationSettingRequest
+	1,  // 10: user.v1.UserService.GetMe:output_type -> user.v1.GetMeResponse
+	3,  // 11: user.v1.UserService.UpdateSlug:output_type -> user.v1.UpdateSlugResponse
+	5,  // 12: user.v1.UserService.CheckSlugAvailability:output_type -> user.v1.CheckSlugAvailabilityResponse
+	8,  // 13: user.v1.UserService.GetNotificationSetting:output_type -> user.v1.GetNotificationSettingResponse
+	10, // 14: user.v1.UserService.UpdateNotificationSetting:output_type -> user.v1.UpdateNotificationSettingResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_service_proto_init() }
@@ -344,13 +611,14 @@ func file_user_v1_user_service_proto_init() {
 		return
 	}
 	file_user_v1_user_proto_init()
+	file_user_v1_user_service_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_service_proto_rawDesc), len(file_user_v1_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,6 +40,12 @@ const (
 	// UserServiceCheckSlugAvailabilityProcedure is the fully-qualified name of the UserService's
 	// CheckSlugAvailability RPC.
 	UserServiceCheckSlugAvailabilityProcedure = "/user.v1.UserService/CheckSlugAvailability"
+	// UserServiceGetNotificationSettingProcedure is the fully-qualified name of the UserService's
+	// GetNotificationSetting RPC.
+	UserServiceGetNotificationSettingProcedure = "/user.v1.UserService/GetNotificationSetting"
+	// UserServiceUpdateNotificationSettingProcedure is the fully-qualified name of the UserService's
+	// UpdateNotificationSetting RPC.
+	UserServiceUpdateNotificationSettingProcedure = "/user.v1.UserService/UpdateNotificationSetting"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
@@ -50,6 +56,10 @@ type UserServiceClient interface {
 	UpdateSlug(context.Context, *connect.Request[v1.UpdateSlugRequest]) (*connect.Response[v1.UpdateSlugResponse], error)
 	// CheckSlugAvailability checks whether a slug is available.
 	CheckSlugAvailability(context.Context, *connect.Request[v1.CheckSlugAvailabilityRequest]) (*connect.Response[v1.CheckSlugAvailabilityResponse], error)
+	// GetNotificationSetting returns how the authenticated user is notified.
+	GetNotificationSetting(context.Context, *connect.Request[v1.GetNotificationSettingRequest]) (*connect.Response[v1.GetNotificationSettingResponse], error)
+	// UpdateNotificationSetting changes how the authenticated user is notified.
+	UpdateNotificationSetting(context.Context, *connect.Request[v1.UpdateNotificationSettingRequest]) (*connect.Response[v1.UpdateNotificationSettingResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -81,14 +91,28 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("CheckSlugAvailability")),
 			connect.WithClientOptions(opts...),
 		),
+		getNotificationSetting: connect.NewClient[v1.GetNotificationSettingRequest, v1.GetNotificationSettingResponse](
+			httpClient,
+			baseURL+UserServiceGetNotificationSettingProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetNotificationSetting")),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationSetting: connect.NewClient[v1.UpdateNotificationSettingRequest, v1.UpdateNotificationSettingResponse](
+			httpClient,
+			baseURL+UserServiceUpdateNotificationSettingProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateNotificationSetting")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getMe                 *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
-	updateSlug            *connect.Client[v1.UpdateSlugRequest, v1.UpdateSlugResponse]
-	checkSlugAvailability *connect.Client[v1.CheckSlugAvailabilityRequest, v1.CheckSlugAvailabilityResponse]
+	getMe                     *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	updateSlug                *connect.Client[v1.UpdateSlugRequest, v1.UpdateSlugResponse]
+	checkSlugAvailability     *connect.Client[v1.CheckSlugAvailabilityRequest, v1.CheckSlugAvailabilityResponse]
+	getNotificationSetting    *connect.Client[v1.GetNotificationSettingRequest, v1.GetNotificationSettingResponse]
+	updateNotificationSetting *connect.Client[v1.UpdateNotificationSettingRequest, v1.UpdateNotificationSettingResponse]
 }
 
 // GetMe calls user.v1.UserService.GetMe.
@@ -106,6 +130,16 @@ func (c *userServiceClient) CheckSlugAvailability(ctx context.Context, req *conn
 	return c.checkSlugAvailability.CallUnary(ctx, req)
 }
 
+// GetNotificationSetting calls user.v1.UserService.GetNotificationSetting.
+func (c *userServiceClient) GetNotificationSetting(ctx context.Context, req *connect.Request[v1.GetNotificationSettingRequest]) (*connect.Response[v1.GetNotificationSettingResponse], error) {
+	return c.getNotificationSetting.CallUnary(ctx, req)
+}
+
+// UpdateNotificationSetting calls user.v1.UserService.UpdateNotificationSetting.
+func (c *userServiceClient) UpdateNotificationSetting(ctx context.Context, req *connect.Request[v1.UpdateNotificationSettingRequest]) (*connect.Response[v1.UpdateNotificationSettingResponse], error) {
+	return c.updateNotificationSetting.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	// GetMe returns the authenticated user's info.
@@ -114,6 +148,10 @@ type UserServiceHandler interface {
 	UpdateSlug(context.Context, *connect.Request[v1.UpdateSlugRequest]) (*connect.Response[v1.UpdateSlugResponse], error)
 	// CheckSlugAvailability checks whether a slug is available.
 	CheckSlugAvailability(context.Context, *connect.Request[v1.CheckSlugAvailabilityRequest]) (*connect.Response[v1.CheckSlugAvailabilityResponse], error)
+	// GetNotificationSetting returns how the authenticated user is notified.
+	GetNotificationSetting(context.Context, *connect.Request[v1.GetNotificationSettingRequest]) (*connect.Response[v1.GetNotificationSettingResponse], error)
+	// UpdateNotificationSetting changes how the authenticated user is notified.
+	UpdateNotificationSetting(context.Context, *connect.Request[v1.UpdateNotificationSettingRequest]) (*connect.Response[v1.UpdateNotificationSettingResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -141,6 +179,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("CheckSlugAvailability")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetNotificationSettingHandler := connect.NewUnaryHandler(
+		UserServiceGetNotificationSettingProcedure,
+		svc.GetNotificationSetting,
+		connect.WithSchema(userServiceMethods.ByName("GetNotificationSetting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateNotificationSettingHandler := connect.NewUnaryHandler(
+		UserServiceUpdateNotificationSettingProcedure,
+		svc.UpdateNotificationSetting,
+		connect.WithSchema(userServiceMethods.ByName("UpdateNotificationSetting")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetMeProcedure:
@@ -149,6 +199,10 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceUpdateSlugHandler.ServeHTTP(w, r)
 		case UserServiceCheckSlugAvailabilityProcedure:
 			userServiceCheckSlugAvailabilityHandler.ServeHTTP(w, r)
+		case UserServiceGetNotificationSettingProcedure:
+			userServiceGetNotificationSettingHandler.ServeHTTP(w, r)
+		case UserServiceUpdateNotificationSettingProcedure:
+			userServiceUpdateNotificationSettingHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -169,3 +223,11 @@ func (UnimplementedUserServiceHandler) UpdateSlug(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) CheckSlugAvailability(context.Context, *connect.Request[v1.CheckSlugAvailabilityRequest]) (*connect.Response[v1.CheckSlugAvailabilityResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.CheckSlugAvailability is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) GetNotificationSetting(context.Context, *connect.Request[v1.GetNotificationSettingRequest]) (*connect.Response[v1.GetNotificationSettingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.GetNotificationSetting is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateNotificationSetting(context.Context, *connect.Request[v1.UpdateNotificationSettingRequest]) (*connect.Response[v1.UpdateNotificationSettingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user.v1.UserService.UpdateNotificationSetting is not implemented"))
+}
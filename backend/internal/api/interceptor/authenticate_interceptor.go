@@ -30,6 +30,9 @@ var authSkippingProcedures = []string{
 	eventv1connect.EventProfileServiceGetEventProcedure,
 	eventv1connect.EventProfileServiceJoinEventProcedure,
 	eventv1connect.EventProfileServiceClaimPaymentProcedure,
+	eventv1connect.EventProfileServiceChangeTierProcedure,
+	eventv1connect.EventProfileServiceLeaveEventProcedure,
+	eventv1connect.EventProfileServiceChangeRoundsProcedure,
 }
 
 func Authenticate(uc ausecase.Authenticate) connect.UnaryInterceptorFunc {
@@ -39,7 +42,7 @@ func Authenticate(uc ausecase.Authenticate) connect.UnaryInterceptorFunc {
 			req connect.AnyRequest,
 		) (connect.AnyResponse, error) {
 			if authSkippingPaths(req) {
-				return next(ctx, req)
+				return next(optionallyAuthenticate(ctx, uc, req), req)
 			}
 
 			token := extractBearerToken(req)
@@ -59,12 +62,34 @@ func Authenticate(uc ausecase.Authenticate) connect.UnaryInterceptorFunc {
 			}
 
 			ctx = contexts.SetAuthenticatedUserID(ctx, out.UserID)
+			ctx = contexts.SetAuthenticatedSessionID(ctx, out.SessionID)
 
 			return next(ctx, req)
 		}
 	}
 }
 
+// optionallyAuthenticate identifies the caller of a public procedure when they send a valid session,
+// e.g. so that joining an event while logged in links the participant to the account.
+// Without one, or with an invalid one, the call goes on anonymously.
+func optionallyAuthenticate(ctx context.Context, uc ausecase.Authenticate, req connect.AnyRequest) context.Context {
+	token := extractBearerToken(req)
+	if token == "" {
+		return ctx
+	}
+
+	out, err := uc.Do(ctx, ausecase.AuthenticateInput{
+		Token: token,
+	})
+	if err != nil {
+		logger.Debug(ctx, "ignoring invalid session on public procedure", "err", err)
+		return ctx
+	}
+
+	ctx = contexts.SetAuthenticatedUserID(ctx, out.UserID)
+	return contexts.SetAuthenticatedSessionID(ctx, out.SessionID)
+}
+
 func authSkippingPaths(req connect.AnyRequest) bool {
 	return slices.Contains(authSkippingProcedures, req.Spec().Procedure)
 }
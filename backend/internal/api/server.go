@@ -15,6 +15,7 @@ import (
 	"github.com/mickamy/sampay/internal/di"
 	"github.com/mickamy/sampay/internal/domain/auth"
 	"github.com/mickamy/sampay/internal/domain/event"
+	"github.com/mickamy/sampay/internal/domain/messaging"
 	"github.com/mickamy/sampay/internal/domain/storage"
 	"github.com/mickamy/sampay/internal/domain/test"
 	"github.com/mickamy/sampay/internal/domain/user"
@@ -30,6 +31,9 @@ func NewServer(infra *di.Infra) http.Server {
 		route(api, infra, interceptors)
 	}
 
+	webhooks := http.NewServeMux()
+	messaging.Route(webhooks, infra)
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
 		if _, err := infra.WriterDB.ExecContext(r.Context(), "SELECT 1"); err != nil {
@@ -53,6 +57,7 @@ func NewServer(infra *di.Infra) http.Server {
 		w.WriteHeader(http.StatusOK)
 	})
 	mux.Handle("/api/", http.StripPrefix("/api", api))
+	mux.Handle("/webhooks/", http.StripPrefix("/webhooks", webhooks))
 
 	return http.Server{
 		Addr:              ":" + strconv.Itoa(config.API().Port),
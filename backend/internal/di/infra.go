@@ -9,8 +9,12 @@ import (
 	"github.com/mickamy/sampay/config"
 	"github.com/mickamy/sampay/internal/infra/aws/s3"
 	"github.com/mickamy/sampay/internal/infra/aws/sqs"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/infra/queue"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
 	"github.com/mickamy/sampay/internal/infra/storage/kvs"
+	"github.com/mickamy/sampay/internal/lib/line"
+	"github.com/mickamy/sampay/internal/lib/mail"
 )
 
 type Infra struct {
@@ -23,6 +27,8 @@ type Infra struct {
 	KVS      *kvs.KVS           `inject:"provider:di.ProvideKVS"`
 	S3       s3.Client          `inject:"provider:di.ProvideS3"`
 	Producer *producer.Producer `inject:"provider:di.ProvideProducer"`
+	Queue    queue.Queue        `inject:"provider:di.ProvideQueue"`
+	Notifier notifier.Notifier  `inject:"provider:di.ProvideNotifier"`
 }
 
 // Close releases all infrastructure resources.
@@ -112,3 +118,24 @@ func ProvideProducer(ctx context.Context, kvsCfg config.KVSConfig) (*producer.Pr
 
 	return p, nil
 }
+
+func ProvideQueue(p *producer.Producer) queue.Queue {
+	return queue.NewSQS(p)
+}
+
+func ProvideNotifier() (notifier.Notifier, error) {
+	cfg := config.Notifier()
+	switch cfg.Kind {
+	case config.NotifierKindLog:
+		return notifier.NewLog(), nil
+	case config.NotifierKindFile:
+		return notifier.NewFile(cfg.FilePath), nil
+	case config.NotifierKindSend:
+		return notifier.NewSender(
+			line.NewClient(line.APIBaseURL, cfg.LINEChannelAccessToken),
+			mail.NewSMTP(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.EmailFrom),
+		), nil
+	default:
+		return nil, fmt.Errorf("di: unknown notifier: %s", cfg.Kind)
+	}
+}
@@ -36,6 +36,11 @@ func NewInfra(context context.Context) (*Infra, error) {
 	if err != nil {
 		return nil, err
 	}
+	provideQueue := ProvideQueue(provideProducer)
+	provideNotifier, err := ProvideNotifier()
+	if err != nil {
+		return nil, err
+	}
 
 	return &Infra{
 		DB:       provideDB,
@@ -44,6 +49,8 @@ func NewInfra(context context.Context) (*Infra, error) {
 		KVS:      provideKVS,
 		S3:       provideS3,
 		Producer: provideProducer,
+		Queue:    provideQueue,
+		Notifier: provideNotifier,
 	}, nil
 }
 
@@ -76,6 +83,11 @@ func MustNewInfra(context context.Context) *Infra {
 	if err != nil {
 		panic(err)
 	}
+	provideQueue := ProvideQueue(provideProducer)
+	provideNotifier, err := ProvideNotifier()
+	if err != nil {
+		panic(err)
+	}
 
 	return &Infra{
 		DB:       provideDB,
@@ -84,5 +96,7 @@ func MustNewInfra(context context.Context) *Infra {
 		KVS:      provideKVS,
 		S3:       provideS3,
 		Producer: provideProducer,
+		Queue:    provideQueue,
+		Notifier: provideNotifier,
 	}
 }
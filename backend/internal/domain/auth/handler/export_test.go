@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/usecase"
+	"github.com/mickamy/sampay/internal/lib/oauth"
+)
+
+// NewOAuthWithResolver builds OAuth against the given providers instead of the configured ones.
+func NewOAuthWithResolver(infra *di.Infra, resolver *oauth.Resolver) *OAuth {
+	return &OAuth{
+		getOAuthURL:   usecase.NewGetOAuthURL(infra, resolver),
+		oauthCallback: usecase.NewOAuthCallback(infra, resolver),
+	}
+}
+
+func SessionClient(header http.Header, peer connect.Peer) model.SessionClient {
+	return sessionClient(header, peer)
+}
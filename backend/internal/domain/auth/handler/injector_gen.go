@@ -13,7 +13,7 @@ import (
 func NewOAuth(infra *di.Infra) *OAuth {
 	oAuth := config.OAuth()
 	resolverFromConfig := oauth.NewResolverFromConfig(oAuth)
-	getOAuthURL := usecase.NewGetOAuthURL(resolverFromConfig)
+	getOAuthURL := usecase.NewGetOAuthURL(infra, resolverFromConfig)
 	oAuthCallback := usecase.NewOAuthCallback(infra, resolverFromConfig)
 
 	return &OAuth{
@@ -26,7 +26,7 @@ func NewOAuth(infra *di.Infra) *OAuth {
 func MustNewOAuth(infra *di.Infra) *OAuth {
 	oAuth := config.OAuth()
 	resolverFromConfig := oauth.NewResolverFromConfig(oAuth)
-	getOAuthURL := usecase.NewGetOAuthURL(resolverFromConfig)
+	getOAuthURL := usecase.NewGetOAuthURL(infra, resolverFromConfig)
 	oAuthCallback := usecase.NewOAuthCallback(infra, resolverFromConfig)
 
 	return &OAuth{
@@ -39,10 +39,16 @@ func MustNewOAuth(infra *di.Infra) *OAuth {
 func NewSession(infra *di.Infra) *Session {
 	refreshToken := usecase.NewRefreshToken(infra)
 	logout := usecase.NewLogout(infra)
+	listSessions := usecase.NewListSessions(infra)
+	revokeSession := usecase.NewRevokeSession(infra)
+	revokeAllSessions := usecase.NewRevokeAllSessions(infra)
 
 	return &Session{
-		refreshToken: refreshToken,
-		logout:       logout,
+		refreshToken:      refreshToken,
+		logout:            logout,
+		listSessions:      listSessions,
+		revokeSession:     revokeSession,
+		revokeAllSessions: revokeAllSessions,
 	}
 }
 
@@ -50,9 +56,15 @@ func NewSession(infra *di.Infra) *Session {
 func MustNewSession(infra *di.Infra) *Session {
 	refreshToken := usecase.NewRefreshToken(infra)
 	logout := usecase.NewLogout(infra)
+	listSessions := usecase.NewListSessions(infra)
+	revokeSession := usecase.NewRevokeSession(infra)
+	revokeAllSessions := usecase.NewRevokeAllSessions(infra)
 
 	return &Session{
-		refreshToken: refreshToken,
-		logout:       logout,
+		refreshToken:      refreshToken,
+		logout:            logout,
+		listSessions:      listSessions,
+		revokeSession:     revokeSession,
+		revokeAllSessions: revokeAllSessions,
 	}
 }
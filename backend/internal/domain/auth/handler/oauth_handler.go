@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/mickamy/errx"
@@ -11,6 +12,7 @@ import (
 	"github.com/mickamy/sampay/gen/auth/v1/authv1connect"
 	"github.com/mickamy/sampay/internal/di"
 	"github.com/mickamy/sampay/internal/domain/auth/mapper"
+	"github.com/mickamy/sampay/internal/domain/auth/model"
 	"github.com/mickamy/sampay/internal/domain/auth/usecase"
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	cresponse "github.com/mickamy/sampay/internal/domain/common/response"
@@ -53,7 +55,12 @@ func (h *OAuth) GetOAuthURL(
 		return nil, cresponse.NewInternalErrorContext(ctx, err).AsConnectError()
 	}
 
-	return connect.NewResponse(&v1.GetOAuthURLResponse{Url: out.AuthenticationURL}), nil
+	res := connect.NewResponse(&v1.GetOAuthURLResponse{Url: out.AuthenticationURL})
+	// bind the state to this browser so that a callback started elsewhere is rejected
+	stateCookie := cookie.Build("oauth_state", out.State.Value, time.Now().Add(model.OAuthStateTTL))
+	res.Header().Add("Set-Cookie", stateCookie.String())
+
+	return res, nil
 }
 
 func (h *OAuth) OAuthCallback(
@@ -70,9 +77,14 @@ func (h *OAuth) OAuthCallback(
 			WithFieldViolation("provider", err.Error())
 	}
 
+	// a missing cookie is rejected by the use-case as a state mismatch
+	cookieState, _ := cookie.ParseOAuthState(r.Header().Get("cookie"))
 	out, err := h.oauthCallback.Do(ctx, usecase.OAuthCallbackInput{
-		Provider: provider,
-		Code:     r.Msg.GetCode(),
+		Provider:    provider,
+		Code:        r.Msg.GetCode(),
+		State:       r.Msg.GetState(),
+		CookieState: cookieState,
+		Client:      sessionClient(r.Header(), r.Peer()),
 	})
 	if err != nil {
 		var localizable *cmodel.LocalizableError
@@ -81,6 +93,10 @@ func (h *OAuth) OAuthCallback(
 				return nil, errx.Wrap(err).
 					WithFieldViolation("provider", localizable.LocalizeContext(ctx))
 			}
+			if errors.Is(localizable, usecase.ErrOAuthCallbackInvalidState) {
+				return nil, errx.Wrap(err).
+					WithFieldViolation("state", localizable.LocalizeContext(ctx))
+			}
 			return nil, errx.Wrap(err).
 				WithFieldViolation("code", localizable.LocalizeContext(ctx))
 		}
@@ -103,6 +119,7 @@ func (h *OAuth) OAuthCallback(
 	)
 	res.Header().Add("Set-Cookie", atCookie.String())
 	res.Header().Add("Set-Cookie", rtCookie.String())
+	res.Header().Add("Set-Cookie", cookie.Build("oauth_state", "", time.Now().Add(-time.Hour)).String())
 
 	return res, nil
 }
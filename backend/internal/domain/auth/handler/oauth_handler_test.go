@@ -1,22 +1,131 @@
 package handler_test
 
 import (
+	"context"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"connectrpc.com/connect"
 	"github.com/mickamy/contest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	authv1 "github.com/mickamy/sampay/gen/auth/v1"
 	"github.com/mickamy/sampay/gen/auth/v1/authv1connect"
 	"github.com/mickamy/sampay/internal/api/interceptor"
 	"github.com/mickamy/sampay/internal/domain/auth/handler"
+	"github.com/mickamy/sampay/internal/lib/oauth"
+	"github.com/mickamy/sampay/internal/lib/ulid"
 )
 
+// fakeOAuthClient stands in for the provider, echoing the state in the authorization URL.
+type fakeOAuthClient struct{}
+
+func (c fakeOAuthClient) AuthenticationURL(state string, _ string) (string, error) {
+	return "https://provider.example.com/authorize?state=" + url.QueryEscape(state), nil
+}
+
+func (c fakeOAuthClient) Callback(_ context.Context, _ string, _ string) (oauth.Payload, error) {
+	return oauth.Payload{Provider: oauth.ProviderLINE, UID: ulid.New(), Name: "Test User"}, nil
+}
+
 func TestOAuth_OAuthCallback(t *testing.T) {
 	t.Parallel()
-	t.Skip("requires real OAuth provider interaction")
+
+	tests := []struct {
+		name string
+		// cookie is the Cookie header the SSR server forwards from the browser, given the one set at the start
+		cookie func(stateCookie *http.Cookie) string
+		assert func(t *testing.T, res *connect.Response[authv1.OAuthCallbackResponse], err error)
+	}{
+		{
+			name: "state cookie forwarded",
+			cookie: func(stateCookie *http.Cookie) string {
+				return "_ga=GA1.1; " + stateCookie.Name + "=" + stateCookie.Value
+			},
+			assert: func(t *testing.T, res *connect.Response[authv1.OAuthCallbackResponse], err error) {
+				require.NoError(t, err)
+				assert.True(t, res.Msg.GetIsNewUser())
+
+				cookies := make(map[string]*http.Cookie)
+				for _, c := range (&http.Response{Header: res.Header()}).Cookies() {
+					cookies[c.Name] = c
+				}
+				assert.Contains(t, cookies, "access_token")
+				assert.Contains(t, cookies, "refresh_token")
+				require.Contains(t, cookies, "oauth_state")
+				assert.Negative(t, cookies["oauth_state"].MaxAge, "the state cookie is cleared")
+			},
+		},
+		{
+			name: "state cookie not forwarded",
+			cookie: func(*http.Cookie) string {
+				return ""
+			},
+			assert: func(t *testing.T, _ *connect.Response[authv1.OAuthCallbackResponse], err error) {
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "state cookie of another login",
+			cookie: func(stateCookie *http.Cookie) string {
+				return stateCookie.Name + "=LINE:someone-else"
+			},
+			assert: func(t *testing.T, _ *connect.Response[authv1.OAuthCallbackResponse], err error) {
+				assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// arrange
+			infra := newInfra(t)
+			h := handler.NewOAuthWithResolver(infra, &oauth.Resolver{
+				Clients: map[oauth.Provider]oauth.Client{oauth.ProviderLINE: fakeOAuthClient{}},
+			})
+			mux := http.NewServeMux()
+			mux.Handle(authv1connect.NewOAuthServiceHandler(h,
+				connect.WithInterceptors(interceptor.NewInterceptors(infra)...)))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			client := authv1connect.NewOAuthServiceClient(http.DefaultClient, server.URL)
+
+			started, err := client.GetOAuthURL(t.Context(), connect.NewRequest(&authv1.GetOAuthURLRequest{
+				Provider: authv1.OAuthProvider_O_AUTH_PROVIDER_LINE,
+			}))
+			require.NoError(t, err)
+			authURL, err := url.Parse(started.Msg.GetUrl())
+			require.NoError(t, err)
+			state := authURL.Query().Get("state")
+			var stateCookie *http.Cookie
+			for _, c := range (&http.Response{Header: started.Header()}).Cookies() {
+				if c.Name == "oauth_state" {
+					stateCookie = c
+				}
+			}
+			require.NotNil(t, stateCookie, "the state is bound to the browser")
+			require.Equal(t, state, stateCookie.Value)
+
+			// act
+			req := connect.NewRequest(&authv1.OAuthCallbackRequest{
+				Provider: authv1.OAuthProvider_O_AUTH_PROVIDER_LINE,
+				Code:     "code",
+				State:    state,
+			})
+			if c := tt.cookie(stateCookie); c != "" {
+				req.Header().Set("Cookie", c)
+			}
+			res, err := client.OAuthCallback(t.Context(), req)
+
+			// assert
+			tt.assert(t, res, err)
+		})
+	}
 }
 
 func TestOAuth_GetOAuthURL(t *testing.T) {
@@ -2,20 +2,28 @@ package handler
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"net"
+	"net/http"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/mickamy/errx"
 
+	"github.com/mickamy/sampay/config"
 	authv1 "github.com/mickamy/sampay/gen/auth/v1"
 	"github.com/mickamy/sampay/gen/auth/v1/authv1connect"
 	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/auth/mapper"
+	"github.com/mickamy/sampay/internal/domain/auth/model"
 	"github.com/mickamy/sampay/internal/domain/auth/usecase"
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	cresponse "github.com/mickamy/sampay/internal/domain/common/response"
 	"github.com/mickamy/sampay/internal/lib/cookie"
 	"github.com/mickamy/sampay/internal/lib/logger"
+	"github.com/mickamy/sampay/internal/lib/slicex"
 	"github.com/mickamy/sampay/internal/misc/i18n"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 )
@@ -23,9 +31,12 @@ import (
 var _ authv1connect.SessionServiceHandler = (*Session)(nil)
 
 type Session struct {
-	_            *di.Infra            `inject:"param"`
-	refreshToken usecase.RefreshToken `inject:""`
-	logout       usecase.Logout       `inject:""`
+	_                 *di.Infra                 `inject:"param"`
+	refreshToken      usecase.RefreshToken      `inject:""`
+	logout            usecase.Logout            `inject:""`
+	listSessions      usecase.ListSessions      `inject:""`
+	revokeSession     usecase.RevokeSession     `inject:""`
+	revokeAllSessions usecase.RevokeAllSessions `inject:""`
 }
 
 func (h *Session) RefreshToken(
@@ -43,11 +54,15 @@ func (h *Session) RefreshToken(
 	}
 
 	out, err := h.refreshToken.Do(ctx, usecase.RefreshTokenInput{
-		Token: refreshToken,
+		Token:  refreshToken,
+		Client: sessionClient(r.Header(), r.Peer()),
 	})
 	if err != nil {
 		var localizable *cmodel.LocalizableError
 		if errors.As(err, &localizable) {
+			if errors.Is(err, usecase.ErrRefreshTokenReused) {
+				logger.Warn(ctx, "refresh token reused; session family revoked", "err", err)
+			}
 			return nil, errx.Wrap(err).
 				WithCode(errx.InvalidArgument).
 				WithFieldViolation("refresh_token", localizable.LocalizeContext(ctx))
@@ -125,8 +140,104 @@ func (h *Session) Logout(
 	}
 
 	res := connect.NewResponse(&authv1.LogoutResponse{})
-	expiredAt := time.Now().Add(-time.Hour)
-	res.Header().Add("Set-Cookie", cookie.Build("access_token", "", expiredAt).String())
-	res.Header().Add("Set-Cookie", cookie.Build("refresh_token", "", expiredAt).String())
+	clearTokenCookies(res.Header())
 	return res, nil
 }
+
+func (h *Session) ListSessions(
+	ctx context.Context, _ *connect.Request[authv1.ListSessionsRequest],
+) (*connect.Response[authv1.ListSessionsResponse], error) {
+	out, err := h.listSessions.Do(ctx, usecase.ListSessionsInput{})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	sessions := slicex.Map(out.Sessions, func(s model.ActiveSession) *authv1.Session {
+		return mapper.ToV1Session(s, out.CurrentID)
+	})
+	return connect.NewResponse(&authv1.ListSessionsResponse{
+		Sessions: sessions,
+	}), nil
+}
+
+func (h *Session) RevokeSession(
+	ctx context.Context, r *connect.Request[authv1.RevokeSessionRequest],
+) (*connect.Response[authv1.RevokeSessionResponse], error) {
+	out, err := h.revokeSession.Do(ctx, usecase.RevokeSessionInput{
+		ID: r.Msg.GetId(),
+	})
+	if err != nil {
+		var localizable *cmodel.LocalizableError
+		if errors.As(err, &localizable) {
+			return nil, errx.Wrap(err).
+				WithFieldViolation("id", localizable.LocalizeContext(ctx))
+		}
+
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, cresponse.NewInternalErrorContext(ctx, err).AsConnectError()
+	}
+
+	res := connect.NewResponse(&authv1.RevokeSessionResponse{})
+	if out.Current {
+		clearTokenCookies(res.Header())
+	}
+	return res, nil
+}
+
+func (h *Session) RevokeAllSessions(
+	ctx context.Context, r *connect.Request[authv1.RevokeAllSessionsRequest],
+) (*connect.Response[authv1.RevokeAllSessionsResponse], error) {
+	out, err := h.revokeAllSessions.Do(ctx, usecase.RevokeAllSessionsInput{
+		ExceptCurrent: r.Msg.GetExceptCurrent(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	res := connect.NewResponse(&authv1.RevokeAllSessionsResponse{})
+	if out.Current {
+		clearTokenCookies(res.Header())
+	}
+	return res, nil
+}
+
+func clearTokenCookies(header http.Header) {
+	expiredAt := time.Now().Add(-time.Hour)
+	header.Add("Set-Cookie", cookie.Build("access_token", "", expiredAt).String())
+	header.Add("Set-Cookie", cookie.Build("refresh_token", "", expiredAt).String())
+}
+
+// Headers the SSR server relays the browser's details in, trusted only along with the secret shared with it.
+const (
+	ssrSecretHeader       = "X-SSR-Secret"
+	clientUserAgentHeader = "X-Client-User-Agent"
+	clientIPHeader        = "X-Client-IP"
+)
+
+// sessionClient describes the device a request came from, for display in the session list.
+// Requests relayed by the SSR server carry the browser's details in headers. Otherwise, the address is
+// the one the reverse proxy in front of the API appended to X-Forwarded-For, as entries before it are
+// up to the client.
+func sessionClient(header http.Header, peer connect.Peer) model.SessionClient {
+	if secret := config.Auth().SSRSecret; secret != "" &&
+		subtle.ConstantTimeCompare([]byte(header.Get(ssrSecretHeader)), []byte(secret)) == 1 {
+		return model.SessionClient{
+			UserAgent: header.Get(clientUserAgentHeader),
+			IPAddress: strings.TrimSpace(header.Get(clientIPHeader)),
+		}
+	}
+
+	ip := peer.Addr
+	if forwarded := header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		entries := strings.Split(forwarded[len(forwarded)-1], ",")
+		ip = entries[len(entries)-1]
+	} else if host, _, err := net.SplitHostPort(peer.Addr); err == nil {
+		ip = host
+	}
+	return model.SessionClient{
+		UserAgent: header.Get("User-Agent"),
+		IPAddress: strings.TrimSpace(ip),
+	}
+}
@@ -8,8 +8,10 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/mickamy/contest"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mickamy/sampay/config"
 	authv1 "github.com/mickamy/sampay/gen/auth/v1"
 	"github.com/mickamy/sampay/gen/auth/v1/authv1connect"
 	"github.com/mickamy/sampay/internal/api/interceptor"
@@ -21,6 +23,7 @@ import (
 	"github.com/mickamy/sampay/internal/lib/cookie"
 	"github.com/mickamy/sampay/internal/lib/jwt"
 	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/test/ctest"
 )
 
 func TestSession_RefreshToken(t *testing.T) {
@@ -166,3 +169,109 @@ func TestSession_Logout(t *testing.T) {
 		})
 	}
 }
+
+func TestSession_ListSessions(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	infra := newInfra(t)
+	_, authHeader := ctest.UserSession(t, infra)
+
+	// act
+	var out authv1.ListSessionsResponse
+	ct := contest.NewWith(t,
+		contest.Bind(authv1connect.NewSessionServiceHandler)(handler.NewSession(infra)),
+		connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+	).
+		Procedure(authv1connect.SessionServiceListSessionsProcedure).
+		Header("Authorization", authHeader).
+		In(&authv1.ListSessionsRequest{}).
+		Do()
+
+	// assert
+	ct.ExpectStatus(http.StatusOK).Out(&out)
+	require.Len(t, out.GetSessions(), 1)
+	require.True(t, out.GetSessions()[0].GetCurrent())
+}
+
+func TestSession_RevokeSession(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	infra := newInfra(t)
+	userID, authHeader := ctest.UserSession(t, infra)
+	lost := model.MustNewSession(userID)
+	require.NoError(t, repository.NewSession(infra.KVS).Create(t.Context(), lost))
+
+	// act
+	ct := contest.NewWith(t,
+		contest.Bind(authv1connect.NewSessionServiceHandler)(handler.NewSession(infra)),
+		connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+	).
+		Procedure(authv1connect.SessionServiceRevokeSessionProcedure).
+		Header("Authorization", authHeader).
+		In(&authv1.RevokeSessionRequest{Id: lost.FamilyID}).
+		Do()
+
+	// assert
+	ct.ExpectStatus(http.StatusOK)
+	exists, err := repository.NewSession(infra.KVS).AccessTokenExists(t.Context(), userID, lost.Tokens.Access.Value)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestSessionClient(t *testing.T) {
+	t.Parallel()
+
+	peer := connect.Peer{Addr: "10.0.0.2:54321"}
+	tests := []struct {
+		name   string
+		header http.Header
+		want   model.SessionClient
+	}{
+		{
+			name: "relayed by the SSR server",
+			header: http.Header{
+				"User-Agent":          {"node"},
+				"X-Forwarded-For":     {"10.0.0.3"},
+				"X-Ssr-Secret":        {config.Auth().SSRSecret},
+				"X-Client-User-Agent": {"Mozilla/5.0"},
+				"X-Client-Ip":         {"198.51.100.7"},
+			},
+			want: model.SessionClient{UserAgent: "Mozilla/5.0", IPAddress: "198.51.100.7"},
+		},
+		{
+			name: "relayed with a wrong secret",
+			header: http.Header{
+				"User-Agent":          {"curl/8.0"},
+				"X-Forwarded-For":     {"203.0.113.9"},
+				"X-Ssr-Secret":        {"guess"},
+				"X-Client-User-Agent": {"Mozilla/5.0"},
+				"X-Client-Ip":         {"198.51.100.7"},
+			},
+			want: model.SessionClient{UserAgent: "curl/8.0", IPAddress: "203.0.113.9"},
+		},
+		{
+			name: "forwarded by the reverse proxy",
+			header: http.Header{
+				"User-Agent": {"Mozilla/5.0"},
+				// the first entry is whatever the client sent
+				"X-Forwarded-For": {"192.0.2.1, 203.0.113.9"},
+			},
+			want: model.SessionClient{UserAgent: "Mozilla/5.0", IPAddress: "203.0.113.9"},
+		},
+		{
+			name:   "direct",
+			header: http.Header{"User-Agent": {"Mozilla/5.0"}},
+			want:   model.SessionClient{UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, handler.SessionClient(tt.header, peer))
+		})
+	}
+}
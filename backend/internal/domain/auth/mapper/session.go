@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	authv1 "github.com/mickamy/sampay/gen/auth/v1"
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+)
+
+func ToV1Session(src model.ActiveSession, currentID string) *authv1.Session {
+	return &authv1.Session{
+		Id:         src.FamilyID,
+		UserAgent:  src.Client.UserAgent,
+		IpAddress:  src.Client.IPAddress,
+		CreatedAt:  converter.TimeToTimestamppb(src.CreatedAt),
+		LastUsedAt: converter.TimeToTimestamppb(src.LastUsedAt),
+		Current:    src.FamilyID != "" && src.FamilyID == currentID,
+	}
+}
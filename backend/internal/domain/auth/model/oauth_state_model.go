@@ -0,0 +1,39 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/oauth"
+)
+
+// OAuthStateTTL is how long an issued state stays valid before the callback must consume it.
+const OAuthStateTTL = 10 * time.Minute
+
+// OAuthState is the server-side record of an authorization request.
+// It is issued when the authorization URL is built and consumed exactly once by the callback.
+type OAuthState struct {
+	Value        string
+	Provider     OAuthProvider
+	CodeVerifier string
+}
+
+func NewOAuthState(provider OAuthProvider) (OAuthState, error) {
+	value, err := oauth.NewState(oauth.Provider(provider))
+	if err != nil {
+		return OAuthState{}, fmt.Errorf("failed to generate oauth state: %w", err)
+	}
+	return OAuthState{
+		Value:        value,
+		Provider:     provider,
+		CodeVerifier: oauth.NewCodeVerifier(),
+	}, nil
+}
+
+func MustNewOAuthState(provider OAuthProvider) OAuthState {
+	s, err := NewOAuthState(provider)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
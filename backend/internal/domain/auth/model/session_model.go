@@ -2,23 +2,50 @@ package model
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mickamy/sampay/internal/lib/jwt"
+	"github.com/mickamy/sampay/internal/lib/ulid"
 )
 
+// Session is a pair of tokens issued to a user.
+// Sessions rotated from the same login share a FamilyID, so that a whole family can be revoked at once.
 type Session struct {
-	UserID string
-	Tokens jwt.Tokens
+	UserID   string
+	FamilyID string
+	Tokens   jwt.Tokens
+	Client   SessionClient
 }
 
+// SessionClient describes the device a session was issued to.
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+// ActiveSession is a token family which is still alive, as shown to the user.
+type ActiveSession struct {
+	FamilyID   string
+	Client     SessionClient
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// NewSession starts a new token family, e.g. on login.
 func NewSession(userID string) (Session, error) {
+	return NewSessionInFamily(userID, ulid.New())
+}
+
+// NewSessionInFamily issues new tokens in an existing token family, e.g. on refresh token rotation.
+func NewSessionInFamily(userID string, familyID string) (Session, error) {
 	tokens, err := jwt.New(userID)
 	if err != nil {
 		return Session{}, fmt.Errorf("failed to create jwt tokens: %w", err)
 	}
 	return Session{
-		UserID: userID,
-		Tokens: tokens,
+		UserID:   userID,
+		FamilyID: familyID,
+		Tokens:   tokens,
 	}, nil
 }
 
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/infra/storage/kvs"
+)
+
+var ErrOAuthStateNotFound = errors.New("repository: oauth state not found")
+
+type OAuthState interface {
+	Create(ctx context.Context, state model.OAuthState) error
+	// Consume returns the state and removes it, so that the same state can never be used twice.
+	// It returns ErrOAuthStateNotFound when the state was never issued, has expired or has already been consumed.
+	Consume(ctx context.Context, value string) (model.OAuthState, error)
+}
+
+type oauthState struct {
+	kvs *kvs.KVS
+}
+
+func NewOAuthState(kvs *kvs.KVS) OAuthState {
+	return &oauthState{kvs: kvs}
+}
+
+type oauthStateRecord struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+func (repo *oauthState) Create(ctx context.Context, state model.OAuthState) error {
+	if state.Value == "" || state.Provider == "" || state.CodeVerifier == "" {
+		return errors.New("oauth state contains empty value")
+	}
+	b, err := json.Marshal(oauthStateRecord{
+		Provider:     state.Provider.String(),
+		CodeVerifier: state.CodeVerifier,
+	})
+	if err != nil {
+		return fmt.Errorf("repository: failed to marshal oauth state: %w", err)
+	}
+	if err := repo.kvs.Set(ctx, repo.key(state.Value), string(b), model.OAuthStateTTL); err != nil {
+		return fmt.Errorf("repository: failed to set oauth state: %w", err)
+	}
+	return nil
+}
+
+func (repo *oauthState) Consume(ctx context.Context, value string) (model.OAuthState, error) {
+	v, err := repo.kvs.GetDel(ctx, repo.key(value))
+	if errors.Is(err, kvs.ErrKeyNotFound) {
+		return model.OAuthState{}, ErrOAuthStateNotFound
+	}
+	if err != nil {
+		return model.OAuthState{}, fmt.Errorf("repository: failed to consume oauth state: %w", err)
+	}
+
+	var record oauthStateRecord
+	if err := json.Unmarshal([]byte(v), &record); err != nil {
+		return model.OAuthState{}, fmt.Errorf("repository: failed to unmarshal oauth state: %w", err)
+	}
+	return model.OAuthState{
+		Value:        value,
+		Provider:     model.OAuthProvider(record.Provider),
+		CodeVerifier: record.CodeVerifier,
+	}, nil
+}
+
+func (repo *oauthState) key(value string) string {
+	return fmt.Sprintf("sampay:oauth_state:%s", value)
+}
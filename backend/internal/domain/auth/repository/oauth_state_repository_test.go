@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/test/itest"
+)
+
+func TestOAuthState_Consume(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	state := model.MustNewOAuthState(model.OAuthProviderLINE)
+	sut := repository.NewOAuthState(kvStore)
+	require.NoError(t, sut.Create(t.Context(), state))
+
+	// act
+	got, err := sut.Consume(t.Context(), state.Value)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, state, got)
+
+	// the state can be consumed only once
+	_, err = sut.Consume(t.Context(), state.Value)
+	require.ErrorIs(t, err, repository.ErrOAuthStateNotFound)
+}
+
+func TestOAuthState_Consume_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewOAuthState(kvStore)
+
+	// act
+	_, err := sut.Consume(t.Context(), "line:nonexistent")
+
+	// assert
+	require.ErrorIs(t, err, repository.ErrOAuthStateNotFound)
+}
@@ -2,20 +2,67 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/mickamy/sampay/internal/domain/auth/model"
 	"github.com/mickamy/sampay/internal/infra/storage/kvs"
+	"github.com/mickamy/sampay/internal/lib/jwt"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("repository: refresh token not found")
+	ErrRefreshTokenReused   = errors.New("repository: refresh token reused")
 )
 
 type Session interface {
 	Create(ctx context.Context, session model.Session) error
+	// Delete removes the tokens of the session and revokes the token family they belong to.
 	Delete(ctx context.Context, session model.Session) error
 	AccessTokenExists(ctx context.Context, userID string, accessToken string) (bool, error)
 	RefreshTokenExists(ctx context.Context, userID string, refreshToken string) (bool, error)
+	// ConsumeRefreshToken invalidates the refresh token in favor of the successor session, stores the successor
+	// in the token family of the consumed token and returns the rotation. The successor's FamilyID is set by it;
+	// a token issued before token families existed starts a new family.
+	// A token consumed moments ago, e.g. by another tab refreshing at the same time, returns the rotation
+	// it went through then. It returns ErrRefreshTokenReused with the family ID when the token was consumed
+	// longer ago, and ErrRefreshTokenNotFound when the token was never issued or has expired.
+	ConsumeRefreshToken(
+		ctx context.Context, refreshToken string, successor model.Session,
+	) (RefreshTokenRotation, error)
+	// RevokeFamily removes every token still alive in the token family.
+	RevokeFamily(ctx context.Context, userID string, familyID string) error
+	// Touch records a use of the access token on its token family and returns the family ID.
+	// It returns an empty family ID when the token does not belong to a family.
+	Touch(ctx context.Context, userID string, accessToken string) (string, error)
+	// List returns the token families of the user which are still alive, most recently used first.
+	List(ctx context.Context, userID string) ([]model.ActiveSession, error)
 }
 
+// RefreshTokenRotation is a refresh token being replaced by its successor tokens in the token family.
+type RefreshTokenRotation struct {
+	FamilyID  string
+	Successor jwt.Tokens
+	// Replayed is true when the token had already been rotated within refreshTokenReplayWindow,
+	// in which case Successor is what it was rotated to then.
+	Replayed bool
+}
+
+// refreshTokenReplayWindow is how long a consumed refresh token keeps returning the same successor
+// rather than being treated as reused, so that concurrent refreshes do not revoke their own family.
+const refreshTokenReplayWindow = 10 * time.Second
+
+// refreshTokenRotationPollInterval is how often a concurrent consumer checks whether the rotation it lost to
+// has stored its successor yet.
+const refreshTokenRotationPollInterval = 50 * time.Millisecond
+
+// sessionTouchInterval throttles writes of the last used time, which would otherwise happen on every request.
+const sessionTouchInterval = time.Minute
+
 type session struct {
 	kvs *kvs.KVS
 }
@@ -24,14 +71,71 @@ func NewSession(kvs *kvs.KVS) Session {
 	return &session{kvs: kvs}
 }
 
+type sessionTokenRecord struct {
+	Value     string    `json:"value"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// sessionFamilyRecord tracks the tokens of a family which are still alive, so that they can be revoked together.
+// LastUsedAt is when the family was last rotated; Touch records uses in between under a key of its own.
+type sessionFamilyRecord struct {
+	AccessTokens []sessionTokenRecord `json:"access_tokens"`
+	RefreshToken sessionTokenRecord   `json:"refresh_token"`
+	UserAgent    string               `json:"user_agent"`
+	IPAddress    string               `json:"ip_address"`
+	CreatedAt    time.Time            `json:"created_at"`
+	LastUsedAt   time.Time            `json:"last_used_at"`
+}
+
+// sessionRotationRecord is what a consumed refresh token was rotated to, kept for refreshTokenReplayWindow.
+// It holds no tokens while the rotation is still storing its successor.
+type sessionRotationRecord struct {
+	FamilyID     string             `json:"family_id"`
+	AccessToken  sessionTokenRecord `json:"access_token"`
+	RefreshToken sessionTokenRecord `json:"refresh_token"`
+}
+
 func (repo *session) Create(ctx context.Context, session model.Session) error {
-	if session.UserID == "" || session.Tokens.Access.Value == "" || session.Tokens.Refresh.Value == "" {
+	if session.UserID == "" || session.FamilyID == "" ||
+		session.Tokens.Access.Value == "" || session.Tokens.Refresh.Value == "" {
 		return errors.New("session contains empty value")
 	}
+
+	family, err := repo.getFamily(ctx, session.UserID, session.FamilyID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	accessTokens := make([]sessionTokenRecord, 0, len(family.AccessTokens)+1)
+	for _, at := range family.AccessTokens {
+		if at.ExpiresAt.After(now) {
+			accessTokens = append(accessTokens, at)
+		}
+	}
+	family.AccessTokens = append(accessTokens, sessionTokenRecord{
+		Value:     session.Tokens.Access.Value,
+		ExpiresAt: session.Tokens.Access.ExpiresAt,
+	})
+	family.RefreshToken = sessionTokenRecord{
+		Value:     session.Tokens.Refresh.Value,
+		ExpiresAt: session.Tokens.Refresh.ExpiresAt,
+	}
+	if family.CreatedAt.IsZero() {
+		family.CreatedAt = now
+	}
+	family.LastUsedAt = now
+	// the device keeps the family on rotation, but its address may have changed since
+	if session.Client.UserAgent != "" {
+		family.UserAgent = session.Client.UserAgent
+	}
+	if session.Client.IPAddress != "" {
+		family.IPAddress = session.Client.IPAddress
+	}
+
 	if err := repo.kvs.Set(
 		ctx,
 		repo.accessTokenKey(session.UserID, session.Tokens.Access.Value),
-		session.Tokens.Access.Value,
+		session.FamilyID,
 		session.Tokens.Access.Expiration(),
 	); err != nil {
 		return fmt.Errorf("repository: failed to set access token: %w", err)
@@ -39,16 +143,38 @@ func (repo *session) Create(ctx context.Context, session model.Session) error {
 	if err := repo.kvs.Set(
 		ctx,
 		repo.refreshTokenKey(session.UserID, session.Tokens.Refresh.Value),
-		session.Tokens.Refresh.Value,
+		session.FamilyID,
 		session.Tokens.Refresh.Expiration(),
 	); err != nil {
 		return fmt.Errorf("repository: failed to set refresh token: %w", err)
 	}
+	if err := repo.setFamily(ctx, session.UserID, session.FamilyID, family); err != nil {
+		return err
+	}
+	// the index outlives every family in it, as the latest refresh token expires last
+	if err := repo.kvs.SAdd(
+		ctx,
+		repo.familiesKey(session.UserID),
+		session.Tokens.Refresh.Expiration(),
+		session.FamilyID,
+	); err != nil {
+		return fmt.Errorf("repository: failed to index session family: %w", err)
+	}
 
 	return nil
 }
 
 func (repo *session) Delete(ctx context.Context, session model.Session) error {
+	familyID, err := kvs.Get[string](ctx, repo.kvs, repo.refreshTokenKey(session.UserID, session.Tokens.Refresh.Value))
+	if err != nil && !errors.Is(err, kvs.ErrKeyNotFound) {
+		return fmt.Errorf("repository: failed to get refresh token: %w", err)
+	}
+	if familyID != "" {
+		if err := repo.RevokeFamily(ctx, session.UserID, familyID); err != nil {
+			return err
+		}
+	}
+
 	if err := repo.kvs.Del(ctx, repo.accessTokenKey(session.UserID, session.Tokens.Access.Value)); err != nil {
 		return fmt.Errorf("repository: failed to delete access token: %w", err)
 	}
@@ -75,6 +201,289 @@ func (repo *session) RefreshTokenExists(ctx context.Context, userID string, refr
 	return exists, nil
 }
 
+func (repo *session) ConsumeRefreshToken(
+	ctx context.Context, refreshToken string, successor model.Session,
+) (RefreshTokenRotation, error) {
+	userID := successor.UserID
+	familyID, err := kvs.Get[string](ctx, repo.kvs, repo.refreshTokenKey(userID, refreshToken))
+	if errors.Is(err, kvs.ErrKeyNotFound) {
+		return repo.getRotation(ctx, userID, refreshToken)
+	}
+	if err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to get refresh token: %w", err)
+	}
+	if familyID == refreshToken {
+		// issued before token families, when the token itself was stored
+		familyID = ulid.New()
+	}
+
+	// keep the mark as long as the consumed token would have been valid,
+	// so that any replay within its lifetime is detected
+	ttl, err := repo.kvs.TTL(ctx, repo.refreshTokenKey(userID, refreshToken))
+	if errors.Is(err, kvs.ErrKeyNotFound) {
+		return repo.getRotation(ctx, userID, refreshToken)
+	}
+	if err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to get refresh token ttl: %w", err)
+	}
+	if ttl <= 0 {
+		ttl = successor.Tokens.Refresh.Expiration()
+	}
+
+	// the rotation is claimed first, so that a concurrent consumer either loses the claim and waits for the
+	// successor it publishes, or finds the token already gone and gets it all the same
+	pending, err := json.Marshal(sessionRotationRecord{FamilyID: familyID})
+	if err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to marshal refresh token rotation: %w", err)
+	}
+	claimed, err := repo.kvs.SetNX(
+		ctx, repo.rotatedRefreshTokenKey(userID, refreshToken), string(pending), refreshTokenReplayWindow,
+	)
+	if err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to claim refresh token rotation: %w", err)
+	}
+	if !claimed {
+		return repo.getRotation(ctx, userID, refreshToken)
+	}
+
+	successor.FamilyID = familyID
+	if err := repo.Create(ctx, successor); err != nil {
+		// the token is still there, so the claim is given up for a retry to take
+		_ = repo.kvs.Del(ctx, repo.rotatedRefreshTokenKey(userID, refreshToken))
+		return RefreshTokenRotation{}, err
+	}
+	// published only once stored, so that concurrent consumers never get a successor which does not work yet
+	b, err := json.Marshal(sessionRotationRecord{
+		FamilyID: familyID,
+		AccessToken: sessionTokenRecord{
+			Value: successor.Tokens.Access.Value, ExpiresAt: successor.Tokens.Access.ExpiresAt,
+		},
+		RefreshToken: sessionTokenRecord{
+			Value: successor.Tokens.Refresh.Value, ExpiresAt: successor.Tokens.Refresh.ExpiresAt,
+		},
+	})
+	if err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to marshal refresh token rotation: %w", err)
+	}
+	if err := repo.kvs.Set(
+		ctx, repo.rotatedRefreshTokenKey(userID, refreshToken), string(b), refreshTokenReplayWindow,
+	); err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to record refresh token rotation: %w", err)
+	}
+	if err := repo.kvs.Set(ctx, repo.usedRefreshTokenKey(userID, refreshToken), familyID, ttl); err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to mark refresh token as used: %w", err)
+	}
+	if err := repo.kvs.Del(ctx, repo.refreshTokenKey(userID, refreshToken)); err != nil {
+		return RefreshTokenRotation{}, fmt.Errorf("repository: failed to consume refresh token: %w", err)
+	}
+
+	return RefreshTokenRotation{FamilyID: familyID, Successor: successor.Tokens}, nil
+}
+
+// getRotation returns the rotation an already consumed refresh token went through within refreshTokenReplayWindow,
+// waiting for the rotation to store its successor when it has not yet.
+func (repo *session) getRotation(
+	ctx context.Context, userID string, refreshToken string,
+) (RefreshTokenRotation, error) {
+	for {
+		v, err := kvs.Get[string](ctx, repo.kvs, repo.rotatedRefreshTokenKey(userID, refreshToken))
+		if errors.Is(err, kvs.ErrKeyNotFound) {
+			usedBy, err := kvs.Get[string](ctx, repo.kvs, repo.usedRefreshTokenKey(userID, refreshToken))
+			if errors.Is(err, kvs.ErrKeyNotFound) {
+				return RefreshTokenRotation{}, ErrRefreshTokenNotFound
+			}
+			if err != nil {
+				return RefreshTokenRotation{}, fmt.Errorf("repository: failed to get used refresh token: %w", err)
+			}
+			return RefreshTokenRotation{FamilyID: usedBy}, ErrRefreshTokenReused
+		}
+		if err != nil {
+			return RefreshTokenRotation{}, fmt.Errorf("repository: failed to get refresh token rotation: %w", err)
+		}
+
+		var rotation sessionRotationRecord
+		if err := json.Unmarshal([]byte(v), &rotation); err != nil {
+			return RefreshTokenRotation{}, fmt.Errorf("repository: failed to unmarshal refresh token rotation: %w", err)
+		}
+		if rotation.RefreshToken.Value != "" {
+			return RefreshTokenRotation{
+				FamilyID: rotation.FamilyID,
+				Successor: jwt.Tokens{
+					Access:  jwt.Token{Value: rotation.AccessToken.Value, ExpiresAt: rotation.AccessToken.ExpiresAt},
+					Refresh: jwt.Token{Value: rotation.RefreshToken.Value, ExpiresAt: rotation.RefreshToken.ExpiresAt},
+				},
+				Replayed: true,
+			}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return RefreshTokenRotation{}, fmt.Errorf("repository: failed to wait for refresh token rotation: %w",
+				ctx.Err())
+		case <-time.After(refreshTokenRotationPollInterval):
+		}
+	}
+}
+
+func (repo *session) RevokeFamily(ctx context.Context, userID string, familyID string) error {
+	family, err := repo.getFamily(ctx, userID, familyID)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(family.AccessTokens)+2)
+	for _, at := range family.AccessTokens {
+		keys = append(keys, repo.accessTokenKey(userID, at.Value))
+	}
+	if family.RefreshToken.Value != "" {
+		keys = append(keys, repo.refreshTokenKey(userID, family.RefreshToken.Value))
+	}
+	keys = append(keys, repo.familyKey(userID, familyID), repo.familyLastUsedKey(userID, familyID))
+	// keys may live in different slots, so they cannot be deleted in a single command
+	for _, key := range keys {
+		if err := repo.kvs.Del(ctx, key); err != nil {
+			return fmt.Errorf("repository: failed to revoke session family: %w", err)
+		}
+	}
+	if err := repo.kvs.SRem(ctx, repo.familiesKey(userID), familyID); err != nil {
+		return fmt.Errorf("repository: failed to unindex session family: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *session) Touch(ctx context.Context, userID string, accessToken string) (string, error) {
+	familyID, err := kvs.Get[string](ctx, repo.kvs, repo.accessTokenKey(userID, accessToken))
+	if errors.Is(err, kvs.ErrKeyNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("repository: failed to get access token: %w", err)
+	}
+
+	family, err := repo.getFamily(ctx, userID, familyID)
+	if err != nil {
+		return "", err
+	}
+	if family.CreatedAt.IsZero() {
+		return "", nil
+	}
+	lastUsedAt, err := repo.getLastUsedAt(ctx, userID, familyID, family)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	if now.Sub(lastUsedAt) < sessionTouchInterval {
+		return familyID, nil
+	}
+	ttl := time.Until(family.RefreshToken.ExpiresAt)
+	if ttl <= 0 {
+		return "", nil
+	}
+	// written apart from the family record, which rotation rewrites, so that a touch never puts back a stale one
+	if err := repo.kvs.Set(
+		ctx, repo.familyLastUsedKey(userID, familyID), now.Format(time.RFC3339Nano), ttl,
+	); err != nil {
+		return "", fmt.Errorf("repository: failed to set session family last used time: %w", err)
+	}
+
+	return familyID, nil
+}
+
+func (repo *session) List(ctx context.Context, userID string) ([]model.ActiveSession, error) {
+	familyIDs, err := repo.kvs.SMembers(ctx, repo.familiesKey(userID))
+	if err != nil {
+		return nil, fmt.Errorf("repository: failed to list session families: %w", err)
+	}
+
+	sessions := make([]model.ActiveSession, 0, len(familyIDs))
+	for _, familyID := range familyIDs {
+		family, err := repo.getFamily(ctx, userID, familyID)
+		if err != nil {
+			return nil, err
+		}
+		if family.CreatedAt.IsZero() {
+			// expired since indexed
+			if err := repo.kvs.SRem(ctx, repo.familiesKey(userID), familyID); err != nil {
+				return nil, fmt.Errorf("repository: failed to unindex session family: %w", err)
+			}
+			continue
+		}
+		lastUsedAt, err := repo.getLastUsedAt(ctx, userID, familyID, family)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, model.ActiveSession{
+			FamilyID: familyID,
+			Client: model.SessionClient{
+				UserAgent: family.UserAgent,
+				IPAddress: family.IPAddress,
+			},
+			CreatedAt:  family.CreatedAt,
+			LastUsedAt: lastUsedAt,
+		})
+	}
+	slices.SortFunc(sessions, func(a, b model.ActiveSession) int {
+		return b.LastUsedAt.Compare(a.LastUsedAt)
+	})
+
+	return sessions, nil
+}
+
+// getFamily returns an empty record when the family does not exist.
+func (repo *session) getFamily(ctx context.Context, userID, familyID string) (sessionFamilyRecord, error) {
+	v, err := kvs.Get[string](ctx, repo.kvs, repo.familyKey(userID, familyID))
+	if errors.Is(err, kvs.ErrKeyNotFound) {
+		return sessionFamilyRecord{}, nil
+	}
+	if err != nil {
+		return sessionFamilyRecord{}, fmt.Errorf("repository: failed to get session family: %w", err)
+	}
+
+	var family sessionFamilyRecord
+	if err := json.Unmarshal([]byte(v), &family); err != nil {
+		return sessionFamilyRecord{}, fmt.Errorf("repository: failed to unmarshal session family: %w", err)
+	}
+	return family, nil
+}
+
+// getLastUsedAt returns when the family was last used, either rotated or touched.
+func (repo *session) getLastUsedAt(
+	ctx context.Context, userID, familyID string, family sessionFamilyRecord,
+) (time.Time, error) {
+	v, err := kvs.Get[string](ctx, repo.kvs, repo.familyLastUsedKey(userID, familyID))
+	if errors.Is(err, kvs.ErrKeyNotFound) {
+		return family.LastUsedAt, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("repository: failed to get session family last used time: %w", err)
+	}
+
+	touchedAt, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("repository: failed to parse session family last used time: %w", err)
+	}
+	if touchedAt.Before(family.LastUsedAt) {
+		return family.LastUsedAt, nil
+	}
+	return touchedAt, nil
+}
+
+func (repo *session) setFamily(ctx context.Context, userID, familyID string, family sessionFamilyRecord) error {
+	ttl := time.Until(family.RefreshToken.ExpiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	b, err := json.Marshal(family)
+	if err != nil {
+		return fmt.Errorf("repository: failed to marshal session family: %w", err)
+	}
+	if err := repo.kvs.Set(ctx, repo.familyKey(userID, familyID), string(b), ttl); err != nil {
+		return fmt.Errorf("repository: failed to set session family: %w", err)
+	}
+	return nil
+}
+
 func (repo *session) accessTokenKey(userID, accessToken string) string {
 	return fmt.Sprintf("sampay:session:%s:access:%s", userID, accessToken)
 }
@@ -82,3 +491,23 @@ func (repo *session) accessTokenKey(userID, accessToken string) string {
 func (repo *session) refreshTokenKey(userID, refreshToken string) string {
 	return fmt.Sprintf("sampay:session:%s:refresh:%s", userID, refreshToken)
 }
+
+func (repo *session) usedRefreshTokenKey(userID, refreshToken string) string {
+	return fmt.Sprintf("sampay:session:%s:refresh_used:%s", userID, refreshToken)
+}
+
+func (repo *session) rotatedRefreshTokenKey(userID, refreshToken string) string {
+	return fmt.Sprintf("sampay:session:%s:refresh_rotated:%s", userID, refreshToken)
+}
+
+func (repo *session) familyKey(userID, familyID string) string {
+	return fmt.Sprintf("sampay:session:%s:family:%s", userID, familyID)
+}
+
+func (repo *session) familyLastUsedKey(userID, familyID string) string {
+	return fmt.Sprintf("sampay:session:%s:family_last_used:%s", userID, familyID)
+}
+
+func (repo *session) familiesKey(userID string) string {
+	return fmt.Sprintf("sampay:session:%s:families", userID)
+}
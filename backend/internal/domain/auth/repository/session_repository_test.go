@@ -1,13 +1,16 @@
 package repository_test
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mickamy/sampay/internal/domain/auth/model"
 	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/lib/jwt"
 	"github.com/mickamy/sampay/internal/lib/ulid"
 	"github.com/mickamy/sampay/internal/test/itest"
 )
@@ -84,3 +87,286 @@ func TestSession_RefreshTokenExists(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, exists)
 }
+
+func TestSession_ConsumeRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	session := model.MustNewSession(ulid.New())
+	successor := model.Session{UserID: session.UserID, Tokens: jwt.MustNew(session.UserID)}
+	sut := repository.NewSession(kvStore)
+	require.NoError(t, sut.Create(t.Context(), session))
+
+	// act
+	got, err := sut.ConsumeRefreshToken(t.Context(), session.Tokens.Refresh.Value, successor)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, repository.RefreshTokenRotation{FamilyID: session.FamilyID, Successor: successor.Tokens}, got)
+	rtExists, err := sut.RefreshTokenExists(t.Context(), session.UserID, session.Tokens.Refresh.Value)
+	require.NoError(t, err)
+	assert.False(t, rtExists)
+	successorExists, err := sut.RefreshTokenExists(t.Context(), session.UserID, successor.Tokens.Refresh.Value)
+	require.NoError(t, err)
+	assert.True(t, successorExists, "the successor is stored")
+}
+
+func TestSession_ConsumeRefreshToken_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	session := model.MustNewSession(ulid.New())
+	sut := repository.NewSession(kvStore)
+	require.NoError(t, sut.Create(t.Context(), session))
+
+	// act
+	var wg sync.WaitGroup
+	rotations := make([]repository.RefreshTokenRotation, 2)
+	errs := make([]error, 2)
+	for i := range 2 {
+		wg.Go(func() {
+			successor := model.Session{UserID: session.UserID, Tokens: jwt.MustNew(session.UserID)}
+			rotations[i], errs[i] = sut.ConsumeRefreshToken(t.Context(), session.Tokens.Refresh.Value, successor)
+		})
+	}
+	wg.Wait()
+
+	// assert
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	assert.Equal(t, rotations[0].Successor.Access.Value, rotations[1].Successor.Access.Value,
+		"both get the same successor")
+	assert.Equal(t, rotations[0].Successor.Refresh.Value, rotations[1].Successor.Refresh.Value)
+	assert.NotEqual(t, rotations[0].Replayed, rotations[1].Replayed)
+	exists, err := sut.RefreshTokenExists(t.Context(), session.UserID, rotations[0].Successor.Refresh.Value)
+	require.NoError(t, err)
+	assert.True(t, exists, "the successor is stored by the time it is handed out")
+}
+
+func TestSession_ConsumeRefreshToken_Legacy(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	userID := ulid.New()
+	legacy := jwt.MustNew(userID)
+	// tokens issued before token families were stored as themselves, some with only a day left
+	require.NoError(t, kvStore.Set(t.Context(),
+		"sampay:session:"+userID+":refresh:"+legacy.Refresh.Value, legacy.Refresh.Value, 24*time.Hour))
+	successor := model.Session{UserID: userID, Tokens: jwt.MustNew(userID)}
+	sut := repository.NewSession(kvStore)
+
+	// act
+	got, err := sut.ConsumeRefreshToken(t.Context(), legacy.Refresh.Value, successor)
+
+	// assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, got.FamilyID, "a new family is started")
+	assert.NotEqual(t, legacy.Refresh.Value, got.FamilyID)
+	assert.False(t, got.Replayed)
+	rtExists, err := sut.RefreshTokenExists(t.Context(), userID, legacy.Refresh.Value)
+	require.NoError(t, err)
+	assert.False(t, rtExists)
+	ttl, err := kvStore.TTL(t.Context(), "sampay:session:"+userID+":refresh_used:"+legacy.Refresh.Value)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, ttl, 24*time.Hour, "the mark lives as long as the legacy token had left")
+}
+
+func TestSession_ConsumeRefreshToken_Replayed(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	session := model.MustNewSession(ulid.New())
+	sut := repository.NewSession(kvStore)
+	require.NoError(t, sut.Create(t.Context(), session))
+	first := model.Session{UserID: session.UserID, Tokens: jwt.MustNew(session.UserID)}
+	_, err := sut.ConsumeRefreshToken(t.Context(), session.Tokens.Refresh.Value, first)
+	require.NoError(t, err)
+
+	// act
+	got, err := sut.ConsumeRefreshToken(t.Context(), session.Tokens.Refresh.Value,
+		model.Session{UserID: session.UserID, Tokens: jwt.MustNew(session.UserID)})
+
+	// assert
+	require.NoError(t, err)
+	assert.True(t, got.Replayed)
+	assert.Equal(t, session.FamilyID, got.FamilyID)
+	assert.Equal(t, first.Tokens.Access.Value, got.Successor.Access.Value, "the same successor is returned")
+	assert.Equal(t, first.Tokens.Refresh.Value, got.Successor.Refresh.Value)
+}
+
+func TestSession_ConsumeRefreshToken_Reused(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore, server := itest.NewKVSWithServer(t)
+	session := model.MustNewSession(ulid.New())
+	sut := repository.NewSession(kvStore)
+	require.NoError(t, sut.Create(t.Context(), session))
+	_, err := sut.ConsumeRefreshToken(t.Context(), session.Tokens.Refresh.Value,
+		model.Session{UserID: session.UserID, Tokens: jwt.MustNew(session.UserID)})
+	require.NoError(t, err)
+	server.FastForward(time.Minute)
+
+	// act
+	got, err := sut.ConsumeRefreshToken(t.Context(), session.Tokens.Refresh.Value,
+		model.Session{UserID: session.UserID, Tokens: jwt.MustNew(session.UserID)})
+
+	// assert
+	require.ErrorIs(t, err, repository.ErrRefreshTokenReused)
+	assert.Equal(t, session.FamilyID, got.FamilyID)
+}
+
+func TestSession_ConsumeRefreshToken_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+
+	// act
+	_, err := sut.ConsumeRefreshToken(t.Context(), "nonexistent",
+		model.Session{UserID: "nonexistent", Tokens: jwt.MustNew("nonexistent")})
+
+	// assert
+	require.ErrorIs(t, err, repository.ErrRefreshTokenNotFound)
+}
+
+func TestSession_RevokeFamily(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+	first := model.MustNewSession(ulid.New())
+	require.NoError(t, sut.Create(t.Context(), first))
+	rotated, err := model.NewSessionInFamily(first.UserID, first.FamilyID)
+	require.NoError(t, err)
+	_, err = sut.ConsumeRefreshToken(t.Context(), first.Tokens.Refresh.Value, rotated)
+	require.NoError(t, err)
+	other := model.MustNewSession(first.UserID)
+	require.NoError(t, sut.Create(t.Context(), other))
+
+	// act
+	err = sut.RevokeFamily(t.Context(), first.UserID, first.FamilyID)
+
+	// assert
+	require.NoError(t, err)
+	for _, at := range []string{first.Tokens.Access.Value, rotated.Tokens.Access.Value} {
+		exists, err := sut.AccessTokenExists(t.Context(), first.UserID, at)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	}
+	rtExists, err := sut.RefreshTokenExists(t.Context(), rotated.UserID, rotated.Tokens.Refresh.Value)
+	require.NoError(t, err)
+	assert.False(t, rtExists)
+
+	otherExists, err := sut.AccessTokenExists(t.Context(), other.UserID, other.Tokens.Access.Value)
+	require.NoError(t, err)
+	assert.True(t, otherExists, "sessions in other families must survive")
+}
+
+func TestSession_List(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+	userID := ulid.New()
+	phone := model.MustNewSession(userID)
+	phone.Client = model.SessionClient{UserAgent: "phone", IPAddress: "192.0.2.1"}
+	require.NoError(t, sut.Create(t.Context(), phone))
+	laptop := model.MustNewSession(userID)
+	laptop.Client = model.SessionClient{UserAgent: "laptop", IPAddress: "192.0.2.2"}
+	require.NoError(t, sut.Create(t.Context(), laptop))
+	require.NoError(t, sut.Create(t.Context(), model.MustNewSession(ulid.New())))
+
+	// act
+	got, err := sut.List(t.Context(), userID)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, laptop.FamilyID, got[0].FamilyID, "most recently used first")
+	assert.Equal(t, laptop.Client, got[0].Client)
+	assert.Equal(t, phone.FamilyID, got[1].FamilyID)
+	assert.Equal(t, phone.Client, got[1].Client)
+	assert.False(t, got[1].CreatedAt.IsZero())
+}
+
+func TestSession_List_Rotated(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+	first := model.MustNewSession(ulid.New())
+	first.Client = model.SessionClient{UserAgent: "phone", IPAddress: "192.0.2.1"}
+	require.NoError(t, sut.Create(t.Context(), first))
+	rotated, err := model.NewSessionInFamily(first.UserID, first.FamilyID)
+	require.NoError(t, err)
+	rotated.Client = model.SessionClient{UserAgent: "phone", IPAddress: "192.0.2.9"}
+	require.NoError(t, sut.Create(t.Context(), rotated))
+
+	// act
+	got, err := sut.List(t.Context(), first.UserID)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, first.FamilyID, got[0].FamilyID)
+	assert.Equal(t, "192.0.2.9", got[0].Client.IPAddress)
+}
+
+func TestSession_List_Revoked(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+	session := model.MustNewSession(ulid.New())
+	require.NoError(t, sut.Create(t.Context(), session))
+	require.NoError(t, sut.RevokeFamily(t.Context(), session.UserID, session.FamilyID))
+
+	// act
+	got, err := sut.List(t.Context(), session.UserID)
+
+	// assert
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestSession_Touch(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+	session := model.MustNewSession(ulid.New())
+	require.NoError(t, sut.Create(t.Context(), session))
+
+	// act
+	familyID, err := sut.Touch(t.Context(), session.UserID, session.Tokens.Access.Value)
+
+	// assert
+	require.NoError(t, err)
+	assert.Equal(t, session.FamilyID, familyID)
+}
+
+func TestSession_Touch_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	kvStore := itest.NewKVS(t)
+	sut := repository.NewSession(kvStore)
+
+	// act
+	familyID, err := sut.Touch(t.Context(), "nonexistent", "nonexistent")
+
+	// assert
+	require.NoError(t, err)
+	assert.Empty(t, familyID)
+}
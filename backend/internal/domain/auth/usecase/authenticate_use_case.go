@@ -28,6 +28,8 @@ type AuthenticateInput struct {
 
 type AuthenticateOutput struct {
 	UserID string
+	// SessionID is empty for tokens issued before sessions were tracked.
+	SessionID string
 }
 
 type Authenticate interface {
@@ -61,5 +63,13 @@ func (uc *authenticate) Do(ctx context.Context, input AuthenticateInput) (Authen
 		return AuthenticateOutput{}, ErrAuthenticateSessionNotFound
 	}
 
-	return AuthenticateOutput{UserID: userID}, nil
+	sessionID, err := uc.sessionRepo.Touch(ctx, userID, input.Token)
+	if err != nil {
+		return AuthenticateOutput{}, errx.
+			Wrap(err).
+			With("message", "failed to touch session", "user_id", userID).
+			WithCode(errx.Internal)
+	}
+
+	return AuthenticateOutput{UserID: userID, SessionID: sessionID}, nil
 }
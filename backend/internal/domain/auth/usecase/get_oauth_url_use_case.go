@@ -5,7 +5,9 @@ import (
 
 	"github.com/mickamy/errx"
 
+	"github.com/mickamy/sampay/internal/di"
 	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
 	"github.com/mickamy/sampay/internal/lib/oauth"
 )
 
@@ -15,6 +17,7 @@ type GetOAuthURLInput struct {
 
 type GetOAuthURLOutput struct {
 	AuthenticationURL string
+	State             model.OAuthState
 }
 
 type GetOAuthURL interface {
@@ -22,8 +25,10 @@ type GetOAuthURL interface {
 }
 
 type getOAuthURL struct {
-	_        GetOAuthURL     `inject:"returns"`
-	resolver *oauth.Resolver `inject:"param"`
+	_              GetOAuthURL           `inject:"returns"`
+	_              *di.Infra             `inject:"param"`
+	resolver       *oauth.Resolver       `inject:"param"`
+	oauthStateRepo repository.OAuthState `inject:""`
 }
 
 func (uc *getOAuthURL) Do(ctx context.Context, input GetOAuthURLInput) (GetOAuthURLOutput, error) {
@@ -35,7 +40,15 @@ func (uc *getOAuthURL) Do(ctx context.Context, input GetOAuthURLInput) (GetOAuth
 			WithCode(errx.Internal)
 	}
 
-	url, err := client.AuthenticationURL()
+	state, err := model.NewOAuthState(input.Provider)
+	if err != nil {
+		return GetOAuthURLOutput{}, errx.
+			Wrap(err, "failed to initialize oauth state").
+			With("provider", input.Provider).
+			WithCode(errx.Internal)
+	}
+
+	url, err := client.AuthenticationURL(state.Value, state.CodeVerifier)
 	if err != nil {
 		return GetOAuthURLOutput{}, errx.
 			Wrap(err, "failed to get authentication url").
@@ -43,5 +56,12 @@ func (uc *getOAuthURL) Do(ctx context.Context, input GetOAuthURLInput) (GetOAuth
 			WithCode(errx.Internal)
 	}
 
-	return GetOAuthURLOutput{AuthenticationURL: url}, nil
+	if err := uc.oauthStateRepo.Create(ctx, state); err != nil {
+		return GetOAuthURLOutput{}, errx.
+			Wrap(err, "failed to store oauth state").
+			With("provider", input.Provider).
+			WithCode(errx.Internal)
+	}
+
+	return GetOAuthURLOutput{AuthenticationURL: url, State: state}, nil
 }
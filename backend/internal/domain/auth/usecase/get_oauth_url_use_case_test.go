@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/mickamy/sampay/config"
 	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
 	"github.com/mickamy/sampay/internal/domain/auth/usecase"
 	"github.com/mickamy/sampay/internal/lib/oauth"
 )
@@ -28,6 +30,9 @@ func TestGetOAuthURL_Do(t *testing.T) {
 			assert: func(t *testing.T, got usecase.GetOAuthURLOutput, err error) {
 				require.NoError(t, err)
 				assert.Contains(t, got.AuthenticationURL, "access.line.me")
+				assert.Contains(t, got.AuthenticationURL, url.QueryEscape(got.State.Value))
+				assert.Contains(t, got.AuthenticationURL, "code_challenge_method=S256")
+				assert.Equal(t, model.OAuthProviderLINE, got.State.Provider)
 			},
 		},
 		{
@@ -44,12 +49,18 @@ func TestGetOAuthURL_Do(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			sut := usecase.NewGetOAuthURL(resolver)
+			infra := newInfra(t)
+			sut := usecase.NewGetOAuthURL(infra, resolver)
 			got, err := sut.Do(t.Context(), usecase.GetOAuthURLInput{
 				Provider: tt.provider,
 			})
 
 			tt.assert(t, got, err)
+			if err == nil {
+				stored, err := repository.NewOAuthState(infra.KVS).Consume(t.Context(), got.State.Value)
+				require.NoError(t, err)
+				assert.Equal(t, got.State, stored)
+			}
 		})
 	}
 }
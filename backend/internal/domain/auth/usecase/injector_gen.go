@@ -28,18 +28,40 @@ func MustNewAuthenticate(infra *di.Infra) Authenticate {
 }
 
 // NewGetOAuthURL initializes dependencies and constructs getOAuthURL.
-func NewGetOAuthURL(resolver *oauth.Resolver) GetOAuthURL {
+func NewGetOAuthURL(infra *di.Infra, resolver *oauth.Resolver) GetOAuthURL {
+	oAuthState := repository.NewOAuthState(infra.KVS)
 
 	return &getOAuthURL{
-		resolver: resolver,
+		resolver:       resolver,
+		oauthStateRepo: oAuthState,
 	}
 }
 
 // MustNewGetOAuthURL initializes dependencies and constructs getOAuthURL or panics on failure.
-func MustNewGetOAuthURL(resolver *oauth.Resolver) GetOAuthURL {
+func MustNewGetOAuthURL(infra *di.Infra, resolver *oauth.Resolver) GetOAuthURL {
+	oAuthState := repository.NewOAuthState(infra.KVS)
 
 	return &getOAuthURL{
-		resolver: resolver,
+		resolver:       resolver,
+		oauthStateRepo: oAuthState,
+	}
+}
+
+// NewListSessions initializes dependencies and constructs listSessions.
+func NewListSessions(infra *di.Infra) ListSessions {
+	session := repository.NewSession(infra.KVS)
+
+	return &listSessions{
+		sessionRepo: session,
+	}
+}
+
+// MustNewListSessions initializes dependencies and constructs listSessions or panics on failure.
+func MustNewListSessions(infra *di.Infra) ListSessions {
+	session := repository.NewSession(infra.KVS)
+
+	return &listSessions{
+		sessionRepo: session,
 	}
 }
 
@@ -65,16 +87,20 @@ func MustNewLogout(infra *di.Infra) Logout {
 func NewOAuthCallback(infra *di.Infra, resolver *oauth.Resolver) OAuthCallback {
 	user := repository2.NewUser(infra.DB)
 	endUser := repository2.NewEndUser(infra.DB)
+	lineFriendship := repository2.NewLineFriendship(infra.DB)
 	oAuthAccount := repository.NewOAuthAccount(infra.DB)
+	oAuthState := repository.NewOAuthState(infra.KVS)
 	session := repository.NewSession(infra.KVS)
 
 	return &oauthCallback{
-		resolver:         resolver,
-		writer:           infra.WriterDB,
-		userRepo:         user,
-		endUserRepo:      endUser,
-		oauthAccountRepo: oAuthAccount,
-		sessionRepo:      session,
+		resolver:           resolver,
+		writer:             infra.WriterDB,
+		userRepo:           user,
+		endUserRepo:        endUser,
+		lineFriendshipRepo: lineFriendship,
+		oauthAccountRepo:   oAuthAccount,
+		oauthStateRepo:     oAuthState,
+		sessionRepo:        session,
 	}
 }
 
@@ -82,16 +108,20 @@ func NewOAuthCallback(infra *di.Infra, resolver *oauth.Resolver) OAuthCallback {
 func MustNewOAuthCallback(infra *di.Infra, resolver *oauth.Resolver) OAuthCallback {
 	user := repository2.NewUser(infra.DB)
 	endUser := repository2.NewEndUser(infra.DB)
+	lineFriendship := repository2.NewLineFriendship(infra.DB)
 	oAuthAccount := repository.NewOAuthAccount(infra.DB)
+	oAuthState := repository.NewOAuthState(infra.KVS)
 	session := repository.NewSession(infra.KVS)
 
 	return &oauthCallback{
-		resolver:         resolver,
-		writer:           infra.WriterDB,
-		userRepo:         user,
-		endUserRepo:      endUser,
-		oauthAccountRepo: oAuthAccount,
-		sessionRepo:      session,
+		resolver:           resolver,
+		writer:             infra.WriterDB,
+		userRepo:           user,
+		endUserRepo:        endUser,
+		lineFriendshipRepo: lineFriendship,
+		oauthAccountRepo:   oAuthAccount,
+		oauthStateRepo:     oAuthState,
+		sessionRepo:        session,
 	}
 }
 
@@ -112,3 +142,39 @@ func MustNewRefreshToken(infra *di.Infra) RefreshToken {
 		sessionRepo: session,
 	}
 }
+
+// NewRevokeAllSessions initializes dependencies and constructs revokeAllSessions.
+func NewRevokeAllSessions(infra *di.Infra) RevokeAllSessions {
+	session := repository.NewSession(infra.KVS)
+
+	return &revokeAllSessions{
+		sessionRepo: session,
+	}
+}
+
+// MustNewRevokeAllSessions initializes dependencies and constructs revokeAllSessions or panics on failure.
+func MustNewRevokeAllSessions(infra *di.Infra) RevokeAllSessions {
+	session := repository.NewSession(infra.KVS)
+
+	return &revokeAllSessions{
+		sessionRepo: session,
+	}
+}
+
+// NewRevokeSession initializes dependencies and constructs revokeSession.
+func NewRevokeSession(infra *di.Infra) RevokeSession {
+	session := repository.NewSession(infra.KVS)
+
+	return &revokeSession{
+		sessionRepo: session,
+	}
+}
+
+// MustNewRevokeSession initializes dependencies and constructs revokeSession or panics on failure.
+func MustNewRevokeSession(infra *di.Infra) RevokeSession {
+	session := repository.NewSession(infra.KVS)
+
+	return &revokeSession{
+		sessionRepo: session,
+	}
+}
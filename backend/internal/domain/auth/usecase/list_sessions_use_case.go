@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+type ListSessionsInput struct{}
+
+type ListSessionsOutput struct {
+	Sessions []model.ActiveSession
+	// CurrentID is the ID of the session the request was made with, if any.
+	CurrentID string
+}
+
+type ListSessions interface {
+	Do(ctx context.Context, input ListSessionsInput) (ListSessionsOutput, error)
+}
+
+type listSessions struct {
+	_           ListSessions       `inject:"returns"`
+	_           *di.Infra          `inject:"param"`
+	sessionRepo repository.Session `inject:""`
+}
+
+func (uc *listSessions) Do(ctx context.Context, _ ListSessionsInput) (ListSessionsOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+	currentID, _ := contexts.AuthenticatedSessionID(ctx)
+
+	sessions, err := uc.sessionRepo.List(ctx, userID)
+	if err != nil {
+		return ListSessionsOutput{}, errx.Wrap(err, "message", "failed to list sessions", "user_id", userID).
+			WithCode(errx.Internal)
+	}
+
+	return ListSessionsOutput{Sessions: sessions, CurrentID: currentID}, nil
+}
@@ -0,0 +1,38 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/domain/auth/usecase"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+func TestListSessions_Do(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	infra := newInfra(t)
+	userID := ulid.New()
+	current := model.MustNewSession(userID)
+	other := model.MustNewSession(userID)
+	repo := repository.NewSession(infra.KVS)
+	require.NoError(t, repo.Create(t.Context(), current))
+	require.NoError(t, repo.Create(t.Context(), other))
+	ctx := contexts.SetAuthenticatedUserID(t.Context(), userID)
+	ctx = contexts.SetAuthenticatedSessionID(ctx, current.FamilyID)
+
+	// act
+	sut := usecase.NewListSessions(infra)
+	got, err := sut.Do(ctx, usecase.ListSessionsInput{})
+
+	// assert
+	require.NoError(t, err)
+	assert.Len(t, got.Sessions, 2)
+	assert.Equal(t, current.FamilyID, got.CurrentID)
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 
 	"github.com/google/uuid"
@@ -25,11 +26,19 @@ var (
 		WithMessages(messages.AuthUseCaseErrorUnsupportedOauthProvider())
 	ErrOAuthCallbackFailed = cmodel.NewLocalizableError(errx.NewSentinel("oauth callback failed", errx.InvalidArgument)).
 				WithMessages(messages.AuthUseCaseErrorOauthCallbackFailed())
+	ErrOAuthCallbackInvalidState = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid oauth state", errx.InvalidArgument)).
+		WithMessages(messages.AuthUseCaseErrorOauthStateInvalid())
 )
 
 type OAuthCallbackInput struct {
 	Provider model.OAuthProvider
 	Code     string
+	// State is the state echoed back by the provider.
+	State string
+	// CookieState is the state bound to the browser when the authorization URL was issued.
+	CookieState string
+	Client      model.SessionClient
 }
 
 type OAuthCallbackOutput struct {
@@ -43,14 +52,16 @@ type OAuthCallback interface {
 }
 
 type oauthCallback struct {
-	_                OAuthCallback           `inject:"returns"`
-	_                *di.Infra               `inject:"param"`
-	resolver         *oauth.Resolver         `inject:"param"`
-	writer           *database.Writer        `inject:""`
-	userRepo         urepository.User        `inject:""`
-	endUserRepo      urepository.EndUser     `inject:""`
-	oauthAccountRepo repository.OAuthAccount `inject:""`
-	sessionRepo      repository.Session      `inject:""`
+	_                  OAuthCallback              `inject:"returns"`
+	_                  *di.Infra                  `inject:"param"`
+	resolver           *oauth.Resolver            `inject:"param"`
+	writer             *database.Writer           `inject:""`
+	userRepo           urepository.User           `inject:""`
+	endUserRepo        urepository.EndUser        `inject:""`
+	lineFriendshipRepo urepository.LineFriendship `inject:""`
+	oauthAccountRepo   repository.OAuthAccount    `inject:""`
+	oauthStateRepo     repository.OAuthState      `inject:""`
+	sessionRepo        repository.Session         `inject:""`
 }
 
 func (uc *oauthCallback) Do(ctx context.Context, input OAuthCallbackInput) (OAuthCallbackOutput, error) {
@@ -59,7 +70,12 @@ func (uc *oauthCallback) Do(ctx context.Context, input OAuthCallbackInput) (OAut
 		return OAuthCallbackOutput{}, err
 	}
 
-	payload, err := client.Callback(ctx, input.Code)
+	state, err := uc.verifyState(ctx, input)
+	if err != nil {
+		return OAuthCallbackOutput{}, err
+	}
+
+	payload, err := client.Callback(ctx, input.Code, state.CodeVerifier)
 	if err != nil {
 		return OAuthCallbackOutput{}, errors.Join(ErrOAuthCallbackFailed, err)
 	}
@@ -111,11 +127,24 @@ func (uc *oauthCallback) Do(ctx context.Context, input OAuthCallbackInput) (OAut
 			}
 		}
 
+		// LINE only tells us on login, so a failed lookup keeps whatever we knew before
+		if payload.LineFriendKnown {
+			friendship := umodel.LineFriendship{
+				EndUserID: endUser.UserID,
+				IsFriend:  payload.LineFriend,
+			}
+			if err := uc.lineFriendshipRepo.WithTx(tx).Upsert(ctx, &friendship); err != nil {
+				return errx.Wrap(err, "message", "failed to save line friendship").
+					WithCode(errx.Internal)
+			}
+		}
+
 		session, err = model.NewSession(endUser.UserID)
 		if err != nil {
 			return errx.Wrap(err, "message", "failed to initialize session").
 				WithCode(errx.Internal)
 		}
+		session.Client = input.Client
 
 		if err := uc.sessionRepo.Create(ctx, session); err != nil {
 			return errx.Wrap(err, "message", "failed to create session").
@@ -130,6 +159,36 @@ func (uc *oauthCallback) Do(ctx context.Context, input OAuthCallbackInput) (OAut
 	return OAuthCallbackOutput{Session: session, EndUser: endUser, IsNewUser: isNewUser}, nil
 }
 
+// verifyState consumes the stored state and checks that it was issued for this browser and provider.
+// The state is consumed before any check so that a leaked state cannot be retried.
+func (uc *oauthCallback) verifyState(ctx context.Context, input OAuthCallbackInput) (model.OAuthState, error) {
+	if input.State == "" {
+		return model.OAuthState{}, errx.Wrap(ErrOAuthCallbackInvalidState, "reason", "empty state")
+	}
+
+	state, err := uc.oauthStateRepo.Consume(ctx, input.State)
+	if err != nil {
+		if errors.Is(err, repository.ErrOAuthStateNotFound) {
+			return model.OAuthState{}, errx.Wrap(ErrOAuthCallbackInvalidState, "reason", "expired or already used")
+		}
+		return model.OAuthState{}, errx.Wrap(err, "message", "failed to consume oauth state").
+			WithCode(errx.Internal)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(input.State), []byte(input.CookieState)) != 1 {
+		return model.OAuthState{}, errx.Wrap(ErrOAuthCallbackInvalidState, "reason", "cookie mismatch")
+	}
+	if state.Provider != input.Provider {
+		return model.OAuthState{}, errx.Wrap(ErrOAuthCallbackInvalidState,
+			"reason", "provider mismatch",
+			"expected", state.Provider,
+			"actual", input.Provider,
+		)
+	}
+
+	return state, nil
+}
+
 func (uc *oauthCallback) resolveClient(provider model.OAuthProvider) (oauth.Client, error) {
 	client, err := uc.resolver.Resolve(oauth.Provider(provider))
 	if err != nil {
@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 	"github.com/mickamy/sampay/internal/di"
 	"github.com/mickamy/sampay/internal/domain/auth/model"
 	"github.com/mickamy/sampay/internal/domain/auth/query"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
 	"github.com/mickamy/sampay/internal/domain/auth/usecase"
 	ufixture "github.com/mickamy/sampay/internal/domain/user/fixture"
 	umodel "github.com/mickamy/sampay/internal/domain/user/model"
@@ -23,11 +25,14 @@ type fakeOAuthClient struct {
 	err     error
 }
 
-func (c *fakeOAuthClient) AuthenticationURL() (string, error) {
+func (c *fakeOAuthClient) AuthenticationURL(_ string, _ string) (string, error) {
 	return "https://example.com/auth", nil
 }
 
-func (c *fakeOAuthClient) Callback(_ context.Context, _ string) (oauth.Payload, error) {
+func (c *fakeOAuthClient) Callback(_ context.Context, _ string, codeVerifier string) (oauth.Payload, error) {
+	if codeVerifier == "" {
+		return oauth.Payload{}, errors.New("code verifier is required")
+	}
 	return c.payload, c.err
 }
 
@@ -49,6 +54,7 @@ func TestOAuthCallback_Do(t *testing.T) {
 		Name:     "Test User",
 		Email:    "test@example.com",
 	}
+	issuedState := model.MustNewOAuthState(model.OAuthProviderLINE)
 
 	tests := []struct {
 		name    string
@@ -62,8 +68,10 @@ func TestOAuthCallback_Do(t *testing.T) {
 				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
 			},
 			input: usecase.OAuthCallbackInput{
-				Provider: model.OAuthProviderLINE,
-				Code:     "valid_code",
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
 			},
 			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
 				require.NoError(t, err)
@@ -102,8 +110,10 @@ func TestOAuthCallback_Do(t *testing.T) {
 				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
 			},
 			input: usecase.OAuthCallbackInput{
-				Provider: model.OAuthProviderLINE,
-				Code:     "valid_code",
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
 			},
 			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
 				require.NoError(t, err)
@@ -112,14 +122,61 @@ func TestOAuthCallback_Do(t *testing.T) {
 				assert.False(t, got.IsNewUser)
 			},
 		},
+		{
+			name: "success (line friendship known)",
+			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
+				payload := fakePayload
+				payload.LineFriend = true
+				payload.LineFriendKnown = true
+				return newFakeResolver(&fakeOAuthClient{payload: payload})
+			},
+			input: usecase.OAuthCallbackInput{
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
+			},
+			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
+				require.NoError(t, err)
+
+				friendship, err := uquery.LineFriendships(infra.DB).
+					Where("end_user_id = ?", got.EndUser.UserID).
+					First(t.Context())
+				require.NoError(t, err)
+				assert.True(t, friendship.IsFriend)
+			},
+		},
+		{
+			name: "success (line friendship unknown)",
+			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
+				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
+			},
+			input: usecase.OAuthCallbackInput{
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
+			},
+			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
+				require.NoError(t, err)
+
+				exists, err := uquery.LineFriendships(infra.DB).
+					Where("end_user_id = ?", got.EndUser.UserID).
+					Exists(t.Context())
+				require.NoError(t, err)
+				assert.False(t, exists)
+			},
+		},
 		{
 			name: "unsupported provider",
 			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
 				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
 			},
 			input: usecase.OAuthCallbackInput{
-				Provider: model.OAuthProvider("unknown"),
-				Code:     "valid_code",
+				Provider:    model.OAuthProvider("unknown"),
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
 			},
 			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
 				require.ErrorIs(t, err, usecase.ErrOAuthCallbackUnsupportedProvider)
@@ -133,13 +190,75 @@ func TestOAuthCallback_Do(t *testing.T) {
 				})
 			},
 			input: usecase.OAuthCallbackInput{
-				Provider: model.OAuthProviderLINE,
-				Code:     "invalid_code",
+				Provider:    model.OAuthProviderLINE,
+				Code:        "invalid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
 			},
 			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
 				require.ErrorIs(t, err, usecase.ErrOAuthCallbackFailed)
 			},
 		},
+		{
+			name: "empty state",
+			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
+				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
+			},
+			input: usecase.OAuthCallbackInput{
+				Provider: model.OAuthProviderLINE,
+				Code:     "valid_code",
+			},
+			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
+				require.ErrorIs(t, err, usecase.ErrOAuthCallbackInvalidState)
+			},
+		},
+		{
+			name: "state not issued",
+			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
+				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
+			},
+			input: usecase.OAuthCallbackInput{
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       "line:forged",
+				CookieState: "line:forged",
+			},
+			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
+				require.ErrorIs(t, err, usecase.ErrOAuthCallbackInvalidState)
+			},
+		},
+		{
+			name: "state bound to another browser",
+			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
+				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
+			},
+			input: usecase.OAuthCallbackInput{
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: model.MustNewOAuthState(model.OAuthProviderLINE).Value,
+			},
+			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
+				require.ErrorIs(t, err, usecase.ErrOAuthCallbackInvalidState)
+			},
+		},
+		{
+			name: "replayed state",
+			arrange: func(t *testing.T, infra *di.Infra) *oauth.Resolver {
+				_, err := repository.NewOAuthState(infra.KVS).Consume(t.Context(), issuedState.Value)
+				require.NoError(t, err)
+				return newFakeResolver(&fakeOAuthClient{payload: fakePayload})
+			},
+			input: usecase.OAuthCallbackInput{
+				Provider:    model.OAuthProviderLINE,
+				Code:        "valid_code",
+				State:       issuedState.Value,
+				CookieState: issuedState.Value,
+			},
+			assert: func(t *testing.T, infra *di.Infra, got usecase.OAuthCallbackOutput, err error) {
+				require.ErrorIs(t, err, usecase.ErrOAuthCallbackInvalidState)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -148,6 +267,7 @@ func TestOAuthCallback_Do(t *testing.T) {
 
 			// arrange
 			infra := newInfra(t)
+			require.NoError(t, repository.NewOAuthState(infra.KVS).Create(t.Context(), issuedState))
 			resolver := tt.arrange(t, infra)
 
 			// act
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/mickamy/errx"
 
@@ -20,10 +21,13 @@ var (
 				WithMessages(messages.AuthUseCaseErrorTokenInvalid())
 	ErrRefreshTokenNotFound = cmodel.NewLocalizableError(errx.NewSentinel("session not found", errx.InvalidArgument)).
 				WithMessages(messages.AuthUseCaseErrorSessionNotFound())
+	ErrRefreshTokenReused = cmodel.NewLocalizableError(errx.NewSentinel("token reused", errx.InvalidArgument)).
+				WithMessages(messages.AuthUseCaseErrorTokenReused())
 )
 
 type RefreshTokenInput struct {
-	Token string
+	Token  string
+	Client model.SessionClient
 }
 
 type RefreshTokenOutput struct {
@@ -50,25 +54,33 @@ func (uc *refreshToken) Do(ctx context.Context, input RefreshTokenInput) (Refres
 		return RefreshTokenOutput{}, ErrRefreshTokenInvalid
 	}
 
-	exists, err := uc.sessionRepo.RefreshTokenExists(ctx, userID, input.Token)
+	successor, err := jwt.New(userID)
 	if err != nil {
-		return RefreshTokenOutput{}, errx.Wrap(err, "message", "failed to check session existence").
+		return RefreshTokenOutput{}, errx.Wrap(err, "message", "failed to initialize tokens").
 			WithCode(errx.Internal)
 	}
-	if !exists {
+
+	rotation, err := uc.sessionRepo.ConsumeRefreshToken(ctx, input.Token, model.Session{
+		UserID: userID,
+		Tokens: successor,
+		Client: input.Client,
+	})
+	if errors.Is(err, repository.ErrRefreshTokenNotFound) {
 		return RefreshTokenOutput{}, ErrRefreshTokenNotFound
 	}
-
-	session, err := model.NewSession(userID)
-	if err != nil {
-		return RefreshTokenOutput{}, errx.Wrap(err, "message", "failed to initialize session").
-			WithCode(errx.Internal)
+	if errors.Is(err, repository.ErrRefreshTokenReused) {
+		// a consumed token being presented again means it has leaked, so nobody in the family can be trusted anymore
+		if err := uc.sessionRepo.RevokeFamily(ctx, userID, rotation.FamilyID); err != nil {
+			return RefreshTokenOutput{}, errx.Wrap(err, "message", "failed to revoke session family").
+				WithCode(errx.Internal)
+		}
+		return RefreshTokenOutput{}, errx.Wrap(ErrRefreshTokenReused, "user_id", userID, "family_id", rotation.FamilyID)
 	}
-
-	if err := uc.sessionRepo.Create(ctx, session); err != nil {
-		return RefreshTokenOutput{}, errx.Wrap(err, "message", "failed to create session").
+	if err != nil {
+		return RefreshTokenOutput{}, errx.Wrap(err, "message", "failed to consume refresh token").
 			WithCode(errx.Internal)
 	}
-
-	return RefreshTokenOutput{Tokens: session.Tokens}, nil
+	// a replayed rotation comes from another refresh with the same token, e.g. from another tab,
+	// which has just stored its successor; either way the successor is ready to use
+	return RefreshTokenOutput{Tokens: rotation.Successor}, nil
 }
@@ -2,14 +2,19 @@ package usecase_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/require"
 
+	"github.com/mickamy/sampay/internal/di"
 	"github.com/mickamy/sampay/internal/domain/auth/model"
 	"github.com/mickamy/sampay/internal/domain/auth/repository"
 	"github.com/mickamy/sampay/internal/domain/auth/usecase"
 	"github.com/mickamy/sampay/internal/infra/storage/kvs"
+	"github.com/mickamy/sampay/internal/lib/jwt"
 	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/test/itest"
 )
 
 func TestRefreshToken_Do(t *testing.T) {
@@ -17,48 +22,115 @@ func TestRefreshToken_Do(t *testing.T) {
 
 	userID := ulid.New()
 	validSession := model.MustNewSession(userID)
+	rotatedSession, err := model.NewSessionInFamily(userID, validSession.FamilyID)
+	require.NoError(t, err)
+	legacyTokens := jwt.MustNew(userID)
 
 	tests := []struct {
 		name    string
-		arrange func(t *testing.T, kvs *kvs.KVS)
+		arrange func(t *testing.T, kvs *kvs.KVS, server *miniredis.Miniredis)
 		token   string
-		assert  func(t *testing.T, got usecase.RefreshTokenOutput, err error)
+		assert  func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error)
 	}{
 		{
 			name: "success",
-			arrange: func(t *testing.T, kvs *kvs.KVS) {
+			arrange: func(t *testing.T, kvs *kvs.KVS, _ *miniredis.Miniredis) {
 				require.NoError(t, repository.NewSession(kvs).Create(t.Context(), validSession))
 			},
 			token: validSession.Tokens.Refresh.Value,
-			assert: func(t *testing.T, got usecase.RefreshTokenOutput, err error) {
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
 				require.NoError(t, err)
 				require.NotEmpty(t, got.Tokens.Access.Value)
 				require.NotEmpty(t, got.Tokens.Refresh.Value)
+				require.NotEqual(t, validSession.Tokens.Refresh.Value, got.Tokens.Refresh.Value)
+
+				// the presented token is rotated out
+				exists, err := repository.NewSession(kvs).RefreshTokenExists(t.Context(), userID, validSession.Tokens.Refresh.Value)
+				require.NoError(t, err)
+				require.False(t, exists)
+			},
+		},
+		{
+			name: "legacy refresh token starts a family",
+			arrange: func(t *testing.T, kvs *kvs.KVS, _ *miniredis.Miniredis) {
+				// tokens issued before token families were stored as themselves
+				require.NoError(t, kvs.Set(t.Context(),
+					"sampay:session:"+userID+":refresh:"+legacyTokens.Refresh.Value, legacyTokens.Refresh.Value,
+					legacyTokens.Refresh.Expiration()))
+			},
+			token: legacyTokens.Refresh.Value,
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
+				require.NoError(t, err)
+				require.NotEqual(t, legacyTokens.Refresh.Value, got.Tokens.Refresh.Value)
+
+				sessions, err := repository.NewSession(kvs).List(t.Context(), userID)
+				require.NoError(t, err)
+				require.Len(t, sessions, 1)
+			},
+		},
+		{
+			name: "concurrent refresh gets the same tokens",
+			arrange: func(t *testing.T, kvs *kvs.KVS, _ *miniredis.Miniredis) {
+				repo := repository.NewSession(kvs)
+				require.NoError(t, repo.Create(t.Context(), validSession))
+				_, err := repo.ConsumeRefreshToken(t.Context(), validSession.Tokens.Refresh.Value, rotatedSession)
+				require.NoError(t, err)
+			},
+			token: validSession.Tokens.Refresh.Value,
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
+				require.NoError(t, err)
+				require.Equal(t, rotatedSession.Tokens.Refresh.Value, got.Tokens.Refresh.Value)
+
+				exists, err := repository.NewSession(kvs).RefreshTokenExists(t.Context(), userID, got.Tokens.Refresh.Value)
+				require.NoError(t, err)
+				require.True(t, exists, "the family is not revoked")
+			},
+		},
+		{
+			name: "reused refresh token revokes the family",
+			arrange: func(t *testing.T, kvs *kvs.KVS, server *miniredis.Miniredis) {
+				repo := repository.NewSession(kvs)
+				require.NoError(t, repo.Create(t.Context(), validSession))
+				_, err := repo.ConsumeRefreshToken(t.Context(), validSession.Tokens.Refresh.Value, rotatedSession)
+				require.NoError(t, err)
+				server.FastForward(time.Minute)
+			},
+			token: validSession.Tokens.Refresh.Value,
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
+				require.ErrorIs(t, err, usecase.ErrRefreshTokenReused)
+
+				repo := repository.NewSession(kvs)
+				atExists, err := repo.AccessTokenExists(t.Context(), userID, rotatedSession.Tokens.Access.Value)
+				require.NoError(t, err)
+				require.False(t, atExists)
+				rtExists, err := repo.RefreshTokenExists(t.Context(), userID, rotatedSession.Tokens.Refresh.Value)
+				require.NoError(t, err)
+				require.False(t, rtExists)
 			},
 		},
 		{
 			name:    "token not set",
-			arrange: func(t *testing.T, kvs *kvs.KVS) {},
+			arrange: func(t *testing.T, kvs *kvs.KVS, _ *miniredis.Miniredis) {},
 			token:   "",
-			assert: func(t *testing.T, got usecase.RefreshTokenOutput, err error) {
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
 				require.ErrorIs(t, err, usecase.ErrRefreshTokenNotSet)
 			},
 		},
 		{
 			name:    "refresh token not found",
-			arrange: func(t *testing.T, kvs *kvs.KVS) {},
+			arrange: func(t *testing.T, kvs *kvs.KVS, _ *miniredis.Miniredis) {},
 			token:   validSession.Tokens.Refresh.Value,
-			assert: func(t *testing.T, got usecase.RefreshTokenOutput, err error) {
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
 				require.ErrorIs(t, err, usecase.ErrRefreshTokenNotFound)
 			},
 		},
 		{
 			name: "invalid refresh token",
-			arrange: func(t *testing.T, kvs *kvs.KVS) {
+			arrange: func(t *testing.T, kvs *kvs.KVS, _ *miniredis.Miniredis) {
 				require.NoError(t, repository.NewSession(kvs).Create(t.Context(), validSession))
 			},
 			token: validSession.Tokens.Refresh.Value + "_invalid",
-			assert: func(t *testing.T, got usecase.RefreshTokenOutput, err error) {
+			assert: func(t *testing.T, kvs *kvs.KVS, got usecase.RefreshTokenOutput, err error) {
 				require.ErrorIs(t, err, usecase.ErrRefreshTokenInvalid)
 			},
 		},
@@ -68,15 +140,18 @@ func TestRefreshToken_Do(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			infra := newInfra(t)
-			tt.arrange(t, infra.KVS)
+			kvStore, server := itest.NewKVSWithServer(t)
+			infra := newInfra(t, func(infra *di.Infra) {
+				infra.KVS = kvStore
+			})
+			tt.arrange(t, infra.KVS, server)
 
 			sut := usecase.NewRefreshToken(infra)
 			got, err := sut.Do(t.Context(), usecase.RefreshTokenInput{
 				Token: tt.token,
 			})
 
-			tt.assert(t, got, err)
+			tt.assert(t, infra.KVS, got, err)
 		})
 	}
 }
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+type RevokeAllSessionsInput struct {
+	ExceptCurrent bool
+}
+
+type RevokeAllSessionsOutput struct {
+	// Current is true when the session the request was made with has been revoked.
+	Current bool
+}
+
+type RevokeAllSessions interface {
+	Do(ctx context.Context, input RevokeAllSessionsInput) (RevokeAllSessionsOutput, error)
+}
+
+type revokeAllSessions struct {
+	_           RevokeAllSessions  `inject:"returns"`
+	_           *di.Infra          `inject:"param"`
+	sessionRepo repository.Session `inject:""`
+}
+
+func (uc *revokeAllSessions) Do(ctx context.Context, input RevokeAllSessionsInput) (RevokeAllSessionsOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+	currentID, _ := contexts.AuthenticatedSessionID(ctx)
+
+	sessions, err := uc.sessionRepo.List(ctx, userID)
+	if err != nil {
+		return RevokeAllSessionsOutput{}, errx.Wrap(err, "message", "failed to list sessions", "user_id", userID).
+			WithCode(errx.Internal)
+	}
+
+	var out RevokeAllSessionsOutput
+	for _, s := range sessions {
+		isCurrent := s.FamilyID == currentID
+		if isCurrent && input.ExceptCurrent {
+			continue
+		}
+		if err := uc.sessionRepo.RevokeFamily(ctx, userID, s.FamilyID); err != nil {
+			return RevokeAllSessionsOutput{}, errx.Wrap(err,
+				"message", "failed to revoke session", "user_id", userID, "id", s.FamilyID,
+			).WithCode(errx.Internal)
+		}
+		if isCurrent {
+			out.Current = true
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,57 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/domain/auth/usecase"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+func TestRevokeAllSessions_Do(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		exceptCurrent bool
+		wantCurrent   bool
+		wantRemaining int
+	}{
+		{name: "all", exceptCurrent: false, wantCurrent: true, wantRemaining: 0},
+		{name: "except current", exceptCurrent: true, wantCurrent: false, wantRemaining: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// arrange
+			infra := newInfra(t)
+			userID := ulid.New()
+			repo := repository.NewSession(infra.KVS)
+			current := model.MustNewSession(userID)
+			require.NoError(t, repo.Create(t.Context(), current))
+			for range 2 {
+				require.NoError(t, repo.Create(t.Context(), model.MustNewSession(userID)))
+			}
+			ctx := contexts.SetAuthenticatedUserID(t.Context(), userID)
+			ctx = contexts.SetAuthenticatedSessionID(ctx, current.FamilyID)
+
+			// act
+			sut := usecase.NewRevokeAllSessions(infra)
+			got, err := sut.Do(ctx, usecase.RevokeAllSessionsInput{ExceptCurrent: tt.exceptCurrent})
+
+			// assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCurrent, got.Current)
+			remaining, err := repo.List(t.Context(), userID)
+			require.NoError(t, err)
+			assert.Len(t, remaining, tt.wantRemaining)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrRevokeSessionNotFound = cmodel.NewLocalizableError(errx.NewSentinel("session not found", errx.NotFound)).
+		WithMessages(messages.AuthUseCaseErrorSessionNotFound())
+)
+
+type RevokeSessionInput struct {
+	ID string
+}
+
+type RevokeSessionOutput struct {
+	// Current is true when the session the request was made with has been revoked.
+	Current bool
+}
+
+type RevokeSession interface {
+	Do(ctx context.Context, input RevokeSessionInput) (RevokeSessionOutput, error)
+}
+
+type revokeSession struct {
+	_           RevokeSession      `inject:"returns"`
+	_           *di.Infra          `inject:"param"`
+	sessionRepo repository.Session `inject:""`
+}
+
+func (uc *revokeSession) Do(ctx context.Context, input RevokeSessionInput) (RevokeSessionOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+	currentID, _ := contexts.AuthenticatedSessionID(ctx)
+
+	// look the session up among the user's own, so that sessions of other users can never be revoked
+	sessions, err := uc.sessionRepo.List(ctx, userID)
+	if err != nil {
+		return RevokeSessionOutput{}, errx.Wrap(err, "message", "failed to list sessions", "user_id", userID).
+			WithCode(errx.Internal)
+	}
+	if !slices.ContainsFunc(sessions, func(s model.ActiveSession) bool {
+		return s.FamilyID == input.ID
+	}) {
+		return RevokeSessionOutput{}, ErrRevokeSessionNotFound
+	}
+
+	if err := uc.sessionRepo.RevokeFamily(ctx, userID, input.ID); err != nil {
+		return RevokeSessionOutput{}, errx.Wrap(err, "message", "failed to revoke session", "user_id", userID, "id", input.ID).
+			WithCode(errx.Internal)
+	}
+
+	return RevokeSessionOutput{Current: input.ID == currentID}, nil
+}
@@ -0,0 +1,77 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/auth/model"
+	"github.com/mickamy/sampay/internal/domain/auth/repository"
+	"github.com/mickamy/sampay/internal/domain/auth/usecase"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+func TestRevokeSession_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		userID := ulid.New()
+		current := model.MustNewSession(userID)
+		lost := model.MustNewSession(userID)
+		repo := repository.NewSession(infra.KVS)
+		require.NoError(t, repo.Create(t.Context(), current))
+		require.NoError(t, repo.Create(t.Context(), lost))
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), userID)
+		ctx = contexts.SetAuthenticatedSessionID(ctx, current.FamilyID)
+
+		sut := usecase.NewRevokeSession(infra)
+		got, err := sut.Do(ctx, usecase.RevokeSessionInput{ID: lost.FamilyID})
+
+		require.NoError(t, err)
+		assert.False(t, got.Current)
+		exists, err := repo.RefreshTokenExists(t.Context(), userID, lost.Tokens.Refresh.Value)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		exists, err = repo.AccessTokenExists(t.Context(), userID, current.Tokens.Access.Value)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("current session", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		current := model.MustNewSession(ulid.New())
+		require.NoError(t, repository.NewSession(infra.KVS).Create(t.Context(), current))
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), current.UserID)
+		ctx = contexts.SetAuthenticatedSessionID(ctx, current.FamilyID)
+
+		sut := usecase.NewRevokeSession(infra)
+		got, err := sut.Do(ctx, usecase.RevokeSessionInput{ID: current.FamilyID})
+
+		require.NoError(t, err)
+		assert.True(t, got.Current)
+	})
+
+	t.Run("session of another user", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		others := model.MustNewSession(ulid.New())
+		require.NoError(t, repository.NewSession(infra.KVS).Create(t.Context(), others))
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), ulid.New())
+
+		sut := usecase.NewRevokeSession(infra)
+		_, err := sut.Do(ctx, usecase.RevokeSessionInput{ID: others.FamilyID})
+
+		require.ErrorIs(t, err, usecase.ErrRevokeSessionNotFound)
+		exists, err := repository.NewSession(infra.KVS).RefreshTokenExists(t.Context(), others.UserID, others.Tokens.Refresh.Value)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
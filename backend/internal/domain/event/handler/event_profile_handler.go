@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"connectrpc.com/connect"
+	"github.com/mickamy/errx"
 
 	"github.com/mickamy/sampay/config"
 	eventv1 "github.com/mickamy/sampay/gen/event/v1"
@@ -27,6 +28,9 @@ type EventProfile struct {
 	getEvent     usecase.GetEvent     `inject:""`
 	joinEvent    usecase.JoinEvent    `inject:""`
 	claimPayment usecase.ClaimPayment `inject:""`
+	changeTier   usecase.ChangeTier   `inject:""`
+	leaveEvent   usecase.LeaveEvent   `inject:""`
+	changeRounds usecase.ChangeRounds `inject:""`
 }
 
 func (h *EventProfile) GetEvent(
@@ -56,6 +60,7 @@ func (h *EventProfile) GetEvent(
 		User:           &user,
 		PaymentMethods: methods,
 		Participants:   participants,
+		Rounds:         mapper.ToV1EventRounds(out.Event),
 	}), nil
 }
 
@@ -63,9 +68,11 @@ func (h *EventProfile) JoinEvent(
 	ctx context.Context, r *connect.Request[eventv1.JoinEventRequest],
 ) (*connect.Response[eventv1.JoinEventResponse], error) {
 	out, err := h.joinEvent.Do(ctx, usecase.JoinEventInput{
-		EventID: r.Msg.GetEventId(),
-		Name:    r.Msg.GetName(),
-		Tier:    converter.Int32ToInt(r.Msg.GetTier()),
+		EventID:  r.Msg.GetEventId(),
+		Name:     r.Msg.GetName(),
+		Tier:     converter.Int32ToInt(r.Msg.GetTier()),
+		Waitlist: r.Msg.GetWaitlist(),
+		Rounds:   slicex.Map(r.Msg.GetRounds(), converter.Int32ToInt),
 	})
 	if err != nil {
 		logger.Error(ctx, "failed to execute use-case", "err", err)
@@ -74,15 +81,30 @@ func (h *EventProfile) JoinEvent(
 
 	participant := mapper.ToV1EventParticipant(out.Participant)
 	return connect.NewResponse(&eventv1.JoinEventResponse{
-		Participant: &participant,
+		Participant:      &participant,
+		ParticipantToken: out.Token,
+		Estimated:        out.Estimated,
 	}), nil
 }
 
 func (h *EventProfile) ClaimPayment(
 	ctx context.Context, r *connect.Request[eventv1.ClaimPaymentRequest],
 ) (*connect.Response[eventv1.ClaimPaymentResponse], error) {
+	var paymentMethod string
+	if t := r.Msg.GetPaymentMethodType(); t != userv1.PaymentMethodType_PAYMENT_METHOD_TYPE_UNSPECIFIED {
+		var err error
+		paymentMethod, err = converter.ToPaymentMethodType(t)
+		if err != nil {
+			return nil, errx.Wrap(err, "message", "invalid payment method type").
+				WithCode(errx.InvalidArgument).
+				WithFieldViolation("payment_method_type", err.Error())
+		}
+	}
+
 	out, err := h.claimPayment.Do(ctx, usecase.ClaimPaymentInput{
 		ParticipantID: r.Msg.GetParticipantId(),
+		Token:         r.Msg.GetParticipantToken(),
+		PaymentMethod: paymentMethod,
 	})
 	if err != nil {
 		logger.Error(ctx, "failed to execute use-case", "err", err)
@@ -94,3 +116,55 @@ func (h *EventProfile) ClaimPayment(
 		Participant: &participant,
 	}), nil
 }
+
+func (h *EventProfile) ChangeTier(
+	ctx context.Context, r *connect.Request[eventv1.ChangeTierRequest],
+) (*connect.Response[eventv1.ChangeTierResponse], error) {
+	out, err := h.changeTier.Do(ctx, usecase.ChangeTierInput{
+		ParticipantID: r.Msg.GetParticipantId(),
+		Token:         r.Msg.GetParticipantToken(),
+		Tier:          converter.Int32ToInt(r.Msg.GetTier()),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	participant := mapper.ToV1EventParticipant(out.Participant)
+	return connect.NewResponse(&eventv1.ChangeTierResponse{
+		Participant: &participant,
+	}), nil
+}
+
+func (h *EventProfile) LeaveEvent(
+	ctx context.Context, r *connect.Request[eventv1.LeaveEventRequest],
+) (*connect.Response[eventv1.LeaveEventResponse], error) {
+	if _, err := h.leaveEvent.Do(ctx, usecase.LeaveEventInput{
+		ParticipantID: r.Msg.GetParticipantId(),
+		Token:         r.Msg.GetParticipantToken(),
+	}); err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&eventv1.LeaveEventResponse{}), nil
+}
+
+func (h *EventProfile) ChangeRounds(
+	ctx context.Context, r *connect.Request[eventv1.ChangeRoundsRequest],
+) (*connect.Response[eventv1.ChangeRoundsResponse], error) {
+	out, err := h.changeRounds.Do(ctx, usecase.ChangeRoundsInput{
+		ParticipantID: r.Msg.GetParticipantId(),
+		Token:         r.Msg.GetParticipantToken(),
+		Rounds:        slicex.Map(r.Msg.GetRounds(), converter.Int32ToInt),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	participant := mapper.ToV1EventParticipant(out.Participant)
+	return connect.NewResponse(&eventv1.ChangeRoundsResponse{
+		Participant: &participant,
+	}), nil
+}
@@ -19,6 +19,7 @@ import (
 	ufixture "github.com/mickamy/sampay/internal/domain/user/fixture"
 	umodel "github.com/mickamy/sampay/internal/domain/user/model"
 	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/lib/ulid"
 	"github.com/mickamy/sampay/internal/misc/i18n"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 	"github.com/mickamy/sampay/internal/test/ctest"
@@ -72,6 +73,60 @@ func TestEventProfile_GetEvent(t *testing.T) {
 		assert.Equal(t, int32(5000), out.GetParticipants()[0].GetAmount())
 	})
 
+	t.Run("breaks down amounts by round", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = endUser.UserID; m.TotalAmount = 6000 })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) {
+			m.EventID = ev.ID
+			m.Count = 3
+			m.Amount = 2000
+			m.Name = "regular"
+		})
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+		home := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID; m.Amount = 2000 })
+		onward := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID; m.Amount = 4000 })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).
+			CreateAll(t.Context(), []*model.EventParticipant{&home, &onward}))
+		round := model.EventRound{ID: ulid.New(), EventID: ev.ID, Round: 2, Title: "karaoke", TotalAmount: 4000}
+		require.NoError(t, query.EventRounds(infra.WriterDB).Create(t.Context(), &round))
+		roundTier := model.EventRoundTier{
+			ID: ulid.New(), EventID: ev.ID, RoundID: round.ID, Tier: 1, Weight: model.WeightScale, Count: 2, Amount: 2000,
+		}
+		require.NoError(t, query.EventRoundTiers(infra.WriterDB).Create(t.Context(), &roundTier))
+		joined := model.EventRoundParticipant{ID: ulid.New(), EventID: ev.ID, RoundID: round.ID, ParticipantID: onward.ID}
+		require.NoError(t, query.EventRoundParticipants(infra.WriterDB).Create(t.Context(), &joined))
+
+		// act
+		var out eventv1.GetEventResponse
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventProfileServiceHandler)(handler.NewEventProfile(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventProfileServiceGetEventProcedure).
+			In(&eventv1.GetEventRequest{Id: ev.ID}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusOK).Out(&out)
+		require.Len(t, out.GetRounds(), 2)
+		first, second := out.GetRounds()[0], out.GetRounds()[1]
+		assert.Equal(t, int32(1), first.GetRound())
+		assert.Equal(t, ev.Title, first.GetTitle())
+		assert.Len(t, first.GetShares(), 2)
+		assert.Equal(t, int32(2), second.GetRound())
+		assert.Equal(t, "karaoke", second.GetTitle())
+		require.Len(t, second.GetTiers(), 1)
+		assert.Equal(t, "regular", second.GetTiers()[0].GetName())
+		require.Len(t, second.GetShares(), 1)
+		assert.Equal(t, onward.ID, second.GetShares()[0].GetParticipantId())
+		assert.Equal(t, int32(2000), second.GetShares()[0].GetAmount())
+	})
+
 	t.Run("returns not found for nonexistent event", func(t *testing.T) {
 		t.Parallel()
 
@@ -145,6 +200,7 @@ func TestEventProfile_JoinEvent(t *testing.T) {
 		assert.Equal(t, int32(2), out.GetParticipant().GetTier())
 		assert.Equal(t, eventv1.ParticipantStatus_PARTICIPANT_STATUS_UNPAID, out.GetParticipant().GetStatus())
 		assert.Positive(t, out.GetParticipant().GetAmount())
+		assert.NotEmpty(t, out.GetParticipantToken())
 	})
 
 	t.Run("returns error for empty name", func(t *testing.T) {
@@ -261,6 +317,8 @@ func TestEventProfile_ClaimPayment(t *testing.T) {
 			m.EventID = ev.ID
 			m.Status = model.ParticipantStatusUnpaid
 		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
 		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
 
 		// act
@@ -270,7 +328,7 @@ func TestEventProfile_ClaimPayment(t *testing.T) {
 			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
 		).
 			Procedure(eventv1connect.EventProfileServiceClaimPaymentProcedure).
-			In(&eventv1.ClaimPaymentRequest{ParticipantId: p.ID}).
+			In(&eventv1.ClaimPaymentRequest{ParticipantId: p.ID, ParticipantToken: token}).
 			Do()
 
 		// assert
@@ -313,6 +371,8 @@ func TestEventProfile_ClaimPayment(t *testing.T) {
 			m.EventID = ev.ID
 			m.Status = model.ParticipantStatusClaimed
 		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
 		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
 
 		// act
@@ -321,7 +381,7 @@ func TestEventProfile_ClaimPayment(t *testing.T) {
 			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
 		).
 			Procedure(eventv1connect.EventProfileServiceClaimPaymentProcedure).
-			In(&eventv1.ClaimPaymentRequest{ParticipantId: p.ID}).
+			In(&eventv1.ClaimPaymentRequest{ParticipantId: p.ID, ParticipantToken: token}).
 			Do()
 
 		// assert
@@ -331,4 +391,112 @@ func TestEventProfile_ClaimPayment(t *testing.T) {
 		localized := ctest.LocalizedMessage(t, connErr)
 		assert.Equal(t, i18n.Japanese(messages.EventUseCaseErrorAlreadyClaimed()), localized)
 	})
+
+	t.Run("returns permission denied without the participant's token", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = owner.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		p := fixture.EventParticipant(func(m *model.EventParticipant) {
+			m.EventID = ev.ID
+			m.Status = model.ParticipantStatusUnpaid
+		})
+		_, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventProfileServiceHandler)(handler.NewEventProfile(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventProfileServiceClaimPaymentProcedure).
+			In(&eventv1.ClaimPaymentRequest{ParticipantId: p.ID}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusForbidden)
+		connErr := ct.Err()
+		ctest.AssertCode(t, connect.CodePermissionDenied, connErr)
+		localized := ctest.LocalizedMessage(t, connErr)
+		assert.Equal(t, i18n.Japanese(messages.EventUseCaseErrorInvalidParticipantToken()), localized)
+	})
+}
+
+func TestEventProfile_ChangeTier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns permission denied without the participant's token", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		ev := fixture.Event(func(m *model.Event) {
+			m.UserID = owner.UserID
+			m.TierCount = 2
+		})
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		p := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID })
+		_, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventProfileServiceHandler)(handler.NewEventProfile(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventProfileServiceChangeTierProcedure).
+			In(&eventv1.ChangeTierRequest{ParticipantId: p.ID, Tier: 2}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusForbidden)
+		connErr := ct.Err()
+		ctest.AssertCode(t, connect.CodePermissionDenied, connErr)
+		localized := ctest.LocalizedMessage(t, connErr)
+		assert.Equal(t, i18n.Japanese(messages.EventUseCaseErrorInvalidParticipantToken()), localized)
+	})
+}
+
+func TestEventProfile_LeaveEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("leaves event successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = owner.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) {
+			m.EventID = ev.ID
+			m.Count = 2
+		})
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+		p := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID })
+		token, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventProfileServiceHandler)(handler.NewEventProfile(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventProfileServiceLeaveEventProcedure).
+			In(&eventv1.LeaveEventRequest{ParticipantId: p.ID, ParticipantToken: token}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusOK)
+		exists, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", p.ID).Exists(t.Context())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
 }
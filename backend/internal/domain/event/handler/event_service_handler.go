@@ -9,27 +9,45 @@ import (
 
 	v1 "github.com/mickamy/sampay/gen/event/v1"
 	"github.com/mickamy/sampay/gen/event/v1/eventv1connect"
+	userv1 "github.com/mickamy/sampay/gen/user/v1"
 	"github.com/mickamy/sampay/internal/di"
 	"github.com/mickamy/sampay/internal/domain/event/mapper"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/usecase"
 	"github.com/mickamy/sampay/internal/lib/converter"
 	"github.com/mickamy/sampay/internal/lib/logger"
+	"github.com/mickamy/sampay/internal/lib/money"
 	"github.com/mickamy/sampay/internal/lib/slicex"
 )
 
 var _ eventv1connect.EventServiceHandler = (*EventService)(nil)
 
 type EventService struct {
-	_                       *di.Infra                       `inject:"param"`
-	listMyEvents            usecase.ListMyEvents            `inject:""`
-	createEvent             usecase.CreateEvent             `inject:""`
-	updateEvent             usecase.UpdateEvent             `inject:""`
-	deleteEvent             usecase.DeleteEvent             `inject:""`
-	listEventParticipants   usecase.ListEventParticipants   `inject:""`
-	updateParticipantStatus usecase.UpdateParticipantStatus `inject:""`
-	archiveEvent            usecase.ArchiveEvent            `inject:""`
-	unarchiveEvent          usecase.UnarchiveEvent          `inject:""`
+	_                         *di.Infra                         `inject:"param"`
+	listMyEvents              usecase.ListMyEvents              `inject:""`
+	createEvent               usecase.CreateEvent               `inject:""`
+	updateEvent               usecase.UpdateEvent               `inject:""`
+	deleteEvent               usecase.DeleteEvent               `inject:""`
+	listEventParticipants     usecase.ListEventParticipants     `inject:""`
+	updateParticipantStatus   usecase.UpdateParticipantStatus   `inject:""`
+	recordPayment             usecase.RecordPayment             `inject:""`
+	settleDeposits            usecase.SettleDeposits            `inject:""`
+	finalizeEvent             usecase.FinalizeEvent             `inject:""`
+	listRefunds               usecase.ListRefunds               `inject:""`
+	recordRefund              usecase.RecordRefund              `inject:""`
+	setParticipantFixedAmount usecase.SetParticipantFixedAmount `inject:""`
+	setParticipantRounds      usecase.SetParticipantRounds      `inject:""`
+	setParticipantAttendance  usecase.SetParticipantAttendance  `inject:""`
+	reissueParticipantToken   usecase.ReissueParticipantToken   `inject:""`
+	addParticipant            usecase.AddParticipant            `inject:""`
+	updateParticipant         usecase.UpdateParticipant         `inject:""`
+	removeParticipant         usecase.RemoveParticipant         `inject:""`
+	mergeParticipants         usecase.MergeParticipants         `inject:""`
+	addExpense                usecase.AddExpense                `inject:""`
+	deleteExpense             usecase.DeleteExpense             `inject:""`
+	listExpenses              usecase.ListExpenses              `inject:""`
+	archiveEvent              usecase.ArchiveEvent              `inject:""`
+	unarchiveEvent            usecase.UnarchiveEvent            `inject:""`
 }
 
 func (h *EventService) ListMyEvents(
@@ -56,25 +74,58 @@ func (h *EventService) CreateEvent(
 	ctx context.Context, r *connect.Request[v1.CreateEventRequest],
 ) (*connect.Response[v1.CreateEventResponse], error) {
 	input := r.Msg.GetInput()
-	tiers := slicex.Map(input.GetTiers(), func(tc *v1.TierConfig) usecase.TierConfig {
-		return usecase.TierConfig{
-			Tier:  converter.Int32ToInt(tc.GetTier()),
-			Count: converter.Int32ToInt(tc.GetCount()),
-		}
-	})
+	tiers, err := toTierConfigs(input.GetTiers())
+	if err != nil {
+		return nil, err
+	}
+	rounds, err := toRoundConfigs(input.GetRounds())
+	if err != nil {
+		return nil, err
+	}
 
 	var heldAt time.Time
 	if ts := input.GetHeldAt(); ts != nil {
 		heldAt = ts.AsTime()
 	}
 
+	policy, err := converter.FromV1RemainderPolicy(input.GetRemainderPolicy())
+	if err != nil {
+		return nil, errx.Wrap(err, "message", "invalid remainder policy").
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation("remainder_policy", err.Error())
+	}
+	splitMode, err := converter.FromV1SplitMode(input.GetSplitMode())
+	if err != nil {
+		return nil, errx.Wrap(err, "message", "invalid split mode").
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation("split_mode", err.Error())
+	}
+
+	currency, settlement, err := toCurrency(input)
+	if err != nil {
+		return nil, err
+	}
+	bill, err := toBill(input.SubtotalAmount, input)
+	if err != nil {
+		return nil, err
+	}
+
 	out, err := h.createEvent.Do(ctx, usecase.CreateEventInput{
-		Title:       input.GetTitle(),
-		Description: input.GetDescription(),
-		TotalAmount: converter.Int32ToInt(input.GetTotalAmount()),
-		TierCount:   converter.Int32ToInt(input.GetTierCount()),
-		HeldAt:      heldAt,
-		Tiers:       tiers,
+		Title:           input.GetTitle(),
+		Description:     input.GetDescription(),
+		TotalAmount:     converter.Int32ToInt(input.GetTotalAmount()),
+		Bill:            bill,
+		TotalEstimated:  input.GetTotalEstimated(),
+		TierCount:       converter.Int32ToInt(input.GetTierCount()),
+		HeldAt:          heldAt,
+		Tiers:           tiers,
+		RemainderPolicy: policy,
+		SplitMode:       splitMode,
+		EndsAt:          converter.TimestamppbToPtrTime(input.GetEndsAt()),
+		DepositAmount:   converter.PtrInt32ToPtrInt(input.DepositAmount),
+		Currency:        currency,
+		Settlement:      settlement,
+		Rounds:          rounds,
 	})
 	if err != nil {
 		logger.Error(ctx, "failed to execute use-case", "err", err)
@@ -91,26 +142,59 @@ func (h *EventService) UpdateEvent(
 	ctx context.Context, r *connect.Request[v1.UpdateEventRequest],
 ) (*connect.Response[v1.UpdateEventResponse], error) {
 	input := r.Msg.GetInput()
-	tiers := slicex.Map(input.GetTiers(), func(tc *v1.TierConfig) usecase.TierConfig {
-		return usecase.TierConfig{
-			Tier:  converter.Int32ToInt(tc.GetTier()),
-			Count: converter.Int32ToInt(tc.GetCount()),
-		}
-	})
+	tiers, err := toTierConfigs(input.GetTiers())
+	if err != nil {
+		return nil, err
+	}
+	rounds, err := toRoundConfigs(input.GetRounds())
+	if err != nil {
+		return nil, err
+	}
 
 	var updateHeldAt time.Time
 	if ts := input.GetHeldAt(); ts != nil {
 		updateHeldAt = ts.AsTime()
 	}
 
+	policy, err := converter.FromV1RemainderPolicy(input.GetRemainderPolicy())
+	if err != nil {
+		return nil, errx.Wrap(err, "message", "invalid remainder policy").
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation("remainder_policy", err.Error())
+	}
+	splitMode, err := converter.FromV1SplitMode(input.GetSplitMode())
+	if err != nil {
+		return nil, errx.Wrap(err, "message", "invalid split mode").
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation("split_mode", err.Error())
+	}
+
+	currency, settlement, err := toCurrency(input)
+	if err != nil {
+		return nil, err
+	}
+	bill, err := toBill(input.SubtotalAmount, input)
+	if err != nil {
+		return nil, err
+	}
+
 	out, err := h.updateEvent.Do(ctx, usecase.UpdateEventInput{
-		ID:          r.Msg.GetId(),
-		Title:       input.GetTitle(),
-		Description: input.GetDescription(),
-		TotalAmount: converter.Int32ToInt(input.GetTotalAmount()),
-		TierCount:   converter.Int32ToInt(input.GetTierCount()),
-		HeldAt:      updateHeldAt,
-		Tiers:       tiers,
+		ID:              r.Msg.GetId(),
+		Title:           input.GetTitle(),
+		Description:     input.GetDescription(),
+		TotalAmount:     converter.Int32ToInt(input.GetTotalAmount()),
+		Bill:            bill,
+		TierCount:       converter.Int32ToInt(input.GetTierCount()),
+		HeldAt:          updateHeldAt,
+		Tiers:           tiers,
+		RemainderPolicy: policy,
+		SplitMode:       splitMode,
+		EndsAt:          converter.TimestamppbToPtrTime(input.GetEndsAt()),
+		DepositAmount:   converter.PtrInt32ToPtrInt(input.DepositAmount),
+		Currency:        currency,
+		Settlement:      settlement,
+		Rounds:          rounds,
+		AdjustmentNote:  r.Msg.GetAdjustmentNote(),
 	})
 	if err != nil {
 		logger.Error(ctx, "failed to execute use-case", "err", err)
@@ -119,7 +203,8 @@ func (h *EventService) UpdateEvent(
 
 	ev := mapper.ToV1Event(out.Event)
 	return connect.NewResponse(&v1.UpdateEventResponse{
-		Event: &ev,
+		Event:       &ev,
+		Adjustments: slicex.Map(out.Adjustments, mapper.ToV1ParticipantAdjustment),
 	}), nil
 }
 
@@ -153,7 +238,10 @@ func (h *EventService) ListEventParticipants(
 		return &ep
 	})
 	return connect.NewResponse(&v1.ListEventParticipantsResponse{
-		Participants: participants,
+		Participants:  participants,
+		StatusChanges: slicex.Map(out.StatusChanges, mapper.ToV1ParticipantStatusChange),
+		Payments:      slicex.Map(out.Payments, mapper.ToV1ParticipantPayment),
+		Adjustments:   slicex.Map(out.Adjustments, mapper.ToV1ParticipantAdjustment),
 	}), nil
 }
 
@@ -171,6 +259,7 @@ func (h *EventService) UpdateParticipantStatus(
 		EventID:       r.Msg.GetEventId(),
 		ParticipantID: r.Msg.GetParticipantId(),
 		Status:        status,
+		Reason:        r.Msg.GetReason(),
 	})
 	if err != nil {
 		logger.Error(ctx, "failed to execute use-case", "err", err)
@@ -183,6 +272,402 @@ func (h *EventService) UpdateParticipantStatus(
 	}), nil
 }
 
+func (h *EventService) RecordPayment(
+	ctx context.Context, r *connect.Request[v1.RecordPaymentRequest],
+) (*connect.Response[v1.RecordPaymentResponse], error) {
+	var paymentMethod string
+	if t := r.Msg.GetPaymentMethodType(); t != userv1.PaymentMethodType_PAYMENT_METHOD_TYPE_UNSPECIFIED {
+		var err error
+		paymentMethod, err = converter.ToPaymentMethodType(t)
+		if err != nil {
+			return nil, errx.Wrap(err, "message", "invalid payment method type").
+				WithCode(errx.InvalidArgument).
+				WithFieldViolation("payment_method_type", err.Error())
+		}
+	}
+	var paidAt *time.Time
+	if ts := r.Msg.GetPaidAt(); ts != nil {
+		t := ts.AsTime()
+		paidAt = &t
+	}
+
+	out, err := h.recordPayment.Do(ctx, usecase.RecordPaymentInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+		Amount:        converter.Int32ToInt(r.Msg.GetAmount()),
+		PaymentMethod: paymentMethod,
+		PaidAt:        paidAt,
+		Note:          r.Msg.GetNote(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	participant := mapper.ToV1EventParticipant(out.Participant)
+	return connect.NewResponse(&v1.RecordPaymentResponse{
+		Participant: &participant,
+		Payment:     mapper.ToV1ParticipantPayment(out.Payment),
+	}), nil
+}
+
+func (h *EventService) SettleDeposits(
+	ctx context.Context, r *connect.Request[v1.SettleDepositsRequest],
+) (*connect.Response[v1.SettleDepositsResponse], error) {
+	out, err := h.settleDeposits.Do(ctx, usecase.SettleDepositsInput{
+		EventID:        r.Msg.GetEventId(),
+		TotalAmount:    converter.Int32ToInt(r.Msg.GetTotalAmount()),
+		AdjustmentNote: r.Msg.GetAdjustmentNote(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.SettleDepositsResponse{
+		Event:        &ev,
+		Participants: participants,
+		Adjustments:  slicex.Map(out.Adjustments, mapper.ToV1ParticipantAdjustment),
+	}), nil
+}
+
+func (h *EventService) FinalizeEvent(
+	ctx context.Context, r *connect.Request[v1.FinalizeEventRequest],
+) (*connect.Response[v1.FinalizeEventResponse], error) {
+	bill, err := toBill(r.Msg.SubtotalAmount, r.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.finalizeEvent.Do(ctx, usecase.FinalizeEventInput{
+		EventID:        r.Msg.GetEventId(),
+		TotalAmount:    converter.Int32ToInt(r.Msg.GetTotalAmount()),
+		Bill:           bill,
+		AdjustmentNote: r.Msg.GetAdjustmentNote(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.FinalizeEventResponse{
+		Event:        &ev,
+		Participants: participants,
+		Adjustments:  slicex.Map(out.Adjustments, mapper.ToV1ParticipantAdjustment),
+	}), nil
+}
+
+func (h *EventService) ListRefunds(
+	ctx context.Context, _ *connect.Request[v1.ListRefundsRequest],
+) (*connect.Response[v1.ListRefundsResponse], error) {
+	out, err := h.listRefunds.Do(ctx, usecase.ListRefundsInput{})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&v1.ListRefundsResponse{
+		Refunds: slicex.Map(out.Refunds, mapper.ToV1Refund),
+	}), nil
+}
+
+func (h *EventService) RecordRefund(
+	ctx context.Context, r *connect.Request[v1.RecordRefundRequest],
+) (*connect.Response[v1.RecordRefundResponse], error) {
+	out, err := h.recordRefund.Do(ctx, usecase.RecordRefundInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+		Amount:        converter.Int32ToInt(r.Msg.GetAmount()),
+		RefundedAt:    converter.TimestamppbToPtrTime(r.Msg.GetRefundedAt()),
+		Note:          r.Msg.GetNote(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	participant := mapper.ToV1EventParticipant(out.Participant)
+	return connect.NewResponse(&v1.RecordRefundResponse{
+		Participant: &participant,
+		Payment:     mapper.ToV1ParticipantPayment(out.Payment),
+	}), nil
+}
+
+func (h *EventService) SetParticipantFixedAmount(
+	ctx context.Context, r *connect.Request[v1.SetParticipantFixedAmountRequest],
+) (*connect.Response[v1.SetParticipantFixedAmountResponse], error) {
+	var fixedAmount *int
+	if r.Msg.FixedAmount != nil {
+		amount := converter.Int32ToInt(r.Msg.GetFixedAmount())
+		fixedAmount = &amount
+	}
+
+	out, err := h.setParticipantFixedAmount.Do(ctx, usecase.SetParticipantFixedAmountInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+		FixedAmount:   fixedAmount,
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.SetParticipantFixedAmountResponse{
+		Event:        &ev,
+		Participants: participants,
+	}), nil
+}
+
+func (h *EventService) SetParticipantRounds(
+	ctx context.Context, r *connect.Request[v1.SetParticipantRoundsRequest],
+) (*connect.Response[v1.SetParticipantRoundsResponse], error) {
+	out, err := h.setParticipantRounds.Do(ctx, usecase.SetParticipantRoundsInput{
+		EventID:        r.Msg.GetEventId(),
+		ParticipantID:  r.Msg.GetParticipantId(),
+		Rounds:         slicex.Map(r.Msg.GetRounds(), converter.Int32ToInt),
+		AdjustmentNote: r.Msg.GetAdjustmentNote(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.SetParticipantRoundsResponse{
+		Event:        &ev,
+		Participants: participants,
+		Adjustments:  slicex.Map(out.Adjustments, mapper.ToV1ParticipantAdjustment),
+	}), nil
+}
+
+func (h *EventService) SetParticipantAttendance(
+	ctx context.Context, r *connect.Request[v1.SetParticipantAttendanceRequest],
+) (*connect.Response[v1.SetParticipantAttendanceResponse], error) {
+	var attendance *model.Attendance
+	if a := r.Msg.GetAttendance(); a != "" {
+		parsed, err := model.ParseAttendance(a)
+		if err != nil {
+			return nil, errx.Wrap(err, "message", "invalid attendance").
+				WithCode(errx.InvalidArgument).
+				WithFieldViolation("attendance", err.Error())
+		}
+		attendance = &parsed
+	}
+
+	out, err := h.setParticipantAttendance.Do(ctx, usecase.SetParticipantAttendanceInput{
+		EventID:        r.Msg.GetEventId(),
+		ParticipantID:  r.Msg.GetParticipantId(),
+		ArrivedAt:      converter.TimestamppbToPtrTime(r.Msg.GetArrivedAt()),
+		LeftAt:         converter.TimestamppbToPtrTime(r.Msg.GetLeftAt()),
+		Attendance:     attendance,
+		AdjustmentNote: r.Msg.GetAdjustmentNote(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.SetParticipantAttendanceResponse{
+		Event:        &ev,
+		Participants: participants,
+		Adjustments:  slicex.Map(out.Adjustments, mapper.ToV1ParticipantAdjustment),
+	}), nil
+}
+
+func (h *EventService) ReissueParticipantToken(
+	ctx context.Context, r *connect.Request[v1.ReissueParticipantTokenRequest],
+) (*connect.Response[v1.ReissueParticipantTokenResponse], error) {
+	out, err := h.reissueParticipantToken.Do(ctx, usecase.ReissueParticipantTokenInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	participant := mapper.ToV1EventParticipant(out.Participant)
+	return connect.NewResponse(&v1.ReissueParticipantTokenResponse{
+		Participant:      &participant,
+		ParticipantToken: out.Token,
+	}), nil
+}
+
+func (h *EventService) AddParticipant(
+	ctx context.Context, r *connect.Request[v1.AddParticipantRequest],
+) (*connect.Response[v1.AddParticipantResponse], error) {
+	out, err := h.addParticipant.Do(ctx, usecase.AddParticipantInput{
+		EventID: r.Msg.GetEventId(),
+		Name:    r.Msg.GetName(),
+		Tier:    converter.Int32ToInt(r.Msg.GetTier()),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participant := mapper.ToV1EventParticipant(out.Participant)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.AddParticipantResponse{
+		Event:            &ev,
+		Participant:      &participant,
+		ParticipantToken: out.Token,
+		Participants:     participants,
+	}), nil
+}
+
+func (h *EventService) UpdateParticipant(
+	ctx context.Context, r *connect.Request[v1.UpdateParticipantRequest],
+) (*connect.Response[v1.UpdateParticipantResponse], error) {
+	out, err := h.updateParticipant.Do(ctx, usecase.UpdateParticipantInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+		Name:          r.Msg.GetName(),
+		Tier:          converter.Int32ToInt(r.Msg.GetTier()),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.UpdateParticipantResponse{
+		Event:        &ev,
+		Participants: participants,
+	}), nil
+}
+
+func (h *EventService) RemoveParticipant(
+	ctx context.Context, r *connect.Request[v1.RemoveParticipantRequest],
+) (*connect.Response[v1.RemoveParticipantResponse], error) {
+	out, err := h.removeParticipant.Do(ctx, usecase.RemoveParticipantInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.RemoveParticipantResponse{
+		Event:        &ev,
+		Participants: participants,
+	}), nil
+}
+
+func (h *EventService) MergeParticipants(
+	ctx context.Context, r *connect.Request[v1.MergeParticipantsRequest],
+) (*connect.Response[v1.MergeParticipantsResponse], error) {
+	out, err := h.mergeParticipants.Do(ctx, usecase.MergeParticipantsInput{
+		EventID:       r.Msg.GetEventId(),
+		ParticipantID: r.Msg.GetParticipantId(),
+		DuplicateID:   r.Msg.GetDuplicateId(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	ev := mapper.ToV1Event(out.Event)
+	participants := slicex.Map(out.Event.Participants, func(p model.EventParticipant) *v1.EventParticipant {
+		ep := mapper.ToV1EventParticipant(p)
+		return &ep
+	})
+	return connect.NewResponse(&v1.MergeParticipantsResponse{
+		Event:        &ev,
+		Participants: participants,
+	}), nil
+}
+
+func (h *EventService) AddExpense(
+	ctx context.Context, r *connect.Request[v1.AddExpenseRequest],
+) (*connect.Response[v1.AddExpenseResponse], error) {
+	out, err := h.addExpense.Do(ctx, usecase.AddExpenseInput{
+		EventID:        r.Msg.GetEventId(),
+		PayerID:        r.Msg.GetPayerId(),
+		Title:          r.Msg.GetTitle(),
+		Amount:         converter.Int32ToInt(r.Msg.GetAmount()),
+		ParticipantIDs: r.Msg.GetParticipantIds(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&v1.AddExpenseResponse{
+		Expense: mapper.ToV1Expense(out.Expense),
+	}), nil
+}
+
+func (h *EventService) DeleteExpense(
+	ctx context.Context, r *connect.Request[v1.DeleteExpenseRequest],
+) (*connect.Response[v1.DeleteExpenseResponse], error) {
+	if _, err := h.deleteExpense.Do(ctx, usecase.DeleteExpenseInput{
+		EventID:   r.Msg.GetEventId(),
+		ExpenseID: r.Msg.GetExpenseId(),
+	}); err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&v1.DeleteExpenseResponse{}), nil
+}
+
+func (h *EventService) ListExpenses(
+	ctx context.Context, r *connect.Request[v1.ListExpensesRequest],
+) (*connect.Response[v1.ListExpensesResponse], error) {
+	out, err := h.listExpenses.Do(ctx, usecase.ListExpensesInput{
+		EventID: r.Msg.GetEventId(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&v1.ListExpensesResponse{
+		Expenses:  slicex.Map(out.Expenses, mapper.ToV1Expense),
+		Balances:  slicex.Map(out.Balances, mapper.ToV1Balance),
+		Transfers: slicex.Map(out.Transfers, mapper.ToV1Transfer),
+	}), nil
+}
+
 func (h *EventService) ArchiveEvent(
 	ctx context.Context, r *connect.Request[v1.ArchiveEventRequest],
 ) (*connect.Response[v1.ArchiveEventResponse], error) {
@@ -216,3 +701,133 @@ func (h *EventService) UnarchiveEvent(
 		Event: &ev,
 	}), nil
 }
+
+func toTierConfigs(src []*v1.TierConfig) ([]usecase.TierConfig, error) {
+	tiers := make([]usecase.TierConfig, len(src))
+	for i, tc := range src {
+		var weight model.Weight
+		if w := tc.GetWeight(); w != "" {
+			parsed, err := model.ParseWeight(w)
+			if err != nil {
+				return nil, errx.Wrap(err, "message", "invalid tier weight", "tier", tc.GetTier()).
+					WithCode(errx.InvalidArgument).
+					WithFieldViolation("tiers", err.Error())
+			}
+			weight = parsed
+		}
+		tiers[i] = usecase.TierConfig{
+			Tier:   converter.Int32ToInt(tc.GetTier()),
+			Name:   tc.GetName(),
+			Weight: weight,
+			Count:  converter.Int32ToInt(tc.GetCount()),
+		}
+	}
+	return tiers, nil
+}
+
+func toRoundConfigs(src []*v1.RoundConfig) ([]usecase.RoundConfig, error) {
+	rounds := make([]usecase.RoundConfig, len(src))
+	for i, rc := range src {
+		tiers, err := toTierConfigs(rc.GetTiers())
+		if err != nil {
+			return nil, err
+		}
+		rounds[i] = usecase.RoundConfig{
+			Round:       converter.Int32ToInt(rc.GetRound()),
+			Title:       rc.GetTitle(),
+			TotalAmount: converter.Int32ToInt(rc.GetTotalAmount()),
+			Tiers:       tiers,
+		}
+	}
+	return rounds, nil
+}
+
+// billInput is a message itemizing a bill, EventInput or FinalizeEventRequest.
+type billInput interface {
+	GetTaxRate() string
+	GetTaxIncluded() bool
+	GetServiceChargeRate() string
+	GetTipRate() string
+}
+
+// toBill reads the itemized bill of the input, nil when its subtotal is unset and the total amount was
+// entered as is.
+func toBill(subtotal *int32, input billInput) (*model.Bill, error) {
+	if subtotal == nil {
+		return nil, nil //nolint:nilnil // no bill is not an error
+	}
+
+	taxRate, err := toPercent("tax_rate", input.GetTaxRate())
+	if err != nil {
+		return nil, err
+	}
+	serviceChargeRate, err := toPercent("service_charge_rate", input.GetServiceChargeRate())
+	if err != nil {
+		return nil, err
+	}
+	tipRate, err := toPercent("tip_rate", input.GetTipRate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Bill{
+		Subtotal:          converter.Int32ToInt(*subtotal),
+		TaxRate:           taxRate,
+		TaxIncluded:       input.GetTaxIncluded(),
+		ServiceChargeRate: serviceChargeRate,
+		TipRate:           tipRate,
+	}, nil
+}
+
+// toPercent parses a rate of the bill, zero when empty.
+func toPercent(field, s string) (model.Percent, error) {
+	if s == "" {
+		return 0, nil
+	}
+	p, err := model.ParsePercent(s)
+	if err != nil {
+		return 0, errx.Wrap(err, "message", "invalid rate", "field", field).
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation(field, err.Error())
+	}
+	return p, nil
+}
+
+func toCurrency(input *v1.EventInput) (money.Currency, *usecase.Settlement, error) {
+	var currency money.Currency
+	if c := input.GetCurrency(); c != "" {
+		parsed, err := money.ParseCurrency(c)
+		if err != nil {
+			return "", nil, errx.Wrap(err, "message", "invalid currency").
+				WithCode(errx.InvalidArgument).
+				WithFieldViolation("currency", err.Error())
+		}
+		currency = parsed
+	}
+
+	if input.GetSettlementCurrency() == "" && input.GetExchangeRate() == "" {
+		return currency, nil, nil
+	}
+
+	settlementCurrency, err := money.ParseCurrency(input.GetSettlementCurrency())
+	if err != nil {
+		return "", nil, errx.Wrap(err, "message", "invalid settlement currency").
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation("settlement_currency", err.Error())
+	}
+	rate, err := money.ParseRate(input.GetExchangeRate())
+	if err != nil {
+		return "", nil, errx.Wrap(err, "message", "invalid exchange rate").
+			WithCode(errx.InvalidArgument).
+			WithFieldViolation("exchange_rate", err.Error())
+	}
+
+	settlement := &usecase.Settlement{
+		Currency: settlementCurrency,
+		Rate:     rate,
+	}
+	if ts := input.GetExchangeRateAt(); ts != nil {
+		settlement.RateAt = ts.AsTime()
+	}
+	return currency, settlement, nil
+}
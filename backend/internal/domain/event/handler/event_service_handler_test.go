@@ -13,11 +13,13 @@ import (
 
 	eventv1 "github.com/mickamy/sampay/gen/event/v1"
 	"github.com/mickamy/sampay/gen/event/v1/eventv1connect"
+	userv1 "github.com/mickamy/sampay/gen/user/v1"
 	"github.com/mickamy/sampay/internal/api/interceptor"
 	"github.com/mickamy/sampay/internal/domain/event/fixture"
 	"github.com/mickamy/sampay/internal/domain/event/handler"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
 	"github.com/mickamy/sampay/internal/misc/i18n"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 	"github.com/mickamy/sampay/internal/test/ctest"
@@ -163,7 +165,7 @@ func TestEventService_CreateEvent(t *testing.T) {
 				Input: &eventv1.EventInput{
 					Title:       "bounenkai",
 					TotalAmount: 30000,
-					TierCount:   2,
+					TierCount:   11,
 					HeldAt:      timestamppb.New(time.Now().Add(24 * time.Hour)),
 					Tiers:       []*eventv1.TierConfig{{Tier: 1, Count: 3}, {Tier: 2, Count: 2}},
 				},
@@ -531,6 +533,40 @@ func TestEventService_UpdateParticipantStatus(t *testing.T) {
 		// assert
 		ct.ExpectStatus(http.StatusOK).Out(&out)
 		assert.Equal(t, eventv1.ParticipantStatus_PARTICIPANT_STATUS_CONFIRMED, out.GetParticipant().GetStatus())
+		assert.NotNil(t, out.GetParticipant().GetConfirmedAt())
+	})
+
+	t.Run("returns error when reverting a confirmed payment", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		userID, authHeader := ctest.UserSession(t, infra)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = userID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		p := fixture.EventParticipant(func(m *model.EventParticipant) {
+			m.EventID = ev.ID
+			m.Status = model.ParticipantStatusConfirmed
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventServiceHandler)(handler.NewEventService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventServiceUpdateParticipantStatusProcedure).
+			Header("Authorization", authHeader).
+			In(&eventv1.UpdateParticipantStatusRequest{
+				EventId:       ev.ID,
+				ParticipantId: p.ID,
+				Status:        eventv1.ParticipantStatus_PARTICIPANT_STATUS_UNPAID,
+				Reason:        "mistake",
+			}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusBadRequest)
 	})
 
 	t.Run("returns not found for nonexistent participant", func(t *testing.T) {
@@ -858,3 +894,149 @@ func TestEventService_UnarchiveEvent(t *testing.T) {
 		assert.Equal(t, i18n.Japanese(messages.EventUseCaseErrorForbidden()), localized)
 	})
 }
+
+func TestEventService_AddParticipant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds participant successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		userID, authHeader := ctest.UserSession(t, infra)
+		ev := fixture.Event(func(m *model.Event) {
+			m.UserID = userID
+			m.TotalAmount = 9000
+		})
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) {
+			m.EventID = ev.ID
+			m.Count = 3
+			m.Amount = 3000
+		})
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+
+		// act
+		var out eventv1.AddParticipantResponse
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventServiceHandler)(handler.NewEventService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventServiceAddParticipantProcedure).
+			Header("Authorization", authHeader).
+			In(&eventv1.AddParticipantRequest{EventId: ev.ID, Name: "Guest", Tier: 1}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusOK).Out(&out)
+		assert.Equal(t, "Guest", out.GetParticipant().GetName())
+		assert.Equal(t, int32(3000), out.GetParticipant().GetAmount())
+		assert.NotEmpty(t, out.GetParticipantToken())
+		assert.Len(t, out.GetParticipants(), 1)
+	})
+}
+
+func TestEventService_RemoveParticipant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error when participant has expenses", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		userID, authHeader := ctest.UserSession(t, infra)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = userID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		participant := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &participant))
+		expense := model.NewEventExpense(ev.ID, participant.ID, "taxi", 1000, []string{participant.ID})
+		require.NoError(t, repository.NewEventExpense(infra.WriterDB.DB).Create(t.Context(), &expense))
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventServiceHandler)(handler.NewEventService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventServiceRemoveParticipantProcedure).
+			Header("Authorization", authHeader).
+			In(&eventv1.RemoveParticipantRequest{EventId: ev.ID, ParticipantId: participant.ID}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusBadRequest)
+		connErr := ct.Err()
+		ctest.AssertCode(t, connect.CodeFailedPrecondition, connErr)
+		localized := ctest.LocalizedMessage(t, connErr)
+		assert.Equal(t, i18n.Japanese(messages.EventUseCaseErrorParticipantHasExpenses()), localized)
+	})
+}
+
+func TestEventService_RecordPayment(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records a cash payment", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		userID, authHeader := ctest.UserSession(t, infra)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = userID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		participant := fixture.EventParticipant(func(m *model.EventParticipant) {
+			m.EventID = ev.ID
+			m.Amount = 5000
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &participant))
+
+		// act
+		var out eventv1.RecordPaymentResponse
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventServiceHandler)(handler.NewEventService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventServiceRecordPaymentProcedure).
+			Header("Authorization", authHeader).
+			In(&eventv1.RecordPaymentRequest{EventId: ev.ID, ParticipantId: participant.ID, Amount: 3000}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusOK).Out(&out)
+		assert.Equal(t, eventv1.ParticipantStatus_PARTICIPANT_STATUS_PARTIALLY_PAID, out.GetParticipant().GetStatus())
+		assert.Equal(t, int32(3000), out.GetParticipant().GetPaidAmount())
+		assert.Equal(t, int32(3000), out.GetPayment().GetAmount())
+	})
+
+	t.Run("returns error for a payment method the organizer does not accept", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		userID, authHeader := ctest.UserSession(t, infra)
+		ev := fixture.Event(func(m *model.Event) { m.UserID = userID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		participant := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &participant))
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(eventv1connect.NewEventServiceHandler)(handler.NewEventService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(eventv1connect.EventServiceRecordPaymentProcedure).
+			Header("Authorization", authHeader).
+			In(&eventv1.RecordPaymentRequest{
+				EventId:           ev.ID,
+				ParticipantId:     participant.ID,
+				Amount:            1000,
+				PaymentMethodType: userv1.PaymentMethodType_PAYMENT_METHOD_TYPE_KYASH,
+			}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusBadRequest)
+		connErr := ct.Err()
+		ctest.AssertCode(t, connect.CodeInvalidArgument, connErr)
+		localized := ctest.LocalizedMessage(t, connErr)
+		assert.Equal(t, i18n.Japanese(messages.EventUseCaseErrorPaymentMethodNotAccepted()), localized)
+	})
+}
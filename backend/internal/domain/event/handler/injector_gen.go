@@ -12,11 +12,17 @@ func NewEventProfile(infra *di.Infra) *EventProfile {
 	getEvent := usecase.NewGetEvent(infra)
 	joinEvent := usecase.NewJoinEvent(infra)
 	claimPayment := usecase.NewClaimPayment(infra)
+	changeTier := usecase.NewChangeTier(infra)
+	leaveEvent := usecase.NewLeaveEvent(infra)
+	changeRounds := usecase.NewChangeRounds(infra)
 
 	return &EventProfile{
 		getEvent:     getEvent,
 		joinEvent:    joinEvent,
 		claimPayment: claimPayment,
+		changeTier:   changeTier,
+		leaveEvent:   leaveEvent,
+		changeRounds: changeRounds,
 	}
 }
 
@@ -25,11 +31,17 @@ func MustNewEventProfile(infra *di.Infra) *EventProfile {
 	getEvent := usecase.NewGetEvent(infra)
 	joinEvent := usecase.NewJoinEvent(infra)
 	claimPayment := usecase.NewClaimPayment(infra)
+	changeTier := usecase.NewChangeTier(infra)
+	leaveEvent := usecase.NewLeaveEvent(infra)
+	changeRounds := usecase.NewChangeRounds(infra)
 
 	return &EventProfile{
 		getEvent:     getEvent,
 		joinEvent:    joinEvent,
 		claimPayment: claimPayment,
+		changeTier:   changeTier,
+		leaveEvent:   leaveEvent,
+		changeRounds: changeRounds,
 	}
 }
 
@@ -41,18 +53,50 @@ func NewEventService(infra *di.Infra) *EventService {
 	deleteEvent := usecase.NewDeleteEvent(infra)
 	listEventParticipants := usecase.NewListEventParticipants(infra)
 	updateParticipantStatus := usecase.NewUpdateParticipantStatus(infra)
+	recordPayment := usecase.NewRecordPayment(infra)
+	settleDeposits := usecase.NewSettleDeposits(infra)
+	finalizeEvent := usecase.NewFinalizeEvent(infra)
+	listRefunds := usecase.NewListRefunds(infra)
+	recordRefund := usecase.NewRecordRefund(infra)
+	setParticipantFixedAmount := usecase.NewSetParticipantFixedAmount(infra)
+	setParticipantRounds := usecase.NewSetParticipantRounds(infra)
+	setParticipantAttendance := usecase.NewSetParticipantAttendance(infra)
+	reissueParticipantToken := usecase.NewReissueParticipantToken(infra)
+	addParticipant := usecase.NewAddParticipant(infra)
+	updateParticipant := usecase.NewUpdateParticipant(infra)
+	removeParticipant := usecase.NewRemoveParticipant(infra)
+	mergeParticipants := usecase.NewMergeParticipants(infra)
+	addExpense := usecase.NewAddExpense(infra)
+	deleteExpense := usecase.NewDeleteExpense(infra)
+	listExpenses := usecase.NewListExpenses(infra)
 	archiveEvent := usecase.NewArchiveEvent(infra)
 	unarchiveEvent := usecase.NewUnarchiveEvent(infra)
 
 	return &EventService{
-		listMyEvents:            listMyEvents,
-		createEvent:             createEvent,
-		updateEvent:             updateEvent,
-		deleteEvent:             deleteEvent,
-		listEventParticipants:   listEventParticipants,
-		updateParticipantStatus: updateParticipantStatus,
-		archiveEvent:            archiveEvent,
-		unarchiveEvent:          unarchiveEvent,
+		listMyEvents:              listMyEvents,
+		createEvent:               createEvent,
+		updateEvent:               updateEvent,
+		deleteEvent:               deleteEvent,
+		listEventParticipants:     listEventParticipants,
+		updateParticipantStatus:   updateParticipantStatus,
+		recordPayment:             recordPayment,
+		settleDeposits:            settleDeposits,
+		finalizeEvent:             finalizeEvent,
+		listRefunds:               listRefunds,
+		recordRefund:              recordRefund,
+		setParticipantFixedAmount: setParticipantFixedAmount,
+		setParticipantRounds:      setParticipantRounds,
+		setParticipantAttendance:  setParticipantAttendance,
+		reissueParticipantToken:   reissueParticipantToken,
+		addParticipant:            addParticipant,
+		updateParticipant:         updateParticipant,
+		removeParticipant:         removeParticipant,
+		mergeParticipants:         mergeParticipants,
+		addExpense:                addExpense,
+		deleteExpense:             deleteExpense,
+		listExpenses:              listExpenses,
+		archiveEvent:              archiveEvent,
+		unarchiveEvent:            unarchiveEvent,
 	}
 }
 
@@ -64,17 +108,49 @@ func MustNewEventService(infra *di.Infra) *EventService {
 	deleteEvent := usecase.NewDeleteEvent(infra)
 	listEventParticipants := usecase.NewListEventParticipants(infra)
 	updateParticipantStatus := usecase.NewUpdateParticipantStatus(infra)
+	recordPayment := usecase.NewRecordPayment(infra)
+	settleDeposits := usecase.NewSettleDeposits(infra)
+	finalizeEvent := usecase.NewFinalizeEvent(infra)
+	listRefunds := usecase.NewListRefunds(infra)
+	recordRefund := usecase.NewRecordRefund(infra)
+	setParticipantFixedAmount := usecase.NewSetParticipantFixedAmount(infra)
+	setParticipantRounds := usecase.NewSetParticipantRounds(infra)
+	setParticipantAttendance := usecase.NewSetParticipantAttendance(infra)
+	reissueParticipantToken := usecase.NewReissueParticipantToken(infra)
+	addParticipant := usecase.NewAddParticipant(infra)
+	updateParticipant := usecase.NewUpdateParticipant(infra)
+	removeParticipant := usecase.NewRemoveParticipant(infra)
+	mergeParticipants := usecase.NewMergeParticipants(infra)
+	addExpense := usecase.NewAddExpense(infra)
+	deleteExpense := usecase.NewDeleteExpense(infra)
+	listExpenses := usecase.NewListExpenses(infra)
 	archiveEvent := usecase.NewArchiveEvent(infra)
 	unarchiveEvent := usecase.NewUnarchiveEvent(infra)
 
 	return &EventService{
-		listMyEvents:            listMyEvents,
-		createEvent:             createEvent,
-		updateEvent:             updateEvent,
-		deleteEvent:             deleteEvent,
-		listEventParticipants:   listEventParticipants,
-		updateParticipantStatus: updateParticipantStatus,
-		archiveEvent:            archiveEvent,
-		unarchiveEvent:          unarchiveEvent,
+		listMyEvents:              listMyEvents,
+		createEvent:               createEvent,
+		updateEvent:               updateEvent,
+		deleteEvent:               deleteEvent,
+		listEventParticipants:     listEventParticipants,
+		updateParticipantStatus:   updateParticipantStatus,
+		recordPayment:             recordPayment,
+		settleDeposits:            settleDeposits,
+		finalizeEvent:             finalizeEvent,
+		listRefunds:               listRefunds,
+		recordRefund:              recordRefund,
+		setParticipantFixedAmount: setParticipantFixedAmount,
+		setParticipantRounds:      setParticipantRounds,
+		setParticipantAttendance:  setParticipantAttendance,
+		reissueParticipantToken:   reissueParticipantToken,
+		addParticipant:            addParticipant,
+		updateParticipant:         updateParticipant,
+		removeParticipant:         removeParticipant,
+		mergeParticipants:         mergeParticipants,
+		addExpense:                addExpense,
+		deleteExpense:             deleteExpense,
+		listExpenses:              listExpenses,
+		archiveEvent:              archiveEvent,
+		unarchiveEvent:            unarchiveEvent,
 	}
 }
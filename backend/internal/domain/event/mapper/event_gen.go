@@ -33,7 +33,28 @@ func ToV1EventPtr(src *model.Event) *eventv1.Event {
 			}
 			return result
 		}(),
-		ArchivedAt: converter.PtrTimeToTimestamppb(src.ArchivedAt),
+		ArchivedAt:            converter.PtrTimeToTimestamppb(src.ArchivedAt),
+		RemainderPolicy:       converter.ToV1RemainderPolicy(src.RemainderPolicy),
+		Currency:              converter.CurrencyToString(src.Currency),
+		SettlementCurrency:    converter.CurrencyToString(src.SettlementCurrency),
+		ExchangeRate:          converter.RateToString(src.ExchangeRate),
+		ExchangeRateAt:        converter.PtrTimeToTimestamppb(src.ExchangeRateAt),
+		SettlementTotalAmount: converter.IntToInt32(src.SettlementTotalAmount),
+		SplitMode:             converter.ToV1SplitMode(src.SplitMode),
+		EndsAt:                converter.PtrTimeToTimestamppb(src.EndsAt),
+		DepositAmount:         converter.PtrIntToPtrInt32(src.DepositAmount),
+		DepositSettledAt:      converter.PtrTimeToTimestamppb(src.DepositSettledAt),
+		EstimatedTotalAmount:  converter.PtrIntToPtrInt32(src.EstimatedTotalAmount),
+		FinalizedAt:           converter.PtrTimeToTimestamppb(src.FinalizedAt),
+		SubtotalAmount:        converter.PtrIntToPtrInt32(src.SubtotalAmount),
+		TaxRate:               converter.PercentToString(src.TaxRate),
+		TaxIncluded:           src.TaxIncluded,
+		ServiceChargeRate:     converter.PercentToString(src.ServiceChargeRate),
+		TipRate:               converter.PercentToString(src.TipRate),
+		ServiceChargeAmount:   converter.IntToInt32(src.ServiceChargeAmount),
+		TaxAmount:             converter.IntToInt32(src.TaxAmount),
+		TipAmount:             converter.IntToInt32(src.TipAmount),
+		Surplus:               converter.IntToInt32(src.Surplus),
 	}
 
 }
@@ -60,7 +81,28 @@ func ToV1Event(src model.Event) eventv1.Event {
 			}
 			return result
 		}(),
-		ArchivedAt: converter.PtrTimeToTimestamppb(src.ArchivedAt),
+		ArchivedAt:            converter.PtrTimeToTimestamppb(src.ArchivedAt),
+		RemainderPolicy:       converter.ToV1RemainderPolicy(src.RemainderPolicy),
+		Currency:              converter.CurrencyToString(src.Currency),
+		SettlementCurrency:    converter.CurrencyToString(src.SettlementCurrency),
+		ExchangeRate:          converter.RateToString(src.ExchangeRate),
+		ExchangeRateAt:        converter.PtrTimeToTimestamppb(src.ExchangeRateAt),
+		SettlementTotalAmount: converter.IntToInt32(src.SettlementTotalAmount),
+		SplitMode:             converter.ToV1SplitMode(src.SplitMode),
+		EndsAt:                converter.PtrTimeToTimestamppb(src.EndsAt),
+		DepositAmount:         converter.PtrIntToPtrInt32(src.DepositAmount),
+		DepositSettledAt:      converter.PtrTimeToTimestamppb(src.DepositSettledAt),
+		EstimatedTotalAmount:  converter.PtrIntToPtrInt32(src.EstimatedTotalAmount),
+		FinalizedAt:           converter.PtrTimeToTimestamppb(src.FinalizedAt),
+		SubtotalAmount:        converter.PtrIntToPtrInt32(src.SubtotalAmount),
+		TaxRate:               converter.PercentToString(src.TaxRate),
+		TaxIncluded:           src.TaxIncluded,
+		ServiceChargeRate:     converter.PercentToString(src.ServiceChargeRate),
+		TipRate:               converter.PercentToString(src.TipRate),
+		ServiceChargeAmount:   converter.IntToInt32(src.ServiceChargeAmount),
+		TaxAmount:             converter.IntToInt32(src.TaxAmount),
+		TipAmount:             converter.IntToInt32(src.TipAmount),
+		Surplus:               converter.IntToInt32(src.Surplus),
 	}
 
 }
@@ -77,6 +119,8 @@ func ToV1EventTierPtr(src *model.EventTier) *eventv1.EventTier {
 		Tier:    converter.IntToInt32(src.Tier),
 		Count:   converter.IntToInt32(src.Count),
 		Amount:  converter.IntToInt32(src.Amount),
+		Name:    src.Name,
+		Weight:  converter.WeightToString(src.Weight),
 	}
 
 }
@@ -90,6 +134,8 @@ func ToV1EventTier(src model.EventTier) eventv1.EventTier {
 		Tier:    converter.IntToInt32(src.Tier),
 		Count:   converter.IntToInt32(src.Count),
 		Amount:  converter.IntToInt32(src.Amount),
+		Name:    src.Name,
+		Weight:  converter.WeightToString(src.Weight),
 	}
 
 }
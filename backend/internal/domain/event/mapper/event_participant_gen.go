@@ -15,13 +15,22 @@ func ToV1EventParticipantPtr(src *model.EventParticipant) *eventv1.EventParticip
 	}
 
 	return &eventv1.EventParticipant{
-		Id:        src.ID,
-		EventId:   src.EventID,
-		Name:      src.Name,
-		Tier:      converter.IntToInt32(src.Tier),
-		Status:    converter.ToV1ParticipantStatus(src.Status),
-		Amount:    converter.IntToInt32(src.Amount),
-		CreatedAt: converter.TimeToTimestamppb(src.CreatedAt),
+		Id:               src.ID,
+		EventId:          src.EventID,
+		Name:             src.Name,
+		Tier:             converter.IntToInt32(src.Tier),
+		Status:           converter.ToV1ParticipantStatus(src.Status),
+		Amount:           converter.IntToInt32(src.Amount),
+		CreatedAt:        converter.TimeToTimestamppb(src.CreatedAt),
+		FixedAmount:      converter.PtrIntToPtrInt32(src.FixedAmount),
+		ClaimedAt:        converter.PtrTimeToTimestamppb(src.ClaimedAt),
+		ConfirmedAt:      converter.PtrTimeToTimestamppb(src.ConfirmedAt),
+		PaidAmount:       converter.IntToInt32(src.PaidAmount),
+		AdjustmentAmount: converter.IntToInt32(src.AdjustmentAmount),
+		ArrivedAt:        converter.PtrTimeToTimestamppb(src.ArrivedAt),
+		LeftAt:           converter.PtrTimeToTimestamppb(src.LeftAt),
+		Attendance:       converter.PtrAttendanceToString(src.Attendance),
+		SettlementAmount: converter.IntToInt32(src.SettlementAmount),
 	}
 
 }
@@ -30,13 +39,22 @@ func ToV1EventParticipantPtr(src *model.EventParticipant) *eventv1.EventParticip
 func ToV1EventParticipant(src model.EventParticipant) eventv1.EventParticipant {
 
 	return eventv1.EventParticipant{
-		Id:        src.ID,
-		EventId:   src.EventID,
-		Name:      src.Name,
-		Tier:      converter.IntToInt32(src.Tier),
-		Status:    converter.ToV1ParticipantStatus(src.Status),
-		Amount:    converter.IntToInt32(src.Amount),
-		CreatedAt: converter.TimeToTimestamppb(src.CreatedAt),
+		Id:               src.ID,
+		EventId:          src.EventID,
+		Name:             src.Name,
+		Tier:             converter.IntToInt32(src.Tier),
+		Status:           converter.ToV1ParticipantStatus(src.Status),
+		Amount:           converter.IntToInt32(src.Amount),
+		CreatedAt:        converter.TimeToTimestamppb(src.CreatedAt),
+		FixedAmount:      converter.PtrIntToPtrInt32(src.FixedAmount),
+		ClaimedAt:        converter.PtrTimeToTimestamppb(src.ClaimedAt),
+		ConfirmedAt:      converter.PtrTimeToTimestamppb(src.ConfirmedAt),
+		PaidAmount:       converter.IntToInt32(src.PaidAmount),
+		AdjustmentAmount: converter.IntToInt32(src.AdjustmentAmount),
+		ArrivedAt:        converter.PtrTimeToTimestamppb(src.ArrivedAt),
+		LeftAt:           converter.PtrTimeToTimestamppb(src.LeftAt),
+		Attendance:       converter.PtrAttendanceToString(src.Attendance),
+		SettlementAmount: converter.IntToInt32(src.SettlementAmount),
 	}
 
 }
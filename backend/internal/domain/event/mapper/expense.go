@@ -0,0 +1,42 @@
+package mapper
+
+import (
+	eventv1 "github.com/mickamy/sampay/gen/event/v1"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/lib/slicex"
+)
+
+func ToV1Expense(src model.EventExpense) *eventv1.Expense {
+	return &eventv1.Expense{
+		Id:      src.ID,
+		EventId: src.EventID,
+		PayerId: src.PayerID,
+		Title:   src.Title,
+		Amount:  converter.IntToInt32(src.Amount),
+		Shares: slicex.Map(src.Shares, func(s model.EventExpenseShare) *eventv1.ExpenseShare {
+			return &eventv1.ExpenseShare{
+				ParticipantId: s.ParticipantID,
+				Amount:        converter.IntToInt32(s.Amount),
+			}
+		}),
+		CreatedAt: converter.TimeToTimestamppb(src.CreatedAt),
+	}
+}
+
+func ToV1Balance(src model.Balance) *eventv1.Balance {
+	return &eventv1.Balance{
+		ParticipantId: src.ParticipantID,
+		Paid:          converter.IntToInt32(src.Paid),
+		Owed:          converter.IntToInt32(src.Owed),
+		Net:           converter.IntToInt32(src.Net),
+	}
+}
+
+func ToV1Transfer(src model.Transfer) *eventv1.Transfer {
+	return &eventv1.Transfer{
+		FromParticipantId: src.FromParticipantID,
+		ToParticipantId:   src.ToParticipantID,
+		Amount:            converter.IntToInt32(src.Amount),
+	}
+}
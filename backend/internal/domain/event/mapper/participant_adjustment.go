@@ -0,0 +1,18 @@
+package mapper
+
+import (
+	eventv1 "github.com/mickamy/sampay/gen/event/v1"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+func ToV1ParticipantAdjustment(src model.EventParticipantAdjustment) *eventv1.ParticipantAdjustment {
+	return &eventv1.ParticipantAdjustment{
+		Id:            src.ID,
+		ParticipantId: src.ParticipantID,
+		Amount:        converter.IntToInt32(src.Amount),
+		Note:          ptr.ZeroIfNull(src.Note),
+		CreatedAt:     converter.TimeToTimestamppb(src.CreatedAt),
+	}
+}
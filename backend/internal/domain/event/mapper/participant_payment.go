@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	eventv1 "github.com/mickamy/sampay/gen/event/v1"
+	userv1 "github.com/mickamy/sampay/gen/user/v1"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+func ToV1ParticipantPayment(src model.EventParticipantPayment) *eventv1.ParticipantPayment {
+	methodType := userv1.PaymentMethodType_PAYMENT_METHOD_TYPE_UNSPECIFIED
+	if src.PaymentMethod != nil {
+		methodType = converter.ToV1PaymentMethodType(*src.PaymentMethod)
+	}
+	return &eventv1.ParticipantPayment{
+		Id:                src.ID,
+		ParticipantId:     src.ParticipantID,
+		Amount:            converter.IntToInt32(src.Amount),
+		PaymentMethodType: methodType,
+		Note:              ptr.ZeroIfNull(src.Note),
+		PaidAt:            converter.TimeToTimestamppb(src.PaidAt),
+	}
+}
@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	eventv1 "github.com/mickamy/sampay/gen/event/v1"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+func ToV1ParticipantStatusChange(src model.EventParticipantStatusChange) *eventv1.ParticipantStatusChange {
+	fromStatus := eventv1.ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
+	if src.FromStatus != nil {
+		fromStatus = converter.ToV1ParticipantStatus(*src.FromStatus)
+	}
+	return &eventv1.ParticipantStatusChange{
+		Id:            src.ID,
+		ParticipantId: src.ParticipantID,
+		FromStatus:    fromStatus,
+		ToStatus:      converter.ToV1ParticipantStatus(src.ToStatus),
+		Actor:         converter.ToV1ParticipantStatusActor(src.Actor),
+		Reason:        ptr.ZeroIfNull(src.Reason),
+		CreatedAt:     converter.TimeToTimestamppb(src.CreatedAt),
+	}
+}
@@ -0,0 +1,20 @@
+package mapper
+
+import (
+	eventv1 "github.com/mickamy/sampay/gen/event/v1"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+)
+
+func ToV1Refund(src model.Refund) *eventv1.Refund {
+	participant := ToV1EventParticipant(src.Participant)
+	return &eventv1.Refund{
+		EventId:            src.EventID,
+		EventTitle:         src.EventTitle,
+		Currency:           converter.CurrencyToString(src.Currency),
+		Participant:        &participant,
+		Amount:             converter.IntToInt32(src.Amount),
+		SettlementCurrency: converter.CurrencyToString(src.SettlementCurrency),
+		SettlementAmount:   converter.IntToInt32(src.SettlementAmount),
+	}
+}
@@ -0,0 +1,74 @@
+package mapper
+
+import (
+	"slices"
+
+	eventv1 "github.com/mickamy/sampay/gen/event/v1"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/converter"
+)
+
+// ToV1EventRounds breaks down what participants owe round by round, the event itself being round 1.
+func ToV1EventRounds(ev model.Event) []*eventv1.EventRound {
+	first := &eventv1.EventRound{
+		Round:       1,
+		Title:       ev.Title,
+		TotalAmount: converter.IntToInt32(ev.TotalAmount),
+		Remainder:   converter.IntToInt32(ev.Remainder),
+		Surplus:     converter.IntToInt32(ev.Surplus),
+		Shares:      toV1RoundShares(ev, ev.FirstRoundShares()),
+	}
+	for _, t := range ev.Tiers {
+		first.Tiers = append(first.Tiers, &eventv1.EventRoundTier{
+			Tier:   converter.IntToInt32(t.Tier),
+			Name:   t.Name,
+			Weight: converter.WeightToString(t.Weight),
+			Count:  converter.IntToInt32(t.Count),
+			Amount: converter.IntToInt32(t.Amount),
+		})
+	}
+
+	rounds := []*eventv1.EventRound{first}
+	for _, r := range ev.Rounds {
+		round := &eventv1.EventRound{
+			Round:       converter.IntToInt32(r.Round),
+			Title:       r.Title,
+			TotalAmount: converter.IntToInt32(r.TotalAmount),
+			Remainder:   converter.IntToInt32(r.Remainder),
+			Surplus:     converter.IntToInt32(r.Surplus),
+			Shares:      toV1RoundShares(ev, ev.RoundShares(r)),
+		}
+		for _, t := range r.Tiers {
+			// the round's tiers go by the names of the event's
+			var name string
+			if idx := slices.IndexFunc(ev.Tiers, func(et model.EventTier) bool { return et.Tier == t.Tier }); idx >= 0 {
+				name = ev.Tiers[idx].Name
+			}
+			round.Tiers = append(round.Tiers, &eventv1.EventRoundTier{
+				Tier:   converter.IntToInt32(t.Tier),
+				Name:   name,
+				Weight: converter.WeightToString(t.Weight),
+				Count:  converter.IntToInt32(t.Count),
+				Amount: converter.IntToInt32(t.Amount),
+			})
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
+
+// toV1RoundShares lists the shares in the order of the event's participants, as maps have none.
+func toV1RoundShares(ev model.Event, shares map[string]int) []*eventv1.RoundShare {
+	var result []*eventv1.RoundShare
+	for _, p := range ev.Participants {
+		amount, ok := shares[p.ID]
+		if !ok {
+			continue
+		}
+		result = append(result, &eventv1.RoundShare{
+			ParticipantId: p.ID,
+			Amount:        converter.IntToInt32(amount),
+		})
+	}
+	return result
+}
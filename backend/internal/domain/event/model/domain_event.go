@@ -0,0 +1,58 @@
+package model
+
+// DomainEventType names something that happened to an event or its participants.
+// It is also the worker job type the event is delivered as, so the names must match the job types.
+type DomainEventType string
+
+const (
+	DomainEventParticipantJoined DomainEventType = "ParticipantJoined"
+	DomainEventPaymentClaimed    DomainEventType = "PaymentClaimed"
+	DomainEventPaymentConfirmed  DomainEventType = "PaymentConfirmed"
+	DomainEventEventArchived     DomainEventType = "EventArchived"
+	DomainEventTotalFinalized    DomainEventType = "TotalFinalized"
+)
+
+func (t DomainEventType) String() string {
+	return string(t)
+}
+
+// ParticipantJoined is published when someone joins an event, including onto the waitlist.
+type ParticipantJoined struct {
+	EventID       string            `json:"event_id"`
+	ParticipantID string            `json:"participant_id"`
+	Status        ParticipantStatus `json:"status"`
+}
+
+// PaymentClaimed is published when a participant reports having paid.
+type PaymentClaimed struct {
+	EventID       string `json:"event_id"`
+	ParticipantID string `json:"participant_id"`
+	// PaymentMethod is the payment method type the participant says they used, empty if they did not say.
+	PaymentMethod string `json:"payment_method,omitempty"`
+}
+
+// PaymentConfirmed is published when the organizer confirms a participant's payment.
+type PaymentConfirmed struct {
+	EventID       string `json:"event_id"`
+	ParticipantID string `json:"participant_id"`
+}
+
+// EventArchived is published when the organizer archives an event.
+type EventArchived struct {
+	EventID string `json:"event_id"`
+}
+
+// TotalFinalized is published when the organizer replaces an estimated total with the actual one,
+// so participants can be told what they owe exactly.
+type TotalFinalized struct {
+	EventID     string            `json:"event_id"`
+	TotalAmount int               `json:"total_amount"`
+	Amounts     []FinalizedAmount `json:"amounts"`
+}
+
+// FinalizedAmount is what a participant owed against the estimate and owes against the actual total.
+type FinalizedAmount struct {
+	ParticipantID string `json:"participant_id"`
+	PreviousDue   int    `json:"previous_due"`
+	Due           int    `json:"due"`
+}
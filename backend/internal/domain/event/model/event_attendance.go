@@ -0,0 +1,176 @@
+package model
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SplitMode decides how what is left of TotalAmount after fixed amounts is split among participants.
+type SplitMode string
+
+const (
+	// SplitModeTier splits by tier weight over every tier's capacity, so a join does not move what others owe.
+	SplitModeTier SplitMode = "tier"
+	// SplitModeAttendance splits among the participants holding a spot in proportion to how much of the event
+	// they attended, so whoever stayed longer covers what late arrivals and early leavers do not.
+	SplitModeAttendance SplitMode = "attendance"
+	// SplitModeAttendanceTier weighs each participant's attendance by their tier's weight as well.
+	SplitModeAttendanceTier SplitMode = "attendance_tier"
+)
+
+func (m SplitMode) IsValid() bool {
+	switch m {
+	case SplitModeTier, SplitModeAttendance, SplitModeAttendanceTier:
+		return true
+	default:
+		return false
+	}
+}
+
+// ByAttendance reports whether shares follow who actually attended rather than the tiers' capacity.
+// Everyone's share then moves whenever someone joins, leaves or has their attendance changed.
+func (m SplitMode) ByAttendance() bool {
+	return m == SplitModeAttendance || m == SplitModeAttendanceTier
+}
+
+// AttendanceScale is how many Attendance units make attending the whole event.
+const AttendanceScale = 1000
+
+// Attendance is the fraction of the event a participant attended, in thousandths (e.g. 500 for half of it).
+type Attendance int
+
+var (
+	ErrInvalidAttendance = errors.New("attendance must be a decimal from 0 to 1 with at most 3 fractional digits")
+
+	attendancePattern = regexp.MustCompile(`^[01](\.\d{1,3})?$`)
+)
+
+// ParseAttendance parses a decimal string from "0" to "1" such as "0.5" or "0.75".
+func ParseAttendance(s string) (Attendance, error) {
+	if !attendancePattern.MatchString(s) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAttendance, s)
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	a, _ := strconv.Atoi(whole + frac + strings.Repeat("0", 3-len(frac)))
+	if a > AttendanceScale {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAttendance, s)
+	}
+	return Attendance(a), nil
+}
+
+// String formats the attendance as the shortest decimal, e.g. "1" or "0.5".
+func (a Attendance) String() string {
+	return Weight(a).String()
+}
+
+// AttendanceOf returns how much of the event the participant attended. An Attendance set on the participant
+// wins; otherwise it is the part of HeldAt to EndsAt between their ArrivedAt and LeftAt, which default to
+// the start and the end. Without EndsAt, times cannot be turned into a fraction, so everyone attended in full.
+func (e *Event) AttendanceOf(p EventParticipant) Attendance {
+	if p.Attendance != nil {
+		return *p.Attendance
+	}
+	if e.EndsAt == nil || e.EndsAt.Sub(e.HeldAt) < time.Second {
+		return AttendanceScale
+	}
+
+	from, to := e.HeldAt, *e.EndsAt
+	if p.ArrivedAt != nil && p.ArrivedAt.After(from) {
+		from = *p.ArrivedAt
+	}
+	if p.LeftAt != nil && p.LeftAt.Before(to) {
+		to = *p.LeftAt
+	}
+	if !to.After(from) {
+		return 0
+	}
+	// in seconds, as nanoseconds times AttendanceScale overflow int64 for events longer than about 106 days
+	attended, span := int64(to.Sub(from)/time.Second), int64(e.EndsAt.Sub(e.HeldAt)/time.Second)
+	return Attendance(attended * AttendanceScale / span)
+}
+
+// attendanceWeight is how heavily the participant's share counts under an attendance split.
+func (e *Event) attendanceWeight(p EventParticipant, attendance Attendance) int {
+	weight := Weight(WeightScale)
+	if e.SplitMode == SplitModeAttendanceTier {
+		weight = e.tierWeight(p.Tier)
+	}
+	return int(attendance) * int(weight) / WeightScale
+}
+
+// attendanceShares returns the weight of each participant sharing the total under an attendance split, keyed by
+// their index in Participants, and the sum of those weights.
+func (e *Event) attendanceShares() (map[int]int, int) {
+	weights := make(map[int]int)
+	total := 0
+	for i, p := range e.Participants {
+		if p.IsWaitlisted() || p.HasFixedAmount() {
+			continue
+		}
+		weights[i] = e.attendanceWeight(p, e.AttendanceOf(p))
+		total += weights[i]
+	}
+	return weights, total
+}
+
+// attendanceAmounts returns what each participant sharing the total owes under an attendance split, keyed by
+// their index in Participants, and what those amounts fall short of the shared total.
+// Under RemainderPolicyDistribute the shortfall is spread one yen at a time, heavier shares first and then in
+// join order, so it comes back as zero.
+func (e *Event) attendanceAmounts() (map[int]int, int) {
+	shareTotal := e.TotalAmount - e.FixedTotal()
+	weights, totalWeight := e.attendanceShares()
+
+	amounts := make(map[int]int, len(weights))
+	leftover := shareTotal
+	for i, w := range weights {
+		if totalWeight > 0 {
+			amounts[i] = e.share(shareTotal, w, totalWeight)
+		}
+		leftover -= amounts[i]
+	}
+	if e.RemainderPolicy != RemainderPolicyDistribute || totalWeight == 0 {
+		return amounts, leftover
+	}
+
+	order := make([]int, 0, len(weights))
+	for i := range weights {
+		order = append(order, i)
+	}
+	slices.SortFunc(order, func(a, b int) int {
+		if c := cmp.Compare(weights[b], weights[a]); c != 0 {
+			return c
+		}
+		return compareJoinOrder(e.Participants[a], e.Participants[b])
+	})
+	for _, i := range order {
+		if leftover <= 0 {
+			break
+		}
+		amounts[i]++
+		leftover--
+	}
+	return amounts, leftover
+}
+
+// calcAttendanceAmounts is CalcTierAmounts under an attendance split. Tier amounts are what someone of the
+// tier attending in full owes, for reference, and Remainder is what the participant amounts fall short of.
+func (e *Event) calcAttendanceAmounts() {
+	_, totalWeight := e.attendanceShares()
+	shareTotal := e.TotalAmount - e.FixedTotal()
+	for i := range e.Tiers {
+		e.Tiers[i].Amount = 0
+		if totalWeight > 0 {
+			full := e.attendanceWeight(EventParticipant{Tier: e.Tiers[i].Tier}, AttendanceScale)
+			e.Tiers[i].Amount = e.share(shareTotal, full, totalWeight)
+		}
+	}
+	_, e.Remainder = e.attendanceAmounts()
+}
@@ -0,0 +1,213 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+// attendanceEvent runs four hours: a stays throughout, b arrives halfway and c leaves an hour early.
+func attendanceEvent(heldAt time.Time, mode model.SplitMode) model.Event {
+	return model.Event{
+		TotalAmount: 9000,
+		TierCount:   1,
+		HeldAt:      heldAt,
+		EndsAt:      ptr.Of(heldAt.Add(4 * time.Hour)),
+		SplitMode:   mode,
+		Tiers:       []model.EventTier{{Tier: 1, Count: 5, Weight: model.WeightScale}},
+		Participants: []model.EventParticipant{
+			{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: heldAt},
+			{
+				ID: "b", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: heldAt.Add(time.Second),
+				ArrivedAt: ptr.Of(heldAt.Add(2 * time.Hour)),
+			},
+			{
+				ID: "c", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: heldAt.Add(2 * time.Second),
+				LeftAt: ptr.Of(heldAt.Add(3 * time.Hour)),
+			},
+		},
+	}
+}
+
+func participantAmounts(ev model.Event) map[string]int {
+	amounts := map[string]int{}
+	for _, p := range ev.Participants {
+		amounts[p.ID] = p.Amount
+	}
+	return amounts
+}
+
+func TestParseAttendance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    model.Attendance
+		wantErr bool
+	}{
+		{in: "1", want: 1000},
+		{in: "0", want: 0},
+		{in: "0.5", want: 500},
+		{in: "0.75", want: 750},
+		{in: "1.000", want: 1000},
+		{in: "1.5", wantErr: true},
+		{in: "2", wantErr: true},
+		{in: "-0.5", wantErr: true},
+		{in: "0.1234", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := model.ParseAttendance(tt.in)
+			if tt.wantErr {
+				require.ErrorIs(t, err, model.ErrInvalidAttendance)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttendance_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "1", model.Attendance(1000).String())
+	assert.Equal(t, "0.5", model.Attendance(500).String())
+	assert.Equal(t, "0.125", model.Attendance(125).String())
+}
+
+func TestEvent_AttendanceOf(t *testing.T) {
+	t.Parallel()
+
+	heldAt := time.Date(2026, 3, 20, 18, 0, 0, 0, time.UTC)
+	ev := attendanceEvent(heldAt, model.SplitModeAttendance)
+
+	assert.Equal(t, model.Attendance(1000), ev.AttendanceOf(ev.Participants[0]))
+	assert.Equal(t, model.Attendance(500), ev.AttendanceOf(ev.Participants[1]))
+	assert.Equal(t, model.Attendance(750), ev.AttendanceOf(ev.Participants[2]))
+
+	t.Run("override wins over times", func(t *testing.T) {
+		t.Parallel()
+
+		p := ev.Participants[1]
+		p.Attendance = ptr.Of(model.Attendance(250))
+		assert.Equal(t, model.Attendance(250), ev.AttendanceOf(p))
+	})
+
+	t.Run("times outside the event are clamped", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{ArrivedAt: ptr.Of(heldAt.Add(-time.Hour)), LeftAt: ptr.Of(heldAt.Add(5 * time.Hour))}
+		assert.Equal(t, model.Attendance(1000), ev.AttendanceOf(p))
+	})
+
+	t.Run("left before arriving", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{ArrivedAt: ptr.Of(heldAt.Add(3 * time.Hour)), LeftAt: ptr.Of(heldAt.Add(time.Hour))}
+		assert.Zero(t, ev.AttendanceOf(p))
+	})
+
+	t.Run("event lasting months", func(t *testing.T) {
+		t.Parallel()
+
+		season := attendanceEvent(heldAt, model.SplitModeAttendance)
+		season.EndsAt = ptr.Of(heldAt.Add(300 * 24 * time.Hour))
+		p := model.EventParticipant{LeftAt: ptr.Of(heldAt.Add(150 * 24 * time.Hour))}
+		assert.Equal(t, model.Attendance(500), season.AttendanceOf(p))
+	})
+
+	t.Run("without an end everyone attends in full", func(t *testing.T) {
+		t.Parallel()
+
+		open := attendanceEvent(heldAt, model.SplitModeAttendance)
+		open.EndsAt = nil
+		assert.Equal(t, model.Attendance(1000), open.AttendanceOf(open.Participants[1]))
+	})
+}
+
+func TestEvent_AttendanceSplit(t *testing.T) {
+	t.Parallel()
+
+	heldAt := time.Date(2026, 3, 20, 18, 0, 0, 0, time.UTC)
+
+	t.Run("attendance", func(t *testing.T) {
+		t.Parallel()
+
+		ev := attendanceEvent(heldAt, model.SplitModeAttendance)
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		assert.Equal(t, 4000, ev.Tiers[0].Amount, "tier amount is for attending in full")
+		assert.Zero(t, ev.Remainder)
+		assert.Equal(t, map[string]int{"a": 4000, "b": 2000, "c": 3000}, participantAmounts(ev))
+	})
+
+	t.Run("remainder is kept by the organizer", func(t *testing.T) {
+		t.Parallel()
+
+		ev := attendanceEvent(heldAt, model.SplitModeAttendance)
+		ev.TotalAmount = 10001
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		assert.Equal(t, 2, ev.Remainder)
+		assert.Equal(t, map[string]int{"a": 4444, "b": 2222, "c": 3333}, participantAmounts(ev))
+	})
+
+	t.Run("remainder is distributed to heavier shares first", func(t *testing.T) {
+		t.Parallel()
+
+		ev := attendanceEvent(heldAt, model.SplitModeAttendance)
+		ev.TotalAmount = 10001
+		ev.RemainderPolicy = model.RemainderPolicyDistribute
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		assert.Zero(t, ev.Remainder)
+		assert.Equal(t, map[string]int{"a": 4445, "b": 2222, "c": 3334}, participantAmounts(ev))
+	})
+
+	t.Run("waitlisted and fixed amounts do not share", func(t *testing.T) {
+		t.Parallel()
+
+		ev := attendanceEvent(heldAt, model.SplitModeAttendance)
+		ev.TotalAmount = 10000
+		ev.Participants = append(ev.Participants,
+			model.EventParticipant{ID: "d", Tier: 1, Status: model.ParticipantStatusWaitlisted},
+			model.EventParticipant{ID: "e", Tier: 1, Status: model.ParticipantStatusUnpaid, FixedAmount: ptr.Of(1000)},
+		)
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		assert.Zero(t, ev.Remainder)
+		assert.Equal(t, map[string]int{"a": 4000, "b": 2000, "c": 3000, "d": 0, "e": 1000}, participantAmounts(ev))
+	})
+
+	t.Run("attendance weighed by tier", func(t *testing.T) {
+		t.Parallel()
+
+		ev := attendanceEvent(heldAt, model.SplitModeAttendanceTier)
+		ev.TotalAmount = 8000
+		ev.TierCount = 2
+		ev.Tiers = append(ev.Tiers, model.EventTier{Tier: 2, Count: 5, Weight: 2 * model.WeightScale})
+		ev.Participants[1].Tier = 2
+		ev.Participants[2].Tier = 2
+		ev.Participants[2].LeftAt = nil
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		assert.Equal(t, 2000, ev.Tiers[0].Amount)
+		assert.Equal(t, 4000, ev.Tiers[1].Amount)
+		assert.Zero(t, ev.Remainder)
+		assert.Equal(t, map[string]int{"a": 2000, "b": 2000, "c": 4000}, participantAmounts(ev))
+	})
+}
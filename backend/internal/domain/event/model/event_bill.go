@@ -0,0 +1,116 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PercentScale is how many Percent units make one percent.
+const PercentScale = 100
+
+// Percent is a rate in hundredths of a percent (e.g. 1000 for 10%).
+type Percent int
+
+var (
+	ErrInvalidPercent = errors.New("percent must be a decimal from 0 to 100 with at most 2 fractional digits")
+
+	percentPattern = regexp.MustCompile(`^\d{1,3}(\.\d{1,2})?$`)
+)
+
+// ParsePercent parses a decimal string from "0" to "100" such as "10" or "17.5".
+func ParsePercent(s string) (Percent, error) {
+	if !percentPattern.MatchString(s) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPercent, s)
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	p, _ := strconv.Atoi(whole + frac + strings.Repeat("0", 2-len(frac)))
+	if p > 100*PercentScale {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPercent, s)
+	}
+	return Percent(p), nil
+}
+
+// String formats the percent as the shortest decimal, e.g. "10" or "17.5".
+func (p Percent) String() string {
+	s := strconv.Itoa(int(p) / PercentScale)
+	if frac := int(p) % PercentScale; frac != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%02d", frac), "0")
+	}
+	return s
+}
+
+// Of returns the percent of the amount, rounded down.
+func (p Percent) Of(amount int) int {
+	return amount * int(p) / (100 * PercentScale)
+}
+
+// Bill is how a restaurant bill adds up to the total: the subtotal of what was ordered, a service charge
+// (サービス料) on it, tax, and a tip, e.g. on trips abroad. Amounts are rounded down, in minor units.
+type Bill struct {
+	Subtotal int
+	TaxRate  Percent
+	// TaxIncluded is true when the subtotal already includes tax, as prices in Japan usually do,
+	// so tax adds nothing to the total.
+	TaxIncluded       bool
+	ServiceChargeRate Percent
+	TipRate           Percent
+}
+
+// ServiceCharge returns the service charge on the subtotal.
+func (b Bill) ServiceCharge() int {
+	return b.ServiceChargeRate.Of(b.Subtotal)
+}
+
+// Tax returns the tax on the subtotal and the service charge, or the part of them that is tax when TaxIncluded.
+func (b Bill) Tax() int {
+	taxable := b.Subtotal + b.ServiceCharge()
+	if b.TaxIncluded {
+		return taxable * int(b.TaxRate) / (100*PercentScale + int(b.TaxRate))
+	}
+	return b.TaxRate.Of(taxable)
+}
+
+// Tip returns the tip on the subtotal.
+func (b Bill) Tip() int {
+	return b.TipRate.Of(b.Subtotal)
+}
+
+// Total returns what the bill comes to.
+func (b Bill) Total() int {
+	total := b.Subtotal + b.ServiceCharge() + b.Tip()
+	if !b.TaxIncluded {
+		total += b.Tax()
+	}
+	return total
+}
+
+// ApplyBill makes TotalAmount what the bill comes to and keeps its breakdown for display.
+// A nil bill clears the breakdown, leaving TotalAmount as entered.
+func (e *Event) ApplyBill(b *Bill) {
+	if b == nil {
+		e.SubtotalAmount = nil
+		e.TaxRate = 0
+		e.TaxIncluded = false
+		e.ServiceChargeRate = 0
+		e.TipRate = 0
+		e.ServiceChargeAmount = 0
+		e.TaxAmount = 0
+		e.TipAmount = 0
+		return
+	}
+
+	subtotal := b.Subtotal
+	e.SubtotalAmount = &subtotal
+	e.TaxRate = b.TaxRate
+	e.TaxIncluded = b.TaxIncluded
+	e.ServiceChargeRate = b.ServiceChargeRate
+	e.TipRate = b.TipRate
+	e.ServiceChargeAmount = b.ServiceCharge()
+	e.TaxAmount = b.Tax()
+	e.TipAmount = b.Tip()
+	e.TotalAmount = b.Total()
+}
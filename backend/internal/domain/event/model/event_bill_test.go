@@ -0,0 +1,107 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+func TestParsePercent(t *testing.T) {
+	t.Parallel()
+
+	for s, want := range map[string]model.Percent{
+		"0":     0,
+		"10":    1000,
+		"8":     800,
+		"17.5":  1750,
+		"8.25":  825,
+		"100":   10000,
+		"100.0": 10000,
+	} {
+		got, err := model.ParsePercent(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, got, s)
+		if s != "100.0" {
+			assert.Equal(t, s, got.String())
+		}
+	}
+
+	for _, s := range []string{"", "-1", "100.01", "1000", "1.234", "10%", ".5"} {
+		_, err := model.ParsePercent(s)
+		require.ErrorIs(t, err, model.ErrInvalidPercent, s)
+	}
+}
+
+func TestBill(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                    string
+		bill                    model.Bill
+		serviceCharge, tax, tip int
+		total                   int
+	}{
+		{
+			name:  "subtotal only",
+			bill:  model.Bill{Subtotal: 10000},
+			total: 10000,
+		},
+		{
+			name: "tax and service charge excluded",
+			bill: model.Bill{Subtotal: 10000, TaxRate: 1000, ServiceChargeRate: 1000},
+			// tax is on the service charge too
+			serviceCharge: 1000, tax: 1100,
+			total: 12100,
+		},
+		{
+			name:          "tax included",
+			bill:          model.Bill{Subtotal: 10000, TaxRate: 1000, TaxIncluded: true, ServiceChargeRate: 1000},
+			serviceCharge: 1000, tax: 1000,
+			total: 11000,
+		},
+		{
+			name: "tip abroad",
+			bill: model.Bill{Subtotal: 8000, TaxRate: 825, TipRate: 1800},
+			tax:  660, tip: 1440,
+			total: 10100,
+		},
+		{
+			name:          "rounded down",
+			bill:          model.Bill{Subtotal: 3333, TaxRate: 800, ServiceChargeRate: 500},
+			serviceCharge: 166, tax: 279,
+			total: 3778,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.serviceCharge, tt.bill.ServiceCharge())
+			assert.Equal(t, tt.tax, tt.bill.Tax())
+			assert.Equal(t, tt.tip, tt.bill.Tip())
+			assert.Equal(t, tt.total, tt.bill.Total())
+		})
+	}
+}
+
+func TestEvent_ApplyBill(t *testing.T) {
+	t.Parallel()
+
+	ev := model.Event{TotalAmount: 5000}
+	ev.ApplyBill(&model.Bill{Subtotal: 10000, TaxRate: 1000, ServiceChargeRate: 1000})
+
+	assert.Equal(t, 12100, ev.TotalAmount)
+	require.NotNil(t, ev.SubtotalAmount)
+	assert.Equal(t, 10000, *ev.SubtotalAmount)
+	assert.Equal(t, 1000, ev.ServiceChargeAmount)
+	assert.Equal(t, 1100, ev.TaxAmount)
+
+	ev.ApplyBill(nil)
+	assert.Equal(t, 12100, ev.TotalAmount, "the total stays as it was")
+	assert.Nil(t, ev.SubtotalAmount)
+	assert.Zero(t, ev.TaxRate)
+	assert.Zero(t, ev.TaxAmount)
+}
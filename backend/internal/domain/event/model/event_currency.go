@@ -0,0 +1,101 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+)
+
+// assignSettlementAmounts sets SettlementAmount on each participant to their SettlementShare, so that the
+// shares add up the way the amounts do rather than drifting apart by converting each amount on its own.
+// Under RemainderPolicyDistribute, what the split leaves over is spread one unit at a time across the
+// participants, biggest amounts first and then in join order.
+func (e *Event) assignSettlementAmounts() {
+	var active []int
+	participantsTotal := 0
+	assigned := 0
+	for i, p := range e.Participants {
+		e.Participants[i].SettlementAmount = 0
+		if p.IsWaitlisted() {
+			continue
+		}
+		share := e.SettlementShare(p.Amount)
+		e.Participants[i].SettlementAmount = share
+		if share > 0 {
+			active = append(active, i)
+			participantsTotal += p.Amount
+			assigned += share
+		}
+	}
+	total := e.GrandTotal()
+	if e.RemainderPolicy != RemainderPolicyDistribute || e.CollectingDeposits() || total <= 0 {
+		return
+	}
+
+	// what the participants come to together, the whole of SettlementTotalAmount once every seat is taken
+	target := (2*e.SettlementTotalAmount*participantsTotal + total) / (2 * total)
+	slices.SortStableFunc(active, func(a, b int) int {
+		if c := cmp.Compare(e.Participants[b].Amount, e.Participants[a].Amount); c != 0 {
+			return c
+		}
+		return compareJoinOrder(e.Participants[a], e.Participants[b])
+	})
+	for k := 0; k < target-assigned && k < len(active); k++ {
+		e.Participants[active[k]].SettlementAmount++
+	}
+}
+
+// SettlementShare returns the share of SettlementTotalAmount for a participant owing the amount, in proportion
+// to the event's grand total. The organizer covers what the shares leave over, unless the remainder policy
+// rounds them up or spreads it, see assignSettlementAmounts. While the event collects deposits, the amount
+// is converted as is. It is zero without a settlement currency.
+func (e *Event) SettlementShare(amount int) int {
+	total := e.GrandTotal()
+	switch {
+	case !e.HasSettlementCurrency() || amount <= 0:
+		return 0
+	case e.CollectingDeposits() || total <= 0:
+		return e.SettlementAmount(amount)
+	case e.RemainderPolicy.roundUnit() > 0:
+		return ceilDiv(e.SettlementTotalAmount*amount, total)
+	default:
+		return e.SettlementTotalAmount * amount / total
+	}
+}
+
+// SettlementDue returns what the participant owes in the payment currency: their share of
+// SettlementTotalAmount plus their adjustments converted. Without a settlement currency it is Due.
+func (e *Event) SettlementDue(p EventParticipant) int {
+	if !e.HasSettlementCurrency() {
+		return p.Due()
+	}
+	amount := p.SettlementAmount
+	if amount == 0 && p.Amount != 0 {
+		// not split yet, as for participants saved before settlement amounts were
+		amount = e.SettlementAmount(p.Amount)
+	}
+	return amount + e.SettlementAmount(p.AdjustmentAmount)
+}
+
+// SettlementOutstanding returns what the participant still owes in the payment currency.
+// Someone who paid nothing yet owes exactly their SettlementDue, and someone paid up owes nothing;
+// only what is left after a partial payment is converted on its own.
+func (e *Event) SettlementOutstanding(p EventParticipant) int {
+	switch {
+	case !e.HasSettlementCurrency():
+		return p.Outstanding()
+	case p.Outstanding() == 0:
+		return 0
+	case p.PaidAmount == 0:
+		return max(e.SettlementDue(p), 0)
+	default:
+		return max(e.SettlementDue(p)-e.SettlementAmount(p.PaidAmount), 0)
+	}
+}
+
+// SettlementRefundable returns what the organizer owes back to the participant in the payment currency.
+func (e *Event) SettlementRefundable(p EventParticipant) int {
+	if !e.HasSettlementCurrency() || p.Refundable() == 0 {
+		return p.Refundable()
+	}
+	return max(e.SettlementAmount(p.PaidAmount)-e.SettlementDue(p), 0)
+}
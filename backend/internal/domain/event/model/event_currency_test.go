@@ -0,0 +1,138 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/money"
+)
+
+// settlementEvent is a $100.00 dinner for three, paid in yen at 151.25 yen to the dollar.
+func settlementEvent(t *testing.T, policy model.RemainderPolicy) model.Event {
+	t.Helper()
+
+	rate, err := money.ParseRate("151.25")
+	require.NoError(t, err)
+	now := time.Now()
+	ev := model.Event{
+		TotalAmount:        10000,
+		TierCount:          1,
+		Currency:           money.USD,
+		SettlementCurrency: money.JPY,
+		ExchangeRate:       rate,
+		RemainderPolicy:    policy,
+		Tiers:              []model.EventTier{{Tier: 1, Count: 3, Weight: model.WeightScale}},
+		Participants: []model.EventParticipant{
+			{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+			{ID: "b", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Second)},
+			{ID: "c", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(2 * time.Second)},
+		},
+	}
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	return ev
+}
+
+func settlementAmounts(ev model.Event) map[string]int {
+	amounts := make(map[string]int, len(ev.Participants))
+	for _, p := range ev.Participants {
+		amounts[p.ID] = p.SettlementAmount
+	}
+	return amounts
+}
+
+func TestEvent_AssignParticipantAmounts_Settlement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		policy model.RemainderPolicy
+		want   map[string]int
+	}{
+		{
+			name:   "organizer covers what the shares leave over",
+			policy: model.RemainderPolicyOrganizer,
+			want:   map[string]int{"a": 5041, "b": 5041, "c": 5041},
+		},
+		{
+			name:   "distribute adds up to the settlement total",
+			policy: model.RemainderPolicyDistribute,
+			want:   map[string]int{"a": 5043, "b": 5041, "c": 5041},
+		},
+		{
+			name:   "round up converts the rounded amount",
+			policy: model.RemainderPolicyRoundUp10,
+			want:   map[string]int{"a": 6050, "b": 6050, "c": 6050},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := settlementEvent(t, tt.policy)
+
+			assert.Equal(t, 15125, ev.SettlementTotalAmount)
+			assert.Equal(t, tt.want, settlementAmounts(ev))
+		})
+	}
+}
+
+func TestEvent_AssignParticipantAmounts_SettlementWaitlisted(t *testing.T) {
+	t.Parallel()
+
+	ev := settlementEvent(t, model.RemainderPolicyDistribute)
+	ev.Participants[2].Status = model.ParticipantStatusWaitlisted
+	ev.Tiers[0].Count = 2
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+
+	assert.Equal(t, map[string]int{"a": 7563, "b": 7562, "c": 0}, settlementAmounts(ev))
+}
+
+func TestEvent_SettlementOutstanding(t *testing.T) {
+	t.Parallel()
+
+	ev := settlementEvent(t, model.RemainderPolicyDistribute)
+	p := ev.Participants[0]
+	assert.Equal(t, 5043, ev.SettlementDue(p))
+	assert.Equal(t, 5043, ev.SettlementOutstanding(p))
+
+	// $5.00 added for drinks, $20.00 paid so far
+	p.AdjustmentAmount = 500
+	p.PaidAmount = 2000
+	assert.Equal(t, 5043+756, ev.SettlementDue(p))
+	assert.Equal(t, 5043+756-3025, ev.SettlementOutstanding(p))
+
+	p.PaidAmount = p.Due()
+	assert.Zero(t, ev.SettlementOutstanding(p))
+
+	t.Run("no settlement currency", func(t *testing.T) {
+		t.Parallel()
+
+		ev := ev
+		ev.SettlementCurrency = ""
+		p := ev.Participants[1]
+		p.PaidAmount = 1000
+		assert.Equal(t, p.Due(), ev.SettlementDue(p))
+		assert.Equal(t, p.Outstanding(), ev.SettlementOutstanding(p))
+	})
+}
+
+func TestEvent_RefundsOwed_Settlement(t *testing.T) {
+	t.Parallel()
+
+	ev := settlementEvent(t, model.RemainderPolicyDistribute)
+	ev.Participants[1].Status = model.ParticipantStatusConfirmed
+	ev.Participants[1].PaidAmount = 4000
+
+	refunds := ev.RefundsOwed()
+	require.Len(t, refunds, 1)
+	assert.Equal(t, 667, refunds[0].Amount)
+	assert.Equal(t, money.JPY, refunds[0].SettlementCurrency)
+	assert.Equal(t, 6050-5041, refunds[0].SettlementAmount)
+}
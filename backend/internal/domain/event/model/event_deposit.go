@@ -0,0 +1,54 @@
+package model
+
+import (
+	"slices"
+
+	"github.com/mickamy/sampay/internal/lib/money"
+)
+
+// CollectingDeposits reports whether participants are asked for DepositAmount rather than their share of
+// TotalAmount, which is then only the organizer's estimate.
+func (e *Event) CollectingDeposits() bool {
+	return e.DepositAmount != nil && e.DepositSettledAt == nil
+}
+
+// Refund is money the organizer owes back to a participant who paid beyond what they owe.
+type Refund struct {
+	EventID    string
+	EventTitle string
+	// Currency is the event's, which Amount is in.
+	Currency    money.Currency
+	Participant EventParticipant
+	Amount      int
+	// SettlementCurrency and SettlementAmount are the refund in the currency the participant paid in,
+	// empty and zero without a settlement currency.
+	SettlementCurrency money.Currency
+	SettlementAmount   int
+}
+
+// RefundsOwed returns the refunds the organizer still has to make to participants of the event, in join order.
+func (e *Event) RefundsOwed() []Refund {
+	var owed []EventParticipant
+	for _, p := range e.Participants {
+		if p.Refundable() > 0 {
+			owed = append(owed, p)
+		}
+	}
+	slices.SortStableFunc(owed, compareJoinOrder)
+
+	refunds := make([]Refund, len(owed))
+	for i, p := range owed {
+		refunds[i] = Refund{
+			EventID:     e.ID,
+			EventTitle:  e.Title,
+			Currency:    e.Currency,
+			Participant: p,
+			Amount:      p.Refundable(),
+		}
+		if e.HasSettlementCurrency() {
+			refunds[i].SettlementCurrency = e.SettlementCurrency
+			refunds[i].SettlementAmount = e.SettlementRefundable(p)
+		}
+	}
+	return refunds
+}
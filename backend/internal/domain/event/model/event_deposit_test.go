@@ -0,0 +1,122 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/money"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+// depositEvent is a trip estimated at 30000 for three, each asked for a 12000 deposit.
+func depositEvent(now time.Time) model.Event {
+	return model.Event{
+		ID:            "trip",
+		Title:         "trip",
+		TotalAmount:   30000,
+		TierCount:     1,
+		Currency:      money.JPY,
+		DepositAmount: ptr.Of(12000),
+		Tiers:         []model.EventTier{{Tier: 1, Count: 3, Weight: model.WeightScale}},
+		Participants: []model.EventParticipant{
+			{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+			{ID: "b", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Second)},
+			{ID: "c", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(2 * time.Second)},
+		},
+	}
+}
+
+func TestEvent_CollectingDeposits(t *testing.T) {
+	t.Parallel()
+
+	ev := depositEvent(time.Now())
+	assert.True(t, ev.CollectingDeposits())
+	assert.Equal(t, 12000, ev.NextSeatAmount(1))
+
+	ev.Finalize(27000, nil, time.Now())
+	assert.False(t, ev.CollectingDeposits())
+	assert.Equal(t, 27000, ev.TotalAmount)
+	require.NotNil(t, ev.DepositSettledAt)
+
+	ev.DepositAmount = nil
+	assert.False(t, ev.CollectingDeposits())
+}
+
+func TestEvent_AssignParticipantAmounts_Deposit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ev := depositEvent(now)
+	ev.Participants = append(ev.Participants,
+		model.EventParticipant{ID: "d", Tier: 1, Status: model.ParticipantStatusUnpaid, FixedAmount: ptr.Of(0)},
+		model.EventParticipant{ID: "e", Tier: 1, Status: model.ParticipantStatusWaitlisted},
+	)
+	ev.Rounds = []model.EventRound{{
+		Round:        2,
+		TotalAmount:  3000,
+		Tiers:        []model.EventRoundTier{{Tier: 1, Count: 3, Weight: model.WeightScale}},
+		Participants: []model.EventRoundParticipant{{ParticipantID: "a", CreatedAt: now}},
+	}}
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+
+	assert.Equal(t, map[string]int{"a": 12000, "b": 12000, "c": 12000, "d": 0, "e": 0}, participantAmounts(ev),
+		"the deposit covers every round; fixed amounts stand")
+}
+
+func TestEvent_SettleDeposits(t *testing.T) {
+	t.Parallel()
+
+	ev := depositEvent(time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	// a and b paid their deposit, c has not paid yet
+	for i := range 2 {
+		ev.Participants[i].Status = model.ParticipantStatusConfirmed
+		ev.Participants[i].PaidAmount = 12000
+	}
+
+	locked := ev.LockedAmounts()
+	ev.Finalize(27000, nil, time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	adjustments := ev.AdjustLockedAmounts(locked, "actual cost", nil)
+
+	require.Len(t, adjustments, 2)
+	assert.Equal(t, -3000, adjustments[0].Amount)
+	assert.Equal(t, 9000, ev.Participants[0].Due())
+	assert.Equal(t, 9000, ev.Participants[2].Amount, "who had not paid is asked for their share")
+
+	refunds := ev.RefundsOwed()
+	require.Len(t, refunds, 2)
+	assert.Equal(t, "a", refunds[0].Participant.ID)
+	assert.Equal(t, "b", refunds[1].Participant.ID)
+	assert.Equal(t, 3000, refunds[0].Amount)
+	assert.Equal(t, "trip", refunds[0].EventID)
+	assert.Equal(t, money.JPY, refunds[0].Currency)
+}
+
+func TestEvent_SettleDeposits_AdditionalCharge(t *testing.T) {
+	t.Parallel()
+
+	ev := depositEvent(time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	ev.Participants[0].Status = model.ParticipantStatusConfirmed
+	ev.Participants[0].PaidAmount = 12000
+
+	locked := ev.LockedAmounts()
+	ev.Finalize(45000, nil, time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	adjustments := ev.AdjustLockedAmounts(locked, "", nil)
+
+	require.Len(t, adjustments, 1)
+	assert.Equal(t, 3000, adjustments[0].Amount)
+	assert.Equal(t, 3000, ev.Participants[0].Outstanding())
+	assert.Empty(t, ev.RefundsOwed())
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// IsEstimate reports whether TotalAmount is only the organizer's estimate, either announced as one or
+// while the event collects deposits, so what participants are asked for may still change.
+func (e *Event) IsEstimate() bool {
+	return e.EstimatedTotalAmount != nil && e.FinalizedAt == nil || e.CollectingDeposits()
+}
+
+// Finalize replaces the estimated TotalAmount with the actual one, ending the deposit phase as well.
+// The actual bill, when given, makes up TotalAmount and replaces the estimate's breakdown, which is
+// cleared otherwise as it no longer adds up.
+// Amounts need a recalculation afterwards; what it changes for participants who already paid
+// goes through AdjustLockedAmounts, turning into an additional charge or a refund owed.
+func (e *Event) Finalize(totalAmount int, bill *Bill, at time.Time) {
+	e.TotalAmount = totalAmount
+	e.ApplyBill(bill)
+	if e.EstimatedTotalAmount != nil && e.FinalizedAt == nil {
+		e.FinalizedAt = &at
+	}
+	if e.CollectingDeposits() {
+		e.DepositSettledAt = &at
+	}
+}
+
+// DueAmounts returns what each participant off the waitlist owes, keyed by participant ID.
+func (e *Event) DueAmounts() map[string]int {
+	dues := make(map[string]int, len(e.Participants))
+	for _, p := range e.Participants {
+		if !p.IsWaitlisted() {
+			dues[p.ID] = p.Due()
+		}
+	}
+	return dues
+}
+
+// NewTotalFinalized describes the finalization of the event's total, given what participants owed before it
+// as returned by DueAmounts. It lists every participant off the waitlist in join order.
+func (e *Event) NewTotalFinalized(previous map[string]int) TotalFinalized {
+	participants := slices.Clone(e.Participants)
+	slices.SortStableFunc(participants, compareJoinOrder)
+
+	amounts := make([]FinalizedAmount, 0, len(participants))
+	for _, p := range participants {
+		if p.IsWaitlisted() {
+			continue
+		}
+		amounts = append(amounts, FinalizedAmount{
+			ParticipantID: p.ID,
+			PreviousDue:   previous[p.ID],
+			Due:           p.Due(),
+		})
+	}
+	return TotalFinalized{
+		EventID:     e.ID,
+		TotalAmount: e.TotalAmount,
+		Amounts:     amounts,
+	}
+}
@@ -0,0 +1,113 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/money"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+// estimatedEvent is a dinner announced at about 15000 for three.
+func estimatedEvent(now time.Time) model.Event {
+	return model.Event{
+		ID:                   "dinner",
+		TotalAmount:          15000,
+		EstimatedTotalAmount: ptr.Of(15000),
+		TierCount:            1,
+		Currency:             money.JPY,
+		Tiers:                []model.EventTier{{Tier: 1, Count: 3, Weight: model.WeightScale}},
+		Participants: []model.EventParticipant{
+			{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+			{ID: "b", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Second)},
+			{ID: "c", Tier: 1, Status: model.ParticipantStatusWaitlisted, CreatedAt: now.Add(2 * time.Second)},
+		},
+	}
+}
+
+func TestEvent_IsEstimate(t *testing.T) {
+	t.Parallel()
+
+	ev := estimatedEvent(time.Now())
+	ev.ApplyBill(&model.Bill{Subtotal: 15000})
+	assert.True(t, ev.IsEstimate())
+
+	ev.Finalize(18000, nil, time.Now())
+	assert.False(t, ev.IsEstimate())
+	assert.Equal(t, 18000, ev.TotalAmount)
+	assert.Equal(t, 15000, *ev.EstimatedTotalAmount, "the estimate is kept")
+	require.NotNil(t, ev.FinalizedAt)
+	assert.Nil(t, ev.DepositSettledAt)
+	assert.Nil(t, ev.SubtotalAmount, "the estimate's breakdown no longer adds up")
+
+	assert.False(t, (&model.Event{TotalAmount: 15000}).IsEstimate())
+	assert.True(t, (&model.Event{DepositAmount: ptr.Of(5000)}).IsEstimate())
+}
+
+func TestEvent_Finalize(t *testing.T) {
+	t.Parallel()
+
+	ev := estimatedEvent(time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	// a paid against the estimate
+	ev.Participants[0].Status = model.ParticipantStatusConfirmed
+	ev.Participants[0].PaidAmount = 5000
+
+	previous := ev.DueAmounts()
+	assert.Equal(t, map[string]int{"a": 5000, "b": 5000}, previous)
+
+	locked := ev.LockedAmounts()
+	ev.Finalize(18000, nil, time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	adjustments := ev.AdjustLockedAmounts(locked, "", nil)
+
+	require.Len(t, adjustments, 1)
+	assert.Equal(t, 1000, adjustments[0].Amount)
+	assert.Equal(t,
+		model.TotalFinalized{
+			EventID:     "dinner",
+			TotalAmount: 18000,
+			Amounts: []model.FinalizedAmount{
+				{ParticipantID: "a", PreviousDue: 5000, Due: 6000},
+				{ParticipantID: "b", PreviousDue: 5000, Due: 6000},
+			},
+		},
+		ev.NewTotalFinalized(previous),
+	)
+}
+
+func TestEvent_Finalize_Bill(t *testing.T) {
+	t.Parallel()
+
+	ev := estimatedEvent(time.Now())
+	ev.ApplyBill(&model.Bill{Subtotal: 15000})
+
+	ev.Finalize(0, &model.Bill{Subtotal: 16000, TaxRate: 1000, ServiceChargeRate: 1000}, time.Now())
+
+	assert.False(t, ev.IsEstimate())
+	assert.Equal(t, 19360, ev.TotalAmount)
+	require.NotNil(t, ev.SubtotalAmount)
+	assert.Equal(t, 16000, *ev.SubtotalAmount, "the actual bill replaces the estimate's")
+	assert.Equal(t, 1600, ev.ServiceChargeAmount)
+	assert.Equal(t, 1760, ev.TaxAmount)
+}
+
+func TestEvent_Finalize_Deposit(t *testing.T) {
+	t.Parallel()
+
+	ev := depositEvent(time.Now())
+	ev.EstimatedTotalAmount = ptr.Of(30000)
+
+	ev.Finalize(27000, nil, time.Now())
+
+	assert.False(t, ev.IsEstimate())
+	assert.False(t, ev.CollectingDeposits())
+	require.NotNil(t, ev.FinalizedAt)
+	require.NotNil(t, ev.DepositSettledAt)
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+// EventExpense is something one participant paid for on behalf of others, e.g. the hotel or a taxi.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type EventExpense struct {
+	ID        string
+	EventID   string
+	PayerID   string
+	Title     string
+	Amount    int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Shares []EventExpenseShare `rel:"has_many,foreign_key:expense_id"`
+}
+
+// EventExpenseShare is what one covered participant owes for an expense.
+type EventExpenseShare struct {
+	ID            string
+	ExpenseID     string
+	ParticipantID string
+	Amount        int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewEventExpense splits amount equally among the covered participants.
+// The yen that do not split evenly go one each to the first participants in the given order,
+// so the shares always add up to amount.
+func NewEventExpense(eventID, payerID, title string, amount int, participantIDs []string) EventExpense {
+	expense := EventExpense{
+		ID:      ulid.New(),
+		EventID: eventID,
+		PayerID: payerID,
+		Title:   title,
+		Amount:  amount,
+		Shares:  make([]EventExpenseShare, len(participantIDs)),
+	}
+	if len(participantIDs) == 0 {
+		return expense
+	}
+
+	base, extra := amount/len(participantIDs), amount%len(participantIDs)
+	for i, id := range participantIDs {
+		share := base
+		if i < extra {
+			share++
+		}
+		expense.Shares[i] = EventExpenseShare{
+			ID:            ulid.New(),
+			ExpenseID:     expense.ID,
+			ParticipantID: id,
+			Amount:        share,
+		}
+	}
+	return expense
+}
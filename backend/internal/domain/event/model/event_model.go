@@ -2,49 +2,176 @@ package model
 
 import (
 	"slices"
+	"strings"
 	"time"
+
+	"github.com/mickamy/sampay/internal/lib/money"
+)
+
+// RemainderPolicy decides who covers the yen left over when TotalAmount does not split evenly across the tiers.
+type RemainderPolicy string
+
+const (
+	// RemainderPolicyOrganizer leaves the leftover to the organizer as Remainder.
+	RemainderPolicyOrganizer RemainderPolicy = "organizer"
+	// RemainderPolicyRoundUp10 and its siblings round each share up to the unit.
+	// What the shares come to beyond the total goes to the organizer: Remainder turns negative on purpose,
+	// keeping the amounts adding up to the total, and Surplus reports it as a positive amount.
+	RemainderPolicyRoundUp10  RemainderPolicy = "round_up_10"
+	RemainderPolicyRoundUp100 RemainderPolicy = "round_up_100"
+	RemainderPolicyRoundUp500 RemainderPolicy = "round_up_500"
+	// RemainderPolicyDistribute spreads the leftover one yen at a time across the seats,
+	// heavier tiers first and then in join order.
+	RemainderPolicyDistribute RemainderPolicy = "distribute"
 )
 
+func (p RemainderPolicy) IsValid() bool {
+	switch p {
+	case RemainderPolicyOrganizer, RemainderPolicyRoundUp10, RemainderPolicyRoundUp100,
+		RemainderPolicyRoundUp500, RemainderPolicyDistribute:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p RemainderPolicy) roundUnit() int {
+	switch p {
+	case RemainderPolicyRoundUp10:
+		return 10
+	case RemainderPolicyRoundUp100:
+		return 100
+	case RemainderPolicyRoundUp500:
+		return 500
+	default:
+		return 0
+	}
+}
+
 //go:generate go tool ormgen -source=$GOFILE -destination=../query
 type Event struct {
 	ID          string
 	UserID      string
 	Title       string
 	Description string
+	// TotalAmount and every other amount of the event are in minor units of Currency.
 	TotalAmount int
-	Remainder   int
-	TierCount   int
-	HeldAt      time.Time
-	ArchivedAt  *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// SubtotalAmount is the bill TotalAmount was computed from, before the service charge, tax and tip; nil when
+	// TotalAmount was entered as is. The rates and amounts below break TotalAmount down along with it, see Bill.
+	SubtotalAmount      *int
+	TaxRate             Percent
+	TaxIncluded         bool
+	ServiceChargeRate   Percent
+	TipRate             Percent
+	ServiceChargeAmount int
+	// TaxAmount is the tax added to the bill, or the tax the bill includes when TaxIncluded.
+	TaxAmount int
+	TipAmount int
+	// Remainder is what the organizer covers on top of the participant amounts, negative when they come to more.
+	// Surplus is that excess as a positive amount, zero otherwise.
+	Remainder       int
+	Surplus         int
+	RemainderPolicy RemainderPolicy
+	SplitMode       SplitMode
+	// DepositAmount is what each participant pays up front while the actual cost is not known yet, nil when the
+	// event collects no deposit. DepositSettledAt is when the organizer entered the actual TotalAmount, from which
+	// on participants owe their share of it and deposits paid beyond that are refunded.
+	DepositAmount    *int
+	DepositSettledAt *time.Time
+	// EstimatedTotalAmount is what the organizer announced before the bill arrived, e.g. "about 5,000 yen",
+	// nil when the total was known from the start. TotalAmount holds the estimate until FinalizedAt,
+	// when the organizer entered the actual total.
+	EstimatedTotalAmount *int
+	FinalizedAt          *time.Time
+	Currency             money.Currency
+	// SettlementCurrency is what participants actually pay in when it differs from Currency, e.g. yen for a trip
+	// abroad. ExchangeRate and ExchangeRateAt snapshot the rate used, so later rate changes do not move amounts.
+	SettlementCurrency    money.Currency
+	ExchangeRate          money.Rate
+	ExchangeRateAt        *time.Time
+	SettlementTotalAmount int
+	TierCount             int
+	HeldAt                time.Time
+	// EndsAt is when the event ends, which turns participants' arrival and departure times into attendance.
+	EndsAt     *time.Time
+	ArchivedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 
 	Tiers        []EventTier        `rel:"has_many,foreign_key:event_id"`
 	Participants []EventParticipant `rel:"has_many,foreign_key:event_id"`
+	// Rounds are the later rounds, loaded with their tiers and participants by the EventRound repository.
+	Rounds []EventRound `db:"-"`
 }
 
 // CalcTierAmounts computes the per-tier amount and sets it on each EventTier
-// in-place, then sets Remainder on the event.
-// The tier value itself is the weight (e.g. tier=3 means weight 3).
+// in-place, then sets Remainder, Surplus and SettlementTotalAmount on the event. Later rounds are worked out the
+// same way, each on its own total and tiers.
+// Participants with a FixedAmount are taken out first; the rest of TotalAmount is split across the
+// remaining seats, each tier's share being its EffectiveWeight times its shared seats over the sum of those.
+// With every seat filled, the participant amounts plus Remainder add up to TotalAmount exactly.
+// Under an attendance split the rest is split among the participants instead, see SplitMode.
 func (e *Event) CalcTierAmounts() {
-	var totalWeight int
-	for _, t := range e.Tiers {
-		totalWeight += t.Tier * t.Count
+	e.calcTierAmounts()
+	e.Surplus = max(-e.Remainder, 0)
+}
+
+func (e *Event) calcTierAmounts() {
+	e.SettlementTotalAmount = 0
+	if e.HasSettlementCurrency() {
+		e.SettlementTotalAmount = e.SettlementAmount(e.GrandTotal())
+	}
+	e.calcRoundAmounts()
+	if e.SplitMode.ByAttendance() {
+		e.calcAttendanceAmounts()
+		return
 	}
+
+	shareTotal := e.TotalAmount - e.FixedTotal()
+	totalWeight := e.totalWeight()
 	if totalWeight == 0 {
 		e.Remainder = 0
+		if slices.ContainsFunc(e.Participants, EventParticipant.HasFixedAmount) {
+			// every seat is pinned, so the organizer covers whatever the fixed amounts do not
+			e.Remainder = shareTotal
+		}
 		for i := range e.Tiers {
 			e.Tiers[i].Amount = 0
 		}
 		return
 	}
 
-	sum := 0
 	for i := range e.Tiers {
-		e.Tiers[i].Amount = e.TotalAmount * e.Tiers[i].Tier / totalWeight
-		sum += e.Tiers[i].Amount * e.Tiers[i].Count
+		e.Tiers[i].Amount = e.share(shareTotal, int(e.Tiers[i].EffectiveWeight()), totalWeight)
+	}
+
+	if e.RemainderPolicy == RemainderPolicyDistribute {
+		// the leftover is carried by the seats, see SeatAmount
+		e.Remainder = 0
+		return
 	}
-	e.Remainder = e.TotalAmount - sum
+	e.Remainder = e.leftover()
+}
+
+func (e *Event) HasSettlementCurrency() bool {
+	return e.SettlementCurrency != "" && e.SettlementCurrency != e.Currency
+}
+
+// SettlementAmount converts an amount of the event into the settlement currency at the snapshotted rate.
+// Without a settlement currency it returns the amount as is.
+func (e *Event) SettlementAmount(amount int) int {
+	if !e.HasSettlementCurrency() {
+		return amount
+	}
+	return e.ExchangeRate.Convert(amount, e.Currency, e.SettlementCurrency)
+}
+
+// PaymentCurrency is what participants pay in, the settlement currency when there is one.
+func (e *Event) PaymentCurrency() money.Currency {
+	if e.HasSettlementCurrency() {
+		return e.SettlementCurrency
+	}
+	return e.Currency
 }
 
 // SortTiers sorts Tiers by tier number in ascending order.
@@ -63,3 +190,266 @@ func (e *Event) TierAmount(tier int) int {
 	}
 	return 0
 }
+
+// SeatAmount returns what the participant holding the given shared seat (0-based, in join order) of the tier owes.
+// Participants with a FixedAmount hold no shared seat.
+// It differs from TierAmount only under RemainderPolicyDistribute, where the first seats take one extra yen each.
+func (e *Event) SeatAmount(tier, seat int) int {
+	amount := e.TierAmount(tier)
+	if e.RemainderPolicy != RemainderPolicyDistribute {
+		return amount
+	}
+
+	weight := e.tierWeight(tier)
+
+	// seats of heavier tiers come first, ties broken by the higher tier number
+	offset := 0
+	for _, t := range e.Tiers {
+		if w := t.EffectiveWeight(); w > weight || w == weight && t.Tier > tier {
+			offset += e.sharedSeats(t)
+		}
+	}
+	if offset+seat < e.leftover() {
+		amount++
+	}
+	return amount
+}
+
+// NextSeatAmount returns what the next participant to take a spot in the tier owes.
+// Under an attendance split their share depends on everyone else's, so it takes a recalculation instead.
+// While the event collects deposits, it is the deposit.
+func (e *Event) NextSeatAmount(tier int) int {
+	if e.CollectingDeposits() {
+		return *e.DepositAmount
+	}
+	seat := 0
+	for _, p := range e.Participants {
+		if p.Tier == tier && !p.IsWaitlisted() && !p.HasFixedAmount() {
+			seat++
+		}
+	}
+	return e.SeatAmount(tier, seat)
+}
+
+// AssignParticipantAmounts sets Amount on each participant from the seat they hold in their tier,
+// plus their share of each later round they opted into.
+// Seats are handed out in join order; waitlisted participants hold no seat and owe nothing,
+// and participants with a FixedAmount owe exactly that for the first round.
+// Under an attendance split the first round is their share of the attendance instead of a seat.
+// While the event collects deposits, everyone else owes DepositAmount alone.
+func (e *Event) AssignParticipantAmounts() {
+	active := make([]int, 0, len(e.Participants))
+	for i, p := range e.Participants {
+		switch {
+		case p.IsWaitlisted():
+			e.Participants[i].Amount = 0
+		case p.HasFixedAmount():
+			e.Participants[i].Amount = *p.FixedAmount
+		default:
+			active = append(active, i)
+		}
+	}
+	slices.SortStableFunc(active, func(a, b int) int {
+		return compareJoinOrder(e.Participants[a], e.Participants[b])
+	})
+	if e.CollectingDeposits() {
+		// the deposit covers every round, so shares are only worked out once it is settled
+		for _, i := range active {
+			e.Participants[i].Amount = *e.DepositAmount
+		}
+		e.assignSettlementAmounts()
+		return
+	}
+
+	if e.SplitMode.ByAttendance() {
+		amounts, _ := e.attendanceAmounts()
+		for _, i := range active {
+			e.Participants[i].Amount = amounts[i]
+		}
+	} else {
+		seats := make(map[int]int, len(e.Tiers))
+		for _, i := range active {
+			p := &e.Participants[i]
+			p.Amount = e.SeatAmount(p.Tier, seats[p.Tier])
+			seats[p.Tier]++
+		}
+	}
+
+	for _, r := range e.Rounds {
+		for id, amount := range e.RoundShares(r) {
+			idx := slices.IndexFunc(e.Participants, func(p EventParticipant) bool {
+				return p.ID == id
+			})
+			e.Participants[idx].Amount += amount
+		}
+	}
+	e.assignSettlementAmounts()
+}
+
+// AmountsLocked reports whether what participants owe can no longer change by a recalculation alone,
+// because someone has already claimed or paid the amount they were asked to pay.
+func (e *Event) AmountsLocked() bool {
+	return slices.ContainsFunc(e.Participants, EventParticipant.AmountLocked)
+}
+
+// LockedAmounts returns the Amount of each participant whose amount is locked, keyed by their ID.
+// Take it before a recalculation and hand it to AdjustLockedAmounts afterwards.
+func (e *Event) LockedAmounts() map[string]int {
+	locked := make(map[string]int)
+	for _, p := range e.Participants {
+		if p.AmountLocked() {
+			locked[p.ID] = p.Amount
+		}
+	}
+	return locked
+}
+
+// AdjustLockedAmounts puts back the Amount a recalculation overwrote for the participants in locked,
+// and turns the difference between the recalculated amount and what they owed into an adjustment.
+// It updates AdjustmentAmount in-place and returns the adjustments made; statuses are left to Settle.
+func (e *Event) AdjustLockedAmounts(
+	locked map[string]int, note string, recordedByUserID *string,
+) []EventParticipantAdjustment {
+	var adjustments []EventParticipantAdjustment
+	for i := range e.Participants {
+		p := &e.Participants[i]
+		amount, ok := locked[p.ID]
+		if !ok {
+			continue
+		}
+		recalculated := p.Amount
+		p.Amount = amount
+		diff := recalculated - p.Due()
+		if diff == 0 {
+			continue
+		}
+		p.AdjustmentAmount += diff
+		adjustments = append(adjustments, NewParticipantAdjustment(*p, diff, note, recordedByUserID))
+	}
+	return adjustments
+}
+
+// HasVacancy reports whether the given tier can take one more participant without a waitlist.
+// A tier's Count is its capacity, as the per-person amounts are computed for exactly that many people.
+func (e *Event) HasVacancy(tier int) bool {
+	for _, t := range e.Tiers {
+		if t.Tier == tier {
+			return e.activeCount(tier) < t.Count
+		}
+	}
+	return false
+}
+
+// PromoteWaitlisted moves waitlisted participants into the spots free in their tier, first come first served.
+// It updates Participants in-place, reassigns their amounts and returns the promoted ones.
+func (e *Event) PromoteWaitlisted() []EventParticipant {
+	waitlisted := make([]int, 0)
+	for i, p := range e.Participants {
+		if p.IsWaitlisted() {
+			waitlisted = append(waitlisted, i)
+		}
+	}
+	slices.SortStableFunc(waitlisted, func(a, b int) int {
+		return compareJoinOrder(e.Participants[a], e.Participants[b])
+	})
+
+	var promotedIdx []int
+	for _, i := range waitlisted {
+		p := &e.Participants[i]
+		if !e.HasVacancy(p.Tier) {
+			continue
+		}
+		p.Status = ParticipantStatusUnpaid
+		promotedIdx = append(promotedIdx, i)
+	}
+	if len(promotedIdx) == 0 {
+		return nil
+	}
+
+	e.AssignParticipantAmounts()
+	promoted := make([]EventParticipant, len(promotedIdx))
+	for i, idx := range promotedIdx {
+		promoted[i] = e.Participants[idx]
+	}
+	return promoted
+}
+
+func (e *Event) activeCount(tier int) int {
+	n := 0
+	for _, p := range e.Participants {
+		if p.Tier == tier && !p.IsWaitlisted() {
+			n++
+		}
+	}
+	return n
+}
+
+// FixedTotal returns the sum of the fixed amounts pinned on participants holding a spot.
+func (e *Event) FixedTotal() int {
+	total := 0
+	for _, p := range e.Participants {
+		if p.HasFixedAmount() && !p.IsWaitlisted() {
+			total += *p.FixedAmount
+		}
+	}
+	return total
+}
+
+// sharedSeats returns how many of the tier's seats split the total, i.e. those not taken by a fixed amount.
+func (e *Event) sharedSeats(t EventTier) int {
+	n := t.Count
+	for _, p := range e.Participants {
+		if p.Tier == t.Tier && p.HasFixedAmount() && !p.IsWaitlisted() {
+			n--
+		}
+	}
+	return max(n, 0)
+}
+
+// tierWeight returns the EffectiveWeight of the given tier number, zero for a tier the event does not have.
+func (e *Event) tierWeight(tier int) Weight {
+	for _, t := range e.Tiers {
+		if t.Tier == tier {
+			return t.EffectiveWeight()
+		}
+	}
+	return 0
+}
+
+func (e *Event) totalWeight() int {
+	total := 0
+	for _, t := range e.Tiers {
+		total += int(t.EffectiveWeight()) * e.sharedSeats(t)
+	}
+	return total
+}
+
+// leftover is what the tier amounts fall short of the shared total with every seat filled.
+func (e *Event) leftover() int {
+	sum := e.FixedTotal()
+	for _, t := range e.Tiers {
+		sum += t.Amount * e.sharedSeats(t)
+	}
+	return e.TotalAmount - sum
+}
+
+// share returns the part of amount that weight takes out of totalWeight, rounded up to the remainder
+// policy's unit if it has one. Round-up units are whole units of the currency, e.g. $10 rather than 10 cents.
+func (e *Event) share(amount, weight, totalWeight int) int {
+	unit := e.RemainderPolicy.roundUnit() * e.Currency.MinorScale()
+	if unit > 0 {
+		return ceilDiv(amount*weight, totalWeight*unit) * unit
+	}
+	return amount * weight / totalWeight
+}
+
+func compareJoinOrder(a, b EventParticipant) int {
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
@@ -2,10 +2,14 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/money"
+	"github.com/mickamy/sampay/internal/lib/ptr"
 )
 
 func TestEvent_CalcTierAmounts(t *testing.T) {
@@ -75,6 +79,31 @@ func TestEvent_CalcTierAmounts(t *testing.T) {
 			wantAmounts:   map[int]int{3: 3750, 1: 1250},
 			wantRemainder: 1,
 		},
+		{
+			name:        "decimal weights",
+			totalAmount: 10000,
+			tiers: []model.EventTier{
+				{Tier: 1, Name: "students", Weight: 500, Count: 2},
+				{Tier: 2, Name: "members", Weight: 1000, Count: 2},
+				{Tier: 3, Name: "managers", Weight: 2000, Count: 1},
+			},
+			// totalWeight = 0.5*2 + 1*2 + 2*1 = 5
+			// students: 10000*0.5/5=1000, members: 2000, managers: 4000
+			wantAmounts:   map[int]int{1: 1000, 2: 2000, 3: 4000},
+			wantRemainder: 0,
+		},
+		{
+			name:        "weights override tier numbers",
+			totalAmount: 10000,
+			tiers: []model.EventTier{
+				{Tier: 1, Weight: 3000, Count: 1},
+				{Tier: 2, Weight: 1000, Count: 1},
+				{Tier: 3, Weight: 1000, Count: 1},
+			},
+			// totalWeight = 5, tier1: 6000, tier2 and tier3: 2000
+			wantAmounts:   map[int]int{1: 6000, 2: 2000, 3: 2000},
+			wantRemainder: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,3 +125,407 @@ func TestEvent_CalcTierAmounts(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_HasVacancy(t *testing.T) {
+	t.Parallel()
+
+	ev := model.Event{
+		Tiers: []model.EventTier{
+			{Tier: 1, Count: 2},
+			{Tier: 2, Count: 1},
+		},
+		Participants: []model.EventParticipant{
+			{Tier: 1, Status: model.ParticipantStatusUnpaid},
+			{Tier: 1, Status: model.ParticipantStatusWaitlisted},
+			{Tier: 2, Status: model.ParticipantStatusConfirmed},
+		},
+	}
+
+	assert.True(t, ev.HasVacancy(1), "waitlisted participants do not take a spot")
+	assert.False(t, ev.HasVacancy(2))
+	assert.False(t, ev.HasVacancy(3), "unknown tier")
+}
+
+func TestEvent_AmountsLocked(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status []model.ParticipantStatus
+		want   bool
+	}{
+		{name: "no participants", want: false},
+		{name: "unpaid and waitlisted", status: []model.ParticipantStatus{
+			model.ParticipantStatusUnpaid, model.ParticipantStatusWaitlisted,
+		}, want: false},
+		{name: "claimed", status: []model.ParticipantStatus{
+			model.ParticipantStatusUnpaid, model.ParticipantStatusClaimed,
+		}, want: true},
+		{name: "confirmed", status: []model.ParticipantStatus{model.ParticipantStatusConfirmed}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var ev model.Event
+			for _, s := range tt.status {
+				ev.Participants = append(ev.Participants, model.EventParticipant{Tier: 1, Status: s})
+			}
+
+			assert.Equal(t, tt.want, ev.AmountsLocked())
+		})
+	}
+}
+
+func TestEvent_AdjustLockedAmounts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ev := model.Event{
+		TotalAmount: 9000,
+		Tiers:       []model.EventTier{{Tier: 1, Count: 3, Weight: 1}},
+		Participants: []model.EventParticipant{
+			{ID: "confirmed", Tier: 1, Amount: 3000, PaidAmount: 3000, Status: model.ParticipantStatusConfirmed, CreatedAt: now},
+			{ID: "claimed", Tier: 1, Amount: 3000, Status: model.ParticipantStatusClaimed, CreatedAt: now.Add(time.Second)},
+			{ID: "unpaid", Tier: 1, Amount: 3000, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(2 * time.Second)},
+		},
+	}
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	locked := ev.LockedAmounts()
+	require.Len(t, locked, 2)
+
+	// the dessert nobody ordered comes to 1200
+	ev.TotalAmount = 10200
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	organizer := "organizer"
+	adjustments := ev.AdjustLockedAmounts(locked, "dessert", &organizer)
+
+	require.Len(t, adjustments, 2)
+	for _, a := range adjustments {
+		assert.Equal(t, 400, a.Amount)
+		assert.Equal(t, "dessert", *a.Note)
+	}
+	for _, p := range ev.Participants[:2] {
+		assert.Equal(t, 3000, p.Amount, "%s keeps what they were asked for", p.ID)
+		assert.Equal(t, 400, p.AdjustmentAmount)
+		assert.Equal(t, 3400, p.Due())
+	}
+	assert.Equal(t, 3400, ev.Participants[2].Amount, "unpaid is simply recalculated")
+	assert.Zero(t, ev.Participants[2].AdjustmentAmount)
+
+	// a refund later on adjusts from what they owe by then, not from Amount
+	locked = ev.LockedAmounts()
+	ev.TotalAmount = 8400
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	adjustments = ev.AdjustLockedAmounts(locked, "", nil)
+
+	require.Len(t, adjustments, 2)
+	for _, a := range adjustments {
+		assert.Equal(t, -600, a.Amount)
+		assert.Nil(t, a.Note)
+	}
+	assert.Equal(t, 2800, ev.Participants[0].Due())
+	assert.Equal(t, 200, ev.Participants[0].Refundable())
+	assert.Equal(t, 2800, ev.Participants[2].Amount)
+
+	locked = ev.LockedAmounts()
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+	assert.Empty(t, ev.AdjustLockedAmounts(locked, "", nil), "nothing changed")
+}
+
+func TestEvent_PromoteWaitlisted(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ev := model.Event{
+		Tiers: []model.EventTier{
+			{Tier: 1, Count: 2, Amount: 1000},
+			{Tier: 2, Count: 1, Amount: 2000},
+		},
+		Participants: []model.EventParticipant{
+			{ID: "active", Tier: 1, Status: model.ParticipantStatusUnpaid, Amount: 1000, CreatedAt: now},
+			{ID: "late", Tier: 1, Status: model.ParticipantStatusWaitlisted, CreatedAt: now.Add(2 * time.Minute)},
+			{ID: "early", Tier: 1, Status: model.ParticipantStatusWaitlisted, CreatedAt: now.Add(time.Minute)},
+			{ID: "full", Tier: 2, Status: model.ParticipantStatusUnpaid, Amount: 2000, CreatedAt: now},
+			{ID: "waiting", Tier: 2, Status: model.ParticipantStatusWaitlisted, CreatedAt: now.Add(time.Minute)},
+		},
+	}
+
+	promoted := ev.PromoteWaitlisted()
+
+	assert.Len(t, promoted, 1)
+	assert.Equal(t, "early", promoted[0].ID)
+	assert.Equal(t, model.ParticipantStatusUnpaid, promoted[0].Status)
+	assert.Equal(t, 1000, promoted[0].Amount)
+	assert.Equal(t, model.ParticipantStatusUnpaid, ev.Participants[2].Status)
+	assert.Equal(t, model.ParticipantStatusWaitlisted, ev.Participants[1].Status)
+	assert.Equal(t, model.ParticipantStatusWaitlisted, ev.Participants[4].Status)
+}
+
+func TestEvent_CalcTierAmounts_RemainderPolicy(t *testing.T) {
+	t.Parallel()
+
+	tiers := func() []model.EventTier {
+		return []model.EventTier{
+			{Tier: 3, Count: 1},
+			{Tier: 2, Count: 2},
+			{Tier: 1, Count: 3},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		policy        model.RemainderPolicy
+		wantAmounts   map[int]int
+		wantRemainder int
+		wantSurplus   int
+	}{
+		{
+			name:   "organizer",
+			policy: model.RemainderPolicyOrganizer,
+			// totalWeight = 3+4+3 = 10
+			// tier3: 10007*3/10=3002, tier2: 2001, tier1: 1000
+			// sum = 3002+4002+3000 = 10004
+			wantAmounts:   map[int]int{3: 3002, 2: 2001, 1: 1000},
+			wantRemainder: 3,
+		},
+		{
+			name:          "round up to 10",
+			policy:        model.RemainderPolicyRoundUp10,
+			wantAmounts:   map[int]int{3: 3010, 2: 2010, 1: 1010},
+			wantRemainder: 10007 - (3010 + 2010*2 + 1010*3),
+			wantSurplus:   3010 + 2010*2 + 1010*3 - 10007,
+		},
+		{
+			name:          "round up to 100",
+			policy:        model.RemainderPolicyRoundUp100,
+			wantAmounts:   map[int]int{3: 3100, 2: 2100, 1: 1100},
+			wantRemainder: 10007 - (3100 + 2100*2 + 1100*3),
+			wantSurplus:   3100 + 2100*2 + 1100*3 - 10007,
+		},
+		{
+			name:          "round up to 500",
+			policy:        model.RemainderPolicyRoundUp500,
+			wantAmounts:   map[int]int{3: 3500, 2: 2500, 1: 1500},
+			wantRemainder: 10007 - (3500 + 2500*2 + 1500*3),
+			wantSurplus:   3500 + 2500*2 + 1500*3 - 10007,
+		},
+		{
+			name:          "distribute",
+			policy:        model.RemainderPolicyDistribute,
+			wantAmounts:   map[int]int{3: 3002, 2: 2001, 1: 1000},
+			wantRemainder: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := model.Event{
+				TotalAmount:     10007,
+				RemainderPolicy: tt.policy,
+				Tiers:           tiers(),
+			}
+			ev.CalcTierAmounts()
+
+			got := make(map[int]int, len(ev.Tiers))
+			for _, tier := range ev.Tiers {
+				got[tier.Tier] = tier.Amount
+			}
+			assert.Equal(t, tt.wantAmounts, got)
+			assert.Equal(t, tt.wantRemainder, ev.Remainder)
+			assert.Equal(t, tt.wantSurplus, ev.Surplus)
+		})
+	}
+}
+
+func TestEvent_AssignParticipantAmounts(t *testing.T) {
+	t.Parallel()
+
+	policies := []model.RemainderPolicy{
+		model.RemainderPolicyOrganizer,
+		model.RemainderPolicyRoundUp10,
+		model.RemainderPolicyRoundUp100,
+		model.RemainderPolicyRoundUp500,
+		model.RemainderPolicyDistribute,
+	}
+
+	for _, policy := range policies {
+		t.Run(string(policy), func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			ev := model.Event{
+				TotalAmount:     10007,
+				RemainderPolicy: policy,
+				Tiers: []model.EventTier{
+					{Tier: 3, Count: 1},
+					{Tier: 2, Count: 2},
+					{Tier: 1, Count: 3},
+				},
+				Participants: []model.EventParticipant{
+					{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(2 * time.Minute)},
+					{ID: "b", Tier: 1, Status: model.ParticipantStatusConfirmed, CreatedAt: now},
+					{ID: "c", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+					{ID: "d", Tier: 2, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+					{ID: "e", Tier: 2, Status: model.ParticipantStatusClaimed, CreatedAt: now.Add(time.Minute)},
+					{ID: "f", Tier: 3, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+					{ID: "w", Tier: 3, Status: model.ParticipantStatusWaitlisted, CreatedAt: now.Add(time.Minute)},
+				},
+			}
+			ev.CalcTierAmounts()
+			ev.AssignParticipantAmounts()
+
+			sum := 0
+			for _, p := range ev.Participants {
+				sum += p.Amount
+			}
+			assert.Equal(t, ev.TotalAmount, sum+ev.Remainder)
+			assert.Zero(t, ev.Participants[6].Amount, "waitlisted participants owe nothing")
+		})
+	}
+}
+
+func TestEvent_AssignParticipantAmounts_Distribute(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ev := model.Event{
+		TotalAmount:     10007,
+		RemainderPolicy: model.RemainderPolicyDistribute,
+		Tiers: []model.EventTier{
+			{Tier: 3, Count: 1},
+			{Tier: 2, Count: 2},
+			{Tier: 1, Count: 3},
+		},
+		Participants: []model.EventParticipant{
+			{ID: "late", Tier: 2, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Minute)},
+			{ID: "early", Tier: 2, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+			{ID: "heavy", Tier: 3, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Hour)},
+			{ID: "light", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+		},
+	}
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+
+	// 3 yen left over: the tier 3 seat first, then the tier 2 seats in join order
+	assert.Equal(t, 2002, ev.Participants[0].Amount)
+	assert.Equal(t, 2002, ev.Participants[1].Amount)
+	assert.Equal(t, 3003, ev.Participants[2].Amount)
+	assert.Equal(t, 1000, ev.Participants[3].Amount)
+	assert.Equal(t, 1000, ev.NextSeatAmount(1))
+}
+
+func TestEvent_FixedAmounts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ev := model.Event{
+		TotalAmount:     20000,
+		RemainderPolicy: model.RemainderPolicyDistribute,
+		Tiers: []model.EventTier{
+			{Tier: 1, Count: 3},
+			{Tier: 2, Count: 2},
+		},
+		Participants: []model.EventParticipant{
+			{ID: "guest", Tier: 2, Status: model.ParticipantStatusUnpaid, FixedAmount: ptr.Of(0), CreatedAt: now},
+			{ID: "prepaid", Tier: 1, Status: model.ParticipantStatusUnpaid, FixedAmount: ptr.Of(3000), CreatedAt: now},
+			{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Minute)},
+			{ID: "b", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(2 * time.Minute)},
+			{ID: "c", Tier: 2, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Minute)},
+		},
+	}
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+
+	// 17000 left for weights 1+1+2 = 4: 4250 and 8500
+	assert.Equal(t, 3000, ev.FixedTotal())
+	assert.Equal(t, 4250, ev.TierAmount(1))
+	assert.Equal(t, 8500, ev.TierAmount(2))
+
+	sum := 0
+	for _, p := range ev.Participants {
+		sum += p.Amount
+	}
+	assert.Equal(t, []int{0, 3000, 4250, 4250, 8500}, []int{
+		ev.Participants[0].Amount, ev.Participants[1].Amount, ev.Participants[2].Amount,
+		ev.Participants[3].Amount, ev.Participants[4].Amount,
+	})
+	assert.Equal(t, ev.TotalAmount, sum+ev.Remainder)
+}
+
+func TestEvent_FixedAmounts_AllPinned(t *testing.T) {
+	t.Parallel()
+
+	ev := model.Event{
+		TotalAmount: 10000,
+		Tiers:       []model.EventTier{{Tier: 1, Count: 1}},
+		Participants: []model.EventParticipant{
+			{ID: "guest", Tier: 1, Status: model.ParticipantStatusUnpaid, FixedAmount: ptr.Of(0)},
+		},
+	}
+	ev.CalcTierAmounts()
+
+	assert.Equal(t, 10000, ev.Remainder, "the organizer covers what nobody shares")
+}
+
+func TestEvent_CalcTierAmounts_Currency(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round up in whole units of the currency", func(t *testing.T) {
+		t.Parallel()
+
+		ev := model.Event{
+			// $100.00 for 3 people
+			TotalAmount:     10000,
+			Currency:        money.USD,
+			RemainderPolicy: model.RemainderPolicyRoundUp10,
+			Tiers:           []model.EventTier{{Tier: 1, Count: 3}},
+		}
+		ev.CalcTierAmounts()
+
+		assert.Equal(t, 4000, ev.TierAmount(1), "$33.34 rounds up to $40.00")
+		assert.Equal(t, -2000, ev.Remainder)
+		assert.Equal(t, 2000, ev.Surplus)
+	})
+
+	t.Run("minor units split evenly", func(t *testing.T) {
+		t.Parallel()
+
+		ev := model.Event{
+			// $100.00 for 3 people
+			TotalAmount: 10000,
+			Currency:    money.USD,
+			Tiers:       []model.EventTier{{Tier: 1, Count: 3}},
+		}
+		ev.CalcTierAmounts()
+
+		assert.Equal(t, 3333, ev.TierAmount(1))
+		assert.Equal(t, 1, ev.Remainder)
+		assert.Zero(t, ev.SettlementTotalAmount)
+	})
+
+	t.Run("settlement currency", func(t *testing.T) {
+		t.Parallel()
+
+		rate, err := money.ParseRate("151.25")
+		require.NoError(t, err)
+		ev := model.Event{
+			TotalAmount:        10000,
+			Currency:           money.USD,
+			SettlementCurrency: money.JPY,
+			ExchangeRate:       rate,
+			Tiers:              []model.EventTier{{Tier: 1, Count: 3}},
+		}
+		ev.CalcTierAmounts()
+
+		assert.Equal(t, 15125, ev.SettlementTotalAmount)
+		assert.Equal(t, 5041, ev.SettlementAmount(ev.TierAmount(1)), "$33.33 is 5041.16 yen")
+	})
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+// EventParticipantAdjustment is an entry of a participant's adjustments ledger, a change to what they owe made
+// after their Amount was locked: an additional charge when positive and a refund owed when negative.
+// Entries are never updated.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type EventParticipantAdjustment struct {
+	ID            string
+	EventID       string
+	ParticipantID string
+	Amount        int
+	// Note is why the bill changed, e.g. a forgotten dessert or a refund from the venue.
+	Note *string
+	// RecordedByUserID is the organizer whose edit made the adjustment.
+	RecordedByUserID *string
+	CreatedAt        time.Time
+}
+
+// NewParticipantAdjustment records that what the participant owes changed by the amount.
+// An empty note is stored as nil.
+func NewParticipantAdjustment(
+	p EventParticipant, amount int, note string, recordedByUserID *string,
+) EventParticipantAdjustment {
+	m := EventParticipantAdjustment{
+		ID:               ulid.New(),
+		EventID:          p.EventID,
+		ParticipantID:    p.ID,
+		Amount:           amount,
+		RecordedByUserID: recordedByUserID,
+	}
+	if note != "" {
+		m.Note = &note
+	}
+	return m
+}
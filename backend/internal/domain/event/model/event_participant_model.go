@@ -1,6 +1,15 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/random"
+)
 
 type ParticipantStatus string
 
@@ -8,16 +17,186 @@ const (
 	ParticipantStatusUnpaid    ParticipantStatus = "unpaid"
 	ParticipantStatusClaimed   ParticipantStatus = "claimed"
 	ParticipantStatusConfirmed ParticipantStatus = "confirmed"
+	// ParticipantStatusPartiallyPaid is for people who paid some but not all of their Amount.
+	// Like confirmed, it follows from the payments ledger rather than being set directly.
+	ParticipantStatusPartiallyPaid ParticipantStatus = "partially_paid"
+	// ParticipantStatusWaitlisted is for people who joined a full tier.
+	// They owe nothing until they are promoted to unpaid when a spot frees up.
+	ParticipantStatusWaitlisted ParticipantStatus = "waitlisted"
 )
 
+// participantStatusTransitions lists where each status may move to. Confirmed is final for the organizer:
+// once they have the money, undoing it is a refund rather than a status change.
+var participantStatusTransitions = map[ParticipantStatus][]ParticipantStatus{
+	ParticipantStatusWaitlisted: {ParticipantStatusUnpaid},
+	// organizers confirm payments made outside the app, e.g. in cash, without a claim
+	ParticipantStatusUnpaid: {ParticipantStatusClaimed, ParticipantStatusPartiallyPaid, ParticipantStatusConfirmed},
+	// organizers send a claim back when the money never arrived, or only part of it did
+	ParticipantStatusClaimed: {ParticipantStatusUnpaid, ParticipantStatusPartiallyPaid, ParticipantStatusConfirmed},
+	// participants claim the rest once they have sent it
+	ParticipantStatusPartiallyPaid: {ParticipantStatusClaimed, ParticipantStatusConfirmed},
+	// an additional charge after the bill changed leaves part of the new amount to pay
+	ParticipantStatusConfirmed: {ParticipantStatusPartiallyPaid},
+}
+
+// CanTransitionTo reports whether the status may move to the given one.
+func (s ParticipantStatus) CanTransitionTo(to ParticipantStatus) bool {
+	return slices.Contains(participantStatusTransitions[s], to)
+}
+
 //go:generate go tool ormgen -source=$GOFILE -destination=../query
 type EventParticipant struct {
-	ID        string
-	EventID   string
+	ID      string
+	EventID string
+	// EndUserID links the participant to a sampay account when they joined while logged in, so we can reach them.
+	EndUserID *string
 	Name      string
 	Tier      int
 	Amount    int
-	Status    ParticipantStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	// PaidAmount is the sum of the participant's payments ledger, kept here so listing needs no aggregation.
+	PaidAmount int
+	// AdjustmentAmount is the sum of the participant's adjustments ledger, what the bill changed by after
+	// Amount was locked. Amount itself keeps what they were first asked to pay.
+	AdjustmentAmount int
+	// SettlementAmount is Amount in the event's settlement currency, the participant's share of
+	// SettlementTotalAmount; zero without a settlement currency. See Event.SettlementDue.
+	SettlementAmount int
+	// FixedAmount pins Amount, e.g. to zero for the guest of honor or to what someone prepaid.
+	// Everyone else splits what is left of TotalAmount. Nil means the participant pays their tier's share.
+	// It covers the first round only; later rounds they opt into are added on top.
+	FixedAmount *int
+	// ArrivedAt and LeftAt are when the participant came late or left early, nil for the event's start and end.
+	// Along with Attendance, they only move amounts under an attendance split.
+	ArrivedAt *time.Time
+	LeftAt    *time.Time
+	// Attendance overrides the times with how much of the event the participant attended, e.g. 0.5 for half.
+	Attendance *Attendance
+	Status     ParticipantStatus
+	// TokenHash is the SHA-256 of the secret handed to the participant on join.
+	// Only its holder can act as the participant. It is nil for participants who joined before tokens were
+	// issued, who cannot act on their own until the organizer reissues one.
+	TokenHash *string
+	// ClaimedAt is when the participant said they paid, kept once confirmed and cleared if the claim is sent back.
+	ClaimedAt   *time.Time
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (p EventParticipant) IsWaitlisted() bool {
+	return p.Status == ParticipantStatusWaitlisted
+}
+
+// HasOutstandingPayment reports whether the organizer is still waiting on the participant's money,
+// including when the participant has claimed it but the organizer has not confirmed yet.
+func (p EventParticipant) HasOutstandingPayment() bool {
+	return p.Status == ParticipantStatusUnpaid || p.Status == ParticipantStatusClaimed ||
+		p.Status == ParticipantStatusPartiallyPaid
+}
+
+// AmountLocked reports whether the participant has acted on what they were asked to pay,
+// so a change to it has to go through the adjustments ledger rather than rewriting Amount.
+func (p EventParticipant) AmountLocked() bool {
+	if p.IsWaitlisted() {
+		return false
+	}
+	return p.Status != ParticipantStatusUnpaid || p.AdjustmentAmount != 0
+}
+
+// Due returns what the participant owes in total, Amount with the adjustments applied.
+func (p EventParticipant) Due() int {
+	return p.Amount + p.AdjustmentAmount
+}
+
+// Outstanding returns what the participant still owes after the payments recorded so far.
+func (p EventParticipant) Outstanding() int {
+	return max(p.Due()-p.PaidAmount, 0)
+}
+
+// Refundable returns what the participant paid beyond what they owe, e.g. after the venue refunded part
+// of the bill.
+func (p EventParticipant) Refundable() int {
+	return max(p.PaidAmount-p.Due(), 0)
+}
+
+// BalanceStatus returns the status the payments recorded so far put the participant in.
+func (p EventParticipant) BalanceStatus() ParticipantStatus {
+	switch {
+	case p.PaidAmount <= 0:
+		return ParticipantStatusUnpaid
+	case p.PaidAmount < p.Due():
+		return ParticipantStatusPartiallyPaid
+	default:
+		return ParticipantStatusConfirmed
+	}
+}
+
+// ApplyPayment adds the payment to PaidAmount and settles the participant, reporting whether the status changed.
+func (p *EventParticipant) ApplyPayment(payment EventParticipantPayment) bool {
+	p.PaidAmount += payment.Amount
+	return p.Settle(payment.PaidAt)
+}
+
+// Settle moves the participant to the status their balance puts them in at the given time,
+// reporting whether the status changed. A pending claim is kept until the balance covers it.
+func (p *EventParticipant) Settle(at time.Time) bool {
+	to := p.BalanceStatus()
+	if to == p.Status || p.Status == ParticipantStatusClaimed && to != ParticipantStatusConfirmed {
+		return false
+	}
+	return p.TransitionTo(to, at)
+}
+
+// TransitionTo moves the participant to the status at the given time, reporting false when it is not allowed.
+func (p *EventParticipant) TransitionTo(to ParticipantStatus, at time.Time) bool {
+	if !p.Status.CanTransitionTo(to) {
+		return false
+	}
+	switch to {
+	case ParticipantStatusClaimed:
+		p.ClaimedAt = &at
+	case ParticipantStatusConfirmed:
+		p.ConfirmedAt = &at
+	case ParticipantStatusUnpaid:
+		p.ClaimedAt = nil
+	case ParticipantStatusPartiallyPaid:
+		// the payment is no longer complete once an additional charge reopens it
+		p.ConfirmedAt = nil
+	case ParticipantStatusWaitlisted:
+	}
+	p.Status = to
+	return true
+}
+
+func (p EventParticipant) HasFixedAmount() bool {
+	return p.FixedAmount != nil
+}
+
+// IssueToken replaces the participant's token with a new one and returns it.
+// The token is only known to the caller; the participant keeps just its hash.
+func (p *EventParticipant) IssueToken() (string, error) {
+	token, err := random.NewString(participantTokenBytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate participant token: %w", err)
+	}
+	hash := hashParticipantToken(token)
+	p.TokenHash = &hash
+	return token, nil
+}
+
+// VerifyToken reports whether the token lets its holder act as the participant.
+// None does until one is issued, so nobody can act on a participant ID alone.
+func (p EventParticipant) VerifyToken(token string) bool {
+	if p.TokenHash == nil || token == "" {
+		return false
+	}
+	hash := hashParticipantToken(token)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(*p.TokenHash)) == 1
+}
+
+const participantTokenBytes = 32
+
+func hashParticipantToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,287 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+func TestEventParticipant_IssueToken(t *testing.T) {
+	t.Parallel()
+
+	var p model.EventParticipant
+	assert.False(t, p.VerifyToken(""), "joined before tokens were issued")
+	assert.False(t, p.VerifyToken("anything"), "joined before tokens were issued")
+
+	first, err := p.IssueToken()
+	require.NoError(t, err)
+	require.NotNil(t, p.TokenHash)
+	assert.NotEqual(t, first, *p.TokenHash, "only the hash is kept")
+	assert.True(t, p.VerifyToken(first))
+	assert.False(t, p.VerifyToken(""))
+	assert.False(t, p.VerifyToken(first+"x"))
+
+	second, err := p.IssueToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	assert.True(t, p.VerifyToken(second))
+	assert.False(t, p.VerifyToken(first), "reissuing revokes the previous token")
+}
+
+func TestParticipantStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		from model.ParticipantStatus
+		to   model.ParticipantStatus
+		want bool
+	}{
+		{from: model.ParticipantStatusWaitlisted, to: model.ParticipantStatusUnpaid, want: true},
+		{from: model.ParticipantStatusWaitlisted, to: model.ParticipantStatusClaimed, want: false},
+		{from: model.ParticipantStatusWaitlisted, to: model.ParticipantStatusConfirmed, want: false},
+		{from: model.ParticipantStatusUnpaid, to: model.ParticipantStatusClaimed, want: true},
+		{from: model.ParticipantStatusUnpaid, to: model.ParticipantStatusConfirmed, want: true},
+		{from: model.ParticipantStatusUnpaid, to: model.ParticipantStatusWaitlisted, want: false},
+		{from: model.ParticipantStatusUnpaid, to: model.ParticipantStatusUnpaid, want: false},
+		{from: model.ParticipantStatusClaimed, to: model.ParticipantStatusUnpaid, want: true},
+		{from: model.ParticipantStatusClaimed, to: model.ParticipantStatusConfirmed, want: true},
+		{from: model.ParticipantStatusConfirmed, to: model.ParticipantStatusUnpaid, want: false},
+		{from: model.ParticipantStatusConfirmed, to: model.ParticipantStatusClaimed, want: false},
+		{from: model.ParticipantStatusUnpaid, to: model.ParticipantStatusPartiallyPaid, want: true},
+		{from: model.ParticipantStatusClaimed, to: model.ParticipantStatusPartiallyPaid, want: true},
+		{from: model.ParticipantStatusPartiallyPaid, to: model.ParticipantStatusClaimed, want: true},
+		{from: model.ParticipantStatusPartiallyPaid, to: model.ParticipantStatusConfirmed, want: true},
+		{from: model.ParticipantStatusPartiallyPaid, to: model.ParticipantStatusUnpaid, want: false},
+		{from: model.ParticipantStatusConfirmed, to: model.ParticipantStatusPartiallyPaid, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestEventParticipant_TransitionTo(t *testing.T) {
+	t.Parallel()
+
+	claimedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	confirmedAt := claimedAt.Add(time.Hour)
+
+	p := model.EventParticipant{Status: model.ParticipantStatusUnpaid}
+	require.True(t, p.TransitionTo(model.ParticipantStatusClaimed, claimedAt))
+	assert.Equal(t, model.ParticipantStatusClaimed, p.Status)
+	assert.Equal(t, &claimedAt, p.ClaimedAt)
+
+	// sending the claim back forgets it
+	require.True(t, p.TransitionTo(model.ParticipantStatusUnpaid, confirmedAt))
+	assert.Nil(t, p.ClaimedAt)
+
+	require.True(t, p.TransitionTo(model.ParticipantStatusClaimed, claimedAt))
+	require.True(t, p.TransitionTo(model.ParticipantStatusConfirmed, confirmedAt))
+	assert.Equal(t, &claimedAt, p.ClaimedAt)
+	assert.Equal(t, &confirmedAt, p.ConfirmedAt)
+
+	assert.False(t, p.TransitionTo(model.ParticipantStatusUnpaid, confirmedAt))
+	assert.Equal(t, model.ParticipantStatusConfirmed, p.Status)
+}
+
+func TestNewParticipantStatusChange(t *testing.T) {
+	t.Parallel()
+
+	p := model.EventParticipant{ID: "participant", EventID: "event", Status: model.ParticipantStatusUnpaid}
+	from := model.ParticipantStatusClaimed
+	organizer := "organizer"
+
+	got := model.NewParticipantStatusChange(p, &from, model.ParticipantStatusActorOrganizer, &organizer, "not received")
+	assert.NotEmpty(t, got.ID)
+	assert.Equal(t, "event", got.EventID)
+	assert.Equal(t, "participant", got.ParticipantID)
+	assert.Equal(t, &from, got.FromStatus)
+	assert.Equal(t, model.ParticipantStatusUnpaid, got.ToStatus)
+	assert.Equal(t, model.ParticipantStatusActorOrganizer, got.Actor)
+	assert.Equal(t, &organizer, got.ActorUserID)
+	require.NotNil(t, got.Reason)
+	assert.Equal(t, "not received", *got.Reason)
+
+	joined := model.NewParticipantStatusChange(p, nil, model.ParticipantStatusActorParticipant, nil, "")
+	assert.Nil(t, joined.FromStatus)
+	assert.Nil(t, joined.Reason)
+}
+
+func TestEventParticipant_ApplyPayment(t *testing.T) {
+	t.Parallel()
+
+	paidAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	pay := func(amount int) model.EventParticipantPayment {
+		return model.EventParticipantPayment{Amount: amount, PaidAt: paidAt}
+	}
+
+	t.Run("partial then the rest", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{Amount: 5000, Status: model.ParticipantStatusUnpaid}
+
+		assert.True(t, p.ApplyPayment(pay(3000)))
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, p.Status)
+		assert.Equal(t, 3000, p.PaidAmount)
+		assert.Equal(t, 2000, p.Outstanding())
+
+		assert.True(t, p.ApplyPayment(pay(2000)))
+		assert.Equal(t, model.ParticipantStatusConfirmed, p.Status)
+		assert.Equal(t, &paidAt, p.ConfirmedAt)
+		assert.Zero(t, p.Outstanding())
+	})
+
+	t.Run("partial payment keeps a pending claim", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{Amount: 5000, Status: model.ParticipantStatusClaimed}
+
+		assert.False(t, p.ApplyPayment(pay(1000)))
+		assert.Equal(t, model.ParticipantStatusClaimed, p.Status)
+		assert.Equal(t, 1000, p.PaidAmount)
+	})
+
+	t.Run("overpayment", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{Amount: 5000, Status: model.ParticipantStatusUnpaid}
+
+		assert.True(t, p.ApplyPayment(pay(6000)))
+		assert.Equal(t, model.ParticipantStatusConfirmed, p.Status)
+		assert.Zero(t, p.Outstanding())
+		assert.False(t, p.ApplyPayment(pay(1000)), "confirmed stays confirmed")
+	})
+}
+
+func TestEventParticipant_Settle(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("additional charge reopens a confirmed payment", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{
+			Amount: 5000, PaidAmount: 5000, AdjustmentAmount: 800,
+			Status: model.ParticipantStatusConfirmed, ConfirmedAt: &at,
+		}
+
+		assert.True(t, p.Settle(at))
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, p.Status)
+		assert.Nil(t, p.ConfirmedAt)
+		assert.Equal(t, 800, p.Outstanding())
+	})
+
+	t.Run("refund completes a partial payment", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{
+			Amount: 5000, PaidAmount: 4000, AdjustmentAmount: -1500,
+			Status: model.ParticipantStatusPartiallyPaid,
+		}
+
+		assert.True(t, p.Settle(at))
+		assert.Equal(t, model.ParticipantStatusConfirmed, p.Status)
+		assert.Zero(t, p.Outstanding())
+		assert.Equal(t, 500, p.Refundable())
+	})
+
+	t.Run("refund keeps a confirmed payment", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{
+			Amount: 5000, PaidAmount: 5000, AdjustmentAmount: -1000,
+			Status: model.ParticipantStatusConfirmed,
+		}
+
+		assert.False(t, p.Settle(at))
+		assert.Equal(t, 1000, p.Refundable())
+	})
+
+	t.Run("pending claim is kept", func(t *testing.T) {
+		t.Parallel()
+
+		p := model.EventParticipant{Amount: 5000, AdjustmentAmount: 800, Status: model.ParticipantStatusClaimed}
+
+		assert.False(t, p.Settle(at))
+		assert.Equal(t, model.ParticipantStatusClaimed, p.Status)
+		assert.Equal(t, 5800, p.Outstanding())
+	})
+}
+
+func TestEventParticipant_AmountLocked(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		p    model.EventParticipant
+		want bool
+	}{
+		{name: "unpaid", p: model.EventParticipant{Status: model.ParticipantStatusUnpaid}, want: false},
+		{name: "waitlisted", p: model.EventParticipant{Status: model.ParticipantStatusWaitlisted}, want: false},
+		{name: "claimed", p: model.EventParticipant{Status: model.ParticipantStatusClaimed}, want: true},
+		{name: "partially paid", p: model.EventParticipant{Status: model.ParticipantStatusPartiallyPaid}, want: true},
+		{name: "confirmed", p: model.EventParticipant{Status: model.ParticipantStatusConfirmed}, want: true},
+		// e.g. a claim sent back after the bill changed
+		{name: "unpaid with adjustments", p: model.EventParticipant{
+			Status: model.ParticipantStatusUnpaid, AdjustmentAmount: 500,
+		}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.p.AmountLocked())
+		})
+	}
+}
+
+func TestNewParticipantAdjustment(t *testing.T) {
+	t.Parallel()
+
+	p := model.EventParticipant{ID: "participant", EventID: "event"}
+	organizer := "organizer"
+
+	got := model.NewParticipantAdjustment(p, -500, "refund from the venue", &organizer)
+	assert.NotEmpty(t, got.ID)
+	assert.Equal(t, "event", got.EventID)
+	assert.Equal(t, "participant", got.ParticipantID)
+	assert.Equal(t, -500, got.Amount)
+	require.NotNil(t, got.Note)
+	assert.Equal(t, "refund from the venue", *got.Note)
+	assert.Equal(t, &organizer, got.RecordedByUserID)
+
+	assert.Nil(t, model.NewParticipantAdjustment(p, 500, "", nil).Note)
+}
+
+func TestNewParticipantPayment(t *testing.T) {
+	t.Parallel()
+
+	p := model.EventParticipant{ID: "participant", EventID: "event"}
+	organizer := "organizer"
+	paidAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	got := model.NewParticipantPayment(p, 3000, "paypay", "first half", &organizer, paidAt)
+	assert.NotEmpty(t, got.ID)
+	assert.Equal(t, "event", got.EventID)
+	assert.Equal(t, "participant", got.ParticipantID)
+	assert.Equal(t, 3000, got.Amount)
+	require.NotNil(t, got.PaymentMethod)
+	assert.Equal(t, "paypay", *got.PaymentMethod)
+	require.NotNil(t, got.Note)
+	assert.Equal(t, "first half", *got.Note)
+	assert.Equal(t, paidAt, got.PaidAt)
+
+	cash := model.NewParticipantPayment(p, 3000, "", "", &organizer, paidAt)
+	assert.Nil(t, cash.PaymentMethod)
+	assert.Nil(t, cash.Note)
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+// EventParticipantPayment is an entry of a participant's payments ledger, money the organizer has received
+// toward the participant's Amount and in the same currency. A negative Amount is money the organizer paid back,
+// e.g. what a deposit covered beyond the actual cost. Entries are never updated.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type EventParticipantPayment struct {
+	ID            string
+	EventID       string
+	ParticipantID string
+	Amount        int
+	// PaymentMethod is the type of the organizer's payment method the money came through,
+	// nil when it was paid outside of them, e.g. in cash.
+	PaymentMethod *string
+	Note          *string
+	// RecordedByUserID is the organizer who recorded the payment.
+	RecordedByUserID *string
+	PaidAt           time.Time
+	CreatedAt        time.Time
+}
+
+// NewParticipantPayment records that the participant paid the amount at the given time.
+// An empty payment method or note is stored as nil.
+func NewParticipantPayment(
+	p EventParticipant, amount int, paymentMethod string, note string, recordedByUserID *string, paidAt time.Time,
+) EventParticipantPayment {
+	m := EventParticipantPayment{
+		ID:               ulid.New(),
+		EventID:          p.EventID,
+		ParticipantID:    p.ID,
+		Amount:           amount,
+		RecordedByUserID: recordedByUserID,
+		PaidAt:           paidAt,
+	}
+	if paymentMethod != "" {
+		m.PaymentMethod = &paymentMethod
+	}
+	if note != "" {
+		m.Note = &note
+	}
+	return m
+}
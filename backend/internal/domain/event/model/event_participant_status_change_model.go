@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+// ParticipantStatusActor is who changed a participant's status.
+type ParticipantStatusActor string
+
+const (
+	ParticipantStatusActorOrganizer   ParticipantStatusActor = "organizer"
+	ParticipantStatusActorParticipant ParticipantStatusActor = "participant"
+	// ParticipantStatusActorSystem is for changes nobody asked for directly, such as promotion from the waitlist.
+	ParticipantStatusActorSystem ParticipantStatusActor = "system"
+)
+
+// EventParticipantStatusChange is an entry of a participant's status history. Entries are never updated.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type EventParticipantStatusChange struct {
+	ID            string
+	EventID       string
+	ParticipantID string
+	// FromStatus is nil for the status the participant joined with.
+	FromStatus *ParticipantStatus
+	ToStatus   ParticipantStatus
+	Actor      ParticipantStatusActor
+	// ActorUserID is the account behind the change, nil for the system and for participants who are not logged in.
+	ActorUserID *string
+	Reason      *string
+	CreatedAt   time.Time
+}
+
+// NewParticipantStatusChange records that the participant moved from the given status to their current one.
+// from is nil when they just joined, and an empty reason is stored as nil.
+func NewParticipantStatusChange(
+	p EventParticipant, from *ParticipantStatus, actor ParticipantStatusActor, actorUserID *string, reason string,
+) EventParticipantStatusChange {
+	m := EventParticipantStatusChange{
+		ID:            ulid.New(),
+		EventID:       p.EventID,
+		ParticipantID: p.ID,
+		FromStatus:    from,
+		ToStatus:      p.Status,
+		Actor:         actor,
+		ActorUserID:   actorUserID,
+	}
+	if reason != "" {
+		m.Reason = &reason
+	}
+	return m
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// EventReminder records that the payment reminder for a step of the schedule went out,
+// so each step is sent once however often reminders are swept.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type EventReminder struct {
+	ID        string
+	EventID   string
+	Step      int
+	CreatedAt time.Time
+}
+
+// DueReminderStep returns how many steps of the schedule have passed since heldAt, 0 if none.
+// offsets are the delays after heldAt at which reminders go out, in ascending order.
+func DueReminderStep(heldAt time.Time, now time.Time, offsets []time.Duration) int {
+	step := 0
+	for i, offset := range offsets {
+		if now.Before(heldAt.Add(offset)) {
+			break
+		}
+		step = i + 1
+	}
+	return step
+}
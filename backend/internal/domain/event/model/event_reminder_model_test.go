@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+func TestDueReminderStep(t *testing.T) {
+	t.Parallel()
+
+	heldAt := time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)
+	offsets := []time.Duration{24 * time.Hour, 72 * time.Hour, 168 * time.Hour}
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		offsets []time.Duration
+		want    int
+	}{
+		{name: "before the event", now: heldAt.Add(-time.Hour), offsets: offsets, want: 0},
+		{name: "before the first offset", now: heldAt.Add(23 * time.Hour), offsets: offsets, want: 0},
+		{name: "at the first offset", now: heldAt.Add(24 * time.Hour), offsets: offsets, want: 1},
+		{name: "between offsets", now: heldAt.Add(100 * time.Hour), offsets: offsets, want: 2},
+		{name: "after the last offset", now: heldAt.Add(30 * 24 * time.Hour), offsets: offsets, want: 3},
+		{name: "no offsets", now: heldAt.Add(30 * 24 * time.Hour), offsets: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, model.DueReminderStep(heldAt, tt.now, tt.offsets))
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// EventRound is a later venue of the event, e.g. the second round (2次会), with its own total and tiers.
+// The event itself is the first round, which everyone takes part in; later rounds only those who opt in.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type EventRound struct {
+	ID      string
+	EventID string
+	// Round numbers the venues in order. It starts at 2, as the event itself is the first round.
+	Round       int
+	Title       string
+	TotalAmount int
+	Remainder   int
+	Surplus     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	Tiers        []EventRoundTier        `rel:"has_many,foreign_key:round_id"`
+	Participants []EventRoundParticipant `rel:"has_many,foreign_key:round_id"`
+}
+
+// EventRoundTier is a tier of a later round. It shares its tier number with one of the event's tiers,
+// so participants are in the same tier in every round, but its weight and capacity are the round's own.
+type EventRoundTier struct {
+	ID        string
+	EventID   string
+	RoundID   string
+	Tier      int
+	Weight    Weight
+	Count     int
+	Amount    int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// EventRoundParticipant records that a participant opted into a later round.
+type EventRoundParticipant struct {
+	ID            string
+	EventID       string
+	RoundID       string
+	ParticipantID string
+	CreatedAt     time.Time
+}
+
+// HasParticipant reports whether the participant opted into the round.
+func (r EventRound) HasParticipant(participantID string) bool {
+	return slices.ContainsFunc(r.Participants, func(p EventRoundParticipant) bool {
+		return p.ParticipantID == participantID
+	})
+}
+
+// SortTiers sorts Tiers by tier number in ascending order.
+func (r *EventRound) SortTiers() {
+	slices.SortFunc(r.Tiers, func(a, b EventRoundTier) int {
+		return a.Tier - b.Tier
+	})
+}
+
+// split returns the round as an event of its own, so that its tier amounts and seats are worked out
+// the same way as the first round's. Seats go in the order participants opted in.
+func (r EventRound) split(ev *Event) Event {
+	v := Event{
+		TotalAmount:     r.TotalAmount,
+		RemainderPolicy: ev.RemainderPolicy,
+		Currency:        ev.Currency,
+	}
+	for _, t := range r.Tiers {
+		v.Tiers = append(v.Tiers, EventTier{Tier: t.Tier, Weight: t.Weight, Count: t.Count, Amount: t.Amount})
+	}
+	for _, a := range r.Participants {
+		idx := slices.IndexFunc(ev.Participants, func(p EventParticipant) bool {
+			return p.ID == a.ParticipantID
+		})
+		if idx < 0 {
+			continue
+		}
+		p := ev.Participants[idx]
+		v.Participants = append(v.Participants, EventParticipant{
+			ID: p.ID, Tier: p.Tier, Status: p.Status, CreatedAt: a.CreatedAt,
+		})
+	}
+	return v
+}
+
+// GrandTotal returns what all the rounds cost together, TotalAmount being the first round's.
+func (e *Event) GrandTotal() int {
+	total := e.TotalAmount
+	for _, r := range e.Rounds {
+		total += r.TotalAmount
+	}
+	return total
+}
+
+// Round returns the later round with the given number.
+func (e *Event) Round(round int) (*EventRound, bool) {
+	idx := slices.IndexFunc(e.Rounds, func(r EventRound) bool {
+		return r.Round == round
+	})
+	if idx < 0 {
+		return nil, false
+	}
+	return &e.Rounds[idx], true
+}
+
+// FirstRoundShares returns what each participant holding a spot owes for the event itself, keyed by their ID.
+func (e *Event) FirstRoundShares() map[string]int {
+	v := *e
+	v.Participants = slices.Clone(e.Participants)
+	v.Rounds = nil
+	v.AssignParticipantAmounts()
+
+	shares := make(map[string]int, len(v.Participants))
+	for _, p := range v.Participants {
+		if !p.IsWaitlisted() {
+			shares[p.ID] = p.Amount
+		}
+	}
+	return shares
+}
+
+// RoundShares returns what each participant of the later round owes for it, keyed by their ID.
+func (e *Event) RoundShares(r EventRound) map[string]int {
+	v := r.split(e)
+	v.AssignParticipantAmounts()
+
+	shares := make(map[string]int, len(v.Participants))
+	for _, p := range v.Participants {
+		shares[p.ID] = p.Amount
+	}
+	return shares
+}
+
+// HasRoundVacancy reports whether the given tier of the later round can take one more participant.
+// Later rounds have no waitlist.
+func (e *Event) HasRoundVacancy(r EventRound, tier int) bool {
+	v := r.split(e)
+	return v.HasVacancy(tier)
+}
+
+// NextRoundSeatAmount returns what the next participant of the tier to opt into the later round owes for it.
+func (e *Event) NextRoundSeatAmount(r EventRound, tier int) int {
+	v := r.split(e)
+	return v.NextSeatAmount(tier)
+}
+
+// RoundsWithinCapacity reports whether every tier of every later round has at most as many participants
+// as its capacity, e.g. after someone moved to another tier.
+func (e *Event) RoundsWithinCapacity() bool {
+	for _, r := range e.Rounds {
+		v := r.split(e)
+		for _, p := range v.Participants {
+			if v.activeCount(p.Tier) > r.tierCount(p.Tier) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// calcRoundAmounts sets the tier amounts, Remainder and Surplus of each later round, see CalcTierAmounts.
+func (e *Event) calcRoundAmounts() {
+	for i := range e.Rounds {
+		r := &e.Rounds[i]
+		v := r.split(e)
+		v.CalcTierAmounts()
+		r.Remainder = v.Remainder
+		r.Surplus = v.Surplus
+		for j := range r.Tiers {
+			r.Tiers[j].Amount = v.TierAmount(r.Tiers[j].Tier)
+		}
+	}
+}
+
+func (r EventRound) tierCount(tier int) int {
+	for _, t := range r.Tiers {
+		if t.Tier == tier {
+			return t.Count
+		}
+	}
+	return 0
+}
@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// twoRoundEvent has three participants at the first venue, two of whom went on to the second.
+func twoRoundEvent(now time.Time) model.Event {
+	return model.Event{
+		TotalAmount: 6000,
+		TierCount:   1,
+		Tiers:       []model.EventTier{{Tier: 1, Count: 3, Weight: model.WeightScale}},
+		Participants: []model.EventParticipant{
+			{ID: "a", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now},
+			{ID: "b", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(time.Second)},
+			{ID: "c", Tier: 1, Status: model.ParticipantStatusUnpaid, CreatedAt: now.Add(2 * time.Second)},
+		},
+		Rounds: []model.EventRound{{
+			Round:       2,
+			TotalAmount: 4001,
+			Tiers:       []model.EventRoundTier{{Tier: 1, Count: 2, Weight: model.WeightScale}},
+			Participants: []model.EventRoundParticipant{
+				{ParticipantID: "b", CreatedAt: now.Add(time.Hour)},
+				{ParticipantID: "a", CreatedAt: now.Add(time.Hour + time.Second)},
+			},
+		}},
+	}
+}
+
+func TestEvent_CalcTierAmounts_Rounds(t *testing.T) {
+	t.Parallel()
+
+	ev := twoRoundEvent(time.Now())
+	ev.CalcTierAmounts()
+
+	assert.Equal(t, 2000, ev.Tiers[0].Amount)
+	assert.Zero(t, ev.Remainder)
+	assert.Equal(t, 2000, ev.Rounds[0].Tiers[0].Amount)
+	assert.Equal(t, 1, ev.Rounds[0].Remainder, "each round keeps its own remainder")
+	assert.Equal(t, 10001, ev.GrandTotal())
+}
+
+func TestEvent_AssignParticipantAmounts_Rounds(t *testing.T) {
+	t.Parallel()
+
+	ev := twoRoundEvent(time.Now())
+	ev.CalcTierAmounts()
+	ev.AssignParticipantAmounts()
+
+	assert.Equal(t, map[string]int{"a": 2000, "b": 2000, "c": 2000}, ev.FirstRoundShares())
+	assert.Equal(t, map[string]int{"a": 2000, "b": 2000}, ev.RoundShares(ev.Rounds[0]))
+
+	amounts := map[string]int{}
+	for _, p := range ev.Participants {
+		amounts[p.ID] = p.Amount
+	}
+	assert.Equal(t, map[string]int{"a": 4000, "b": 4000, "c": 2000}, amounts, "one amount covering every round")
+}
+
+func TestEvent_RoundCapacity(t *testing.T) {
+	t.Parallel()
+
+	ev := twoRoundEvent(time.Now())
+	ev.CalcTierAmounts()
+	r, ok := ev.Round(2)
+	require.True(t, ok)
+	_, ok = ev.Round(3)
+	assert.False(t, ok)
+
+	assert.True(t, r.HasParticipant("a"))
+	assert.False(t, r.HasParticipant("c"))
+	assert.False(t, ev.HasRoundVacancy(*r, 1))
+	assert.True(t, ev.RoundsWithinCapacity())
+
+	r.Tiers[0].Count = 3
+	ev.CalcTierAmounts()
+	assert.True(t, ev.HasRoundVacancy(*r, 1))
+	assert.Equal(t, 1333, ev.NextRoundSeatAmount(*r, 1))
+
+	r.Tiers[0].Count = 1
+	assert.False(t, ev.RoundsWithinCapacity())
+}
@@ -1,14 +1,68 @@
 package model
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WeightScale is how many Weight units make a weight of 1.
+const WeightScale = 1000
+
+// Weight is a tier's share relative to the other tiers, in thousandths (e.g. 1500 for 1.5x).
+// Only ratios matter, so integer weights like 1:2:3 and decimal ones like 0.5x:2x work the same way.
+type Weight int
+
+var (
+	ErrInvalidWeight = errors.New("weight must be a positive decimal with at most 3 fractional digits")
+
+	weightPattern = regexp.MustCompile(`^\d{1,6}(\.\d{1,3})?$`)
+)
+
+// ParseWeight parses a decimal string such as "2", "0.5" or "1.25".
+func ParseWeight(s string) (Weight, error) {
+	if !weightPattern.MatchString(s) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidWeight, s)
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	w, _ := strconv.Atoi(whole + frac + strings.Repeat("0", 3-len(frac)))
+	if w <= 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidWeight, s)
+	}
+	return Weight(w), nil
+}
+
+// String formats the weight as the shortest decimal, e.g. "2" or "0.5".
+func (w Weight) String() string {
+	s := strconv.Itoa(int(w) / WeightScale)
+	if frac := int(w) % WeightScale; frac != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%03d", frac), "0")
+	}
+	return s
+}
 
 //go:generate go tool ormgen -source=$GOFILE -destination=../query
 type EventTier struct {
-	ID        string
-	EventID   string
-	Tier      int
+	ID      string
+	EventID string
+	Tier    int
+	Name    string
+	// Weight is zero for tiers created before weights existed; those weigh their tier number.
+	Weight    Weight
 	Count     int
 	Amount    int
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// EffectiveWeight returns the weight used to split the total.
+func (t EventTier) EffectiveWeight() Weight {
+	if t.Weight == 0 {
+		return Weight(t.Tier * WeightScale)
+	}
+	return t.Weight
+}
@@ -0,0 +1,64 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+func TestParseWeight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in      string
+		want    model.Weight
+		wantErr bool
+	}{
+		{in: "1", want: 1000},
+		{in: "2", want: 2000},
+		{in: "0.5", want: 500},
+		{in: "1.25", want: 1250},
+		{in: "0.125", want: 125},
+		{in: "0", wantErr: true},
+		{in: "0.000", wantErr: true},
+		{in: "-1", wantErr: true},
+		{in: "0.0005", wantErr: true},
+		{in: ".5", wantErr: true},
+		{in: "1.", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := model.ParseWeight(tt.in)
+			if tt.wantErr {
+				require.ErrorIs(t, err, model.ErrInvalidWeight)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWeight_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "2", model.Weight(2000).String())
+	assert.Equal(t, "0.5", model.Weight(500).String())
+	assert.Equal(t, "1.25", model.Weight(1250).String())
+	assert.Equal(t, "0.125", model.Weight(125).String())
+}
+
+func TestEventTier_EffectiveWeight(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, model.Weight(3000), model.EventTier{Tier: 3}.EffectiveWeight(), "falls back to the tier number")
+	assert.Equal(t, model.Weight(500), model.EventTier{Tier: 3, Weight: 500}.EffectiveWeight())
+}
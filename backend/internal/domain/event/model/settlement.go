@@ -0,0 +1,221 @@
+package model
+
+import (
+	"cmp"
+	"math/bits"
+	"slices"
+)
+
+// Balance is where a participant stands across all expenses of an event.
+// A positive Net means others owe them; a negative one means they owe others.
+type Balance struct {
+	ParticipantID string
+	Paid          int
+	Owed          int
+	Net           int
+}
+
+// Transfer is a payment from one participant to another that settles up the expenses.
+type Transfer struct {
+	FromParticipantID string
+	ToParticipantID   string
+	Amount            int
+}
+
+// CalcBalances sums up what each participant paid and owes, ordered by participant ID.
+func CalcBalances(expenses []EventExpense) []Balance {
+	byID := make(map[string]*Balance)
+	balance := func(id string) *Balance {
+		b, ok := byID[id]
+		if !ok {
+			b = &Balance{ParticipantID: id}
+			byID[id] = b
+		}
+		return b
+	}
+
+	for _, e := range expenses {
+		balance(e.PayerID).Paid += e.Amount
+		for _, s := range e.Shares {
+			balance(s.ParticipantID).Owed += s.Amount
+		}
+	}
+
+	balances := make([]Balance, 0, len(byID))
+	for _, b := range byID {
+		b.Net = b.Paid - b.Owed
+		balances = append(balances, *b)
+	}
+	slices.SortFunc(balances, func(a, b Balance) int {
+		return cmp.Compare(a.ParticipantID, b.ParticipantID)
+	})
+	return balances
+}
+
+// exactSettlementLimit is the most participants with a non-zero balance SettleTransfers finds the fewest
+// transfers for; its search grows with two to the power of their number.
+const exactSettlementLimit = 16
+
+// SettleTransfers returns the fewest transfers that bring every balance to zero.
+// Participants whose balances cancel each other out exactly settle among themselves: a group of n of them
+// needs n-1 transfers, so the more groups the balances split into, the fewer transfers are needed.
+// Beyond exactSettlementLimit participants, only pairs cancelling each other out are grouped, which may
+// need a few more transfers than necessary. Transfers are listed group by group, in order of the
+// participant ID they start with.
+func SettleTransfers(balances []Balance) []Transfer {
+	var parties []party
+	for _, b := range balances {
+		if b.Net != 0 {
+			parties = append(parties, party{id: b.ParticipantID, amount: b.Net})
+		}
+	}
+	slices.SortFunc(parties, func(a, b party) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	var groups [][]party
+	if len(parties) <= exactSettlementLimit {
+		groups = zeroSumGroups(parties)
+	} else {
+		groups = cancellingPairs(parties)
+	}
+
+	var transfers []Transfer
+	for _, g := range groups {
+		transfers = append(transfers, settleGroup(g)...)
+	}
+	return transfers
+}
+
+// party is a participant with a non-zero balance, positive when owed and negative when owing.
+type party struct {
+	id     string
+	amount int
+}
+
+// zeroSumGroups splits the parties into as many groups with a zero sum as possible, each ordered as the
+// parties were and the groups by their first party.
+func zeroSumGroups(parties []party) [][]party {
+	n := len(parties)
+	full := 1<<n - 1
+	sums := make([]int, full+1)
+	// groups[mask] is the most zero-sum groups the parties in mask, adding up to zero, split into
+	groups := make([]int, full+1)
+	for mask := 1; mask <= full; mask++ {
+		low := mask & -mask
+		sums[mask] = sums[mask^low] + parties[bits.TrailingZeros(uint(low))].amount
+		for i := range n {
+			if bit := 1 << i; mask&bit != 0 {
+				groups[mask] = max(groups[mask], groups[mask^bit])
+			}
+		}
+		if sums[mask] == 0 {
+			groups[mask]++
+		}
+	}
+
+	// walk back from all parties, taking off one at a time without losing a group;
+	// every time the rest adds up to zero, what was taken off since is a group
+	var result [][]party
+	var group []party
+	for mask := full; mask != 0; {
+		want := groups[mask]
+		if sums[mask] == 0 {
+			want--
+		}
+		for i := range n {
+			if bit := 1 << i; mask&bit != 0 && groups[mask^bit] == want {
+				group = append(group, parties[i])
+				mask ^= bit
+				break
+			}
+		}
+		if sums[mask] == 0 {
+			slices.SortFunc(group, func(a, b party) int {
+				return cmp.Compare(a.id, b.id)
+			})
+			result = append(result, group)
+			group = nil
+		}
+	}
+	slices.SortFunc(result, func(a, b []party) int {
+		return cmp.Compare(a[0].id, b[0].id)
+	})
+	return result
+}
+
+// cancellingPairs groups a debtor and a creditor whose balances cancel each other out, leaving the rest
+// of the parties in one group.
+func cancellingPairs(parties []party) [][]party {
+	var groups [][]party
+	var rest []party
+	paired := make([]bool, len(parties))
+	for i, p := range parties {
+		if paired[i] {
+			continue
+		}
+		j := -1
+		for k := i + 1; k < len(parties); k++ {
+			if !paired[k] && parties[k].amount == -p.amount {
+				j = k
+				break
+			}
+		}
+		if j < 0 {
+			rest = append(rest, p)
+			continue
+		}
+		paired[i], paired[j] = true, true
+		groups = append(groups, []party{p, parties[j]})
+	}
+	if len(rest) > 0 {
+		groups = append(groups, rest)
+	}
+	slices.SortFunc(groups, func(a, b []party) int {
+		return cmp.Compare(a[0].id, b[0].id)
+	})
+	return groups
+}
+
+// settleGroup has the biggest debtor pay the biggest creditor of the group until everyone is settled,
+// which needs at most one transfer fewer than the parties in it. Ties are broken by participant ID.
+func settleGroup(group []party) []Transfer {
+	var debtors, creditors []party
+	for _, p := range group {
+		if p.amount < 0 {
+			debtors = append(debtors, party{id: p.id, amount: -p.amount})
+		} else {
+			creditors = append(creditors, p)
+		}
+	}
+
+	byAmountDesc := func(a, b party) int {
+		if c := cmp.Compare(b.amount, a.amount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id, b.id)
+	}
+
+	var transfers []Transfer
+	for len(debtors) > 0 && len(creditors) > 0 {
+		slices.SortFunc(debtors, byAmountDesc)
+		slices.SortFunc(creditors, byAmountDesc)
+
+		amount := min(debtors[0].amount, creditors[0].amount)
+		transfers = append(transfers, Transfer{
+			FromParticipantID: debtors[0].id,
+			ToParticipantID:   creditors[0].id,
+			Amount:            amount,
+		})
+
+		debtors[0].amount -= amount
+		creditors[0].amount -= amount
+		if debtors[0].amount == 0 {
+			debtors = debtors[1:]
+		}
+		if creditors[0].amount == 0 {
+			creditors = creditors[1:]
+		}
+	}
+	return transfers
+}
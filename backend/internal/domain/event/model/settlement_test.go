@@ -0,0 +1,139 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+func TestNewEventExpense(t *testing.T) {
+	t.Parallel()
+
+	expense := model.NewEventExpense("event", "a", "taxi", 1000, []string{"a", "b", "c"})
+
+	require.Len(t, expense.Shares, 3)
+	assert.Equal(t, 334, expense.Shares[0].Amount)
+	assert.Equal(t, 333, expense.Shares[1].Amount)
+	assert.Equal(t, 333, expense.Shares[2].Amount)
+	for _, s := range expense.Shares {
+		assert.Equal(t, expense.ID, s.ExpenseID)
+	}
+}
+
+func TestSettle(t *testing.T) {
+	t.Parallel()
+
+	// hotel by A, dinner by B, taxi by C, everyone covered
+	expenses := []model.EventExpense{
+		model.NewEventExpense("event", "a", "hotel", 30000, []string{"a", "b", "c"}),
+		model.NewEventExpense("event", "b", "dinner", 9000, []string{"a", "b", "c"}),
+		model.NewEventExpense("event", "c", "taxi", 3000, []string{"a", "b", "c"}),
+	}
+
+	balances := model.CalcBalances(expenses)
+
+	assert.Equal(t, []model.Balance{
+		{ParticipantID: "a", Paid: 30000, Owed: 14000, Net: 16000},
+		{ParticipantID: "b", Paid: 9000, Owed: 14000, Net: -5000},
+		{ParticipantID: "c", Paid: 3000, Owed: 14000, Net: -11000},
+	}, balances)
+
+	transfers := model.SettleTransfers(balances)
+
+	assert.Equal(t, []model.Transfer{
+		{FromParticipantID: "c", ToParticipantID: "a", Amount: 11000},
+		{FromParticipantID: "b", ToParticipantID: "a", Amount: 5000},
+	}, transfers)
+}
+
+func TestSettleTransfers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		balances []model.Balance
+		want     []model.Transfer
+	}{
+		{
+			name:     "nothing to settle",
+			balances: []model.Balance{{ParticipantID: "a"}, {ParticipantID: "b"}},
+			want:     nil,
+		},
+		{
+			name: "one debtor pays several creditors",
+			balances: []model.Balance{
+				{ParticipantID: "a", Net: 3000},
+				{ParticipantID: "b", Net: 2000},
+				{ParticipantID: "c", Net: -5000},
+			},
+			want: []model.Transfer{
+				{FromParticipantID: "c", ToParticipantID: "a", Amount: 3000},
+				{FromParticipantID: "c", ToParticipantID: "b", Amount: 2000},
+			},
+		},
+		{
+			name: "matched pairs",
+			balances: []model.Balance{
+				{ParticipantID: "a", Net: 1000},
+				{ParticipantID: "b", Net: -1000},
+				{ParticipantID: "c", Net: 500},
+				{ParticipantID: "d", Net: -500},
+			},
+			want: []model.Transfer{
+				{FromParticipantID: "b", ToParticipantID: "a", Amount: 1000},
+				{FromParticipantID: "d", ToParticipantID: "c", Amount: 500},
+			},
+		},
+		{
+			name: "groups cancelling out settle among themselves",
+			// the biggest debtor paying the biggest creditor first would take four transfers
+			balances: []model.Balance{
+				{ParticipantID: "a", Net: 6000},
+				{ParticipantID: "b", Net: 4000},
+				{ParticipantID: "c", Net: -4000},
+				{ParticipantID: "d", Net: -3000},
+				{ParticipantID: "e", Net: -3000},
+			},
+			want: []model.Transfer{
+				{FromParticipantID: "d", ToParticipantID: "a", Amount: 3000},
+				{FromParticipantID: "e", ToParticipantID: "a", Amount: 3000},
+				{FromParticipantID: "c", ToParticipantID: "b", Amount: 4000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := model.SettleTransfers(tt.balances)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSettleTransfers_ManyParticipants(t *testing.T) {
+	t.Parallel()
+
+	// beyond the exact search, pairs cancelling each other out still settle directly
+	var balances []model.Balance
+	for i := range 10 {
+		balances = append(balances,
+			model.Balance{ParticipantID: fmt.Sprintf("c%02d", i), Net: (i + 1) * 1000},
+			model.Balance{ParticipantID: fmt.Sprintf("d%02d", i), Net: -(i + 1) * 1000},
+		)
+	}
+
+	got := model.SettleTransfers(balances)
+
+	require.Len(t, got, 10)
+	for i, tr := range got {
+		assert.Equal(t, fmt.Sprintf("d%02d", i), tr.FromParticipantID)
+		assert.Equal(t, fmt.Sprintf("c%02d", i), tr.ToParticipantID)
+		assert.Equal(t, (i+1)*1000, tr.Amount)
+	}
+}
@@ -0,0 +1,162 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/ormgen/scope"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// EventExpenses returns a new Query for the event_expenses table.
+func EventExpenses(db orm.Querier) *orm.Query[model.EventExpense] {
+	q := orm.NewQuery[model.EventExpense](
+		db, orm.ResolveTableName[model.EventExpense]("event_expenses"), eventExpensesColumns, "id",
+		scanEventExpense, eventExpenseColumnValuePairs, nil,
+	)
+	q.RegisterJoin("Shares", orm.JoinConfig{
+		TargetTable: orm.ResolveTableName[model.EventExpenseShare]("event_expense_shares"), TargetColumn: "expense_id",
+		SourceTable: orm.ResolveTableName[model.EventExpense]("event_expenses"), SourceColumn: "id",
+	})
+	q.RegisterPreloader("Shares", preloadEventExpenseShares)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventExpenseCreatedAt,
+		[]string{"updated_at"},
+		setEventExpenseUpdatedAt,
+	)
+	return q
+}
+
+var eventExpensesColumns = []string{"id", "event_id", "payer_id", "title", "amount", "created_at", "updated_at"}
+
+func scanEventExpense(rows *sql.Rows) (model.EventExpense, error) {
+	cols, _ := rows.Columns()
+	var v model.EventExpense
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "payer_id":
+			dest[i] = &v.PayerID
+		case "title":
+			dest[i] = &v.Title
+		case "amount":
+			dest[i] = &v.Amount
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		case "updated_at":
+			dest[i] = &v.UpdatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventExpenseColumnValuePairs(v *model.EventExpense, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "payer_id", "title", "amount", "created_at", "updated_at"},
+			[]any{v.ID, v.EventID, v.PayerID, v.Title, v.Amount, v.CreatedAt, v.UpdatedAt}
+	}
+	return []string{"event_id", "payer_id", "title", "amount", "created_at", "updated_at"},
+		[]any{v.EventID, v.PayerID, v.Title, v.Amount, v.CreatedAt, v.UpdatedAt}
+}
+
+func setEventExpenseCreatedAt(v *model.EventExpense, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
+func setEventExpenseUpdatedAt(v *model.EventExpense, now time.Time) {
+	v.UpdatedAt = now
+}
+func preloadEventExpenseShares(ctx context.Context, db orm.Querier, results []model.EventExpense) error {
+	if len(results) == 0 {
+		return nil
+	}
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].ID
+	}
+	related, err := EventExpenseShares(db).Scopes(scope.In("expense_id", ids)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byFK := make(map[string][]model.EventExpenseShare)
+	for _, r := range related {
+		byFK[r.ExpenseID] = append(byFK[r.ExpenseID], r)
+	}
+	for i := range results {
+		results[i].Shares = byFK[results[i].ID]
+	}
+	return nil
+}
+
+// EventExpenseShares returns a new Query for the event_expense_shares table.
+func EventExpenseShares(db orm.Querier) *orm.Query[model.EventExpenseShare] {
+	q := orm.NewQuery[model.EventExpenseShare](
+		db, orm.ResolveTableName[model.EventExpenseShare]("event_expense_shares"), eventExpenseSharesColumns, "id",
+		scanEventExpenseShare, eventExpenseShareColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventExpenseShareCreatedAt,
+		[]string{"updated_at"},
+		setEventExpenseShareUpdatedAt,
+	)
+	return q
+}
+
+var eventExpenseSharesColumns = []string{"id", "expense_id", "participant_id", "amount", "created_at", "updated_at"}
+
+func scanEventExpenseShare(rows *sql.Rows) (model.EventExpenseShare, error) {
+	cols, _ := rows.Columns()
+	var v model.EventExpenseShare
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "expense_id":
+			dest[i] = &v.ExpenseID
+		case "participant_id":
+			dest[i] = &v.ParticipantID
+		case "amount":
+			dest[i] = &v.Amount
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		case "updated_at":
+			dest[i] = &v.UpdatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventExpenseShareColumnValuePairs(v *model.EventExpenseShare, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "expense_id", "participant_id", "amount", "created_at", "updated_at"},
+			[]any{v.ID, v.ExpenseID, v.ParticipantID, v.Amount, v.CreatedAt, v.UpdatedAt}
+	}
+	return []string{"expense_id", "participant_id", "amount", "created_at", "updated_at"},
+		[]any{v.ExpenseID, v.ParticipantID, v.Amount, v.CreatedAt, v.UpdatedAt}
+}
+
+func setEventExpenseShareCreatedAt(v *model.EventExpenseShare, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
+func setEventExpenseShareUpdatedAt(v *model.EventExpenseShare, now time.Time) {
+	v.UpdatedAt = now
+}
@@ -36,7 +36,7 @@ func Events(db orm.Querier) *orm.Query[model.Event] {
 	return q
 }
 
-var eventsColumns = []string{"id", "user_id", "title", "description", "total_amount", "remainder", "tier_count", "held_at", "archived_at", "created_at", "updated_at"}
+var eventsColumns = []string{"id", "user_id", "title", "description", "total_amount", "subtotal_amount", "tax_rate", "tax_included", "service_charge_rate", "tip_rate", "service_charge_amount", "tax_amount", "tip_amount", "remainder", "surplus", "remainder_policy", "split_mode", "deposit_amount", "deposit_settled_at", "estimated_total_amount", "finalized_at", "currency", "settlement_currency", "exchange_rate", "exchange_rate_at", "settlement_total_amount", "tier_count", "held_at", "ends_at", "archived_at", "created_at", "updated_at"}
 
 func scanEvent(rows *sql.Rows) (model.Event, error) {
 	cols, _ := rows.Columns()
@@ -54,12 +54,54 @@ func scanEvent(rows *sql.Rows) (model.Event, error) {
 			dest[i] = &v.Description
 		case "total_amount":
 			dest[i] = &v.TotalAmount
+		case "subtotal_amount":
+			dest[i] = &v.SubtotalAmount
+		case "tax_rate":
+			dest[i] = &v.TaxRate
+		case "tax_included":
+			dest[i] = &v.TaxIncluded
+		case "service_charge_rate":
+			dest[i] = &v.ServiceChargeRate
+		case "tip_rate":
+			dest[i] = &v.TipRate
+		case "service_charge_amount":
+			dest[i] = &v.ServiceChargeAmount
+		case "tax_amount":
+			dest[i] = &v.TaxAmount
+		case "tip_amount":
+			dest[i] = &v.TipAmount
 		case "remainder":
 			dest[i] = &v.Remainder
+		case "surplus":
+			dest[i] = &v.Surplus
+		case "remainder_policy":
+			dest[i] = &v.RemainderPolicy
+		case "split_mode":
+			dest[i] = &v.SplitMode
+		case "deposit_amount":
+			dest[i] = &v.DepositAmount
+		case "deposit_settled_at":
+			dest[i] = &v.DepositSettledAt
+		case "estimated_total_amount":
+			dest[i] = &v.EstimatedTotalAmount
+		case "finalized_at":
+			dest[i] = &v.FinalizedAt
+		case "currency":
+			dest[i] = &v.Currency
+		case "settlement_currency":
+			dest[i] = &v.SettlementCurrency
+		case "exchange_rate":
+			dest[i] = &v.ExchangeRate
+		case "exchange_rate_at":
+			dest[i] = &v.ExchangeRateAt
+		case "settlement_total_amount":
+			dest[i] = &v.SettlementTotalAmount
 		case "tier_count":
 			dest[i] = &v.TierCount
 		case "held_at":
 			dest[i] = &v.HeldAt
+		case "ends_at":
+			dest[i] = &v.EndsAt
 		case "archived_at":
 			dest[i] = &v.ArchivedAt
 		case "created_at":
@@ -76,11 +118,11 @@ func scanEvent(rows *sql.Rows) (model.Event, error) {
 
 func eventColumnValuePairs(v *model.Event, includesPK bool) ([]string, []any) {
 	if includesPK {
-		return []string{"id", "user_id", "title", "description", "total_amount", "remainder", "tier_count", "held_at", "archived_at", "created_at", "updated_at"},
-			[]any{v.ID, v.UserID, v.Title, v.Description, v.TotalAmount, v.Remainder, v.TierCount, v.HeldAt, v.ArchivedAt, v.CreatedAt, v.UpdatedAt}
+		return []string{"id", "user_id", "title", "description", "total_amount", "subtotal_amount", "tax_rate", "tax_included", "service_charge_rate", "tip_rate", "service_charge_amount", "tax_amount", "tip_amount", "remainder", "surplus", "remainder_policy", "split_mode", "deposit_amount", "deposit_settled_at", "estimated_total_amount", "finalized_at", "currency", "settlement_currency", "exchange_rate", "exchange_rate_at", "settlement_total_amount", "tier_count", "held_at", "ends_at", "archived_at", "created_at", "updated_at"},
+			[]any{v.ID, v.UserID, v.Title, v.Description, v.TotalAmount, v.SubtotalAmount, v.TaxRate, v.TaxIncluded, v.ServiceChargeRate, v.TipRate, v.ServiceChargeAmount, v.TaxAmount, v.TipAmount, v.Remainder, v.Surplus, v.RemainderPolicy, v.SplitMode, v.DepositAmount, v.DepositSettledAt, v.EstimatedTotalAmount, v.FinalizedAt, v.Currency, v.SettlementCurrency, v.ExchangeRate, v.ExchangeRateAt, v.SettlementTotalAmount, v.TierCount, v.HeldAt, v.EndsAt, v.ArchivedAt, v.CreatedAt, v.UpdatedAt}
 	}
-	return []string{"user_id", "title", "description", "total_amount", "remainder", "tier_count", "held_at", "archived_at", "created_at", "updated_at"},
-		[]any{v.UserID, v.Title, v.Description, v.TotalAmount, v.Remainder, v.TierCount, v.HeldAt, v.ArchivedAt, v.CreatedAt, v.UpdatedAt}
+	return []string{"user_id", "title", "description", "total_amount", "subtotal_amount", "tax_rate", "tax_included", "service_charge_rate", "tip_rate", "service_charge_amount", "tax_amount", "tip_amount", "remainder", "surplus", "remainder_policy", "split_mode", "deposit_amount", "deposit_settled_at", "estimated_total_amount", "finalized_at", "currency", "settlement_currency", "exchange_rate", "exchange_rate_at", "settlement_total_amount", "tier_count", "held_at", "ends_at", "archived_at", "created_at", "updated_at"},
+		[]any{v.UserID, v.Title, v.Description, v.TotalAmount, v.SubtotalAmount, v.TaxRate, v.TaxIncluded, v.ServiceChargeRate, v.TipRate, v.ServiceChargeAmount, v.TaxAmount, v.TipAmount, v.Remainder, v.Surplus, v.RemainderPolicy, v.SplitMode, v.DepositAmount, v.DepositSettledAt, v.EstimatedTotalAmount, v.FinalizedAt, v.Currency, v.SettlementCurrency, v.ExchangeRate, v.ExchangeRateAt, v.SettlementTotalAmount, v.TierCount, v.HeldAt, v.EndsAt, v.ArchivedAt, v.CreatedAt, v.UpdatedAt}
 }
 
 func setEventCreatedAt(v *model.Event, now time.Time) {
@@ -0,0 +1,70 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// EventParticipantAdjustments returns a new Query for the event_participant_adjustments table.
+func EventParticipantAdjustments(db orm.Querier) *orm.Query[model.EventParticipantAdjustment] {
+	q := orm.NewQuery[model.EventParticipantAdjustment](
+		db, orm.ResolveTableName[model.EventParticipantAdjustment]("event_participant_adjustments"), eventParticipantAdjustmentsColumns, "id",
+		scanEventParticipantAdjustment, eventParticipantAdjustmentColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventParticipantAdjustmentCreatedAt,
+		nil,
+		nil,
+	)
+	return q
+}
+
+var eventParticipantAdjustmentsColumns = []string{"id", "event_id", "participant_id", "amount", "note", "recorded_by_user_id", "created_at"}
+
+func scanEventParticipantAdjustment(rows *sql.Rows) (model.EventParticipantAdjustment, error) {
+	cols, _ := rows.Columns()
+	var v model.EventParticipantAdjustment
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "participant_id":
+			dest[i] = &v.ParticipantID
+		case "amount":
+			dest[i] = &v.Amount
+		case "note":
+			dest[i] = &v.Note
+		case "recorded_by_user_id":
+			dest[i] = &v.RecordedByUserID
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventParticipantAdjustmentColumnValuePairs(v *model.EventParticipantAdjustment, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "participant_id", "amount", "note", "recorded_by_user_id", "created_at"},
+			[]any{v.ID, v.EventID, v.ParticipantID, v.Amount, v.Note, v.RecordedByUserID, v.CreatedAt}
+	}
+	return []string{"event_id", "participant_id", "amount", "note", "recorded_by_user_id", "created_at"},
+		[]any{v.EventID, v.ParticipantID, v.Amount, v.Note, v.RecordedByUserID, v.CreatedAt}
+}
+
+func setEventParticipantAdjustmentCreatedAt(v *model.EventParticipantAdjustment, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
@@ -24,7 +24,7 @@ func EventParticipants(db orm.Querier) *orm.Query[model.EventParticipant] {
 	return q
 }
 
-var eventParticipantsColumns = []string{"id", "event_id", "name", "tier", "amount", "status", "created_at", "updated_at"}
+var eventParticipantsColumns = []string{"id", "event_id", "end_user_id", "name", "tier", "amount", "paid_amount", "adjustment_amount", "settlement_amount", "fixed_amount", "arrived_at", "left_at", "status", "token_hash", "claimed_at", "confirmed_at", "created_at", "updated_at"}
 
 func scanEventParticipant(rows *sql.Rows) (model.EventParticipant, error) {
 	cols, _ := rows.Columns()
@@ -36,14 +36,34 @@ func scanEventParticipant(rows *sql.Rows) (model.EventParticipant, error) {
 			dest[i] = &v.ID
 		case "event_id":
 			dest[i] = &v.EventID
+		case "end_user_id":
+			dest[i] = &v.EndUserID
 		case "name":
 			dest[i] = &v.Name
 		case "tier":
 			dest[i] = &v.Tier
 		case "amount":
 			dest[i] = &v.Amount
+		case "paid_amount":
+			dest[i] = &v.PaidAmount
+		case "adjustment_amount":
+			dest[i] = &v.AdjustmentAmount
+		case "settlement_amount":
+			dest[i] = &v.SettlementAmount
+		case "fixed_amount":
+			dest[i] = &v.FixedAmount
+		case "arrived_at":
+			dest[i] = &v.ArrivedAt
+		case "left_at":
+			dest[i] = &v.LeftAt
 		case "status":
 			dest[i] = &v.Status
+		case "token_hash":
+			dest[i] = &v.TokenHash
+		case "claimed_at":
+			dest[i] = &v.ClaimedAt
+		case "confirmed_at":
+			dest[i] = &v.ConfirmedAt
 		case "created_at":
 			dest[i] = &v.CreatedAt
 		case "updated_at":
@@ -58,11 +78,11 @@ func scanEventParticipant(rows *sql.Rows) (model.EventParticipant, error) {
 
 func eventParticipantColumnValuePairs(v *model.EventParticipant, includesPK bool) ([]string, []any) {
 	if includesPK {
-		return []string{"id", "event_id", "name", "tier", "amount", "status", "created_at", "updated_at"},
-			[]any{v.ID, v.EventID, v.Name, v.Tier, v.Amount, v.Status, v.CreatedAt, v.UpdatedAt}
+		return []string{"id", "event_id", "end_user_id", "name", "tier", "amount", "paid_amount", "adjustment_amount", "settlement_amount", "fixed_amount", "arrived_at", "left_at", "status", "token_hash", "claimed_at", "confirmed_at", "created_at", "updated_at"},
+			[]any{v.ID, v.EventID, v.EndUserID, v.Name, v.Tier, v.Amount, v.PaidAmount, v.AdjustmentAmount, v.SettlementAmount, v.FixedAmount, v.ArrivedAt, v.LeftAt, v.Status, v.TokenHash, v.ClaimedAt, v.ConfirmedAt, v.CreatedAt, v.UpdatedAt}
 	}
-	return []string{"event_id", "name", "tier", "amount", "status", "created_at", "updated_at"},
-		[]any{v.EventID, v.Name, v.Tier, v.Amount, v.Status, v.CreatedAt, v.UpdatedAt}
+	return []string{"event_id", "end_user_id", "name", "tier", "amount", "paid_amount", "adjustment_amount", "settlement_amount", "fixed_amount", "arrived_at", "left_at", "status", "token_hash", "claimed_at", "confirmed_at", "created_at", "updated_at"},
+		[]any{v.EventID, v.EndUserID, v.Name, v.Tier, v.Amount, v.PaidAmount, v.AdjustmentAmount, v.SettlementAmount, v.FixedAmount, v.ArrivedAt, v.LeftAt, v.Status, v.TokenHash, v.ClaimedAt, v.ConfirmedAt, v.CreatedAt, v.UpdatedAt}
 }
 
 func setEventParticipantCreatedAt(v *model.EventParticipant, now time.Time) {
@@ -0,0 +1,74 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// EventParticipantPayments returns a new Query for the event_participant_payments table.
+func EventParticipantPayments(db orm.Querier) *orm.Query[model.EventParticipantPayment] {
+	q := orm.NewQuery[model.EventParticipantPayment](
+		db, orm.ResolveTableName[model.EventParticipantPayment]("event_participant_payments"), eventParticipantPaymentsColumns, "id",
+		scanEventParticipantPayment, eventParticipantPaymentColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventParticipantPaymentCreatedAt,
+		nil,
+		nil,
+	)
+	return q
+}
+
+var eventParticipantPaymentsColumns = []string{"id", "event_id", "participant_id", "amount", "payment_method", "note", "recorded_by_user_id", "paid_at", "created_at"}
+
+func scanEventParticipantPayment(rows *sql.Rows) (model.EventParticipantPayment, error) {
+	cols, _ := rows.Columns()
+	var v model.EventParticipantPayment
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "participant_id":
+			dest[i] = &v.ParticipantID
+		case "amount":
+			dest[i] = &v.Amount
+		case "payment_method":
+			dest[i] = &v.PaymentMethod
+		case "note":
+			dest[i] = &v.Note
+		case "recorded_by_user_id":
+			dest[i] = &v.RecordedByUserID
+		case "paid_at":
+			dest[i] = &v.PaidAt
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventParticipantPaymentColumnValuePairs(v *model.EventParticipantPayment, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "participant_id", "amount", "payment_method", "note", "recorded_by_user_id", "paid_at", "created_at"},
+			[]any{v.ID, v.EventID, v.ParticipantID, v.Amount, v.PaymentMethod, v.Note, v.RecordedByUserID, v.PaidAt, v.CreatedAt}
+	}
+	return []string{"event_id", "participant_id", "amount", "payment_method", "note", "recorded_by_user_id", "paid_at", "created_at"},
+		[]any{v.EventID, v.ParticipantID, v.Amount, v.PaymentMethod, v.Note, v.RecordedByUserID, v.PaidAt, v.CreatedAt}
+}
+
+func setEventParticipantPaymentCreatedAt(v *model.EventParticipantPayment, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
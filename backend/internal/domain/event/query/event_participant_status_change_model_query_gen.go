@@ -0,0 +1,72 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// EventParticipantStatusChanges returns a new Query for the event_participant_status_changes table.
+func EventParticipantStatusChanges(db orm.Querier) *orm.Query[model.EventParticipantStatusChange] {
+	q := orm.NewQuery[model.EventParticipantStatusChange](
+		db, orm.ResolveTableName[model.EventParticipantStatusChange]("event_participant_status_changes"), eventParticipantStatusChangesColumns, "id",
+		scanEventParticipantStatusChange, eventParticipantStatusChangeColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventParticipantStatusChangeCreatedAt,
+		nil,
+		nil,
+	)
+	return q
+}
+
+var eventParticipantStatusChangesColumns = []string{"id", "event_id", "participant_id", "to_status", "actor", "actor_user_id", "reason", "created_at"}
+
+func scanEventParticipantStatusChange(rows *sql.Rows) (model.EventParticipantStatusChange, error) {
+	cols, _ := rows.Columns()
+	var v model.EventParticipantStatusChange
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "participant_id":
+			dest[i] = &v.ParticipantID
+		case "to_status":
+			dest[i] = &v.ToStatus
+		case "actor":
+			dest[i] = &v.Actor
+		case "actor_user_id":
+			dest[i] = &v.ActorUserID
+		case "reason":
+			dest[i] = &v.Reason
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventParticipantStatusChangeColumnValuePairs(v *model.EventParticipantStatusChange, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "participant_id", "to_status", "actor", "actor_user_id", "reason", "created_at"},
+			[]any{v.ID, v.EventID, v.ParticipantID, v.ToStatus, v.Actor, v.ActorUserID, v.Reason, v.CreatedAt}
+	}
+	return []string{"event_id", "participant_id", "to_status", "actor", "actor_user_id", "reason", "created_at"},
+		[]any{v.EventID, v.ParticipantID, v.ToStatus, v.Actor, v.ActorUserID, v.Reason, v.CreatedAt}
+}
+
+func setEventParticipantStatusChangeCreatedAt(v *model.EventParticipantStatusChange, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
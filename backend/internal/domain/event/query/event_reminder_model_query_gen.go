@@ -0,0 +1,64 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// EventReminders returns a new Query for the event_reminders table.
+func EventReminders(db orm.Querier) *orm.Query[model.EventReminder] {
+	q := orm.NewQuery[model.EventReminder](
+		db, orm.ResolveTableName[model.EventReminder]("event_reminders"), eventRemindersColumns, "id",
+		scanEventReminder, eventReminderColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventReminderCreatedAt,
+		nil,
+		nil,
+	)
+	return q
+}
+
+var eventRemindersColumns = []string{"id", "event_id", "step", "created_at"}
+
+func scanEventReminder(rows *sql.Rows) (model.EventReminder, error) {
+	cols, _ := rows.Columns()
+	var v model.EventReminder
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "step":
+			dest[i] = &v.Step
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventReminderColumnValuePairs(v *model.EventReminder, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "step", "created_at"},
+			[]any{v.ID, v.EventID, v.Step, v.CreatedAt}
+	}
+	return []string{"event_id", "step", "created_at"},
+		[]any{v.EventID, v.Step, v.CreatedAt}
+}
+
+func setEventReminderCreatedAt(v *model.EventReminder, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
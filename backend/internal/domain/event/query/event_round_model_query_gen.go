@@ -0,0 +1,254 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/ormgen/scope"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+)
+
+// EventRounds returns a new Query for the event_rounds table.
+func EventRounds(db orm.Querier) *orm.Query[model.EventRound] {
+	q := orm.NewQuery[model.EventRound](
+		db, orm.ResolveTableName[model.EventRound]("event_rounds"), eventRoundsColumns, "id",
+		scanEventRound, eventRoundColumnValuePairs, nil,
+	)
+	q.RegisterJoin("Tiers", orm.JoinConfig{
+		TargetTable: orm.ResolveTableName[model.EventRoundTier]("event_round_tiers"), TargetColumn: "round_id",
+		SourceTable: orm.ResolveTableName[model.EventRound]("event_rounds"), SourceColumn: "id",
+	})
+	q.RegisterPreloader("Tiers", preloadEventRoundTiers)
+	q.RegisterJoin("Participants", orm.JoinConfig{
+		TargetTable: orm.ResolveTableName[model.EventRoundParticipant]("event_round_participants"), TargetColumn: "round_id",
+		SourceTable: orm.ResolveTableName[model.EventRound]("event_rounds"), SourceColumn: "id",
+	})
+	q.RegisterPreloader("Participants", preloadEventRoundParticipants)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventRoundCreatedAt,
+		[]string{"updated_at"},
+		setEventRoundUpdatedAt,
+	)
+	return q
+}
+
+var eventRoundsColumns = []string{"id", "event_id", "round", "title", "total_amount", "remainder", "surplus", "created_at", "updated_at"}
+
+func scanEventRound(rows *sql.Rows) (model.EventRound, error) {
+	cols, _ := rows.Columns()
+	var v model.EventRound
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "round":
+			dest[i] = &v.Round
+		case "title":
+			dest[i] = &v.Title
+		case "total_amount":
+			dest[i] = &v.TotalAmount
+		case "remainder":
+			dest[i] = &v.Remainder
+		case "surplus":
+			dest[i] = &v.Surplus
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		case "updated_at":
+			dest[i] = &v.UpdatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventRoundColumnValuePairs(v *model.EventRound, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "round", "title", "total_amount", "remainder", "surplus", "created_at", "updated_at"},
+			[]any{v.ID, v.EventID, v.Round, v.Title, v.TotalAmount, v.Remainder, v.Surplus, v.CreatedAt, v.UpdatedAt}
+	}
+	return []string{"event_id", "round", "title", "total_amount", "remainder", "surplus", "created_at", "updated_at"},
+		[]any{v.EventID, v.Round, v.Title, v.TotalAmount, v.Remainder, v.Surplus, v.CreatedAt, v.UpdatedAt}
+}
+
+func setEventRoundCreatedAt(v *model.EventRound, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
+func setEventRoundUpdatedAt(v *model.EventRound, now time.Time) {
+	v.UpdatedAt = now
+}
+func preloadEventRoundTiers(ctx context.Context, db orm.Querier, results []model.EventRound) error {
+	if len(results) == 0 {
+		return nil
+	}
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].ID
+	}
+	related, err := EventRoundTiers(db).Scopes(scope.In("round_id", ids)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byFK := make(map[string][]model.EventRoundTier)
+	for _, r := range related {
+		byFK[r.RoundID] = append(byFK[r.RoundID], r)
+	}
+	for i := range results {
+		results[i].Tiers = byFK[results[i].ID]
+	}
+	return nil
+}
+func preloadEventRoundParticipants(ctx context.Context, db orm.Querier, results []model.EventRound) error {
+	if len(results) == 0 {
+		return nil
+	}
+	ids := make([]string, len(results))
+	for i := range results {
+		ids[i] = results[i].ID
+	}
+	related, err := EventRoundParticipants(db).Scopes(scope.In("round_id", ids)).All(ctx)
+	if err != nil {
+		return err
+	}
+	byFK := make(map[string][]model.EventRoundParticipant)
+	for _, r := range related {
+		byFK[r.RoundID] = append(byFK[r.RoundID], r)
+	}
+	for i := range results {
+		results[i].Participants = byFK[results[i].ID]
+	}
+	return nil
+}
+
+// EventRoundTiers returns a new Query for the event_round_tiers table.
+func EventRoundTiers(db orm.Querier) *orm.Query[model.EventRoundTier] {
+	q := orm.NewQuery[model.EventRoundTier](
+		db, orm.ResolveTableName[model.EventRoundTier]("event_round_tiers"), eventRoundTiersColumns, "id",
+		scanEventRoundTier, eventRoundTierColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventRoundTierCreatedAt,
+		[]string{"updated_at"},
+		setEventRoundTierUpdatedAt,
+	)
+	return q
+}
+
+var eventRoundTiersColumns = []string{"id", "event_id", "round_id", "tier", "weight", "count", "amount", "created_at", "updated_at"}
+
+func scanEventRoundTier(rows *sql.Rows) (model.EventRoundTier, error) {
+	cols, _ := rows.Columns()
+	var v model.EventRoundTier
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "round_id":
+			dest[i] = &v.RoundID
+		case "tier":
+			dest[i] = &v.Tier
+		case "weight":
+			dest[i] = &v.Weight
+		case "count":
+			dest[i] = &v.Count
+		case "amount":
+			dest[i] = &v.Amount
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		case "updated_at":
+			dest[i] = &v.UpdatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventRoundTierColumnValuePairs(v *model.EventRoundTier, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "round_id", "tier", "weight", "count", "amount", "created_at", "updated_at"},
+			[]any{v.ID, v.EventID, v.RoundID, v.Tier, v.Weight, v.Count, v.Amount, v.CreatedAt, v.UpdatedAt}
+	}
+	return []string{"event_id", "round_id", "tier", "weight", "count", "amount", "created_at", "updated_at"},
+		[]any{v.EventID, v.RoundID, v.Tier, v.Weight, v.Count, v.Amount, v.CreatedAt, v.UpdatedAt}
+}
+
+func setEventRoundTierCreatedAt(v *model.EventRoundTier, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
+func setEventRoundTierUpdatedAt(v *model.EventRoundTier, now time.Time) {
+	v.UpdatedAt = now
+}
+
+// EventRoundParticipants returns a new Query for the event_round_participants table.
+func EventRoundParticipants(db orm.Querier) *orm.Query[model.EventRoundParticipant] {
+	q := orm.NewQuery[model.EventRoundParticipant](
+		db, orm.ResolveTableName[model.EventRoundParticipant]("event_round_participants"), eventRoundParticipantsColumns, "id",
+		scanEventRoundParticipant, eventRoundParticipantColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setEventRoundParticipantCreatedAt,
+		nil,
+		nil,
+	)
+	return q
+}
+
+var eventRoundParticipantsColumns = []string{"id", "event_id", "round_id", "participant_id", "created_at"}
+
+func scanEventRoundParticipant(rows *sql.Rows) (model.EventRoundParticipant, error) {
+	cols, _ := rows.Columns()
+	var v model.EventRoundParticipant
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "event_id":
+			dest[i] = &v.EventID
+		case "round_id":
+			dest[i] = &v.RoundID
+		case "participant_id":
+			dest[i] = &v.ParticipantID
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func eventRoundParticipantColumnValuePairs(v *model.EventRoundParticipant, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "event_id", "round_id", "participant_id", "created_at"},
+			[]any{v.ID, v.EventID, v.RoundID, v.ParticipantID, v.CreatedAt}
+	}
+	return []string{"event_id", "round_id", "participant_id", "created_at"},
+		[]any{v.EventID, v.RoundID, v.ParticipantID, v.CreatedAt}
+}
+
+func setEventRoundParticipantCreatedAt(v *model.EventRoundParticipant, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
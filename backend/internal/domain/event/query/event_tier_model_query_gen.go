@@ -24,7 +24,7 @@ func EventTiers(db orm.Querier) *orm.Query[model.EventTier] {
 	return q
 }
 
-var eventTiersColumns = []string{"id", "event_id", "tier", "count", "amount", "created_at", "updated_at"}
+var eventTiersColumns = []string{"id", "event_id", "tier", "name", "weight", "count", "amount", "created_at", "updated_at"}
 
 func scanEventTier(rows *sql.Rows) (model.EventTier, error) {
 	cols, _ := rows.Columns()
@@ -38,6 +38,10 @@ func scanEventTier(rows *sql.Rows) (model.EventTier, error) {
 			dest[i] = &v.EventID
 		case "tier":
 			dest[i] = &v.Tier
+		case "name":
+			dest[i] = &v.Name
+		case "weight":
+			dest[i] = &v.Weight
 		case "count":
 			dest[i] = &v.Count
 		case "amount":
@@ -56,11 +60,11 @@ func scanEventTier(rows *sql.Rows) (model.EventTier, error) {
 
 func eventTierColumnValuePairs(v *model.EventTier, includesPK bool) ([]string, []any) {
 	if includesPK {
-		return []string{"id", "event_id", "tier", "count", "amount", "created_at", "updated_at"},
-			[]any{v.ID, v.EventID, v.Tier, v.Count, v.Amount, v.CreatedAt, v.UpdatedAt}
+		return []string{"id", "event_id", "tier", "name", "weight", "count", "amount", "created_at", "updated_at"},
+			[]any{v.ID, v.EventID, v.Tier, v.Name, v.Weight, v.Count, v.Amount, v.CreatedAt, v.UpdatedAt}
 	}
-	return []string{"event_id", "tier", "count", "amount", "created_at", "updated_at"},
-		[]any{v.EventID, v.Tier, v.Count, v.Amount, v.CreatedAt, v.UpdatedAt}
+	return []string{"event_id", "tier", "name", "weight", "count", "amount", "created_at", "updated_at"},
+		[]any{v.EventID, v.Tier, v.Name, v.Weight, v.Count, v.Amount, v.CreatedAt, v.UpdatedAt}
 }
 
 func setEventTierCreatedAt(v *model.EventTier, now time.Time) {
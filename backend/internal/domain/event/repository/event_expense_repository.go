@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/ormgen/scope"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/slicex"
+)
+
+type EventExpense interface {
+	// Create stores the expense together with its shares.
+	Create(ctx context.Context, m *model.EventExpense) error
+	Get(ctx context.Context, id string, scopes ...scope.Scope) (model.EventExpense, error)
+	ListByEventID(ctx context.Context, eventID string, scopes ...scope.Scope) ([]model.EventExpense, error)
+	Delete(ctx context.Context, id string) error
+	// ExistsForParticipant reports whether the participant paid for or is covered by any expense.
+	ExistsForParticipant(ctx context.Context, participantID string) (bool, error)
+	// ReassignParticipant moves everything the participant paid for and owes over to another participant.
+	// Shares of an expense covering both are added together.
+	ReassignParticipant(ctx context.Context, fromID, toID string) error
+	WithTx(tx *database.DB) EventExpense
+}
+
+type eventExpense struct {
+	db *database.DB
+}
+
+func NewEventExpense(db *database.DB) EventExpense {
+	return &eventExpense{db: db}
+}
+
+func (repo *eventExpense) Create(ctx context.Context, m *model.EventExpense) error {
+	if err := query.EventExpenses(repo.db).Create(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	if len(m.Shares) == 0 {
+		return nil
+	}
+	if err := query.EventExpenseShares(repo.db).CreateAll(ctx, slicex.MapToPointer(m.Shares)); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventExpense) Get(
+	ctx context.Context, id string, scopes ...scope.Scope,
+) (model.EventExpense, error) {
+	m, err := query.EventExpenses(repo.db).Scopes(scopes...).Where("id = ?", id).First(ctx)
+	if errors.Is(err, orm.ErrNotFound) {
+		return model.EventExpense{}, database.ErrNotFound
+	}
+	if err != nil {
+		return m, fmt.Errorf("repository: %w", err)
+	}
+	return m, nil
+}
+
+func (repo *eventExpense) ListByEventID(
+	ctx context.Context, eventID string, scopes ...scope.Scope,
+) ([]model.EventExpense, error) {
+	expenses, err := query.EventExpenses(repo.db).
+		Scopes(scopes...).
+		Where("event_id = ?", eventID).
+		OrderBy("created_at ASC").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	return expenses, nil
+}
+
+func (repo *eventExpense) Delete(ctx context.Context, id string) error {
+	if err := query.EventExpenses(repo.db).Where("id = ?", id).Delete(ctx); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventExpense) ExistsForParticipant(ctx context.Context, participantID string) (bool, error) {
+	paid, err := query.EventExpenses(repo.db).Where("payer_id = ?", participantID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("repository: %w", err)
+	}
+	if paid {
+		return true, nil
+	}
+	covered, err := query.EventExpenseShares(repo.db).Where("participant_id = ?", participantID).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("repository: %w", err)
+	}
+	return covered, nil
+}
+
+func (repo *eventExpense) ReassignParticipant(ctx context.Context, fromID, toID string) error {
+	statements := []string{
+		`UPDATE event_expenses SET payer_id = $2, updated_at = CURRENT_TIMESTAMP WHERE payer_id = $1`,
+		`UPDATE event_expense_shares kept SET amount = kept.amount + moved.amount, updated_at = CURRENT_TIMESTAMP
+			FROM event_expense_shares moved
+			WHERE moved.participant_id = $1 AND kept.participant_id = $2 AND kept.expense_id = moved.expense_id`,
+		`DELETE FROM event_expense_shares moved USING event_expense_shares kept
+			WHERE moved.participant_id = $1 AND kept.participant_id = $2 AND kept.expense_id = moved.expense_id`,
+		`UPDATE event_expense_shares SET participant_id = $2, updated_at = CURRENT_TIMESTAMP WHERE participant_id = $1`,
+	}
+	for _, stmt := range statements {
+		if _, err := repo.db.ExecContext(ctx, stmt, fromID, toID); err != nil {
+			return fmt.Errorf("repository: %w", err)
+		}
+	}
+	return nil
+}
+
+func (repo *eventExpense) WithTx(tx *database.DB) EventExpense {
+	return &eventExpense{db: tx}
+}
+
+func EventExpensePreloadShares() scope.Scope {
+	return scope.Preload("Shares")
+}
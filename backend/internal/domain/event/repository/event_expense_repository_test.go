@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+func createParticipants(t *testing.T, db *database.ReadWriter, eventID string, n int) []model.EventParticipant {
+	t.Helper()
+	participants := make([]model.EventParticipant, n)
+	for i := range participants {
+		participants[i] = fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = eventID })
+		require.NoError(t, query.EventParticipants(db.Writer.DB).Create(t.Context(), &participants[i]))
+	}
+	return participants
+}
+
+func TestEventExpense_ExistsForParticipant(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	participants := createParticipants(t, db, ev.ID, 3)
+	expense := model.NewEventExpense(ev.ID, participants[0].ID, "taxi", 3000, []string{participants[1].ID})
+	sut := repository.NewEventExpense(db.Writer.DB)
+	require.NoError(t, sut.Create(t.Context(), &expense))
+
+	for i, want := range []bool{true, true, false} {
+		got, err := sut.ExistsForParticipant(t.Context(), participants[i].ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "participant %d", i)
+	}
+}
+
+func TestEventExpense_ReassignParticipant(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	participants := createParticipants(t, db, ev.ID, 3)
+	kept, dup, other := participants[0], participants[1], participants[2]
+	sut := repository.NewEventExpense(db.Writer.DB)
+	// the duplicate paid for both of them, and both share the same expense
+	paid := model.NewEventExpense(ev.ID, dup.ID, "taxi", 3000, []string{kept.ID, dup.ID, other.ID})
+	require.NoError(t, sut.Create(t.Context(), &paid))
+
+	err := sut.ReassignParticipant(t.Context(), dup.ID, kept.ID)
+
+	require.NoError(t, err)
+	got, err := sut.Get(t.Context(), paid.ID, repository.EventExpensePreloadShares())
+	require.NoError(t, err)
+	assert.Equal(t, kept.ID, got.PayerID)
+	shares := make(map[string]int, len(got.Shares))
+	for _, s := range got.Shares {
+		shares[s.ParticipantID] += s.Amount
+	}
+	assert.Equal(t, map[string]int{kept.ID: 2000, other.ID: 1000}, shares)
+	assert.Len(t, got.Shares, 2)
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+// EventParticipantAdjustment is append-only like the payments ledger; a later edit adds another entry.
+type EventParticipantAdjustment interface {
+	CreateAll(ctx context.Context, ms []*model.EventParticipantAdjustment) error
+	// ListByEventID returns the adjustments of every participant of the event, oldest first.
+	ListByEventID(ctx context.Context, eventID string) ([]model.EventParticipantAdjustment, error)
+	WithTx(tx *database.DB) EventParticipantAdjustment
+}
+
+type eventParticipantAdjustment struct {
+	db *database.DB
+}
+
+func NewEventParticipantAdjustment(db *database.DB) EventParticipantAdjustment {
+	return &eventParticipantAdjustment{db: db}
+}
+
+func (repo *eventParticipantAdjustment) CreateAll(
+	ctx context.Context, ms []*model.EventParticipantAdjustment,
+) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	if err := query.EventParticipantAdjustments(repo.db).CreateAll(ctx, ms); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventParticipantAdjustment) ListByEventID(
+	ctx context.Context, eventID string,
+) ([]model.EventParticipantAdjustment, error) {
+	adjustments, err := query.EventParticipantAdjustments(repo.db).
+		Where("event_id = ?", eventID).
+		OrderBy("created_at ASC, id ASC").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	return adjustments, nil
+}
+
+func (repo *eventParticipantAdjustment) WithTx(tx *database.DB) EventParticipantAdjustment {
+	return &eventParticipantAdjustment{db: tx}
+}
@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+)
+
+func TestEventParticipantAdjustment_ListByEventID(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	other := createEvent(t, db)
+	p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+	q := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = other.ID })
+	require.NoError(t, query.EventParticipants(db.Writer.DB).CreateAll(t.Context(), []*model.EventParticipant{&p, &q}))
+
+	charge := model.NewParticipantAdjustment(p, 400, "dessert", &ev.UserID)
+	refund := model.NewParticipantAdjustment(p, -600, "", &ev.UserID)
+	elsewhere := model.NewParticipantAdjustment(q, 1000, "", &other.UserID)
+
+	sut := repository.NewEventParticipantAdjustment(db.Writer.DB)
+	require.NoError(t, sut.CreateAll(t.Context(), []*model.EventParticipantAdjustment{&charge, &elsewhere}))
+	require.NoError(t, sut.CreateAll(t.Context(), []*model.EventParticipantAdjustment{&refund}))
+	require.NoError(t, sut.CreateAll(t.Context(), nil))
+
+	got, err := repository.NewEventParticipantAdjustment(db.Reader.DB).ListByEventID(t.Context(), ev.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, charge.ID, got[0].ID)
+	assert.Equal(t, 400, got[0].Amount)
+	require.NotNil(t, got[0].Note)
+	assert.Equal(t, "dessert", *got[0].Note)
+	assert.Equal(t, refund.ID, got[1].ID)
+	assert.Equal(t, -600, got[1].Amount)
+	assert.Nil(t, got[1].Note)
+}
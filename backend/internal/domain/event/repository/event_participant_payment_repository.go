@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+// EventParticipantPayment is append-only like the status history; a mistaken entry is corrected by another.
+type EventParticipantPayment interface {
+	Create(ctx context.Context, m *model.EventParticipantPayment) error
+	// ListByEventID returns the payments of every participant of the event, oldest first.
+	ListByEventID(ctx context.Context, eventID string) ([]model.EventParticipantPayment, error)
+	WithTx(tx *database.DB) EventParticipantPayment
+}
+
+type eventParticipantPayment struct {
+	db *database.DB
+}
+
+func NewEventParticipantPayment(db *database.DB) EventParticipantPayment {
+	return &eventParticipantPayment{db: db}
+}
+
+func (repo *eventParticipantPayment) Create(ctx context.Context, m *model.EventParticipantPayment) error {
+	if err := query.EventParticipantPayments(repo.db).Create(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventParticipantPayment) ListByEventID(
+	ctx context.Context, eventID string,
+) ([]model.EventParticipantPayment, error) {
+	payments, err := query.EventParticipantPayments(repo.db).
+		Where("event_id = ?", eventID).
+		OrderBy("paid_at ASC, id ASC").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	return payments, nil
+}
+
+func (repo *eventParticipantPayment) WithTx(tx *database.DB) EventParticipantPayment {
+	return &eventParticipantPayment{db: tx}
+}
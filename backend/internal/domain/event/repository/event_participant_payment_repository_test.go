@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+)
+
+func TestEventParticipantPayment_ListByEventID(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	other := createEvent(t, db)
+	p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+	q := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = other.ID })
+	require.NoError(t, query.EventParticipants(db.Writer.DB).CreateAll(t.Context(), []*model.EventParticipant{&p, &q}))
+
+	now := time.Now().Truncate(time.Microsecond)
+	rest := model.NewParticipantPayment(p, 2000, "", "cash at the venue", &ev.UserID, now)
+	first := model.NewParticipantPayment(p, 3000, "paypay", "", &ev.UserID, now.Add(-time.Hour))
+	elsewhere := model.NewParticipantPayment(q, 1000, "", "", &other.UserID, now)
+
+	sut := repository.NewEventParticipantPayment(db.Writer.DB)
+	for _, m := range []*model.EventParticipantPayment{&rest, &first, &elsewhere} {
+		require.NoError(t, sut.Create(t.Context(), m))
+	}
+
+	got, err := repository.NewEventParticipantPayment(db.Reader.DB).ListByEventID(t.Context(), ev.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, first.ID, got[0].ID)
+	require.NotNil(t, got[0].PaymentMethod)
+	assert.Equal(t, "paypay", *got[0].PaymentMethod)
+	assert.Equal(t, rest.ID, got[1].ID)
+	assert.Nil(t, got[1].PaymentMethod)
+	require.NotNil(t, got[1].Note)
+	assert.Equal(t, "cash at the venue", *got[1].Note)
+	assert.Equal(t, 2000, got[1].Amount)
+}
@@ -18,6 +18,7 @@ type EventParticipant interface {
 	Get(ctx context.Context, id string, scopes ...scope.Scope) (model.EventParticipant, error)
 	ListByEventID(ctx context.Context, eventID string, scopes ...scope.Scope) ([]model.EventParticipant, error)
 	Update(ctx context.Context, m *model.EventParticipant) error
+	Delete(ctx context.Context, id string) error
 	WithTx(tx *database.DB) EventParticipant
 }
 
@@ -70,6 +71,13 @@ func (repo *eventParticipant) Update(ctx context.Context, m *model.EventParticip
 	return nil
 }
 
+func (repo *eventParticipant) Delete(ctx context.Context, id string) error {
+	if err := query.EventParticipants(repo.db).Where("id = ?", id).Delete(ctx); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
 func (repo *eventParticipant) WithTx(tx *database.DB) EventParticipant {
 	return &eventParticipant{db: tx}
 }
@@ -124,3 +124,20 @@ func TestEventParticipant_Update(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, model.ParticipantStatusClaimed, got.Status)
 }
+
+func TestEventParticipant_Delete(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	m := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+	require.NoError(t, query.EventParticipants(db.Writer.DB).Create(t.Context(), &m))
+
+	sut := repository.NewEventParticipant(db.Writer.DB)
+	err := sut.Delete(t.Context(), m.ID)
+
+	require.NoError(t, err)
+	exists, err := query.EventParticipants(db.Reader.DB).Where("id = ?", m.ID).Exists(t.Context())
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+// EventParticipantStatusChange is append-only; there is deliberately no way to update or delete an entry.
+type EventParticipantStatusChange interface {
+	Create(ctx context.Context, m *model.EventParticipantStatusChange) error
+	CreateAll(ctx context.Context, ms []*model.EventParticipantStatusChange) error
+	// ListByEventID returns the history of every participant of the event, oldest first.
+	ListByEventID(ctx context.Context, eventID string) ([]model.EventParticipantStatusChange, error)
+	WithTx(tx *database.DB) EventParticipantStatusChange
+}
+
+type eventParticipantStatusChange struct {
+	db *database.DB
+}
+
+func NewEventParticipantStatusChange(db *database.DB) EventParticipantStatusChange {
+	return &eventParticipantStatusChange{db: db}
+}
+
+func (repo *eventParticipantStatusChange) Create(ctx context.Context, m *model.EventParticipantStatusChange) error {
+	if err := query.EventParticipantStatusChanges(repo.db).Create(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventParticipantStatusChange) CreateAll(
+	ctx context.Context, ms []*model.EventParticipantStatusChange,
+) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	if err := query.EventParticipantStatusChanges(repo.db).CreateAll(ctx, ms); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventParticipantStatusChange) ListByEventID(
+	ctx context.Context, eventID string,
+) ([]model.EventParticipantStatusChange, error) {
+	changes, err := query.EventParticipantStatusChanges(repo.db).
+		Where("event_id = ?", eventID).
+		OrderBy("created_at ASC, id ASC").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	return changes, nil
+}
+
+func (repo *eventParticipantStatusChange) WithTx(tx *database.DB) EventParticipantStatusChange {
+	return &eventParticipantStatusChange{db: tx}
+}
@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+)
+
+func TestEventParticipantStatusChange_ListByEventID(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	other := createEvent(t, db)
+	p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+	q := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = other.ID })
+	require.NoError(t, query.EventParticipants(db.Writer.DB).CreateAll(t.Context(), []*model.EventParticipant{&p, &q}))
+
+	joined := model.NewParticipantStatusChange(p, nil, model.ParticipantStatusActorParticipant, nil, "")
+	joined.CreatedAt = time.Now().Add(-time.Hour)
+	unpaid := p.Status
+	p.Status = model.ParticipantStatusConfirmed
+	confirmed := model.NewParticipantStatusChange(p, &unpaid, model.ParticipantStatusActorOrganizer, &ev.UserID, "cash")
+	elsewhere := model.NewParticipantStatusChange(q, nil, model.ParticipantStatusActorParticipant, nil, "")
+
+	sut := repository.NewEventParticipantStatusChange(db.Writer.DB)
+	require.NoError(t, sut.Create(t.Context(), &confirmed))
+	require.NoError(t, sut.CreateAll(t.Context(), []*model.EventParticipantStatusChange{&joined, &elsewhere}))
+	require.NoError(t, sut.CreateAll(t.Context(), nil))
+
+	got, err := repository.NewEventParticipantStatusChange(db.Reader.DB).ListByEventID(t.Context(), ev.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, joined.ID, got[0].ID)
+	assert.Nil(t, got[0].FromStatus)
+	assert.Equal(t, confirmed.ID, got[1].ID)
+	assert.Equal(t, &unpaid, got[1].FromStatus)
+	assert.Equal(t, model.ParticipantStatusConfirmed, got[1].ToStatus)
+	assert.Equal(t, model.ParticipantStatusActorOrganizer, got[1].Actor)
+	assert.Equal(t, &ev.UserID, got[1].ActorUserID)
+	require.NotNil(t, got[1].Reason)
+	assert.Equal(t, "cash", *got[1].Reason)
+}
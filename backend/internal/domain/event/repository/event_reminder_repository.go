@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type EventReminder interface {
+	// LatestStep returns the last step sent for the event, 0 if none.
+	LatestStep(ctx context.Context, eventID string) (int, error)
+	// Record stores the reminder unless the step was already recorded for the event,
+	// reporting whether this call recorded it. Concurrent sweeps race on it, and only the winner sends.
+	Record(ctx context.Context, m *model.EventReminder) (bool, error)
+	WithTx(tx *database.DB) EventReminder
+}
+
+type eventReminder struct {
+	db *database.DB
+}
+
+func NewEventReminder(db *database.DB) EventReminder {
+	return &eventReminder{db: db}
+}
+
+func (repo *eventReminder) LatestStep(ctx context.Context, eventID string) (int, error) {
+	reminders, err := query.EventReminders(repo.db).
+		Where("event_id = ?", eventID).
+		OrderBy("step DESC").
+		Limit(1).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("repository: %w", err)
+	}
+	if len(reminders) == 0 {
+		return 0, nil
+	}
+	return reminders[0].Step, nil
+}
+
+func (repo *eventReminder) Record(ctx context.Context, m *model.EventReminder) (bool, error) {
+	res, err := repo.db.ExecContext(ctx,
+		"INSERT INTO event_reminders (id, event_id, step) VALUES ($1, $2, $3) ON CONFLICT (event_id, step) DO NOTHING",
+		m.ID, m.EventID, m.Step,
+	)
+	if err != nil {
+		return false, fmt.Errorf("repository: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("repository: %w", err)
+	}
+	return n == 1, nil
+}
+
+func (repo *eventReminder) WithTx(tx *database.DB) EventReminder {
+	return &eventReminder{db: tx}
+}
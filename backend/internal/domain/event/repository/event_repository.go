@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/mickamy/ormgen/orm"
 	"github.com/mickamy/ormgen/scope"
@@ -17,8 +18,14 @@ type Event interface {
 	Create(ctx context.Context, m *model.Event) error
 	Get(ctx context.Context, id string, scopes ...scope.Scope) (model.Event, error)
 	ListByUserID(ctx context.Context, userID string, scopes ...scope.Scope) ([]model.Event, error)
+	// ListAwaitingPayment lists unarchived events held at or before heldBefore
+	// where some participant has not paid or their payment is not confirmed yet.
+	ListAwaitingPayment(ctx context.Context, heldBefore time.Time, scopes ...scope.Scope) ([]model.Event, error)
 	Update(ctx context.Context, m *model.Event) error
 	Delete(ctx context.Context, id string) error
+	// Lock takes a row lock on the event until the end of the transaction,
+	// serializing changes that depend on how many participants it has.
+	Lock(ctx context.Context, id string) error
 	WithTx(tx *database.DB) Event
 }
 
@@ -64,6 +71,27 @@ func (repo *event) ListByUserID(ctx context.Context, userID string, scopes ...sc
 	return events, nil
 }
 
+func (repo *event) ListAwaitingPayment(
+	ctx context.Context, heldBefore time.Time, scopes ...scope.Scope,
+) ([]model.Event, error) {
+	events, err := query.Events(repo.db).
+		Scopes(scopes...).
+		Where("archived_at IS NULL AND held_at <= ?", heldBefore).
+		Where(
+			"EXISTS (SELECT 1 FROM event_participants p WHERE p.event_id = events.id AND p.status IN (?, ?, ?))",
+			model.ParticipantStatusUnpaid, model.ParticipantStatusClaimed, model.ParticipantStatusPartiallyPaid,
+		).
+		OrderBy("held_at ASC").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	for i := range events {
+		events[i].SortTiers()
+	}
+	return events, nil
+}
+
 func (repo *event) Update(ctx context.Context, m *model.Event) error {
 	if err := query.Events(repo.db).Update(ctx, m); err != nil {
 		return fmt.Errorf("repository: %w", err)
@@ -78,6 +106,21 @@ func (repo *event) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+func (repo *event) Lock(ctx context.Context, id string) error {
+	rows, err := repo.db.QueryContext(ctx, "SELECT id FROM events WHERE id = $1 FOR UPDATE", id)
+	if err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("repository: %w", err)
+		}
+		return database.ErrNotFound
+	}
+	return nil
+}
+
 func (repo *event) WithTx(tx *database.DB) Event {
 	return &event{db: tx}
 }
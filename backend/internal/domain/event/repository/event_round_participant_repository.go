@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type EventRoundParticipant interface {
+	CreateAll(ctx context.Context, ms []*model.EventRoundParticipant) error
+	Delete(ctx context.Context, id string) error
+	WithTx(tx *database.DB) EventRoundParticipant
+}
+
+type eventRoundParticipant struct {
+	db *database.DB
+}
+
+func NewEventRoundParticipant(db *database.DB) EventRoundParticipant {
+	return &eventRoundParticipant{db: db}
+}
+
+func (repo *eventRoundParticipant) CreateAll(ctx context.Context, ms []*model.EventRoundParticipant) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	if err := query.EventRoundParticipants(repo.db).CreateAll(ctx, ms); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRoundParticipant) Delete(ctx context.Context, id string) error {
+	if err := query.EventRoundParticipants(repo.db).Where("id = ?", id).Delete(ctx); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRoundParticipant) WithTx(tx *database.DB) EventRoundParticipant {
+	return &eventRoundParticipant{db: tx}
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type EventRound interface {
+	CreateAll(ctx context.Context, rounds []*model.EventRound) error
+	// ListByEventID returns the later rounds of the event in order, with their tiers and participants.
+	ListByEventID(ctx context.Context, eventID string) ([]model.EventRound, error)
+	Update(ctx context.Context, m *model.EventRound) error
+	Delete(ctx context.Context, id string) error
+	WithTx(tx *database.DB) EventRound
+}
+
+type eventRound struct {
+	db *database.DB
+}
+
+func NewEventRound(db *database.DB) EventRound {
+	return &eventRound{db: db}
+}
+
+func (repo *eventRound) CreateAll(ctx context.Context, rounds []*model.EventRound) error {
+	if len(rounds) == 0 {
+		return nil
+	}
+	if err := query.EventRounds(repo.db).CreateAll(ctx, rounds); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRound) ListByEventID(ctx context.Context, eventID string) ([]model.EventRound, error) {
+	rounds, err := query.EventRounds(repo.db).
+		Preload("Tiers").
+		Preload("Participants").
+		Where("event_id = ?", eventID).
+		OrderBy("round ASC").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	for i := range rounds {
+		rounds[i].SortTiers()
+	}
+	return rounds, nil
+}
+
+func (repo *eventRound) Update(ctx context.Context, m *model.EventRound) error {
+	if err := query.EventRounds(repo.db).Update(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRound) Delete(ctx context.Context, id string) error {
+	if err := query.EventRounds(repo.db).Where("id = ?", id).Delete(ctx); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRound) WithTx(tx *database.DB) EventRound {
+	return &eventRound{db: tx}
+}
@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+func TestEventRound_ListByEventID(t *testing.T) {
+	t.Parallel()
+
+	db := newReadWriter(t)
+	ev := createEvent(t, db)
+	other := createEvent(t, db)
+	p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+	require.NoError(t, query.EventParticipants(db.Writer.DB).Create(t.Context(), &p))
+
+	third := model.EventRound{ID: ulid.New(), EventID: ev.ID, Round: 3, Title: "ramen", TotalAmount: 3000}
+	second := model.EventRound{ID: ulid.New(), EventID: ev.ID, Round: 2, Title: "karaoke", TotalAmount: 8000}
+	elsewhere := model.EventRound{ID: ulid.New(), EventID: other.ID, Round: 2, Title: "bar", TotalAmount: 5000}
+	sut := repository.NewEventRound(db.Writer.DB)
+	require.NoError(t, sut.CreateAll(t.Context(), []*model.EventRound{&third, &second, &elsewhere}))
+
+	tiers := []*model.EventRoundTier{
+		{ID: ulid.New(), EventID: ev.ID, RoundID: second.ID, Tier: 2, Weight: 2000, Count: 1},
+		{ID: ulid.New(), EventID: ev.ID, RoundID: second.ID, Tier: 1, Weight: 1000, Count: 2},
+	}
+	require.NoError(t, repository.NewEventRoundTier(db.Writer.DB).CreateAll(t.Context(), tiers))
+	joined := model.EventRoundParticipant{ID: ulid.New(), EventID: ev.ID, RoundID: second.ID, ParticipantID: p.ID}
+	require.NoError(t, repository.NewEventRoundParticipant(db.Writer.DB).
+		CreateAll(t.Context(), []*model.EventRoundParticipant{&joined}))
+
+	got, err := repository.NewEventRound(db.Reader.DB).ListByEventID(t.Context(), ev.ID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, second.ID, got[0].ID)
+	assert.Equal(t, third.ID, got[1].ID)
+	require.Len(t, got[0].Tiers, 2)
+	assert.Equal(t, 1, got[0].Tiers[0].Tier, "tiers are sorted")
+	assert.Equal(t, 2, got[0].Tiers[1].Tier)
+	assert.True(t, got[0].HasParticipant(p.ID))
+	assert.Empty(t, got[1].Participants)
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type EventRoundTier interface {
+	CreateAll(ctx context.Context, tiers []*model.EventRoundTier) error
+	DeleteByEventID(ctx context.Context, eventID string) error
+	WithTx(tx *database.DB) EventRoundTier
+}
+
+type eventRoundTier struct {
+	db *database.DB
+}
+
+func NewEventRoundTier(db *database.DB) EventRoundTier {
+	return &eventRoundTier{db: db}
+}
+
+func (repo *eventRoundTier) CreateAll(ctx context.Context, tiers []*model.EventRoundTier) error {
+	if len(tiers) == 0 {
+		return nil
+	}
+	if err := query.EventRoundTiers(repo.db).CreateAll(ctx, tiers); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRoundTier) DeleteByEventID(ctx context.Context, eventID string) error {
+	if err := query.EventRoundTiers(repo.db).Where("event_id = ?", eventID).Delete(ctx); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *eventRoundTier) WithTx(tx *database.DB) EventRoundTier {
+	return &eventRoundTier{db: tx}
+}
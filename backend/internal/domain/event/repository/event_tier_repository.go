@@ -12,6 +12,7 @@ import (
 type EventTier interface {
 	CreateAll(ctx context.Context, tiers []*model.EventTier) error
 	ListByEventID(ctx context.Context, eventID string) ([]model.EventTier, error)
+	Update(ctx context.Context, m *model.EventTier) error
 	DeleteByEventID(ctx context.Context, eventID string) error
 	WithTx(tx *database.DB) EventTier
 }
@@ -44,6 +45,13 @@ func (repo *eventTier) ListByEventID(
 	return tiers, nil
 }
 
+func (repo *eventTier) Update(ctx context.Context, m *model.EventTier) error {
+	if err := query.EventTiers(repo.db).Update(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
 func (repo *eventTier) DeleteByEventID(ctx context.Context, eventID string) error {
 	if err := query.EventTiers(repo.db).Where("event_id = ?", eventID).Delete(ctx); err != nil {
 		return fmt.Errorf("repository: %w", err)
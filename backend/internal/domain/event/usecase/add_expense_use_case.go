@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrAddExpenseNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrAddExpenseForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrAddExpenseEmptyTitle = cmodel.NewLocalizableError(
+		errx.NewSentinel("title is required", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorTitleRequired())
+	ErrAddExpenseNonPositiveAmount = cmodel.NewLocalizableError(
+		errx.NewSentinel("amount must be positive", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorExpenseAmountPositive())
+	ErrAddExpenseParticipantsRequired = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant_ids are required", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorExpenseParticipantsRequired())
+	ErrAddExpenseInvalidParticipant = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid payer or participant", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorExpenseParticipantInvalid())
+)
+
+type AddExpenseInput struct {
+	EventID string
+	PayerID string
+	Title   string
+	Amount  int
+	// ParticipantIDs are the participants the expense covers, which may include the payer.
+	ParticipantIDs []string
+}
+
+type AddExpenseOutput struct {
+	Expense model.EventExpense
+}
+
+type AddExpense interface {
+	Do(ctx context.Context, input AddExpenseInput) (AddExpenseOutput, error)
+}
+
+type addExpense struct {
+	_           AddExpense              `inject:"returns"`
+	_           *di.Infra               `inject:"param"`
+	writer      *database.Writer        `inject:""`
+	eventRepo   repository.Event        `inject:""`
+	expenseRepo repository.EventExpense `inject:""`
+}
+
+func (uc *addExpense) Do(ctx context.Context, input AddExpenseInput) (AddExpenseOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.Title == "" {
+		return AddExpenseOutput{}, errx.Wrap(ErrAddExpenseEmptyTitle).
+			WithFieldViolation("title", ErrAddExpenseEmptyTitle.LocalizeContext(ctx))
+	}
+	if input.Amount <= 0 {
+		return AddExpenseOutput{}, errx.Wrap(ErrAddExpenseNonPositiveAmount, "amount", input.Amount).
+			WithFieldViolation("amount", ErrAddExpenseNonPositiveAmount.LocalizeContext(ctx))
+	}
+	if len(input.ParticipantIDs) == 0 {
+		return AddExpenseOutput{}, errx.Wrap(ErrAddExpenseParticipantsRequired).
+			WithFieldViolation("participant_ids", ErrAddExpenseParticipantsRequired.LocalizeContext(ctx))
+	}
+
+	var expense model.EventExpense
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, input.EventID, repository.EventPreloadParticipants())
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrAddExpenseNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		if ev.UserID != userID {
+			return ErrAddExpenseForbidden
+		}
+
+		// waitlisted people are not on the trip yet, so they can neither pay nor be covered
+		members := make(map[string]bool, len(ev.Participants))
+		for _, p := range ev.Participants {
+			members[p.ID] = !p.IsWaitlisted()
+		}
+		if !members[input.PayerID] {
+			return errx.Wrap(ErrAddExpenseInvalidParticipant, "payer_id", input.PayerID).
+				WithFieldViolation("payer_id", ErrAddExpenseInvalidParticipant.LocalizeContext(ctx))
+		}
+		seen := make(map[string]bool, len(input.ParticipantIDs))
+		for _, id := range input.ParticipantIDs {
+			if !members[id] || seen[id] {
+				return errx.Wrap(ErrAddExpenseInvalidParticipant, "participant_id", id).
+					WithFieldViolation("participant_ids", ErrAddExpenseInvalidParticipant.LocalizeContext(ctx))
+			}
+			seen[id] = true
+		}
+
+		expense = model.NewEventExpense(ev.ID, input.PayerID, input.Title, input.Amount, input.ParticipantIDs)
+		if err := uc.expenseRepo.WithTx(tx).Create(ctx, &expense); err != nil {
+			return errx.Wrap(err, "message", "failed to create expense", "event_id", ev.ID).
+				WithCode(errx.Internal)
+		}
+
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return AddExpenseOutput{}, err
+	}
+
+	return AddExpenseOutput{Expense: expense}, nil
+}
@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestAddExpense_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		payer := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &payer))
+		other := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &other))
+
+		sut := usecase.NewAddExpense(infra)
+		out, err := sut.Do(ctx, usecase.AddExpenseInput{
+			EventID:        ev.ID,
+			PayerID:        payer.ID,
+			Title:          "hotel",
+			Amount:         10001,
+			ParticipantIDs: []string{payer.ID, other.ID},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, payer.ID, out.Expense.PayerID)
+		require.Len(t, out.Expense.Shares, 2)
+		assert.Equal(t, 5001, out.Expense.Shares[0].Amount)
+		assert.Equal(t, 5000, out.Expense.Shares[1].Amount)
+
+		shares, err := query.EventExpenseShares(infra.ReaderDB).Where("expense_id = ?", out.Expense.ID).All(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, shares, 2)
+	})
+
+	t.Run("participant of another event", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		otherEv := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &otherEv))
+		payer := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &payer))
+		stranger := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = otherEv.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &stranger))
+
+		sut := usecase.NewAddExpense(infra)
+		_, err := sut.Do(ctx, usecase.AddExpenseInput{
+			EventID:        ev.ID,
+			PayerID:        payer.ID,
+			Title:          "hotel",
+			Amount:         10000,
+			ParticipantIDs: []string{payer.ID, stranger.ID},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrAddExpenseInvalidParticipant)
+	})
+
+	t.Run("non-positive amount", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		sut := usecase.NewAddExpense(infra)
+		_, err := sut.Do(ctx, usecase.AddExpenseInput{
+			EventID:        "event",
+			PayerID:        "payer",
+			Title:          "hotel",
+			Amount:         0,
+			ParticipantIDs: []string{"payer"},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrAddExpenseNonPositiveAmount)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = owner.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		payer := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &payer))
+
+		sut := usecase.NewAddExpense(infra)
+		_, err := sut.Do(ctx, usecase.AddExpenseInput{
+			EventID:        ev.ID,
+			PayerID:        payer.ID,
+			Title:          "hotel",
+			Amount:         10000,
+			ParticipantIDs: []string{payer.ID},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrAddExpenseForbidden)
+	})
+}
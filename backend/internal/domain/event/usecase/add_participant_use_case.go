@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrAddParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrAddParticipantForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrAddParticipantEmptyName = cmodel.NewLocalizableError(
+		errx.NewSentinel("name is required", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorNameRequired())
+	ErrAddParticipantInvalidTier = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid tier", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorInvalidTier())
+	ErrAddParticipantLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorLocked())
+	ErrAddParticipantTierFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+)
+
+type AddParticipantInput struct {
+	EventID string
+	Name    string
+	Tier    int
+}
+
+type AddParticipantOutput struct {
+	Event       model.Event
+	Participant model.EventParticipant
+	// Token is for the organizer to pass on, so the participant can act on their own behalf later.
+	Token string
+}
+
+type AddParticipant interface {
+	Do(ctx context.Context, input AddParticipantInput) (AddParticipantOutput, error)
+}
+
+type addParticipant struct {
+	_               AddParticipant                          `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+}
+
+// Do adds a participant on the organizer's behalf, e.g. someone without a smartphone at hand.
+// Unlike JoinEvent, it never waitlists: organizers grow the tier with UpdateEvent instead.
+func (uc *addParticipant) Do(ctx context.Context, input AddParticipantInput) (AddParticipantOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.Name == "" {
+		return AddParticipantOutput{}, errx.Wrap(ErrAddParticipantEmptyName).
+			WithFieldViolation("name", ErrAddParticipantEmptyName.LocalizeContext(ctx))
+	}
+
+	var ev model.Event
+	var participant model.EventParticipant
+	var token string
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// concurrent joins must not both see the last spot as free
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrAddParticipantNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		if ev.UserID != userID {
+			return ErrAddParticipantForbidden
+		}
+		if ev.AmountsLocked() {
+			return ErrAddParticipantLocked
+		}
+		if input.Tier < 1 || input.Tier > ev.TierCount {
+			return ErrAddParticipantInvalidTier
+		}
+		if !ev.HasVacancy(input.Tier) {
+			return ErrAddParticipantTierFull
+		}
+
+		participant = model.EventParticipant{
+			ID:      ulid.New(),
+			EventID: ev.ID,
+			Name:    input.Name,
+			Tier:    input.Tier,
+			Status:  model.ParticipantStatusUnpaid,
+		}
+		token, err = participant.IssueToken()
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to issue participant token").
+				WithCode(errx.Internal)
+		}
+		if err := uc.participantRepo.WithTx(tx).Create(ctx, &participant); err != nil {
+			return errx.Wrap(err, "message", "failed to create participant").
+				WithCode(errx.Internal)
+		}
+
+		change := model.NewParticipantStatusChange(
+			participant, nil, model.ParticipantStatusActorOrganizer, &userID, "",
+		)
+		if err := uc.statusRepo.WithTx(tx).Create(ctx, &change); err != nil {
+			return errx.Wrap(err, "message", "failed to record participant status change").
+				WithCode(errx.Internal)
+		}
+
+		ev.Participants = append(ev.Participants, participant)
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+		participant = ev.Participants[len(ev.Participants)-1]
+
+		return saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return AddParticipantOutput{}, err
+	}
+
+	return AddParticipantOutput{Event: ev, Participant: participant, Token: token}, nil
+}
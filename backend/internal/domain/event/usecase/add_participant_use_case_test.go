@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestAddParticipant_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 2)
+
+		sut := usecase.NewAddParticipant(infra)
+		out, err := sut.Do(ctx, usecase.AddParticipantInput{EventID: ev.ID, Name: "Guest", Tier: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Guest", out.Participant.Name)
+		assert.Equal(t, model.ParticipantStatusUnpaid, out.Participant.Status)
+		assert.Equal(t, 3000, out.Participant.Amount)
+		assert.Nil(t, out.Participant.EndUserID, "the organizer does not become the participant")
+		assert.NotEmpty(t, out.Token)
+		assert.Len(t, out.Event.Participants, 3)
+
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", out.Participant.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.True(t, persisted.VerifyToken(out.Token))
+
+		changes := statusChanges(t, infra, out.Participant.ID)
+		require.Len(t, changes, 1)
+		assert.Nil(t, changes[0].FromStatus)
+		assert.Equal(t, model.ParticipantStatusActorOrganizer, changes[0].Actor)
+		assert.Equal(t, endUser.UserID, *changes[0].ActorUserID)
+	})
+
+	t.Run("tier full", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+
+		sut := usecase.NewAddParticipant(infra)
+		_, err := sut.Do(ctx, usecase.AddParticipantInput{EventID: ev.ID, Name: "Guest", Tier: 1})
+
+		require.ErrorIs(t, err, usecase.ErrAddParticipantTierFull)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		participants[0].Status = model.ParticipantStatusClaimed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewAddParticipant(infra)
+		_, err := sut.Do(ctx, usecase.AddParticipantInput{EventID: ev.ID, Name: "Guest", Tier: 1})
+
+		require.ErrorIs(t, err, usecase.ErrAddParticipantLocked)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, _ := seedRoster(t, infra, owner.UserID, 1)
+
+		sut := usecase.NewAddParticipant(infra)
+		_, err := sut.Do(ctx, usecase.AddParticipantInput{EventID: ev.ID, Name: "Guest", Tier: 1})
+
+		require.ErrorIs(t, err, usecase.ErrAddParticipantForbidden)
+	})
+}
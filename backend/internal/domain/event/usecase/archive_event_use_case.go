@@ -11,6 +11,7 @@ import (
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
 	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
@@ -38,10 +39,11 @@ type ArchiveEvent interface {
 }
 
 type archiveEvent struct {
-	_         ArchiveEvent     `inject:"returns"`
-	_         *di.Infra        `inject:"param"`
-	writer    *database.Writer `inject:""`
-	eventRepo repository.Event `inject:""`
+	_          ArchiveEvent              `inject:"returns"`
+	_          *di.Infra                 `inject:"param"`
+	writer     *database.Writer          `inject:""`
+	eventRepo  repository.Event          `inject:""`
+	outboxRepo orepository.OutboxMessage `inject:""`
 }
 
 func (uc *archiveEvent) Do(ctx context.Context, input ArchiveEventInput) (ArchiveEventOutput, error) {
@@ -63,13 +65,20 @@ func (uc *archiveEvent) Do(ctx context.Context, input ArchiveEventInput) (Archiv
 			return ErrArchiveEventForbidden
 		}
 
+		wasArchived := ev.ArchivedAt != nil
 		now := time.Now()
 		ev.ArchivedAt = &now
 		if err := uc.eventRepo.WithTx(tx).Update(ctx, &ev); err != nil {
 			return errx.Wrap(err, "message", "failed to archive event", "id", input.ID).
 				WithCode(errx.Internal)
 		}
-		return nil
+
+		if wasArchived {
+			return nil
+		}
+		return publishDomainEvent(ctx, uc.outboxRepo.WithTx(tx), model.DomainEventEventArchived, model.EventArchived{
+			EventID: ev.ID,
+		})
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
 		return ArchiveEventOutput{}, err
@@ -39,6 +39,11 @@ func TestArchiveEvent_Do(t *testing.T) {
 		persisted, err := query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
 		require.NoError(t, err)
 		assert.NotNil(t, persisted.ArchivedAt)
+
+		assert.Equal(t,
+			[]model.EventArchived{{EventID: ev.ID}},
+			domainEvents[model.EventArchived](t, infra, model.DomainEventEventArchived),
+		)
 	})
 
 	t.Run("not found", func(t *testing.T) {
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrChangeRoundsNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrChangeRoundsInvalidToken = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid participant token", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorInvalidParticipantToken())
+	ErrChangeRoundsArchived = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is archived", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorArchived())
+	ErrChangeRoundsAlreadyClaimed = cmodel.NewLocalizableError(
+		errx.NewSentinel("already claimed", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorAlreadyClaimed())
+	ErrChangeRoundsWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("waitlisted participants cannot join later rounds", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+	ErrChangeRoundsRoundNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("round not found", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorRoundNotFound())
+	ErrChangeRoundsRoundFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("round tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+)
+
+type ChangeRoundsInput struct {
+	ParticipantID string
+	// Token is the participant's secret from JoinEvent, proving the caller is that participant.
+	Token string
+	// Rounds are the later rounds the participant takes part in; the ones left out are dropped.
+	Rounds []int
+}
+
+type ChangeRoundsOutput struct {
+	Participant model.EventParticipant
+}
+
+type ChangeRounds interface {
+	Do(ctx context.Context, input ChangeRoundsInput) (ChangeRoundsOutput, error)
+}
+
+type changeRounds struct {
+	_                    ChangeRounds                            `inject:"returns"`
+	_                    *di.Infra                               `inject:"param"`
+	writer               *database.Writer                        `inject:""`
+	eventRepo            repository.Event                        `inject:""`
+	roundRepo            repository.EventRound                   `inject:""`
+	tierRepo             repository.EventTier                    `inject:""`
+	participantRepo      repository.EventParticipant             `inject:""`
+	roundParticipantRepo repository.EventRoundParticipant        `inject:""`
+	adjustmentRepo       repository.EventParticipantAdjustment   `inject:""`
+	statusRepo           repository.EventParticipantStatusChange `inject:""`
+	outboxRepo           orepository.OutboxMessage               `inject:""`
+}
+
+// Do sets the later rounds the participant takes part in on their own behalf, which they can do until they pay.
+// The recalculation can still move what others owe, e.g. where the remainder of a round goes; for those who
+// already paid, the difference is recorded as an adjustment, so joining the next venue late does not have to
+// wait for the organizer.
+func (uc *changeRounds) Do(ctx context.Context, input ChangeRoundsInput) (ChangeRoundsOutput, error) {
+	var participant model.EventParticipant
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		found, err := uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrChangeRoundsNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if !found.VerifyToken(input.Token) {
+			return ErrChangeRoundsInvalidToken
+		}
+
+		// a seat in a later round is taken here, so joins must wait
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, found.EventID); err != nil {
+			return errx.Wrap(err, "message", "failed to lock event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
+		ev, err := uc.eventRepo.WithTx(tx).Get(
+			ctx, found.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+		if ev.ArchivedAt != nil {
+			return ErrChangeRoundsArchived
+		}
+
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrChangeRoundsNotFound
+		}
+		if ev.Participants[idx].IsWaitlisted() {
+			return ErrChangeRoundsWaitlisted
+		}
+		if ev.Participants[idx].AmountLocked() {
+			return ErrChangeRoundsAlreadyClaimed
+		}
+
+		locked := ev.LockedAmounts()
+		attendance, err := optIntoRounds(&ev, ev.Participants[idx], input.Rounds)
+		if errors.Is(err, errRoundNotFound) {
+			return errx.Wrap(ErrChangeRoundsRoundNotFound, "rounds", input.Rounds).
+				WithFieldViolation("rounds", ErrChangeRoundsRoundNotFound.LocalizeContext(ctx))
+		}
+		if errors.Is(err, errRoundFull) {
+			return ErrChangeRoundsRoundFull
+		}
+
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+		adjustments := ev.AdjustLockedAmounts(locked, "", nil)
+		if err := recordAdjustments(
+			ctx, &ev, adjustments, model.ParticipantStatusActorSystem, nil,
+			uc.adjustmentRepo.WithTx(tx), uc.statusRepo.WithTx(tx), uc.outboxRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		participant = ev.Participants[idx]
+		return attendance.save(ctx, uc.roundParticipantRepo.WithTx(tx))
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return ChangeRoundsOutput{}, err
+	}
+
+	return ChangeRoundsOutput{Participant: participant}, nil
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestChangeRounds_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2)
+		round := seedRound(t, infra, ev, 4000, 2)
+		token := issueToken(t, infra, &participants[1])
+
+		sut := usecase.NewChangeRounds(infra)
+		out, err := sut.Do(t.Context(), usecase.ChangeRoundsInput{
+			ParticipantID: participants[1].ID,
+			Token:         token,
+			Rounds:        []int{2},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5000, out.Participant.Amount)
+		assert.Equal(t, []string{participants[1].ID}, roundParticipantIDs(t, infra, round.ID))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		seedRound(t, infra, ev, 4000, 2)
+		issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeRounds(infra)
+		_, err := sut.Do(t.Context(), usecase.ChangeRoundsInput{
+			ParticipantID: participants[0].ID,
+			Token:         "wrong",
+			Rounds:        []int{2},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrChangeRoundsInvalidToken)
+	})
+
+	t.Run("already claimed", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		seedRound(t, infra, ev, 4000, 2)
+		participants[0].Status = model.ParticipantStatusClaimed
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeRounds(infra)
+		_, err := sut.Do(t.Context(), usecase.ChangeRoundsInput{
+			ParticipantID: participants[0].ID,
+			Token:         token,
+			Rounds:        []int{2},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrChangeRoundsAlreadyClaimed)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3000, persisted.Amount)
+	})
+}
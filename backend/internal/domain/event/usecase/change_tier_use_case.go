@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrChangeTierNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrChangeTierInvalidToken = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid participant token", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorInvalidParticipantToken())
+	ErrChangeTierArchived = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is archived", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorArchived())
+	ErrChangeTierAlreadyClaimed = cmodel.NewLocalizableError(
+		errx.NewSentinel("already claimed", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorAlreadyClaimed())
+	ErrChangeTierLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("amounts are locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorParticipantLocked())
+	ErrChangeTierInvalidTier = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid tier", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorInvalidTier())
+	ErrChangeTierTierFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+)
+
+type ChangeTierInput struct {
+	ParticipantID string
+	// Token is the participant's secret from JoinEvent, proving the caller is that participant.
+	Token string
+	Tier  int
+}
+
+type ChangeTierOutput struct {
+	Participant model.EventParticipant
+}
+
+type ChangeTier interface {
+	Do(ctx context.Context, input ChangeTierInput) (ChangeTierOutput, error)
+}
+
+type changeTier struct {
+	_               ChangeTier                              `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+}
+
+// Do moves the participant to another tier on their own behalf, which they can do until they pay.
+// Moving recomputes everyone's amounts, so like the organizer's UpdateParticipant it is locked
+// once anyone has claimed a payment. A waitlisted participant stays on the waitlist of the new tier
+// unless it has a free spot.
+func (uc *changeTier) Do(ctx context.Context, input ChangeTierInput) (ChangeTierOutput, error) {
+	var participant model.EventParticipant
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		found, err := uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrChangeTierNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if !found.VerifyToken(input.Token) {
+			return ErrChangeTierInvalidToken
+		}
+
+		// moving frees a spot in one tier and takes one in another, so joins must wait
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, found.EventID); err != nil {
+			return errx.Wrap(err, "message", "failed to lock event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
+		ev, err := uc.eventRepo.WithTx(tx).Get(
+			ctx, found.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+		if ev.ArchivedAt != nil {
+			return ErrChangeTierArchived
+		}
+
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrChangeTierNotFound
+		}
+		self := &ev.Participants[idx]
+		if self.Status != model.ParticipantStatusUnpaid && !self.IsWaitlisted() {
+			return ErrChangeTierAlreadyClaimed
+		}
+		if input.Tier < 1 || input.Tier > ev.TierCount {
+			return errx.Wrap(ErrChangeTierInvalidTier).
+				WithFieldViolation("tier", ErrChangeTierInvalidTier.LocalizeContext(ctx))
+		}
+		if self.Tier == input.Tier {
+			participant = *self
+			return nil
+		}
+		if ev.AmountsLocked() {
+			return ErrChangeTierLocked
+		}
+		if !self.IsWaitlisted() && !ev.HasVacancy(input.Tier) {
+			return ErrChangeTierTierFull
+		}
+		self.Tier = input.Tier
+		// they keep their later rounds, so those must have a spot in the new tier too
+		if !ev.RoundsWithinCapacity() {
+			return ErrChangeTierTierFull
+		}
+
+		// the spot left behind goes to whoever waits for it
+		promoted := ev.PromoteWaitlisted()
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		participant = ev.Participants[idx]
+		return recordPromotions(ctx, uc.statusRepo.WithTx(tx), promoted)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return ChangeTierOutput{}, err
+	}
+
+	return ChangeTierOutput{Participant: participant}, nil
+}
@@ -0,0 +1,115 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestChangeTier_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 2, 1)
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeTier(infra)
+		out, err := sut.Do(t.Context(), usecase.ChangeTierInput{
+			ParticipantID: participants[0].ID,
+			Token:         token,
+			Tier:          2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, out.Participant.Tier)
+		assert.Equal(t, model.ParticipantStatusUnpaid, out.Participant.Status)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2, persisted.Tier)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 1, 0)
+		issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeTier(infra)
+		_, err := sut.Do(t.Context(), usecase.ChangeTierInput{
+			ParticipantID: participants[0].ID,
+			Token:         "wrong",
+			Tier:          2,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrChangeTierInvalidToken)
+	})
+
+	t.Run("already claimed", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 1, 0)
+		participants[0].Status = model.ParticipantStatusClaimed
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeTier(infra)
+		_, err := sut.Do(t.Context(), usecase.ChangeTierInput{
+			ParticipantID: participants[0].ID,
+			Token:         token,
+			Tier:          2,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrChangeTierAlreadyClaimed)
+	})
+
+	t.Run("locked by another participant's claim", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 2, 0)
+		participants[1].Status = model.ParticipantStatusClaimed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[1]))
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeTier(infra)
+		_, err := sut.Do(t.Context(), usecase.ChangeTierInput{
+			ParticipantID: participants[0].ID,
+			Token:         token,
+			Tier:          2,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrChangeTierLocked)
+	})
+
+	t.Run("tier full", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 1, 3)
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewChangeTier(infra)
+		_, err := sut.Do(t.Context(), usecase.ChangeTierInput{
+			ParticipantID: participants[0].ID,
+			Token:         token,
+			Tier:          2,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrChangeTierTierFull)
+	})
+}
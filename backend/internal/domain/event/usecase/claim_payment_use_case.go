@@ -3,14 +3,15 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mickamy/errx"
-	"github.com/mickamy/go-sqs-worker/producer"
 
 	"github.com/mickamy/sampay/internal/di"
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 )
@@ -25,10 +26,20 @@ var (
 	ErrClaimPaymentArchived = cmodel.NewLocalizableError(
 		errx.NewSentinel("event is archived", errx.FailedPrecondition),
 	).WithMessages(messages.EventUseCaseErrorArchived())
+	ErrClaimPaymentWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant is waitlisted", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+	ErrClaimPaymentInvalidToken = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid participant token", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorInvalidParticipantToken())
 )
 
 type ClaimPaymentInput struct {
 	ParticipantID string
+	// Token is the participant's secret from JoinEvent, proving the caller is that participant.
+	Token string
+	// PaymentMethod is the payment method type the participant says they used, empty if they did not say.
+	PaymentMethod string
 }
 
 type ClaimPaymentOutput struct {
@@ -40,12 +51,13 @@ type ClaimPayment interface {
 }
 
 type claimPayment struct {
-	_               ClaimPayment                `inject:"returns"`
-	_               *di.Infra                   `inject:"param"`
-	writer          *database.Writer            `inject:""`
-	eventRepo       repository.Event            `inject:""`
-	participantRepo repository.EventParticipant `inject:""`
-	producer        *producer.Producer          `inject:""`
+	_               ClaimPayment                            `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+	outboxRepo      orepository.OutboxMessage               `inject:""`
 }
 
 func (uc *claimPayment) Do(ctx context.Context, input ClaimPaymentInput) (ClaimPaymentOutput, error) {
@@ -53,7 +65,24 @@ func (uc *claimPayment) Do(ctx context.Context, input ClaimPaymentInput) (ClaimP
 	var ev model.Event
 
 	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
-		var err error
+		found, err := uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrClaimPaymentNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if !found.VerifyToken(input.Token) {
+			return ErrClaimPaymentInvalidToken
+		}
+
+		// edits to the roster save every participant as they were read under this lock,
+		// which would undo a claim made in between
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, found.EventID); err != nil {
+			return errx.Wrap(err, "message", "failed to lock event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
 		participant, err = uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
 		if err != nil {
 			if errors.Is(err, database.ErrNotFound) {
@@ -73,17 +102,34 @@ func (uc *claimPayment) Do(ctx context.Context, input ClaimPaymentInput) (ClaimP
 			return ErrClaimPaymentArchived
 		}
 
-		if participant.Status != model.ParticipantStatusUnpaid {
+		if participant.IsWaitlisted() {
+			return ErrClaimPaymentWaitlisted
+		}
+		// a partially paid participant claims again once they have sent the rest
+		if !participant.Status.CanTransitionTo(model.ParticipantStatusClaimed) {
 			return ErrClaimPaymentAlreadyClaimed
 		}
 
-		participant.Status = model.ParticipantStatusClaimed
+		from := participant.Status
+		participant.TransitionTo(model.ParticipantStatusClaimed, time.Now())
 		if err := uc.participantRepo.WithTx(tx).Update(ctx, &participant); err != nil {
 			return errx.Wrap(err, "message", "failed to update participant", "id", input.ParticipantID).
 				WithCode(errx.Internal)
 		}
 
-		return nil
+		change := model.NewParticipantStatusChange(
+			participant, &from, model.ParticipantStatusActorParticipant, participant.EndUserID, "",
+		)
+		if err := uc.statusRepo.WithTx(tx).Create(ctx, &change); err != nil {
+			return errx.Wrap(err, "message", "failed to record participant status change", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+
+		return publishDomainEvent(ctx, uc.outboxRepo.WithTx(tx), model.DomainEventPaymentClaimed, model.PaymentClaimed{
+			EventID:       participant.EventID,
+			ParticipantID: participant.ID,
+			PaymentMethod: input.PaymentMethod,
+		})
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
 		return ClaimPaymentOutput{}, err
@@ -11,6 +11,7 @@ import (
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/query"
 	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/lib/converter"
 	"github.com/mickamy/sampay/internal/test/tseed"
 )
 
@@ -30,13 +31,32 @@ func TestClaimPayment_Do(t *testing.T) {
 			p.EventID = ev.ID
 			p.Status = model.ParticipantStatusUnpaid
 		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
 		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
 
 		sut := usecase.NewClaimPayment(infra)
-		out, err := sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID})
+		out, err := sut.Do(t.Context(), usecase.ClaimPaymentInput{
+			ParticipantID: p.ID,
+			Token:         token,
+			PaymentMethod: converter.PaymentMethodTypePayPay,
+		})
 
 		require.NoError(t, err)
 		assert.Equal(t, model.ParticipantStatusClaimed, out.Participant.Status)
+		assert.NotNil(t, out.Participant.ClaimedAt)
+		changes := statusChanges(t, infra, p.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusActorParticipant, changes[0].Actor)
+		assert.Equal(t, model.ParticipantStatusClaimed, changes[0].ToStatus)
+		assert.Equal(t,
+			[]model.PaymentClaimed{{
+				EventID:       ev.ID,
+				ParticipantID: p.ID,
+				PaymentMethod: converter.PaymentMethodTypePayPay,
+			}},
+			domainEvents[model.PaymentClaimed](t, infra, model.DomainEventPaymentClaimed),
+		)
 	})
 
 	t.Run("not found", func(t *testing.T) {
@@ -63,14 +83,43 @@ func TestClaimPayment_Do(t *testing.T) {
 			p.EventID = ev.ID
 			p.Status = model.ParticipantStatusClaimed
 		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
 		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
 
 		sut := usecase.NewClaimPayment(infra)
-		_, err := sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID})
+		_, err = sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID, Token: token})
 
 		require.ErrorIs(t, err, usecase.ErrClaimPaymentAlreadyClaimed)
 	})
 
+	t.Run("claims the rest after a partial payment", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Amount = 5000
+			p.PaidAmount = 3000
+			p.Status = model.ParticipantStatusPartiallyPaid
+		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewClaimPayment(infra)
+		out, err := sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID, Token: token})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusClaimed, out.Participant.Status)
+		assert.Equal(t, 3000, out.Participant.PaidAmount)
+	})
+
 	t.Run("archived event", func(t *testing.T) {
 		t.Parallel()
 
@@ -88,11 +137,90 @@ func TestClaimPayment_Do(t *testing.T) {
 			p.EventID = ev.ID
 			p.Status = model.ParticipantStatusUnpaid
 		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
 		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
 
 		sut := usecase.NewClaimPayment(infra)
-		_, err := sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID})
+		_, err = sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID, Token: token})
 
 		require.ErrorIs(t, err, usecase.ErrClaimPaymentArchived)
 	})
+
+	t.Run("waitlisted", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusWaitlisted
+		})
+		token, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewClaimPayment(infra)
+		_, err = sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID, Token: token})
+
+		require.ErrorIs(t, err, usecase.ErrClaimPaymentWaitlisted)
+	})
+
+	t.Run("participant who joined before tokens were issued", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusUnpaid
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewClaimPayment(infra)
+		for _, token := range []string{"", "anything"} {
+			_, err := sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID, Token: token})
+			require.ErrorIs(t, err, usecase.ErrClaimPaymentInvalidToken, "until the organizer reissues one")
+		}
+
+		got, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", p.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, got.Status)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusUnpaid
+		})
+		_, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewClaimPayment(infra)
+		for _, token := range []string{"", "not-the-token"} {
+			_, err = sut.Do(t.Context(), usecase.ClaimPaymentInput{ParticipantID: p.ID, Token: token})
+			require.ErrorIs(t, err, usecase.ErrClaimPaymentInvalidToken)
+		}
+
+		got, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", p.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, got.Status)
+	})
 }
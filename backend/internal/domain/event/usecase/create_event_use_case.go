@@ -10,6 +10,8 @@ import (
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/repository"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/money"
+	"github.com/mickamy/sampay/internal/lib/ptr"
 	"github.com/mickamy/sampay/internal/lib/slicex"
 	"github.com/mickamy/sampay/internal/lib/ulid"
 	"github.com/mickamy/sampay/internal/misc/contexts"
@@ -19,9 +21,26 @@ type CreateEventInput struct {
 	Title       string
 	Description string
 	TotalAmount int
-	TierCount   int
-	HeldAt      time.Time
-	Tiers       []TierConfig
+	// Bill, when set, itemizes the first round's bill and TotalAmount is computed from it instead.
+	Bill *model.Bill
+	// TotalEstimated marks TotalAmount as the organizer's estimate, e.g. before the bill arrives,
+	// until FinalizeEvent enters the actual one.
+	TotalEstimated  bool
+	TierCount       int
+	HeldAt          time.Time
+	Tiers           []TierConfig
+	RemainderPolicy model.RemainderPolicy
+	SplitMode       model.SplitMode
+	// EndsAt is needed for participants' arrival and departure times to count under an attendance split.
+	EndsAt *time.Time
+	// DepositAmount has participants pay a fixed amount up front, TotalAmount being an estimate until
+	// FinalizeEvent enters the actual one. Nil collects no deposit.
+	DepositAmount *int
+	Currency      money.Currency
+	// Settlement is nil when participants pay in Currency.
+	Settlement *Settlement
+	// Rounds are the later rounds, e.g. the second round (2次会). TotalAmount and Tiers are the first round's.
+	Rounds []RoundConfig
 }
 
 type CreateEventOutput struct {
@@ -33,21 +52,41 @@ type CreateEvent interface {
 }
 
 type createEvent struct {
-	_         CreateEvent          `inject:"returns"`
-	_         *di.Infra            `inject:"param"`
-	writer    *database.Writer     `inject:""`
-	eventRepo repository.Event     `inject:""`
-	tierRepo  repository.EventTier `inject:""`
+	_             CreateEvent               `inject:"returns"`
+	_             *di.Infra                 `inject:"param"`
+	writer        *database.Writer          `inject:""`
+	eventRepo     repository.Event          `inject:""`
+	tierRepo      repository.EventTier      `inject:""`
+	roundRepo     repository.EventRound     `inject:""`
+	roundTierRepo repository.EventRoundTier `inject:""`
 }
 
 func (uc *createEvent) Do(ctx context.Context, input CreateEventInput) (CreateEventOutput, error) {
 	userID := contexts.MustAuthenticatedUserID(ctx)
 
+	if err := validateBill(ctx, input.Bill); err != nil {
+		return CreateEventOutput{}, err
+	}
+	if input.Bill != nil {
+		input.TotalAmount = input.Bill.Total()
+	}
 	if err := validateEventInput(
 		ctx, input.Title, input.TotalAmount, input.TierCount, input.HeldAt, input.Tiers,
 	); err != nil {
 		return CreateEventOutput{}, err
 	}
+	if err := validateEventSchedule(ctx, input.HeldAt, input.EndsAt); err != nil {
+		return CreateEventOutput{}, err
+	}
+	if err := validateDeposit(ctx, input.DepositAmount); err != nil {
+		return CreateEventOutput{}, err
+	}
+	if err := validateEventCurrency(ctx, input.Currency, input.Settlement); err != nil {
+		return CreateEventOutput{}, err
+	}
+	if err := validateRounds(ctx, input.Rounds, input.TierCount); err != nil {
+		return CreateEventOutput{}, err
+	}
 
 	tiers := make([]model.EventTier, len(input.Tiers))
 	eventID := ulid.New()
@@ -56,20 +95,32 @@ func (uc *createEvent) Do(ctx context.Context, input CreateEventInput) (CreateEv
 			ID:      ulid.New(),
 			EventID: eventID,
 			Tier:    tc.Tier,
+			Name:    tc.Name,
+			Weight:  tc.weight(),
 			Count:   tc.Count,
 		}
 	}
 
 	ev := model.Event{
-		ID:          eventID,
-		UserID:      userID,
-		Title:       input.Title,
-		Description: input.Description,
-		TotalAmount: input.TotalAmount,
-		TierCount:   input.TierCount,
-		HeldAt:      input.HeldAt,
-		Tiers:       tiers,
+		ID:              eventID,
+		UserID:          userID,
+		Title:           input.Title,
+		Description:     input.Description,
+		TotalAmount:     input.TotalAmount,
+		TierCount:       input.TierCount,
+		HeldAt:          input.HeldAt,
+		Tiers:           tiers,
+		RemainderPolicy: remainderPolicyOrDefault(input.RemainderPolicy),
+		SplitMode:       splitModeOrDefault(input.SplitMode),
+		EndsAt:          input.EndsAt,
+		DepositAmount:   input.DepositAmount,
+		Rounds:          buildRounds(eventID, input.Rounds, nil),
+	}
+	ev.ApplyBill(input.Bill)
+	if input.TotalEstimated {
+		ev.EstimatedTotalAmount = ptr.Of(input.TotalAmount)
 	}
+	applyCurrency(&ev, input.Currency, input.Settlement)
 	ev.CalcTierAmounts()
 
 	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
@@ -81,7 +132,7 @@ func (uc *createEvent) Do(ctx context.Context, input CreateEventInput) (CreateEv
 			return errx.Wrap(err, "message", "failed to create event tiers").
 				WithCode(errx.Internal)
 		}
-		return nil
+		return saveRounds(ctx, &ev, nil, uc.roundRepo.WithTx(tx), uc.roundTierRepo.WithTx(tx))
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
 		return CreateEventOutput{}, err
@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
 
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
 	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/lib/money"
 	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/test/tseed"
 )
@@ -46,6 +49,310 @@ func TestCreateEvent_Do(t *testing.T) {
 		assert.Equal(t, 3, out.Event.TierCount)
 		require.Len(t, out.Event.Tiers, 3)
 		assert.Equal(t, out.Event.ID, out.Event.Tiers[0].EventID)
+		assert.Equal(t, model.RemainderPolicyOrganizer, out.Event.RemainderPolicy)
+		assert.Equal(t, model.SplitModeTier, out.Event.SplitMode)
+		assert.Nil(t, out.Event.EstimatedTotalAmount)
+	})
+
+	t.Run("itemized bill", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:     "dinner",
+			Bill:      &model.Bill{Subtotal: 10000, TaxRate: 1000, TaxIncluded: true, ServiceChargeRate: 1000},
+			TierCount: 1,
+			HeldAt:    time.Now().Add(24 * time.Hour),
+			Tiers:     []usecase.TierConfig{{Tier: 1, Count: 2}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 11000, out.Event.TotalAmount, "the service charge is added, the tax is included")
+		assert.Equal(t, 5500, out.Event.Tiers[0].Amount)
+		require.NotNil(t, out.Event.SubtotalAmount)
+		assert.Equal(t, 10000, *out.Event.SubtotalAmount)
+		assert.Equal(t, 1000, out.Event.ServiceChargeAmount)
+		assert.Equal(t, 1000, out.Event.TaxAmount)
+	})
+
+	t.Run("non-positive subtotal", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		sut := usecase.NewCreateEvent(infra)
+		_, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "dinner",
+			TotalAmount: 10000,
+			Bill:        &model.Bill{TaxRate: 1000},
+			TierCount:   1,
+			HeldAt:      time.Now().Add(24 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 2}},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventNonPositiveSubtotal)
+	})
+
+	t.Run("bill total too large", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		sut := usecase.NewCreateEvent(infra)
+		_, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title: "dinner",
+			// doubled by the service charge and doubled again by tax, it no longer fits in int32
+			Bill:      &model.Bill{Subtotal: 1_000_000_000, TaxRate: 10000, ServiceChargeRate: 10000},
+			TierCount: 1,
+			HeldAt:    time.Now().Add(24 * time.Hour),
+			Tiers:     []usecase.TierConfig{{Tier: 1, Count: 2}},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventBillTotalTooLarge)
+	})
+
+	t.Run("estimated total", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:          "dinner",
+			TotalAmount:    15000,
+			TotalEstimated: true,
+			TierCount:      1,
+			HeldAt:         time.Now().Add(24 * time.Hour),
+			Tiers:          []usecase.TierConfig{{Tier: 1, Count: 3}},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, out.Event.EstimatedTotalAmount)
+		assert.Equal(t, 15000, *out.Event.EstimatedTotalAmount)
+		assert.True(t, out.Event.IsEstimate())
+	})
+
+	t.Run("named tiers with decimal weights", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "party",
+			TotalAmount: 10000,
+			TierCount:   2,
+			HeldAt:      time.Now().Add(24 * time.Hour),
+			Tiers: []usecase.TierConfig{
+				{Tier: 1, Name: "students", Weight: 500, Count: 4},
+				{Tier: 2, Name: "managers", Weight: 2000, Count: 1},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Event.Tiers, 2)
+		assert.Equal(t, "students", out.Event.Tiers[0].Name)
+		assert.Equal(t, 1250, out.Event.Tiers[0].Amount)
+		assert.Equal(t, 2500, out.Event.Tiers[1].Amount)
+		assert.Equal(t, 0, out.Event.Remainder)
+	})
+
+	t.Run("foreign currency with settlement", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		rateAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "trip",
+			TotalAmount: 30000,
+			TierCount:   1,
+			HeldAt:      time.Now().Add(24 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 3}},
+			Currency:    money.USD,
+			Settlement: &usecase.Settlement{
+				Currency: money.JPY,
+				Rate:     150 * money.RateScale,
+				RateAt:   rateAt,
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10000, out.Event.Tiers[0].Amount)
+		assert.Equal(t, 45000, out.Event.SettlementTotalAmount)
+
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", out.Event.ID).First(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, money.USD, got.Currency)
+		assert.Equal(t, money.JPY, got.SettlementCurrency)
+		assert.Equal(t, money.Rate(150*money.RateScale), got.ExchangeRate)
+		require.NotNil(t, got.ExchangeRateAt)
+		assert.True(t, rateAt.Equal(*got.ExchangeRateAt))
+	})
+
+	t.Run("settlement in the same currency", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		sut := usecase.NewCreateEvent(infra)
+		_, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "trip",
+			TotalAmount: 30000,
+			TierCount:   1,
+			HeldAt:      time.Now().Add(24 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 3}},
+			Settlement:  &usecase.Settlement{Currency: money.JPY, Rate: money.RateScale},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventInvalidExchangeRate)
+	})
+
+	t.Run("round up remainder policy", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:           "party",
+			TotalAmount:     10000,
+			TierCount:       1,
+			HeldAt:          time.Now().Add(24 * time.Hour),
+			Tiers:           []usecase.TierConfig{{Tier: 1, Count: 3}},
+			RemainderPolicy: model.RemainderPolicyRoundUp100,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Event.Tiers, 1)
+		assert.Equal(t, 3400, out.Event.Tiers[0].Amount)
+		assert.Equal(t, -200, out.Event.Remainder)
+
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", out.Event.ID).First(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, model.RemainderPolicyRoundUp100, got.RemainderPolicy)
+	})
+
+	t.Run("attendance split", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		heldAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		endsAt := heldAt.Add(3 * time.Hour)
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "party",
+			TotalAmount: 10000,
+			TierCount:   1,
+			HeldAt:      heldAt,
+			EndsAt:      &endsAt,
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 4}},
+			SplitMode:   model.SplitModeAttendance,
+		})
+
+		require.NoError(t, err)
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", out.Event.ID).First(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, model.SplitModeAttendance, got.SplitMode)
+		require.NotNil(t, got.EndsAt)
+		assert.True(t, endsAt.Equal(*got.EndsAt))
+	})
+
+	t.Run("ends before it is held", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		heldAt := time.Now().Add(24 * time.Hour)
+		endsAt := heldAt.Add(-time.Hour)
+		sut := usecase.NewCreateEvent(infra)
+		_, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "party",
+			TotalAmount: 10000,
+			TierCount:   1,
+			HeldAt:      heldAt,
+			EndsAt:      &endsAt,
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 4}},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventInvalidEndsAt)
+	})
+
+	t.Run("deposit", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		deposit := 20000
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:         "trip",
+			TotalAmount:   90000,
+			TierCount:     1,
+			HeldAt:        time.Now().Add(24 * time.Hour),
+			Tiers:         []usecase.TierConfig{{Tier: 1, Count: 5}},
+			DepositAmount: &deposit,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, out.Event.CollectingDeposits())
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", out.Event.ID).First(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, got.DepositAmount)
+		assert.Equal(t, 20000, *got.DepositAmount)
+	})
+
+	t.Run("non-positive deposit", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		deposit := 0
+		sut := usecase.NewCreateEvent(infra)
+		_, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:         "trip",
+			TotalAmount:   90000,
+			TierCount:     1,
+			HeldAt:        time.Now().Add(24 * time.Hour),
+			Tiers:         []usecase.TierConfig{{Tier: 1, Count: 5}},
+			DepositAmount: &deposit,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventInvalidDeposit)
 	})
 
 	t.Run("empty title", func(t *testing.T) {
@@ -69,6 +376,71 @@ func TestCreateEvent_Do(t *testing.T) {
 		require.ErrorIs(t, err, usecase.ErrValidateEventEmptyTitle)
 	})
 
+	t.Run("with later rounds", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		sut := usecase.NewCreateEvent(infra)
+		out, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "party",
+			TotalAmount: 30000,
+			TierCount:   2,
+			HeldAt:      time.Now().Add(24 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 4}, {Tier: 2, Count: 4}},
+			Rounds: []usecase.RoundConfig{{
+				Round:       2,
+				Title:       "karaoke",
+				TotalAmount: 9000,
+				Tiers:       []usecase.TierConfig{{Tier: 1, Count: 2}, {Tier: 2, Count: 2}},
+			}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Event.Rounds, 1)
+		assert.Equal(t, 39000, out.Event.GrandTotal())
+
+		rounds, err := query.EventRounds(infra.ReaderDB).
+			Preload("Tiers").
+			Where("event_id = ?", out.Event.ID).
+			All(t.Context())
+		require.NoError(t, err)
+		require.Len(t, rounds, 1)
+		assert.Equal(t, "karaoke", rounds[0].Title)
+		rounds[0].SortTiers()
+		require.Len(t, rounds[0].Tiers, 2)
+		assert.Equal(t, 1500, rounds[0].Tiers[0].Amount)
+		assert.Equal(t, 3000, rounds[0].Tiers[1].Amount)
+	})
+
+	t.Run("invalid round", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		sut := usecase.NewCreateEvent(infra)
+		_, err := sut.Do(ctx, usecase.CreateEventInput{
+			Title:       "party",
+			TotalAmount: 30000,
+			TierCount:   2,
+			HeldAt:      time.Now().Add(24 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 4}, {Tier: 2, Count: 4}},
+			Rounds: []usecase.RoundConfig{{
+				Round:       2,
+				Title:       "karaoke",
+				TotalAmount: 9000,
+				Tiers:       []usecase.TierConfig{{Tier: 1, Count: 2}},
+			}},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventInvalidRound)
+	})
+
 	t.Run("negative total amount", func(t *testing.T) {
 		t.Parallel()
 
@@ -102,7 +474,7 @@ func TestCreateEvent_Do(t *testing.T) {
 		_, err := sut.Do(ctx, usecase.CreateEventInput{
 			Title:       "party",
 			TotalAmount: 30000,
-			TierCount:   2,
+			TierCount:   11,
 			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 5}, {Tier: 2, Count: 3}},
 			HeldAt:      time.Now().Add(24 * time.Hour),
 		})
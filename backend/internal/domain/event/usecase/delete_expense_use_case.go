@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrDeleteExpenseNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("expense not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorExpenseNotFound())
+	ErrDeleteExpenseForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+)
+
+type DeleteExpenseInput struct {
+	EventID   string
+	ExpenseID string
+}
+
+type DeleteExpenseOutput struct{}
+
+type DeleteExpense interface {
+	Do(ctx context.Context, input DeleteExpenseInput) (DeleteExpenseOutput, error)
+}
+
+type deleteExpense struct {
+	_           DeleteExpense           `inject:"returns"`
+	_           *di.Infra               `inject:"param"`
+	writer      *database.Writer        `inject:""`
+	eventRepo   repository.Event        `inject:""`
+	expenseRepo repository.EventExpense `inject:""`
+}
+
+func (uc *deleteExpense) Do(ctx context.Context, input DeleteExpenseInput) (DeleteExpenseOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		expense, err := uc.expenseRepo.WithTx(tx).Get(ctx, input.ExpenseID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrDeleteExpenseNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get expense", "id", input.ExpenseID).
+				WithCode(errx.Internal)
+		}
+		if expense.EventID != input.EventID {
+			return ErrDeleteExpenseNotFound
+		}
+
+		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, expense.EventID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", expense.EventID).
+				WithCode(errx.Internal)
+		}
+		if ev.UserID != userID {
+			return ErrDeleteExpenseForbidden
+		}
+
+		if err := uc.expenseRepo.WithTx(tx).Delete(ctx, expense.ID); err != nil {
+			return errx.Wrap(err, "message", "failed to delete expense", "id", expense.ID).
+				WithCode(errx.Internal)
+		}
+
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return DeleteExpenseOutput{}, err
+	}
+
+	return DeleteExpenseOutput{}, nil
+}
@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestDeleteExpense_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		payer := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &payer))
+		expense := model.NewEventExpense(ev.ID, payer.ID, "hotel", 10000, []string{payer.ID})
+		require.NoError(t, repository.NewEventExpense(infra.WriterDB.DB).Create(t.Context(), &expense))
+
+		sut := usecase.NewDeleteExpense(infra)
+		_, err := sut.Do(ctx, usecase.DeleteExpenseInput{EventID: ev.ID, ExpenseID: expense.ID})
+
+		require.NoError(t, err)
+		count, err := query.EventExpenseShares(infra.ReaderDB).Where("expense_id = ?", expense.ID).Count(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		sut := usecase.NewDeleteExpense(infra)
+		_, err := sut.Do(ctx, usecase.DeleteExpenseInput{EventID: "nonexistent", ExpenseID: "nonexistent"})
+
+		require.ErrorIs(t, err, usecase.ErrDeleteExpenseNotFound)
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/lib/slicex"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+var (
+	errRoundNotFound = errors.New("round not found")
+	errRoundFull     = errors.New("round tier is full")
+)
+
+// buildRounds turns the round inputs into the event's later rounds.
+// Rounds that already exist under the same number keep their ID and participants.
+func buildRounds(eventID string, configs []RoundConfig, existing []model.EventRound) []model.EventRound {
+	rounds := make([]model.EventRound, len(configs))
+	for i, rc := range configs {
+		round := model.EventRound{ID: ulid.New(), EventID: eventID}
+		if idx := slices.IndexFunc(existing, func(r model.EventRound) bool { return r.Round == rc.Round }); idx >= 0 {
+			round = existing[idx]
+		}
+		round.Round = rc.Round
+		round.Title = rc.Title
+		round.TotalAmount = rc.TotalAmount
+		round.Tiers = make([]model.EventRoundTier, len(rc.Tiers))
+		for j, tc := range rc.Tiers {
+			round.Tiers[j] = model.EventRoundTier{
+				ID:      ulid.New(),
+				EventID: eventID,
+				RoundID: round.ID,
+				Tier:    tc.Tier,
+				Weight:  tc.weight(),
+				Count:   tc.Count,
+			}
+		}
+		round.SortTiers()
+		rounds[i] = round
+	}
+	return rounds
+}
+
+// loadRounds fills in the later rounds of the event, which every recalculation of what participants owe needs.
+func loadRounds(ctx context.Context, roundRepo repository.EventRound, ev *model.Event) error {
+	rounds, err := roundRepo.ListByEventID(ctx, ev.ID)
+	if err != nil {
+		return errx.Wrap(err, "message", "failed to list rounds", "id", ev.ID).
+			WithCode(errx.Internal)
+	}
+	ev.Rounds = rounds
+	return nil
+}
+
+// saveRounds stores the later rounds of the event in place of the previous ones.
+// The repositories must be bound to the transaction making the change.
+func saveRounds(
+	ctx context.Context,
+	ev *model.Event,
+	previous []model.EventRound,
+	roundRepo repository.EventRound,
+	roundTierRepo repository.EventRoundTier,
+) error {
+	var created []*model.EventRound
+	for i := range ev.Rounds {
+		r := &ev.Rounds[i]
+		if !slices.ContainsFunc(previous, func(p model.EventRound) bool { return p.ID == r.ID }) {
+			created = append(created, r)
+			continue
+		}
+		if err := roundRepo.Update(ctx, r); err != nil {
+			return errx.Wrap(err, "message", "failed to update round", "id", r.ID).
+				WithCode(errx.Internal)
+		}
+	}
+	for _, p := range previous {
+		if slices.ContainsFunc(ev.Rounds, func(r model.EventRound) bool { return r.ID == p.ID }) {
+			continue
+		}
+		// its participants go with it
+		if err := roundRepo.Delete(ctx, p.ID); err != nil {
+			return errx.Wrap(err, "message", "failed to delete round", "id", p.ID).
+				WithCode(errx.Internal)
+		}
+	}
+	if err := roundRepo.CreateAll(ctx, created); err != nil {
+		return errx.Wrap(err, "message", "failed to create rounds", "id", ev.ID).
+			WithCode(errx.Internal)
+	}
+
+	if err := roundTierRepo.DeleteByEventID(ctx, ev.ID); err != nil {
+		return errx.Wrap(err, "message", "failed to delete old round tiers", "id", ev.ID).
+			WithCode(errx.Internal)
+	}
+	var tiers []*model.EventRoundTier
+	for i := range ev.Rounds {
+		tiers = append(tiers, slicex.MapToPointer(ev.Rounds[i].Tiers)...)
+	}
+	if err := roundTierRepo.CreateAll(ctx, tiers); err != nil {
+		return errx.Wrap(err, "message", "failed to create round tiers", "id", ev.ID).
+			WithCode(errx.Internal)
+	}
+	return nil
+}
+
+// roundAttendance is how a participant's later rounds change, see optIntoRounds.
+type roundAttendance struct {
+	added   []model.EventRoundParticipant
+	removed []model.EventRoundParticipant
+}
+
+// save stores the change. The repository must be bound to the transaction making it.
+func (a roundAttendance) save(ctx context.Context, repo repository.EventRoundParticipant) error {
+	for _, r := range a.removed {
+		if err := repo.Delete(ctx, r.ID); err != nil {
+			return errx.Wrap(err, "message", "failed to leave round", "id", r.ID).
+				WithCode(errx.Internal)
+		}
+	}
+	if err := repo.CreateAll(ctx, slicex.MapToPointer(a.added)); err != nil {
+		return errx.Wrap(err, "message", "failed to join rounds").
+			WithCode(errx.Internal)
+	}
+	return nil
+}
+
+// optIntoRounds has the participant take part in exactly the given later rounds, updating ev.Rounds
+// in-place. It returns errRoundNotFound for a round the event does not have and errRoundFull when the
+// participant's tier of a round they newly opt into has no spot left. Amounts are left to the caller.
+func optIntoRounds(ev *model.Event, participant model.EventParticipant, rounds []int) (roundAttendance, error) {
+	var attendance roundAttendance
+	for _, n := range rounds {
+		if _, ok := ev.Round(n); !ok {
+			return roundAttendance{}, errRoundNotFound
+		}
+	}
+
+	now := time.Now()
+	for i := range ev.Rounds {
+		r := &ev.Rounds[i]
+		want := slices.Contains(rounds, r.Round)
+		idx := slices.IndexFunc(r.Participants, func(p model.EventRoundParticipant) bool {
+			return p.ParticipantID == participant.ID
+		})
+		switch {
+		case want && idx < 0:
+			if !ev.HasRoundVacancy(*r, participant.Tier) {
+				return roundAttendance{}, errRoundFull
+			}
+			joined := model.EventRoundParticipant{
+				ID:            ulid.New(),
+				EventID:       ev.ID,
+				RoundID:       r.ID,
+				ParticipantID: participant.ID,
+				CreatedAt:     now,
+			}
+			r.Participants = append(r.Participants, joined)
+			attendance.added = append(attendance.added, joined)
+		case !want && idx >= 0:
+			attendance.removed = append(attendance.removed, r.Participants[idx])
+			r.Participants = slices.Delete(r.Participants, idx, idx+1)
+		}
+	}
+	return attendance, nil
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrFinalizeEventNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrFinalizeEventForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrFinalizeEventAlreadyFinal = cmodel.NewLocalizableError(
+		errx.NewSentinel("total_amount is already final", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTotalAlreadyFinal())
+	ErrFinalizeEventFixedAmountExceedsTotal = cmodel.NewLocalizableError(
+		errx.NewSentinel("fixed amounts exceed total_amount", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorFixedAmountExceedsTotal())
+)
+
+type FinalizeEventInput struct {
+	EventID string
+	// TotalAmount is the actual cost of the first round, replacing the estimate.
+	TotalAmount int
+	// Bill, when set, itemizes the actual bill of the first round and TotalAmount is computed from it instead.
+	Bill *model.Bill
+	// AdjustmentNote is kept on the adjustments made for participants whose amount is locked.
+	AdjustmentNote string
+}
+
+type FinalizeEventOutput struct {
+	Event model.Event
+	// Adjustments are the additional charges and refunds made for participants who already paid.
+	Adjustments []model.EventParticipantAdjustment
+}
+
+type FinalizeEvent interface {
+	Do(ctx context.Context, input FinalizeEventInput) (FinalizeEventOutput, error)
+}
+
+type finalizeEvent struct {
+	_               FinalizeEvent                           `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	adjustmentRepo  repository.EventParticipantAdjustment   `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+	outboxRepo      orepository.OutboxMessage               `inject:""`
+}
+
+// Do replaces the estimated total of an event with the actual one and recalculates what everyone owes.
+// Changes for participants who already acted on their amount go through the adjustments ledger; participants
+// are told their exact amount through the TotalFinalized domain event.
+// For an event collecting deposits, it settles them up: participants who had not paid theirs are simply asked
+// for their share, and for those who did, the difference becomes an additional charge or a refund owed,
+// listed by ListRefunds.
+func (uc *finalizeEvent) Do(ctx context.Context, input FinalizeEventInput) (FinalizeEventOutput, error) {
+	return uc.finalize(ctx, input, func(ev model.Event) error {
+		if !ev.IsEstimate() {
+			return ErrFinalizeEventAlreadyFinal
+		}
+		return nil
+	})
+}
+
+// finalize does the work of Do, refusing the event when check, run on it once locked, returns an error.
+// SettleDeposits goes through it too, with a check of its own.
+func (uc *finalizeEvent) finalize(
+	ctx context.Context, input FinalizeEventInput, check func(ev model.Event) error,
+) (FinalizeEventOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if err := validateBill(ctx, input.Bill); err != nil {
+		return FinalizeEventOutput{}, err
+	}
+	if input.Bill != nil {
+		input.TotalAmount = input.Bill.Total()
+	}
+	if input.TotalAmount <= 0 {
+		return FinalizeEventOutput{}, errx.Wrap(ErrValidateEventNonPositiveTotalAmount, "total_amount", input.TotalAmount).
+			WithFieldViolation("total_amount", ErrValidateEventNonPositiveTotalAmount.LocalizeContext(ctx))
+	}
+
+	var ev model.Event
+	var adjustments []model.EventParticipantAdjustment
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// joins take the estimated amounts until this is done, so they must wait
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrFinalizeEventNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if ev.UserID != userID {
+			return ErrFinalizeEventForbidden
+		}
+		if err := check(ev); err != nil {
+			return err
+		}
+		if fixed := ev.FixedTotal(); fixed > input.TotalAmount {
+			return errx.Wrap(ErrFinalizeEventFixedAmountExceedsTotal,
+				"fixed_total", fixed, "total_amount", input.TotalAmount,
+			).WithFieldViolation("total_amount", ErrFinalizeEventFixedAmountExceedsTotal.LocalizeContext(ctx))
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		previous := ev.DueAmounts()
+		locked := ev.LockedAmounts()
+		ev.Finalize(input.TotalAmount, input.Bill, time.Now())
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+		adjustments = ev.AdjustLockedAmounts(locked, input.AdjustmentNote, &userID)
+		if err := recordAdjustments(
+			ctx, &ev, adjustments, model.ParticipantStatusActorOrganizer, &userID,
+			uc.adjustmentRepo.WithTx(tx), uc.statusRepo.WithTx(tx), uc.outboxRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+
+		return publishDomainEvent(
+			ctx, uc.outboxRepo.WithTx(tx), model.DomainEventTotalFinalized, ev.NewTotalFinalized(previous),
+		)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return FinalizeEventOutput{}, err
+	}
+
+	return FinalizeEventOutput{Event: ev, Adjustments: adjustments}, nil
+}
@@ -0,0 +1,185 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestFinalizeEvent_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		estimate(t, infra, &ev)
+		participants[0].Status = model.ParticipantStatusConfirmed
+		participants[0].PaidAmount = 3000
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewFinalizeEvent(infra)
+		out, err := sut.Do(ctx, usecase.FinalizeEventInput{
+			EventID:        ev.ID,
+			TotalAmount:    12000,
+			AdjustmentNote: "the bill came in higher",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, out.Event.FinalizedAt)
+		assert.Equal(t, 12000, out.Event.TotalAmount)
+		assert.Equal(t, 9000, *out.Event.EstimatedTotalAmount)
+		require.Len(t, out.Adjustments, 1)
+		assert.Equal(t, 1000, out.Adjustments[0].Amount)
+
+		unpaid, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[1].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 4000, unpaid.Amount)
+
+		finalized := domainEvents[model.TotalFinalized](t, infra, model.DomainEventTotalFinalized)
+		require.Len(t, finalized, 1)
+		assert.Equal(t, ev.ID, finalized[0].EventID)
+		assert.Equal(t, 12000, finalized[0].TotalAmount)
+		require.Len(t, finalized[0].Amounts, 3)
+		for _, a := range finalized[0].Amounts {
+			assert.Equal(t, 3000, a.PreviousDue)
+			assert.Equal(t, 4000, a.Due)
+		}
+	})
+
+	t.Run("with the actual bill", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+		estimate(t, infra, &ev)
+
+		sut := usecase.NewFinalizeEvent(infra)
+		out, err := sut.Do(ctx, usecase.FinalizeEventInput{
+			EventID: ev.ID,
+			Bill:    &model.Bill{Subtotal: 10000, TaxRate: 1000, ServiceChargeRate: 1000},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 12100, out.Event.TotalAmount)
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 12100, got.TotalAmount)
+		require.NotNil(t, got.SubtotalAmount)
+		assert.Equal(t, 10000, *got.SubtotalAmount)
+		assert.Equal(t, 1000, got.ServiceChargeAmount)
+		assert.Equal(t, 1100, got.TaxAmount)
+	})
+
+	t.Run("already final", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+		estimate(t, infra, &ev)
+
+		sut := usecase.NewFinalizeEvent(infra)
+		_, err := sut.Do(ctx, usecase.FinalizeEventInput{EventID: ev.ID, TotalAmount: 12000})
+		require.NoError(t, err)
+		_, err = sut.Do(ctx, usecase.FinalizeEventInput{EventID: ev.ID, TotalAmount: 12000})
+
+		require.ErrorIs(t, err, usecase.ErrFinalizeEventAlreadyFinal)
+	})
+
+	t.Run("not estimated", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+
+		sut := usecase.NewFinalizeEvent(infra)
+		_, err := sut.Do(ctx, usecase.FinalizeEventInput{EventID: ev.ID, TotalAmount: 12000})
+
+		require.ErrorIs(t, err, usecase.ErrFinalizeEventAlreadyFinal)
+	})
+
+	t.Run("settles deposits", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		collectDeposits(t, infra, &ev, participants, 4000)
+		participants[0].Status = model.ParticipantStatusConfirmed
+		participants[0].PaidAmount = 4000
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewFinalizeEvent(infra)
+		out, err := sut.Do(ctx, usecase.FinalizeEventInput{
+			EventID:        ev.ID,
+			TotalAmount:    6000,
+			AdjustmentNote: "actual cost",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, out.Event.DepositSettledAt)
+		assert.Nil(t, out.Event.FinalizedAt, "the total was never announced as an estimate")
+		assert.Equal(t, 6000, out.Event.TotalAmount)
+		require.Len(t, out.Adjustments, 1)
+		assert.Equal(t, -2000, out.Adjustments[0].Amount)
+
+		paid, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2000, paid.Refundable())
+		assert.Equal(t, model.ParticipantStatusConfirmed, paid.Status)
+		unpaid, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[1].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2000, unpaid.Amount)
+
+		_, err = sut.Do(ctx, usecase.FinalizeEventInput{EventID: ev.ID, TotalAmount: 6000})
+		require.ErrorIs(t, err, usecase.ErrFinalizeEventAlreadyFinal, "deposits are settled only once")
+	})
+
+	t.Run("non-positive total", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+		estimate(t, infra, &ev)
+
+		sut := usecase.NewFinalizeEvent(infra)
+		_, err := sut.Do(ctx, usecase.FinalizeEventInput{EventID: ev.ID})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventNonPositiveTotalAmount)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ev, _ := seedRoster(t, infra, owner.UserID, 3)
+		estimate(t, infra, &ev)
+
+		sut := usecase.NewFinalizeEvent(infra)
+		_, err := sut.Do(ctx, usecase.FinalizeEventInput{EventID: ev.ID, TotalAmount: 12000})
+
+		require.ErrorIs(t, err, usecase.ErrFinalizeEventForbidden)
+	})
+}
@@ -39,6 +39,7 @@ type getEvent struct {
 	_                 *di.Infra                     `inject:"param"`
 	reader            *database.Reader              `inject:""`
 	eventRepo         repository.Event              `inject:""`
+	roundRepo         repository.EventRound         `inject:""`
 	endUserRepo       urepository.EndUser           `inject:""`
 	paymentMethodRepo urepository.UserPaymentMethod `inject:""`
 }
@@ -64,6 +65,9 @@ func (uc *getEvent) Do(ctx context.Context, input GetEventInput) (GetEventOutput
 			return errx.Wrap(err, "message", "failed to get event", "id", input.ID).
 				WithCode(errx.Internal)
 		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
 
 		endUser, err = uc.endUserRepo.WithTx(tx).Get(ctx, ev.UserID)
 		if err != nil {
@@ -5,26 +5,175 @@ package usecase
 import (
 	di "github.com/mickamy/sampay/internal/di"
 	repository "github.com/mickamy/sampay/internal/domain/event/repository"
-	repository2 "github.com/mickamy/sampay/internal/domain/user/repository"
+	repository2 "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	repository3 "github.com/mickamy/sampay/internal/domain/user/repository"
 )
 
+// NewAddExpense initializes dependencies and constructs addExpense.
+func NewAddExpense(infra *di.Infra) AddExpense {
+	event := repository.NewEvent(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+
+	return &addExpense{
+		writer:      infra.WriterDB,
+		eventRepo:   event,
+		expenseRepo: eventExpense,
+	}
+}
+
+// MustNewAddExpense initializes dependencies and constructs addExpense or panics on failure.
+func MustNewAddExpense(infra *di.Infra) AddExpense {
+	event := repository.NewEvent(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+
+	return &addExpense{
+		writer:      infra.WriterDB,
+		eventRepo:   event,
+		expenseRepo: eventExpense,
+	}
+}
+
+// NewAddParticipant initializes dependencies and constructs addParticipant.
+func NewAddParticipant(infra *di.Infra) AddParticipant {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &addParticipant{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
+// MustNewAddParticipant initializes dependencies and constructs addParticipant or panics on failure.
+func MustNewAddParticipant(infra *di.Infra) AddParticipant {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &addParticipant{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
 // NewArchiveEvent initializes dependencies and constructs archiveEvent.
 func NewArchiveEvent(infra *di.Infra) ArchiveEvent {
 	event := repository.NewEvent(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &archiveEvent{
-		writer:    infra.WriterDB,
-		eventRepo: event,
+		writer:     infra.WriterDB,
+		eventRepo:  event,
+		outboxRepo: outboxMessage,
 	}
 }
 
 // MustNewArchiveEvent initializes dependencies and constructs archiveEvent or panics on failure.
 func MustNewArchiveEvent(infra *di.Infra) ArchiveEvent {
 	event := repository.NewEvent(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &archiveEvent{
-		writer:    infra.WriterDB,
-		eventRepo: event,
+		writer:     infra.WriterDB,
+		eventRepo:  event,
+		outboxRepo: outboxMessage,
+	}
+}
+
+// NewChangeRounds initializes dependencies and constructs changeRounds.
+func NewChangeRounds(infra *di.Infra) ChangeRounds {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &changeRounds{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		roundParticipantRepo: eventRoundParticipant,
+		adjustmentRepo:       eventParticipantAdjustment,
+		statusRepo:           eventParticipantStatusChange,
+		outboxRepo:           outboxMessage,
+	}
+}
+
+// MustNewChangeRounds initializes dependencies and constructs changeRounds or panics on failure.
+func MustNewChangeRounds(infra *di.Infra) ChangeRounds {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &changeRounds{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		roundParticipantRepo: eventRoundParticipant,
+		adjustmentRepo:       eventParticipantAdjustment,
+		statusRepo:           eventParticipantStatusChange,
+		outboxRepo:           outboxMessage,
+	}
+}
+
+// NewChangeTier initializes dependencies and constructs changeTier.
+func NewChangeTier(infra *di.Infra) ChangeTier {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &changeTier{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
+// MustNewChangeTier initializes dependencies and constructs changeTier or panics on failure.
+func MustNewChangeTier(infra *di.Infra) ChangeTier {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &changeTier{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
 	}
 }
 
@@ -32,12 +181,15 @@ func MustNewArchiveEvent(infra *di.Infra) ArchiveEvent {
 func NewClaimPayment(infra *di.Infra) ClaimPayment {
 	event := repository.NewEvent(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &claimPayment{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
 		participantRepo: eventParticipant,
-		producer:        infra.Producer,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
 	}
 }
 
@@ -45,12 +197,15 @@ func NewClaimPayment(infra *di.Infra) ClaimPayment {
 func MustNewClaimPayment(infra *di.Infra) ClaimPayment {
 	event := repository.NewEvent(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &claimPayment{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
 		participantRepo: eventParticipant,
-		producer:        infra.Producer,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
 	}
 }
 
@@ -58,11 +213,15 @@ func MustNewClaimPayment(infra *di.Infra) ClaimPayment {
 func NewCreateEvent(infra *di.Infra) CreateEvent {
 	event := repository.NewEvent(infra.DB)
 	eventTier := repository.NewEventTier(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventRoundTier := repository.NewEventRoundTier(infra.DB)
 
 	return &createEvent{
-		writer:    infra.WriterDB,
-		eventRepo: event,
-		tierRepo:  eventTier,
+		writer:        infra.WriterDB,
+		eventRepo:     event,
+		tierRepo:      eventTier,
+		roundRepo:     eventRound,
+		roundTierRepo: eventRoundTier,
 	}
 }
 
@@ -70,11 +229,15 @@ func NewCreateEvent(infra *di.Infra) CreateEvent {
 func MustNewCreateEvent(infra *di.Infra) CreateEvent {
 	event := repository.NewEvent(infra.DB)
 	eventTier := repository.NewEventTier(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventRoundTier := repository.NewEventRoundTier(infra.DB)
 
 	return &createEvent{
-		writer:    infra.WriterDB,
-		eventRepo: event,
-		tierRepo:  eventTier,
+		writer:        infra.WriterDB,
+		eventRepo:     event,
+		tierRepo:      eventTier,
+		roundRepo:     eventRound,
+		roundTierRepo: eventRoundTier,
 	}
 }
 
@@ -98,15 +261,85 @@ func MustNewDeleteEvent(infra *di.Infra) DeleteEvent {
 	}
 }
 
+// NewDeleteExpense initializes dependencies and constructs deleteExpense.
+func NewDeleteExpense(infra *di.Infra) DeleteExpense {
+	event := repository.NewEvent(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+
+	return &deleteExpense{
+		writer:      infra.WriterDB,
+		eventRepo:   event,
+		expenseRepo: eventExpense,
+	}
+}
+
+// MustNewDeleteExpense initializes dependencies and constructs deleteExpense or panics on failure.
+func MustNewDeleteExpense(infra *di.Infra) DeleteExpense {
+	event := repository.NewEvent(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+
+	return &deleteExpense{
+		writer:      infra.WriterDB,
+		eventRepo:   event,
+		expenseRepo: eventExpense,
+	}
+}
+
+// NewFinalizeEvent initializes dependencies and constructs finalizeEvent.
+func NewFinalizeEvent(infra *di.Infra) FinalizeEvent {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &finalizeEvent{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		adjustmentRepo:  eventParticipantAdjustment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
+// MustNewFinalizeEvent initializes dependencies and constructs finalizeEvent or panics on failure.
+func MustNewFinalizeEvent(infra *di.Infra) FinalizeEvent {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &finalizeEvent{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		adjustmentRepo:  eventParticipantAdjustment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
 // NewGetEvent initializes dependencies and constructs getEvent.
 func NewGetEvent(infra *di.Infra) GetEvent {
 	event := repository.NewEvent(infra.DB)
-	endUser := repository2.NewEndUser(infra.DB)
-	userPaymentMethod := repository2.NewUserPaymentMethod(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	endUser := repository3.NewEndUser(infra.DB)
+	userPaymentMethod := repository3.NewUserPaymentMethod(infra.DB)
 
 	return &getEvent{
 		reader:            infra.ReaderDB,
 		eventRepo:         event,
+		roundRepo:         eventRound,
 		endUserRepo:       endUser,
 		paymentMethodRepo: userPaymentMethod,
 	}
@@ -115,12 +348,14 @@ func NewGetEvent(infra *di.Infra) GetEvent {
 // MustNewGetEvent initializes dependencies and constructs getEvent or panics on failure.
 func MustNewGetEvent(infra *di.Infra) GetEvent {
 	event := repository.NewEvent(infra.DB)
-	endUser := repository2.NewEndUser(infra.DB)
-	userPaymentMethod := repository2.NewUserPaymentMethod(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	endUser := repository3.NewEndUser(infra.DB)
+	userPaymentMethod := repository3.NewUserPaymentMethod(infra.DB)
 
 	return &getEvent{
 		reader:            infra.ReaderDB,
 		eventRepo:         event,
+		roundRepo:         eventRound,
 		endUserRepo:       endUser,
 		paymentMethodRepo: userPaymentMethod,
 	}
@@ -129,44 +364,140 @@ func MustNewGetEvent(infra *di.Infra) GetEvent {
 // NewJoinEvent initializes dependencies and constructs joinEvent.
 func NewJoinEvent(infra *di.Infra) JoinEvent {
 	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &joinEvent{
-		writer:          infra.WriterDB,
-		eventRepo:       event,
-		participantRepo: eventParticipant,
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		roundParticipantRepo: eventRoundParticipant,
+		statusRepo:           eventParticipantStatusChange,
+		outboxRepo:           outboxMessage,
 	}
 }
 
 // MustNewJoinEvent initializes dependencies and constructs joinEvent or panics on failure.
 func MustNewJoinEvent(infra *di.Infra) JoinEvent {
 	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &joinEvent{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		roundParticipantRepo: eventRoundParticipant,
+		statusRepo:           eventParticipantStatusChange,
+		outboxRepo:           outboxMessage,
+	}
+}
+
+// NewLeaveEvent initializes dependencies and constructs leaveEvent.
+func NewLeaveEvent(infra *di.Infra) LeaveEvent {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &leaveEvent{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		expenseRepo:     eventExpense,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
+// MustNewLeaveEvent initializes dependencies and constructs leaveEvent or panics on failure.
+func MustNewLeaveEvent(infra *di.Infra) LeaveEvent {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &leaveEvent{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
 		participantRepo: eventParticipant,
+		expenseRepo:     eventExpense,
+		statusRepo:      eventParticipantStatusChange,
 	}
 }
 
 // NewListEventParticipants initializes dependencies and constructs listEventParticipants.
 func NewListEventParticipants(infra *di.Infra) ListEventParticipants {
 	event := repository.NewEvent(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
 
 	return &listEventParticipants{
-		reader:    infra.ReaderDB,
-		eventRepo: event,
+		reader:         infra.ReaderDB,
+		eventRepo:      event,
+		statusRepo:     eventParticipantStatusChange,
+		paymentRepo:    eventParticipantPayment,
+		adjustmentRepo: eventParticipantAdjustment,
 	}
 }
 
 // MustNewListEventParticipants initializes dependencies and constructs listEventParticipants or panics on failure.
 func MustNewListEventParticipants(infra *di.Infra) ListEventParticipants {
 	event := repository.NewEvent(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
 
 	return &listEventParticipants{
-		reader:    infra.ReaderDB,
-		eventRepo: event,
+		reader:         infra.ReaderDB,
+		eventRepo:      event,
+		statusRepo:     eventParticipantStatusChange,
+		paymentRepo:    eventParticipantPayment,
+		adjustmentRepo: eventParticipantAdjustment,
+	}
+}
+
+// NewListExpenses initializes dependencies and constructs listExpenses.
+func NewListExpenses(infra *di.Infra) ListExpenses {
+	event := repository.NewEvent(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+
+	return &listExpenses{
+		reader:      infra.ReaderDB,
+		eventRepo:   event,
+		expenseRepo: eventExpense,
+	}
+}
+
+// MustNewListExpenses initializes dependencies and constructs listExpenses or panics on failure.
+func MustNewListExpenses(infra *di.Infra) ListExpenses {
+	event := repository.NewEvent(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+
+	return &listExpenses{
+		reader:      infra.ReaderDB,
+		eventRepo:   event,
+		expenseRepo: eventExpense,
 	}
 }
 
@@ -190,6 +521,458 @@ func MustNewListMyEvents(infra *di.Infra) ListMyEvents {
 	}
 }
 
+// NewListRefunds initializes dependencies and constructs listRefunds.
+func NewListRefunds(infra *di.Infra) ListRefunds {
+	event := repository.NewEvent(infra.DB)
+
+	return &listRefunds{
+		reader:    infra.ReaderDB,
+		eventRepo: event,
+	}
+}
+
+// MustNewListRefunds initializes dependencies and constructs listRefunds or panics on failure.
+func MustNewListRefunds(infra *di.Infra) ListRefunds {
+	event := repository.NewEvent(infra.DB)
+
+	return &listRefunds{
+		reader:    infra.ReaderDB,
+		eventRepo: event,
+	}
+}
+
+// NewMergeParticipants initializes dependencies and constructs mergeParticipants.
+func NewMergeParticipants(infra *di.Infra) MergeParticipants {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &mergeParticipants{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		roundParticipantRepo: eventRoundParticipant,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		expenseRepo:          eventExpense,
+		statusRepo:           eventParticipantStatusChange,
+	}
+}
+
+// MustNewMergeParticipants initializes dependencies and constructs mergeParticipants or panics on failure.
+func MustNewMergeParticipants(infra *di.Infra) MergeParticipants {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &mergeParticipants{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		roundParticipantRepo: eventRoundParticipant,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		expenseRepo:          eventExpense,
+		statusRepo:           eventParticipantStatusChange,
+	}
+}
+
+// NewNotifyPaymentClaimed initializes dependencies and constructs notifyPaymentClaimed.
+func NewNotifyPaymentClaimed(infra *di.Infra) NotifyPaymentClaimed {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	notificationSetting := repository3.NewNotificationSetting(infra.DB)
+
+	return &notifyPaymentClaimed{
+		reader:                  infra.ReaderDB,
+		eventRepo:               event,
+		participantRepo:         eventParticipant,
+		notificationSettingRepo: notificationSetting,
+		notifier:                infra.Notifier,
+	}
+}
+
+// MustNewNotifyPaymentClaimed initializes dependencies and constructs notifyPaymentClaimed or panics on failure.
+func MustNewNotifyPaymentClaimed(infra *di.Infra) NotifyPaymentClaimed {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	notificationSetting := repository3.NewNotificationSetting(infra.DB)
+
+	return &notifyPaymentClaimed{
+		reader:                  infra.ReaderDB,
+		eventRepo:               event,
+		participantRepo:         eventParticipant,
+		notificationSettingRepo: notificationSetting,
+		notifier:                infra.Notifier,
+	}
+}
+
+// NewNotifyTotalFinalized initializes dependencies and constructs notifyTotalFinalized.
+func NewNotifyTotalFinalized(infra *di.Infra) NotifyTotalFinalized {
+	event := repository.NewEvent(infra.DB)
+	notificationSetting := repository3.NewNotificationSetting(infra.DB)
+
+	return &notifyTotalFinalized{
+		reader:      infra.ReaderDB,
+		eventRepo:   event,
+		settingRepo: notificationSetting,
+		notifier:    infra.Notifier,
+	}
+}
+
+// MustNewNotifyTotalFinalized initializes dependencies and constructs notifyTotalFinalized or panics on failure.
+func MustNewNotifyTotalFinalized(infra *di.Infra) NotifyTotalFinalized {
+	event := repository.NewEvent(infra.DB)
+	notificationSetting := repository3.NewNotificationSetting(infra.DB)
+
+	return &notifyTotalFinalized{
+		reader:      infra.ReaderDB,
+		eventRepo:   event,
+		settingRepo: notificationSetting,
+		notifier:    infra.Notifier,
+	}
+}
+
+// NewRecordPayment initializes dependencies and constructs recordPayment.
+func NewRecordPayment(infra *di.Infra) RecordPayment {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	userPaymentMethod := repository3.NewUserPaymentMethod(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &recordPayment{
+		writer:            infra.WriterDB,
+		eventRepo:         event,
+		participantRepo:   eventParticipant,
+		paymentRepo:       eventParticipantPayment,
+		statusRepo:        eventParticipantStatusChange,
+		paymentMethodRepo: userPaymentMethod,
+		outboxRepo:        outboxMessage,
+	}
+}
+
+// MustNewRecordPayment initializes dependencies and constructs recordPayment or panics on failure.
+func MustNewRecordPayment(infra *di.Infra) RecordPayment {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	userPaymentMethod := repository3.NewUserPaymentMethod(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &recordPayment{
+		writer:            infra.WriterDB,
+		eventRepo:         event,
+		participantRepo:   eventParticipant,
+		paymentRepo:       eventParticipantPayment,
+		statusRepo:        eventParticipantStatusChange,
+		paymentMethodRepo: userPaymentMethod,
+		outboxRepo:        outboxMessage,
+	}
+}
+
+// NewRecordRefund initializes dependencies and constructs recordRefund.
+func NewRecordRefund(infra *di.Infra) RecordRefund {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+
+	return &recordRefund{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		participantRepo: eventParticipant,
+		paymentRepo:     eventParticipantPayment,
+	}
+}
+
+// MustNewRecordRefund initializes dependencies and constructs recordRefund or panics on failure.
+func MustNewRecordRefund(infra *di.Infra) RecordRefund {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+
+	return &recordRefund{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		participantRepo: eventParticipant,
+		paymentRepo:     eventParticipantPayment,
+	}
+}
+
+// NewReissueParticipantToken initializes dependencies and constructs reissueParticipantToken.
+func NewReissueParticipantToken(infra *di.Infra) ReissueParticipantToken {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+
+	return &reissueParticipantToken{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		participantRepo: eventParticipant,
+	}
+}
+
+// MustNewReissueParticipantToken initializes dependencies and constructs reissueParticipantToken or panics on failure.
+func MustNewReissueParticipantToken(infra *di.Infra) ReissueParticipantToken {
+	event := repository.NewEvent(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+
+	return &reissueParticipantToken{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		participantRepo: eventParticipant,
+	}
+}
+
+// NewRemoveParticipant initializes dependencies and constructs removeParticipant.
+func NewRemoveParticipant(infra *di.Infra) RemoveParticipant {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &removeParticipant{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		expenseRepo:     eventExpense,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
+// MustNewRemoveParticipant initializes dependencies and constructs removeParticipant or panics on failure.
+func MustNewRemoveParticipant(infra *di.Infra) RemoveParticipant {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventExpense := repository.NewEventExpense(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &removeParticipant{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		expenseRepo:     eventExpense,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
+// NewSendPaymentReminders initializes dependencies and constructs sendPaymentReminders.
+func NewSendPaymentReminders(infra *di.Infra) SendPaymentReminders {
+	event := repository.NewEvent(infra.DB)
+	eventReminder := repository.NewEventReminder(infra.DB)
+	notificationSetting := repository3.NewNotificationSetting(infra.DB)
+
+	return &sendPaymentReminders{
+		reader:       infra.ReaderDB,
+		writer:       infra.WriterDB,
+		eventRepo:    event,
+		reminderRepo: eventReminder,
+		settingRepo:  notificationSetting,
+		notifier:     infra.Notifier,
+	}
+}
+
+// MustNewSendPaymentReminders initializes dependencies and constructs sendPaymentReminders or panics on failure.
+func MustNewSendPaymentReminders(infra *di.Infra) SendPaymentReminders {
+	event := repository.NewEvent(infra.DB)
+	eventReminder := repository.NewEventReminder(infra.DB)
+	notificationSetting := repository3.NewNotificationSetting(infra.DB)
+
+	return &sendPaymentReminders{
+		reader:       infra.ReaderDB,
+		writer:       infra.WriterDB,
+		eventRepo:    event,
+		reminderRepo: eventReminder,
+		settingRepo:  notificationSetting,
+		notifier:     infra.Notifier,
+	}
+}
+
+// NewSetParticipantAttendance initializes dependencies and constructs setParticipantAttendance.
+func NewSetParticipantAttendance(infra *di.Infra) SetParticipantAttendance {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &setParticipantAttendance{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		adjustmentRepo:  eventParticipantAdjustment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
+// MustNewSetParticipantAttendance initializes dependencies and constructs setParticipantAttendance or panics on failure.
+func MustNewSetParticipantAttendance(infra *di.Infra) SetParticipantAttendance {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &setParticipantAttendance{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		adjustmentRepo:  eventParticipantAdjustment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
+// NewSetParticipantFixedAmount initializes dependencies and constructs setParticipantFixedAmount.
+func NewSetParticipantFixedAmount(infra *di.Infra) SetParticipantFixedAmount {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+
+	return &setParticipantFixedAmount{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+	}
+}
+
+// MustNewSetParticipantFixedAmount initializes dependencies and constructs setParticipantFixedAmount or panics on failure.
+func MustNewSetParticipantFixedAmount(infra *di.Infra) SetParticipantFixedAmount {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+
+	return &setParticipantFixedAmount{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+	}
+}
+
+// NewSetParticipantRounds initializes dependencies and constructs setParticipantRounds.
+func NewSetParticipantRounds(infra *di.Infra) SetParticipantRounds {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &setParticipantRounds{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		roundParticipantRepo: eventRoundParticipant,
+		adjustmentRepo:       eventParticipantAdjustment,
+		statusRepo:           eventParticipantStatusChange,
+		outboxRepo:           outboxMessage,
+	}
+}
+
+// MustNewSetParticipantRounds initializes dependencies and constructs setParticipantRounds or panics on failure.
+func MustNewSetParticipantRounds(infra *di.Infra) SetParticipantRounds {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventRoundParticipant := repository.NewEventRoundParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &setParticipantRounds{
+		writer:               infra.WriterDB,
+		eventRepo:            event,
+		roundRepo:            eventRound,
+		tierRepo:             eventTier,
+		participantRepo:      eventParticipant,
+		roundParticipantRepo: eventRoundParticipant,
+		adjustmentRepo:       eventParticipantAdjustment,
+		statusRepo:           eventParticipantStatusChange,
+		outboxRepo:           outboxMessage,
+	}
+}
+
+// NewSettleDeposits initializes dependencies and constructs settleDeposits.
+func NewSettleDeposits(infra *di.Infra) SettleDeposits {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &settleDeposits{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		adjustmentRepo:  eventParticipantAdjustment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
+// MustNewSettleDeposits initializes dependencies and constructs settleDeposits or panics on failure.
+func MustNewSettleDeposits(infra *di.Infra) SettleDeposits {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
+
+	return &settleDeposits{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		adjustmentRepo:  eventParticipantAdjustment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
 // NewUnarchiveEvent initializes dependencies and constructs unarchiveEvent.
 func NewUnarchiveEvent(infra *di.Infra) UnarchiveEvent {
 	event := repository.NewEvent(infra.DB)
@@ -215,12 +998,22 @@ func NewUpdateEvent(infra *di.Infra) UpdateEvent {
 	event := repository.NewEvent(infra.DB)
 	eventTier := repository.NewEventTier(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventRoundTier := repository.NewEventRoundTier(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &updateEvent{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
 		tierRepo:        eventTier,
 		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
+		adjustmentRepo:  eventParticipantAdjustment,
+		roundRepo:       eventRound,
+		roundTierRepo:   eventRoundTier,
+		outboxRepo:      outboxMessage,
 	}
 }
 
@@ -229,12 +1022,22 @@ func MustNewUpdateEvent(infra *di.Infra) UpdateEvent {
 	event := repository.NewEvent(infra.DB)
 	eventTier := repository.NewEventTier(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	eventParticipantAdjustment := repository.NewEventParticipantAdjustment(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventRoundTier := repository.NewEventRoundTier(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &updateEvent{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
 		tierRepo:        eventTier,
 		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
+		adjustmentRepo:  eventParticipantAdjustment,
+		roundRepo:       eventRound,
+		roundTierRepo:   eventRoundTier,
+		outboxRepo:      outboxMessage,
 	}
 }
 
@@ -242,11 +1045,17 @@ func MustNewUpdateEvent(infra *di.Infra) UpdateEvent {
 func NewUpdateParticipantStatus(infra *di.Infra) UpdateParticipantStatus {
 	event := repository.NewEvent(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &updateParticipantStatus{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
 		participantRepo: eventParticipant,
+		paymentRepo:     eventParticipantPayment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
 	}
 }
 
@@ -254,10 +1063,52 @@ func NewUpdateParticipantStatus(infra *di.Infra) UpdateParticipantStatus {
 func MustNewUpdateParticipantStatus(infra *di.Infra) UpdateParticipantStatus {
 	event := repository.NewEvent(infra.DB)
 	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantPayment := repository.NewEventParticipantPayment(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+	outboxMessage := repository2.NewOutboxMessage(infra.DB)
 
 	return &updateParticipantStatus{
 		writer:          infra.WriterDB,
 		eventRepo:       event,
 		participantRepo: eventParticipant,
+		paymentRepo:     eventParticipantPayment,
+		statusRepo:      eventParticipantStatusChange,
+		outboxRepo:      outboxMessage,
+	}
+}
+
+// NewUpdateParticipant initializes dependencies and constructs updateParticipant.
+func NewUpdateParticipant(infra *di.Infra) UpdateParticipant {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &updateParticipant{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
+	}
+}
+
+// MustNewUpdateParticipant initializes dependencies and constructs updateParticipant or panics on failure.
+func MustNewUpdateParticipant(infra *di.Infra) UpdateParticipant {
+	event := repository.NewEvent(infra.DB)
+	eventRound := repository.NewEventRound(infra.DB)
+	eventTier := repository.NewEventTier(infra.DB)
+	eventParticipant := repository.NewEventParticipant(infra.DB)
+	eventParticipantStatusChange := repository.NewEventParticipantStatusChange(infra.DB)
+
+	return &updateParticipant{
+		writer:          infra.WriterDB,
+		eventRepo:       event,
+		roundRepo:       eventRound,
+		tierRepo:        eventTier,
+		participantRepo: eventParticipant,
+		statusRepo:      eventParticipantStatusChange,
 	}
 }
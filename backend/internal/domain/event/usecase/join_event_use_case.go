@@ -10,8 +10,10 @@ import (
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
 	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 )
 
@@ -28,16 +30,37 @@ var (
 	ErrJoinEventArchived = cmodel.NewLocalizableError(
 		errx.NewSentinel("event is archived", errx.FailedPrecondition),
 	).WithMessages(messages.EventUseCaseErrorArchived())
+	ErrJoinEventTierFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+	ErrJoinEventRoundNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("round not found", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorRoundNotFound())
+	ErrJoinEventRoundFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("round tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+	ErrJoinEventLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("amounts are locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorJoinLocked())
 )
 
 type JoinEventInput struct {
 	EventID string
 	Name    string
 	Tier    int
+	// Waitlist puts the participant on the waitlist when the tier is full, instead of rejecting the join.
+	Waitlist bool
+	// Rounds are the later rounds the participant opts into. They are ignored when the participant ends up
+	// on the waitlist, as only those holding a spot can take one in a later round.
+	Rounds []int
 }
 
 type JoinEventOutput struct {
 	Participant model.EventParticipant
+	// Token lets the participant act on their own behalf later. It is not stored and cannot be recovered.
+	Token string
+	// Estimated is true while the event's total is an estimate, so Amount may still change once it is final.
+	Estimated bool
 }
 
 type JoinEvent interface {
@@ -45,11 +68,16 @@ type JoinEvent interface {
 }
 
 type joinEvent struct {
-	_               JoinEvent                   `inject:"returns"`
-	_               *di.Infra                   `inject:"param"`
-	writer          *database.Writer            `inject:""`
-	eventRepo       repository.Event            `inject:""`
-	participantRepo repository.EventParticipant `inject:""`
+	_                    JoinEvent                               `inject:"returns"`
+	_                    *di.Infra                               `inject:"param"`
+	writer               *database.Writer                        `inject:""`
+	eventRepo            repository.Event                        `inject:""`
+	roundRepo            repository.EventRound                   `inject:""`
+	tierRepo             repository.EventTier                    `inject:""`
+	participantRepo      repository.EventParticipant             `inject:""`
+	roundParticipantRepo repository.EventRoundParticipant        `inject:""`
+	statusRepo           repository.EventParticipantStatusChange `inject:""`
+	outboxRepo           orepository.OutboxMessage               `inject:""`
 }
 
 func (uc *joinEvent) Do(ctx context.Context, input JoinEventInput) (JoinEventOutput, error) {
@@ -58,15 +86,23 @@ func (uc *joinEvent) Do(ctx context.Context, input JoinEventInput) (JoinEventOut
 	}
 
 	var participant model.EventParticipant
+	var token string
+	var estimated bool
 
 	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
-		ev, err := uc.eventRepo.WithTx(tx).Get(
-			ctx, input.EventID, repository.EventPreloadTiers(),
-		)
-		if err != nil {
+		// concurrent joins must not both see the last spot as free
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
 			if errors.Is(err, database.ErrNotFound) {
 				return ErrJoinEventNotFound
 			}
+			return errx.Wrap(err, "message", "failed to lock event", "event_id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		ev, err := uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
 			return errx.Wrap(err, "message", "failed to get event", "event_id", input.EventID).
 				WithCode(errx.Internal)
 		}
@@ -74,6 +110,10 @@ func (uc *joinEvent) Do(ctx context.Context, input JoinEventInput) (JoinEventOut
 		if ev.ArchivedAt != nil {
 			return ErrJoinEventArchived
 		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+		estimated = ev.IsEstimate()
 
 		if input.Tier < 1 || input.Tier > ev.TierCount {
 			return ErrJoinEventInvalidTier
@@ -84,20 +124,93 @@ func (uc *joinEvent) Do(ctx context.Context, input JoinEventInput) (JoinEventOut
 			EventID: input.EventID,
 			Name:    input.Name,
 			Tier:    input.Tier,
-			Amount:  ev.TierAmount(input.Tier),
+			Amount:  ev.NextSeatAmount(input.Tier),
 			Status:  model.ParticipantStatusUnpaid,
 		}
+		if userID, err := contexts.AuthenticatedUserID(ctx); err == nil {
+			participant.EndUserID = &userID
+		}
+		if !ev.HasVacancy(input.Tier) {
+			if !input.Waitlist {
+				return ErrJoinEventTierFull
+			}
+			participant.Amount = 0
+			participant.Status = model.ParticipantStatusWaitlisted
+		}
+
+		if !participant.IsWaitlisted() && ev.SplitMode.ByAttendance() && ev.AmountsLocked() {
+			// a join would change what those who already paid owe, leaving the organizer to sort out the
+			// difference with each of them, and the organizer cannot take a participant off once they did
+			return ErrJoinEventLocked
+		}
+
+		var attendance roundAttendance
+		if !participant.IsWaitlisted() {
+			ev.Participants = append(ev.Participants, participant)
+			attendance, err = optIntoRounds(&ev, participant, input.Rounds)
+			if errors.Is(err, errRoundNotFound) {
+				return errx.Wrap(ErrJoinEventRoundNotFound, "rounds", input.Rounds).
+					WithFieldViolation("rounds", ErrJoinEventRoundNotFound.LocalizeContext(ctx))
+			}
+			if errors.Is(err, errRoundFull) {
+				return ErrJoinEventRoundFull
+			}
+			if ev.SplitMode.ByAttendance() {
+				// everyone attending shares the total, so a join lowers what the others owe
+				ev.CalcTierAmounts()
+				ev.AssignParticipantAmounts()
+				participant.Amount = ev.Participants[len(ev.Participants)-1].Amount
+				participant.SettlementAmount = ev.Participants[len(ev.Participants)-1].SettlementAmount
+			} else {
+				if !ev.CollectingDeposits() {
+					for _, r := range ev.Rounds {
+						participant.Amount += ev.RoundShares(r)[participant.ID]
+					}
+				}
+				participant.SettlementAmount = ev.SettlementShare(participant.Amount)
+			}
+		}
+
+		token, err = participant.IssueToken()
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to issue participant token").
+				WithCode(errx.Internal)
+		}
 
 		if err := uc.participantRepo.WithTx(tx).Create(ctx, &participant); err != nil {
 			return errx.Wrap(err, "message", "failed to create participant").
 				WithCode(errx.Internal)
 		}
+		if err := attendance.save(ctx, uc.roundParticipantRepo.WithTx(tx)); err != nil {
+			return err
+		}
+		if !participant.IsWaitlisted() && ev.SplitMode.ByAttendance() {
+			// the copy recalculated above was taken before the token was issued
+			ev.Participants[len(ev.Participants)-1] = participant
+			if err := saveParticipantAmounts(
+				ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+			); err != nil {
+				return err
+			}
+		}
+
+		change := model.NewParticipantStatusChange(
+			participant, nil, model.ParticipantStatusActorParticipant, participant.EndUserID, "",
+		)
+		if err := uc.statusRepo.WithTx(tx).Create(ctx, &change); err != nil {
+			return errx.Wrap(err, "message", "failed to record participant status change").
+				WithCode(errx.Internal)
+		}
 
-		return nil
+		return publishDomainEvent(ctx, uc.outboxRepo.WithTx(tx), model.DomainEventParticipantJoined, model.ParticipantJoined{
+			EventID:       participant.EventID,
+			ParticipantID: participant.ID,
+			Status:        participant.Status,
+		})
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
 		return JoinEventOutput{}, err
 	}
 
-	return JoinEventOutput{Participant: participant}, nil
+	return JoinEventOutput{Participant: participant, Token: token, Estimated: estimated}, nil
 }
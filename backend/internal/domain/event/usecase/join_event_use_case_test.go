@@ -11,6 +11,7 @@ import (
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/query"
 	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/test/tseed"
 )
 
@@ -50,6 +51,48 @@ func TestJoinEvent_Do(t *testing.T) {
 		assert.Equal(t, 2, out.Participant.Tier)
 		assert.Equal(t, 5000, out.Participant.Amount)
 		assert.Equal(t, model.ParticipantStatusUnpaid, out.Participant.Status)
+		require.NotEmpty(t, out.Token)
+
+		got, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", out.Participant.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.True(t, got.VerifyToken(out.Token))
+
+		assert.Equal(t,
+			[]model.ParticipantJoined{{
+				EventID:       ev.ID,
+				ParticipantID: out.Participant.ID,
+				Status:        model.ParticipantStatusUnpaid,
+			}},
+			domainEvents[model.ParticipantJoined](t, infra, model.DomainEventParticipantJoined),
+		)
+		changes := statusChanges(t, infra, out.Participant.ID)
+		require.Len(t, changes, 1)
+		assert.Nil(t, changes[0].FromStatus)
+		assert.Equal(t, model.ParticipantStatusUnpaid, changes[0].ToStatus)
+	})
+
+	t.Run("links the participant to the logged-in user", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		organizer := tseed.EndUser(t, infra.WriterDB)
+		member := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), member.UserID)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = organizer.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) {
+			m.EventID = ev.ID
+			m.Count = 5
+		})
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+
+		sut := usecase.NewJoinEvent(infra)
+		out, err := sut.Do(ctx, usecase.JoinEventInput{EventID: ev.ID, Name: "Bob", Tier: 1})
+
+		require.NoError(t, err)
+		require.NotNil(t, out.Participant.EndUserID)
+		assert.Equal(t, member.UserID, *out.Participant.EndUserID)
 	})
 
 	t.Run("event not found", func(t *testing.T) {
@@ -126,4 +169,189 @@ func TestJoinEvent_Do(t *testing.T) {
 
 		require.ErrorIs(t, err, usecase.ErrJoinEventArchived)
 	})
+
+	t.Run("tier full", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) { m.EventID = ev.ID; m.Count = 1; m.Amount = 3000 })
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+		taken := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &taken))
+
+		sut := usecase.NewJoinEvent(infra)
+		_, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrJoinEventTierFull)
+	})
+
+	t.Run("opts into later rounds", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		round := seedRound(t, infra, ev, 4000, 2, participants[0])
+
+		sut := usecase.NewJoinEvent(infra)
+		out, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+			Rounds:  []int{2},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5000, out.Participant.Amount, "3000 for the first round and 2000 for the second")
+		assert.Equal(t, []string{participants[0].ID, out.Participant.ID}, roundParticipantIDs(t, infra, round.ID))
+	})
+
+	t.Run("attendance split moves what others owe", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		splitByAttendance(t, infra, &ev)
+
+		sut := usecase.NewJoinEvent(infra)
+		out, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4500, out.Participant.Amount)
+
+		joined, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", out.Participant.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 4500, joined.Amount)
+		assert.True(t, joined.VerifyToken(out.Token))
+		other, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 4500, other.Amount)
+	})
+
+	t.Run("attendance split refuses joins once someone paid", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2)
+		splitByAttendance(t, infra, &ev)
+		paid := participants[0]
+		paid.Status = model.ParticipantStatusConfirmed
+		paid.PaidAmount = paid.Amount
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &paid))
+
+		sut := usecase.NewJoinEvent(infra)
+		_, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrJoinEventLocked)
+		got, err := query.EventParticipants(infra.ReaderDB).Where("event_id = ?", ev.ID).All(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		adjustments, err := query.EventParticipantAdjustments(infra.ReaderDB).Where("event_id = ?", ev.ID).All(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, adjustments)
+	})
+
+	t.Run("deposit", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		collectDeposits(t, infra, &ev, participants, 5000)
+		seedRound(t, infra, ev, 4000, 2, participants[0])
+
+		sut := usecase.NewJoinEvent(infra)
+		out, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+			Rounds:  []int{2},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5000, out.Participant.Amount, "the deposit covers every round")
+	})
+
+	t.Run("estimate", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 1)
+		estimate(t, infra, &ev)
+
+		sut := usecase.NewJoinEvent(infra)
+		out, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, out.Estimated)
+		assert.Equal(t, 3000, out.Participant.Amount)
+	})
+
+	t.Run("round full", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		seedRound(t, infra, ev, 4000, 1, participants[0])
+
+		sut := usecase.NewJoinEvent(infra)
+		_, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID: ev.ID,
+			Name:    "Alice",
+			Tier:    1,
+			Rounds:  []int{2},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrJoinEventRoundFull)
+	})
+
+	t.Run("tier full (waitlist)", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) { m.EventID = ev.ID; m.Count = 1; m.Amount = 3000 })
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+		taken := fixture.EventParticipant(func(m *model.EventParticipant) { m.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &taken))
+
+		sut := usecase.NewJoinEvent(infra)
+		out, err := sut.Do(t.Context(), usecase.JoinEventInput{
+			EventID:  ev.ID,
+			Name:     "Alice",
+			Tier:     1,
+			Waitlist: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusWaitlisted, out.Participant.Status)
+		assert.Equal(t, 0, out.Participant.Amount)
+	})
 }
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrLeaveEventNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrLeaveEventInvalidToken = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid participant token", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorInvalidParticipantToken())
+	ErrLeaveEventArchived = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is archived", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorArchived())
+	ErrLeaveEventAlreadyClaimed = cmodel.NewLocalizableError(
+		errx.NewSentinel("already claimed", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorAlreadyClaimed())
+	ErrLeaveEventLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("amounts are locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorParticipantLocked())
+	ErrLeaveEventHasExpenses = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant has expenses", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorParticipantHasExpenses())
+)
+
+type LeaveEventInput struct {
+	ParticipantID string
+	// Token is the participant's secret from JoinEvent, proving the caller is that participant.
+	Token string
+}
+
+type LeaveEventOutput struct{}
+
+type LeaveEvent interface {
+	Do(ctx context.Context, input LeaveEventInput) (LeaveEventOutput, error)
+}
+
+type leaveEvent struct {
+	_               LeaveEvent                              `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	expenseRepo     repository.EventExpense                 `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+}
+
+// Do withdraws the participant on their own behalf, which they can do until they pay.
+// It follows the same rules as the organizer's RemoveParticipant, but is locked once anyone
+// has claimed a payment, as the freed spot changes what the others owe.
+func (uc *leaveEvent) Do(ctx context.Context, input LeaveEventInput) (LeaveEventOutput, error) {
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		found, err := uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrLeaveEventNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if !found.VerifyToken(input.Token) {
+			return ErrLeaveEventInvalidToken
+		}
+
+		// the freed spot goes to the waitlist, so joins must not take it meanwhile
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, found.EventID); err != nil {
+			return errx.Wrap(err, "message", "failed to lock event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
+		ev, err := uc.eventRepo.WithTx(tx).Get(
+			ctx, found.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", found.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+		if ev.ArchivedAt != nil {
+			return ErrLeaveEventArchived
+		}
+
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrLeaveEventNotFound
+		}
+		self := ev.Participants[idx]
+		if self.Status != model.ParticipantStatusUnpaid && !self.IsWaitlisted() {
+			return ErrLeaveEventAlreadyClaimed
+		}
+		// a waitlisted participant holds no spot, so leaving changes nobody's amount
+		if !self.IsWaitlisted() && ev.AmountsLocked() {
+			return ErrLeaveEventLocked
+		}
+
+		hasExpenses, err := uc.expenseRepo.WithTx(tx).ExistsForParticipant(ctx, self.ID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to check participant expenses", "id", self.ID).
+				WithCode(errx.Internal)
+		}
+		if hasExpenses {
+			return ErrLeaveEventHasExpenses
+		}
+
+		if err := uc.participantRepo.WithTx(tx).Delete(ctx, self.ID); err != nil {
+			return errx.Wrap(err, "message", "failed to delete participant", "id", self.ID).
+				WithCode(errx.Internal)
+		}
+		if self.IsWaitlisted() {
+			return nil
+		}
+		ev.Participants = slices.Delete(ev.Participants, idx, idx+1)
+
+		promoted := ev.PromoteWaitlisted()
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		return recordPromotions(ctx, uc.statusRepo.WithTx(tx), promoted)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return LeaveEventOutput{}, err
+	}
+
+	return LeaveEventOutput{}, nil
+}
@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestLeaveEvent_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		waiting := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusWaitlisted
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &waiting))
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewLeaveEvent(infra)
+		_, err := sut.Do(t.Context(), usecase.LeaveEventInput{ParticipantID: participants[0].ID, Token: token})
+
+		require.NoError(t, err)
+		exists, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).Exists(t.Context())
+		require.NoError(t, err)
+		assert.False(t, exists)
+		promoted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", waiting.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, promoted.Status)
+		assert.Equal(t, 3000, promoted.Amount)
+	})
+
+	t.Run("waitlisted participant leaves after a claim", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		participants[0].Status = model.ParticipantStatusClaimed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+		waiting := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusWaitlisted
+		})
+		token, err := waiting.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &waiting))
+
+		sut := usecase.NewLeaveEvent(infra)
+		_, err = sut.Do(t.Context(), usecase.LeaveEventInput{ParticipantID: waiting.ID, Token: token})
+
+		require.NoError(t, err)
+		exists, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", waiting.ID).Exists(t.Context())
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("locked by another participant's claim", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 2)
+		participants[1].Status = model.ParticipantStatusConfirmed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[1]))
+		token := issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewLeaveEvent(infra)
+		_, err := sut.Do(t.Context(), usecase.LeaveEventInput{ParticipantID: participants[0].ID, Token: token})
+
+		require.ErrorIs(t, err, usecase.ErrLeaveEventLocked)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		_, participants := seedRoster(t, infra, endUser.UserID, 1)
+		issueToken(t, infra, &participants[0])
+
+		sut := usecase.NewLeaveEvent(infra)
+		_, err := sut.Do(t.Context(), usecase.LeaveEventInput{ParticipantID: participants[0].ID})
+
+		require.ErrorIs(t, err, usecase.ErrLeaveEventInvalidToken)
+	})
+}
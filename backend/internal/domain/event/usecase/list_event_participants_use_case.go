@@ -30,6 +30,12 @@ type ListEventParticipantsInput struct {
 
 type ListEventParticipantsOutput struct {
 	Participants []model.EventParticipant
+	// StatusChanges is the status history of all the participants, oldest first.
+	StatusChanges []model.EventParticipantStatusChange
+	// Payments is the payments ledger of all the participants, oldest first.
+	Payments []model.EventParticipantPayment
+	// Adjustments is the adjustments ledger of all the participants, oldest first.
+	Adjustments []model.EventParticipantAdjustment
 }
 
 type ListEventParticipants interface {
@@ -37,10 +43,13 @@ type ListEventParticipants interface {
 }
 
 type listEventParticipants struct {
-	_         ListEventParticipants `inject:"returns"`
-	_         *di.Infra             `inject:"param"`
-	reader    *database.Reader      `inject:""`
-	eventRepo repository.Event      `inject:""`
+	_              ListEventParticipants                   `inject:"returns"`
+	_              *di.Infra                               `inject:"param"`
+	reader         *database.Reader                        `inject:""`
+	eventRepo      repository.Event                        `inject:""`
+	statusRepo     repository.EventParticipantStatusChange `inject:""`
+	paymentRepo    repository.EventParticipantPayment      `inject:""`
+	adjustmentRepo repository.EventParticipantAdjustment   `inject:""`
 }
 
 func (uc *listEventParticipants) Do(
@@ -49,6 +58,9 @@ func (uc *listEventParticipants) Do(
 	userID := contexts.MustAuthenticatedUserID(ctx)
 
 	var ev model.Event
+	var changes []model.EventParticipantStatusChange
+	var payments []model.EventParticipantPayment
+	var adjustments []model.EventParticipantAdjustment
 
 	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
 		var err error
@@ -68,11 +80,34 @@ func (uc *listEventParticipants) Do(
 			return ErrListEventParticipantsForbidden
 		}
 
+		changes, err = uc.statusRepo.WithTx(tx).ListByEventID(ctx, ev.ID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to list participant status changes", "id", ev.ID).
+				WithCode(errx.Internal)
+		}
+
+		payments, err = uc.paymentRepo.WithTx(tx).ListByEventID(ctx, ev.ID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to list participant payments", "id", ev.ID).
+				WithCode(errx.Internal)
+		}
+
+		adjustments, err = uc.adjustmentRepo.WithTx(tx).ListByEventID(ctx, ev.ID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to list participant adjustments", "id", ev.ID).
+				WithCode(errx.Internal)
+		}
+
 		return nil
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
 		return ListEventParticipantsOutput{}, err
 	}
 
-	return ListEventParticipantsOutput{Participants: ev.Participants}, nil
+	return ListEventParticipantsOutput{
+		Participants:  ev.Participants,
+		StatusChanges: changes,
+		Payments:      payments,
+		Adjustments:   adjustments,
+	}, nil
 }
@@ -57,6 +57,30 @@ func TestListEventParticipants_Do(t *testing.T) {
 		}
 		assert.Equal(t, 9000, amountByID[p1.ID])
 		assert.Equal(t, 3000, amountByID[p2.ID])
+		assert.Empty(t, out.StatusChanges)
+	})
+
+	t.Run("returns the status history", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+		joined := model.NewParticipantStatusChange(p, nil, model.ParticipantStatusActorParticipant, nil, "")
+		require.NoError(t, query.EventParticipantStatusChanges(infra.WriterDB).Create(t.Context(), &joined))
+
+		sut := usecase.NewListEventParticipants(infra)
+		out, err := sut.Do(ctx, usecase.ListEventParticipantsInput{EventID: ev.ID})
+
+		require.NoError(t, err)
+		require.Len(t, out.StatusChanges, 1)
+		assert.Equal(t, joined.ID, out.StatusChanges[0].ID)
+		assert.Equal(t, p.ID, out.StatusChanges[0].ParticipantID)
 	})
 
 	t.Run("not found", func(t *testing.T) {
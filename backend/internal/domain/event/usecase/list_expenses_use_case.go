@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrListExpensesNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrListExpensesForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+)
+
+type ListExpensesInput struct {
+	EventID string
+}
+
+type ListExpensesOutput struct {
+	Expenses  []model.EventExpense
+	Balances  []model.Balance
+	Transfers []model.Transfer
+}
+
+type ListExpenses interface {
+	Do(ctx context.Context, input ListExpensesInput) (ListExpensesOutput, error)
+}
+
+type listExpenses struct {
+	_           ListExpenses            `inject:"returns"`
+	_           *di.Infra               `inject:"param"`
+	reader      *database.Reader        `inject:""`
+	eventRepo   repository.Event        `inject:""`
+	expenseRepo repository.EventExpense `inject:""`
+}
+
+func (uc *listExpenses) Do(ctx context.Context, input ListExpensesInput) (ListExpensesOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	var expenses []model.EventExpense
+	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
+		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, input.EventID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrListExpensesNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		if ev.UserID != userID {
+			return ErrListExpensesForbidden
+		}
+
+		expenses, err = uc.expenseRepo.WithTx(tx).ListByEventID(
+			ctx, ev.ID, repository.EventExpensePreloadShares(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to list expenses", "event_id", ev.ID).
+				WithCode(errx.Internal)
+		}
+
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return ListExpensesOutput{}, err
+	}
+
+	balances := model.CalcBalances(expenses)
+	return ListExpensesOutput{
+		Expenses:  expenses,
+		Balances:  balances,
+		Transfers: model.SettleTransfers(balances),
+	}, nil
+}
@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestListExpenses_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("balances and transfers", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		a := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &a))
+		b := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &b))
+
+		repo := repository.NewEventExpense(infra.WriterDB.DB)
+		hotel := model.NewEventExpense(ev.ID, a.ID, "hotel", 20000, []string{a.ID, b.ID})
+		require.NoError(t, repo.Create(t.Context(), &hotel))
+		taxi := model.NewEventExpense(ev.ID, b.ID, "taxi", 4000, []string{a.ID, b.ID})
+		require.NoError(t, repo.Create(t.Context(), &taxi))
+
+		sut := usecase.NewListExpenses(infra)
+		out, err := sut.Do(ctx, usecase.ListExpensesInput{EventID: ev.ID})
+
+		require.NoError(t, err)
+		require.Len(t, out.Expenses, 2)
+		assert.Len(t, out.Expenses[0].Shares, 2)
+		require.Len(t, out.Transfers, 1)
+		assert.Equal(t, model.Transfer{FromParticipantID: b.ID, ToParticipantID: a.ID, Amount: 8000}, out.Transfers[0])
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = owner.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		sut := usecase.NewListExpenses(infra)
+		_, err := sut.Do(ctx, usecase.ListExpensesInput{EventID: ev.ID})
+
+		require.ErrorIs(t, err, usecase.ErrListExpensesForbidden)
+	})
+}
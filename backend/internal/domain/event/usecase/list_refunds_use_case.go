@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+type ListRefundsInput struct{}
+
+type ListRefundsOutput struct {
+	// Refunds are ordered by event, most recently held first, then by when the participant joined.
+	Refunds []model.Refund
+}
+
+type ListRefunds interface {
+	Do(ctx context.Context, input ListRefundsInput) (ListRefundsOutput, error)
+}
+
+type listRefunds struct {
+	_         ListRefunds      `inject:"returns"`
+	_         *di.Infra        `inject:"param"`
+	reader    *database.Reader `inject:""`
+	eventRepo repository.Event `inject:""`
+}
+
+// Do lists the refunds the organizer still has to make across all their events, archived ones included,
+// e.g. what deposits covered beyond the actual cost. A refund leaves the list once RecordRefund records it.
+func (uc *listRefunds) Do(ctx context.Context, _ ListRefundsInput) (ListRefundsOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	var events []model.Event
+	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
+		var err error
+		events, err = uc.eventRepo.WithTx(tx).ListByUserID(ctx, userID, repository.EventPreloadParticipants())
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to list events", "user_id", userID).
+				WithCode(errx.Internal)
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return ListRefundsOutput{}, err
+	}
+
+	var refunds []model.Refund
+	for _, ev := range events {
+		refunds = append(refunds, ev.RefundsOwed()...)
+	}
+	return ListRefundsOutput{Refunds: refunds}, nil
+}
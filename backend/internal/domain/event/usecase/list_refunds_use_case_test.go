@@ -0,0 +1,53 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestListRefunds_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		overpay(t, infra, &participants[1], 500)
+		_, othersParticipants := seedRoster(t, infra, other.UserID, 1)
+		overpay(t, infra, &othersParticipants[0], 500)
+
+		sut := usecase.NewListRefunds(infra)
+		out, err := sut.Do(ctx, usecase.ListRefundsInput{})
+
+		require.NoError(t, err)
+		require.Len(t, out.Refunds, 1)
+		assert.Equal(t, ev.ID, out.Refunds[0].EventID)
+		assert.Equal(t, participants[1].ID, out.Refunds[0].Participant.ID)
+		assert.Equal(t, 500, out.Refunds[0].Amount)
+	})
+
+	t.Run("none owed", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		seedRoster(t, infra, endUser.UserID, 3)
+
+		sut := usecase.NewListRefunds(infra)
+		out, err := sut.Do(ctx, usecase.ListRefundsInput{})
+
+		require.NoError(t, err)
+		assert.Empty(t, out.Refunds)
+	})
+}
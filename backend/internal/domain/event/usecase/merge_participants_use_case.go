@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/slicex"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrMergeParticipantsNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrMergeParticipantsParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrMergeParticipantsForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrMergeParticipantsLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorLocked())
+	ErrMergeParticipantsSameParticipant = cmodel.NewLocalizableError(
+		errx.NewSentinel("cannot merge a participant into themselves", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorMergeSameParticipant())
+)
+
+type MergeParticipantsInput struct {
+	EventID string
+	// ParticipantID is the participant to keep.
+	ParticipantID string
+	// DuplicateID is the participant merged into them and removed.
+	DuplicateID string
+}
+
+type MergeParticipantsOutput struct {
+	Event model.Event
+}
+
+type MergeParticipants interface {
+	Do(ctx context.Context, input MergeParticipantsInput) (MergeParticipantsOutput, error)
+}
+
+type mergeParticipants struct {
+	_                    MergeParticipants                       `inject:"returns"`
+	_                    *di.Infra                               `inject:"param"`
+	writer               *database.Writer                        `inject:""`
+	eventRepo            repository.Event                        `inject:""`
+	roundRepo            repository.EventRound                   `inject:""`
+	roundParticipantRepo repository.EventRoundParticipant        `inject:""`
+	tierRepo             repository.EventTier                    `inject:""`
+	participantRepo      repository.EventParticipant             `inject:""`
+	expenseRepo          repository.EventExpense                 `inject:""`
+	statusRepo           repository.EventParticipantStatusChange `inject:""`
+}
+
+// Do folds a duplicate join into the participant to keep. The kept participant takes over the duplicate's
+// expenses and, if they have none, the linked account; the duplicate's spot goes to the waitlist.
+func (uc *mergeParticipants) Do(
+	ctx context.Context, input MergeParticipantsInput,
+) (MergeParticipantsOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.ParticipantID == input.DuplicateID {
+		return MergeParticipantsOutput{}, errx.Wrap(ErrMergeParticipantsSameParticipant).
+			WithFieldViolation("duplicate_id", ErrMergeParticipantsSameParticipant.LocalizeContext(ctx))
+	}
+
+	var ev model.Event
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// the duplicate's spot goes to the waitlist, so joins must not take it meanwhile
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrMergeParticipantsNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		if ev.UserID != userID {
+			return ErrMergeParticipantsForbidden
+		}
+		if ev.AmountsLocked() {
+			return ErrMergeParticipantsLocked
+		}
+		keptIdx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		dupIdx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.DuplicateID
+		})
+		if keptIdx < 0 || dupIdx < 0 {
+			return ErrMergeParticipantsParticipantNotFound
+		}
+
+		duplicate := ev.Participants[dupIdx]
+		if ev.Participants[keptIdx].EndUserID == nil {
+			ev.Participants[keptIdx].EndUserID = duplicate.EndUserID
+		}
+
+		if err := uc.expenseRepo.WithTx(tx).ReassignParticipant(ctx, duplicate.ID, input.ParticipantID); err != nil {
+			return errx.Wrap(err, "message", "failed to reassign expenses", "from", duplicate.ID, "to", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if err := uc.participantRepo.WithTx(tx).Delete(ctx, duplicate.ID); err != nil {
+			return errx.Wrap(err, "message", "failed to delete participant", "id", duplicate.ID).
+				WithCode(errx.Internal)
+		}
+		ev.Participants = slices.Delete(ev.Participants, dupIdx, dupIdx+1)
+
+		if err := uc.roundParticipantRepo.WithTx(tx).CreateAll(
+			ctx, slicex.MapToPointer(carryOverRounds(&ev, duplicate.ID, input.ParticipantID)),
+		); err != nil {
+			return errx.Wrap(err, "message", "failed to carry over rounds", "from", duplicate.ID, "to", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+
+		promoted := ev.PromoteWaitlisted()
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		return recordPromotions(ctx, uc.statusRepo.WithTx(tx), promoted)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return MergeParticipantsOutput{}, err
+	}
+
+	return MergeParticipantsOutput{Event: ev}, nil
+}
+
+// carryOverRounds has the kept participant take the duplicate's place in the later rounds only the duplicate
+// opted into, as long as the kept participant's tier has room. The duplicate must already be off the roster.
+func carryOverRounds(ev *model.Event, duplicateID, keptID string) []model.EventRoundParticipant {
+	keptIdx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+		return p.ID == keptID
+	})
+	kept := ev.Participants[keptIdx]
+
+	var carried []model.EventRoundParticipant
+	for i := range ev.Rounds {
+		r := &ev.Rounds[i]
+		idx := slices.IndexFunc(r.Participants, func(p model.EventRoundParticipant) bool {
+			return p.ParticipantID == duplicateID
+		})
+		if idx < 0 || r.HasParticipant(keptID) || kept.IsWaitlisted() || !ev.HasRoundVacancy(*r, kept.Tier) {
+			continue
+		}
+		m := model.EventRoundParticipant{
+			ID:            ulid.New(),
+			EventID:       ev.ID,
+			RoundID:       r.ID,
+			ParticipantID: keptID,
+			// keep the duplicate's place in line for the seats
+			CreatedAt: r.Participants[idx].CreatedAt,
+		}
+		r.Participants = append(r.Participants, m)
+		carried = append(carried, m)
+	}
+	return carried
+}
@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestMergeParticipants_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		guest := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		kept, dup := participants[0], participants[1]
+		dup.EndUserID = &guest.UserID
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &dup))
+		expense := model.NewEventExpense(ev.ID, dup.ID, "taxi", 2000, []string{kept.ID, dup.ID})
+		require.NoError(t, repository.NewEventExpense(infra.WriterDB.DB).Create(t.Context(), &expense))
+
+		sut := usecase.NewMergeParticipants(infra)
+		out, err := sut.Do(ctx, usecase.MergeParticipantsInput{
+			EventID:       ev.ID,
+			ParticipantID: kept.ID,
+			DuplicateID:   dup.ID,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, out.Event.Participants, 2)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", kept.ID).First(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, persisted.EndUserID)
+		assert.Equal(t, guest.UserID, *persisted.EndUserID)
+
+		got, err := repository.NewEventExpense(infra.ReaderDB.DB).
+			Get(t.Context(), expense.ID, repository.EventExpensePreloadShares())
+		require.NoError(t, err)
+		assert.Equal(t, kept.ID, got.PayerID)
+		require.Len(t, got.Shares, 1)
+		assert.Equal(t, kept.ID, got.Shares[0].ParticipantID)
+		assert.Equal(t, 2000, got.Shares[0].Amount)
+	})
+
+	t.Run("same participant", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+
+		sut := usecase.NewMergeParticipants(infra)
+		_, err := sut.Do(ctx, usecase.MergeParticipantsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			DuplicateID:   participants[0].ID,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrMergeParticipantsSameParticipant)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2)
+		participants[0].Status = model.ParticipantStatusClaimed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewMergeParticipants(infra)
+		_, err := sut.Do(ctx, usecase.MergeParticipantsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			DuplicateID:   participants[1].ID,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrMergeParticipantsLocked)
+	})
+}
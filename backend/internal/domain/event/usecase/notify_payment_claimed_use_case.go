@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/config"
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	urepository "github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/misc/i18n"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+type NotifyPaymentClaimedInput struct {
+	Claim model.PaymentClaimed
+}
+
+type NotifyPaymentClaimedOutput struct {
+	// Notified is false when there was nothing to tell, e.g. the organizer already confirmed the payment.
+	Notified bool
+}
+
+type NotifyPaymentClaimed interface {
+	Do(ctx context.Context, input NotifyPaymentClaimedInput) (NotifyPaymentClaimedOutput, error)
+}
+
+type notifyPaymentClaimed struct {
+	_                       NotifyPaymentClaimed            `inject:"returns"`
+	_                       *di.Infra                       `inject:"param"`
+	reader                  *database.Reader                `inject:""`
+	eventRepo               repository.Event                `inject:""`
+	participantRepo         repository.EventParticipant     `inject:""`
+	notificationSettingRepo urepository.NotificationSetting `inject:""`
+	notifier                notifier.Notifier               `inject:""`
+}
+
+// Do tells the organizer that a participant says they paid, with a link to confirm it.
+// It runs after the claim committed, so it skips claims that were confirmed or undone in the meantime.
+func (uc *notifyPaymentClaimed) Do(
+	ctx context.Context, input NotifyPaymentClaimedInput,
+) (NotifyPaymentClaimedOutput, error) {
+	var ev model.Event
+	var participant model.EventParticipant
+	var recipient umodel.NotificationRecipient
+	var stale bool
+
+	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
+		var err error
+		participant, err = uc.participantRepo.WithTx(tx).Get(ctx, input.Claim.ParticipantID)
+		if errors.Is(err, database.ErrNotFound) {
+			stale = true
+			return nil
+		}
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.Claim.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if participant.Status != model.ParticipantStatusClaimed {
+			stale = true
+			return nil
+		}
+
+		ev, err = uc.eventRepo.WithTx(tx).Get(ctx, participant.EventID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", participant.EventID).
+				WithCode(errx.Internal)
+		}
+
+		recipient, err = uc.notificationSettingRepo.WithTx(tx).GetRecipient(ctx, ev.UserID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get notification recipient", "user_id", ev.UserID).
+				WithCode(errx.Internal)
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return NotifyPaymentClaimedOutput{}, err
+	}
+	if stale {
+		return NotifyPaymentClaimedOutput{}, nil
+	}
+
+	lang := i18n.DefaultLanguage
+	amount := i18n.FormatAmount(lang, ev.PaymentCurrency(), ev.SettlementOutstanding(participant))
+	summary := messages.MessagingClaimNotification(participant.Name, ev.Title, amount)
+	if method, ok := paymentMethodName(input.Claim.PaymentMethod); ok {
+		summary = messages.MessagingClaimNotificationVia(participant.Name, ev.Title, amount, i18n.Localize(lang, method))
+	}
+
+	if err := uc.notifier.Notify(ctx, notifier.Notification{
+		EndUserID:  recipient.EndUserID,
+		LINEUserID: recipient.LINEUserID,
+		Email:      recipient.Email,
+		Title:      i18n.Localize(lang, messages.MessagingClaimNotificationTitle(participant.Name)),
+		Body: i18n.Localize(lang, summary) + "\n" +
+			i18n.Localize(lang, messages.MessagingClaimNotificationConfirm(confirmURL(ev.ID, participant.ID))),
+	}); err != nil {
+		return NotifyPaymentClaimedOutput{}, errx.Wrap(err, "message", "failed to notify payment claim",
+			"participant_id", participant.ID).
+			WithCode(errx.Internal)
+	}
+
+	return NotifyPaymentClaimedOutput{Notified: true}, nil
+}
+
+// confirmURL opens the organizer's event page ready to confirm the participant's payment.
+func confirmURL(eventID, participantID string) string {
+	return config.Notifier().WebURL + "/my/events/" + url.PathEscape(eventID) +
+		"?confirm=" + url.QueryEscape(participantID)
+}
+
+func paymentMethodName(paymentMethod string) (i18n.Message, bool) {
+	switch paymentMethod {
+	case converter.PaymentMethodTypePayPay:
+		return messages.MessagingPaymentMethodPaypay(), true
+	case converter.PaymentMethodTypeKyash:
+		return messages.MessagingPaymentMethodKyash(), true
+	case converter.PaymentMethodTypeRakutenPay:
+		return messages.MessagingPaymentMethodRakutenPay(), true
+	case converter.PaymentMethodTypeMerPay:
+		return messages.MessagingPaymentMethodMerpay(), true
+	default:
+		return i18n.Message{}, false
+	}
+}
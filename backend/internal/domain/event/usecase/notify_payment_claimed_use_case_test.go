@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestNotifyPaymentClaimed_Do(t *testing.T) {
+	t.Parallel()
+
+	setup := func(
+		t *testing.T, status model.ParticipantStatus, setters ...func(*model.EventParticipant),
+	) (*di.Infra, *notifier.Recorder, model.EventParticipant) {
+		t.Helper()
+
+		recorder := notifier.NewRecorder()
+		infra := newInfra(t, func(infra *di.Infra) { infra.Notifier = recorder })
+		organizer := tseed.EndUser(t, infra.WriterDB)
+		setting := umodel.NotificationSetting{
+			EndUserID:    organizer.UserID,
+			EmailEnabled: true,
+			Email:        ptr.Of("organizer@example.com"),
+		}
+		require.NoError(t, uquery.NotificationSettings(infra.WriterDB).Create(t.Context(), &setting))
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = organizer.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = status
+			for _, set := range setters {
+				set(p)
+			}
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		return infra, recorder, p
+	}
+
+	t.Run("notifies the organizer with a confirm link", func(t *testing.T) {
+		t.Parallel()
+
+		infra, recorder, p := setup(t, model.ParticipantStatusClaimed)
+
+		sut := usecase.NewNotifyPaymentClaimed(infra)
+		out, err := sut.Do(t.Context(), usecase.NotifyPaymentClaimedInput{Claim: model.PaymentClaimed{
+			EventID:       p.EventID,
+			ParticipantID: p.ID,
+			PaymentMethod: converter.PaymentMethodTypePayPay,
+		}})
+
+		require.NoError(t, err)
+		assert.True(t, out.Notified)
+		notifications := recorder.Notifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "organizer@example.com", notifications[0].Email)
+		assert.Empty(t, notifications[0].LINEUserID)
+		assert.Contains(t, notifications[0].Title, p.Name)
+		assert.Contains(t, notifications[0].Body, "PayPay")
+		assert.Contains(t, notifications[0].Body, "/my/events/"+p.EventID+"?confirm="+p.ID)
+	})
+
+	t.Run("shows what is left to pay after adjustments and partial payments", func(t *testing.T) {
+		t.Parallel()
+
+		infra, recorder, p := setup(t, model.ParticipantStatusClaimed, func(p *model.EventParticipant) {
+			p.Amount = 5000
+			p.AdjustmentAmount = 1000
+			p.PaidAmount = 2000
+		})
+
+		sut := usecase.NewNotifyPaymentClaimed(infra)
+		_, err := sut.Do(t.Context(), usecase.NotifyPaymentClaimedInput{Claim: model.PaymentClaimed{
+			EventID:       p.EventID,
+			ParticipantID: p.ID,
+		}})
+
+		require.NoError(t, err)
+		notifications := recorder.Notifications()
+		require.Len(t, notifications, 1)
+		assert.Contains(t, notifications[0].Body, "4,000")
+		assert.NotContains(t, notifications[0].Body, "5,000")
+	})
+
+	t.Run("skips payments confirmed in the meantime", func(t *testing.T) {
+		t.Parallel()
+
+		infra, recorder, p := setup(t, model.ParticipantStatusConfirmed)
+
+		sut := usecase.NewNotifyPaymentClaimed(infra)
+		out, err := sut.Do(t.Context(), usecase.NotifyPaymentClaimedInput{Claim: model.PaymentClaimed{
+			EventID:       p.EventID,
+			ParticipantID: p.ID,
+		}})
+
+		require.NoError(t, err)
+		assert.False(t, out.Notified)
+		assert.Empty(t, recorder.Notifications())
+	})
+
+	t.Run("skips removed participants", func(t *testing.T) {
+		t.Parallel()
+
+		infra, recorder, p := setup(t, model.ParticipantStatusClaimed)
+
+		sut := usecase.NewNotifyPaymentClaimed(infra)
+		out, err := sut.Do(t.Context(), usecase.NotifyPaymentClaimedInput{Claim: model.PaymentClaimed{
+			EventID:       p.EventID,
+			ParticipantID: "nonexistent",
+		}})
+
+		require.NoError(t, err)
+		assert.False(t, out.Notified)
+		assert.Empty(t, recorder.Notifications())
+	})
+}
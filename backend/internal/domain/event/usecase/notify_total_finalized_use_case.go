@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	urepository "github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/i18n"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+type NotifyTotalFinalizedInput struct {
+	Finalized model.TotalFinalized
+}
+
+type NotifyTotalFinalizedOutput struct {
+	// Notified is how many participants were told their amount.
+	Notified int
+}
+
+type NotifyTotalFinalized interface {
+	Do(ctx context.Context, input NotifyTotalFinalizedInput) (NotifyTotalFinalizedOutput, error)
+}
+
+type notifyTotalFinalized struct {
+	_           NotifyTotalFinalized            `inject:"returns"`
+	_           *di.Infra                       `inject:"param"`
+	reader      *database.Reader                `inject:""`
+	eventRepo   repository.Event                `inject:""`
+	settingRepo urepository.NotificationSetting `inject:""`
+	notifier    notifier.Notifier               `inject:""`
+}
+
+// Do tells participants linked to an account what they owe now that the event's total is final,
+// next to what they were asked for against the estimate. The organizer and participants who left
+// or were moved to the waitlist in the meantime are skipped. When only some participants could be told,
+// the error includes notifier.ErrPartiallyDelivered.
+func (uc *notifyTotalFinalized) Do(
+	ctx context.Context, input NotifyTotalFinalizedInput,
+) (NotifyTotalFinalizedOutput, error) {
+	var ev model.Event
+	var notifications []notifier.Notification
+
+	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(ctx, input.Finalized.EventID, repository.EventPreloadParticipants())
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.Finalized.EventID).
+				WithCode(errx.Internal)
+		}
+
+		participants := make(map[string]model.EventParticipant, len(ev.Participants))
+		for _, p := range ev.Participants {
+			participants[p.ID] = p
+		}
+
+		lang := i18n.DefaultLanguage
+		for _, a := range input.Finalized.Amounts {
+			p, ok := participants[a.ParticipantID]
+			if !ok || p.IsWaitlisted() || p.EndUserID == nil || *p.EndUserID == ev.UserID {
+				continue
+			}
+
+			r, err := uc.settingRepo.WithTx(tx).GetRecipient(ctx, *p.EndUserID)
+			if err != nil {
+				return errx.Wrap(err, "message", "failed to get notification recipient", "user_id", *p.EndUserID).
+					WithCode(errx.Internal)
+			}
+
+			due := ev.SettlementAmount(a.Due)
+			if p.Due() == a.Due {
+				// their share of the settlement total rather than the amount converted on its own
+				due = ev.SettlementDue(p)
+			}
+			amount := i18n.FormatAmount(lang, ev.PaymentCurrency(), due)
+			body := messages.MessagingTotalFinalizedUnchanged(ev.Title, amount)
+			if a.Due != a.PreviousDue {
+				previous := i18n.FormatAmount(lang, ev.PaymentCurrency(), ev.SettlementAmount(a.PreviousDue))
+				body = messages.MessagingTotalFinalizedChanged(ev.Title, previous, amount)
+			}
+			notifications = append(notifications, notifier.Notification{
+				EndUserID:  r.EndUserID,
+				LINEUserID: r.LINEUserID,
+				Email:      r.Email,
+				Title:      i18n.Localize(lang, messages.MessagingTotalFinalizedTitle(ev.Title)),
+				Body:       i18n.Localize(lang, body),
+			})
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return NotifyTotalFinalizedOutput{}, err
+	}
+
+	var out NotifyTotalFinalizedOutput
+	var errs []error
+	for _, n := range notifications {
+		if err := uc.notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, errx.Wrap(err, "message", "failed to notify finalized total",
+				"end_user_id", n.EndUserID).
+				WithCode(errx.Internal))
+			continue
+		}
+		out.Notified++
+	}
+	if len(errs) > 0 && out.Notified > 0 {
+		errs = append(errs, notifier.ErrPartiallyDelivered)
+	}
+	return out, errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestNotifyTotalFinalized_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tells linked participants their exact amount", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := notifier.NewRecorder()
+		infra := newInfra(t, func(infra *di.Infra) { infra.Notifier = recorder })
+		organizer := tseed.EndUser(t, infra.WriterDB)
+		member := tseed.EndUser(t, infra.WriterDB)
+		setting := umodel.NotificationSetting{
+			EndUserID:    member.UserID,
+			EmailEnabled: true,
+			Email:        ptr.Of("member@example.com"),
+		}
+		require.NoError(t, uquery.NotificationSettings(infra.WriterDB).Create(t.Context(), &setting))
+
+		ev, participants := seedRoster(t, infra, organizer.UserID, 3)
+		participants[0].EndUserID = &member.UserID
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+		// the organizer joined their own event
+		participants[1].EndUserID = &organizer.UserID
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[1]))
+
+		sut := usecase.NewNotifyTotalFinalized(infra)
+		out, err := sut.Do(t.Context(), usecase.NotifyTotalFinalizedInput{Finalized: model.TotalFinalized{
+			EventID:     ev.ID,
+			TotalAmount: 12000,
+			Amounts: []model.FinalizedAmount{
+				{ParticipantID: participants[0].ID, PreviousDue: 3000, Due: 4000},
+				{ParticipantID: participants[1].ID, PreviousDue: 3000, Due: 4000},
+				{ParticipantID: participants[2].ID, PreviousDue: 3000, Due: 4000},
+			},
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, out.Notified)
+		notifications := recorder.Notifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "member@example.com", notifications[0].Email)
+		assert.Contains(t, notifications[0].Title, ev.Title)
+		assert.Contains(t, notifications[0].Body, "4,000")
+		assert.Contains(t, notifications[0].Body, "3,000")
+	})
+
+	t.Run("skips deleted events", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := notifier.NewRecorder()
+		infra := newInfra(t, func(infra *di.Infra) { infra.Notifier = recorder })
+
+		sut := usecase.NewNotifyTotalFinalized(infra)
+		out, err := sut.Do(t.Context(), usecase.NotifyTotalFinalizedInput{Finalized: model.TotalFinalized{
+			EventID: "nonexistent",
+		}})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, out.Notified)
+		assert.Empty(t, recorder.Notifications())
+	})
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/lib/slicex"
+)
+
+// saveParticipantAmounts stores what the event, its tiers and its participants owe after a recalculation.
+// The repositories must be bound to the transaction making the change.
+func saveParticipantAmounts(
+	ctx context.Context,
+	ev *model.Event,
+	eventRepo repository.Event,
+	tierRepo repository.EventTier,
+	participantRepo repository.EventParticipant,
+) error {
+	if err := eventRepo.Update(ctx, ev); err != nil {
+		return errx.Wrap(err, "message", "failed to update event", "id", ev.ID).
+			WithCode(errx.Internal)
+	}
+	for i := range ev.Tiers {
+		if err := tierRepo.Update(ctx, &ev.Tiers[i]); err != nil {
+			return errx.Wrap(err, "message", "failed to update tier amount", "id", ev.Tiers[i].ID).
+				WithCode(errx.Internal)
+		}
+	}
+	for i := range ev.Participants {
+		if err := participantRepo.Update(ctx, &ev.Participants[i]); err != nil {
+			return errx.Wrap(err, "message", "failed to update participant amount", "id", ev.Participants[i].ID).
+				WithCode(errx.Internal)
+		}
+	}
+	return nil
+}
+
+// recordPromotions adds the participants just promoted from the waitlist to the status history.
+func recordPromotions(
+	ctx context.Context, repo repository.EventParticipantStatusChange, promoted []model.EventParticipant,
+) error {
+	waitlisted := model.ParticipantStatusWaitlisted
+	changes := slicex.Map(promoted, func(p model.EventParticipant) *model.EventParticipantStatusChange {
+		change := model.NewParticipantStatusChange(p, &waitlisted, model.ParticipantStatusActorSystem, nil, "")
+		return &change
+	})
+	if err := repo.CreateAll(ctx, changes); err != nil {
+		return errx.Wrap(err, "message", "failed to record waitlist promotions").
+			WithCode(errx.Internal)
+	}
+	return nil
+}
+
+// recordAdjustments stores the adjustments a recalculation made for participants whose amount is locked,
+// then moves each adjusted participant to the status their new balance puts them in, e.g. back to partially
+// paid after an additional charge. It must run before the participants are saved.
+// The repositories must be bound to the transaction making the change.
+func recordAdjustments(
+	ctx context.Context,
+	ev *model.Event,
+	adjustments []model.EventParticipantAdjustment,
+	actor model.ParticipantStatusActor,
+	actorUserID *string,
+	adjustmentRepo repository.EventParticipantAdjustment,
+	statusRepo repository.EventParticipantStatusChange,
+	outboxRepo orepository.OutboxMessage,
+) error {
+	if err := adjustmentRepo.CreateAll(ctx, slicex.MapToPointer(adjustments)); err != nil {
+		return errx.Wrap(err, "message", "failed to record adjustments", "id", ev.ID).
+			WithCode(errx.Internal)
+	}
+
+	now := time.Now()
+	for _, adjustment := range adjustments {
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == adjustment.ParticipantID
+		})
+		participant := &ev.Participants[idx]
+		from := participant.Status
+		if !participant.Settle(now) {
+			continue
+		}
+
+		change := model.NewParticipantStatusChange(*participant, &from, actor, actorUserID, "")
+		if err := statusRepo.Create(ctx, &change); err != nil {
+			return errx.Wrap(err, "message", "failed to record participant status change", "id", participant.ID).
+				WithCode(errx.Internal)
+		}
+		if participant.Status != model.ParticipantStatusConfirmed {
+			continue
+		}
+		// a refund can leave what they paid covering the whole bill
+		if err := publishDomainEvent(ctx, outboxRepo, model.DomainEventPaymentConfirmed, model.PaymentConfirmed{
+			EventID:       participant.EventID,
+			ParticipantID: participant.ID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
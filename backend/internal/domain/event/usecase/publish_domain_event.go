@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	omodel "github.com/mickamy/sampay/internal/domain/outbox/model"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+)
+
+// publishDomainEvent stores the event in the outbox through repo, which must be bound to the transaction
+// making the change, so the event goes out if and only if the change commits.
+func publishDomainEvent(
+	ctx context.Context, repo orepository.OutboxMessage, eventType model.DomainEventType, payload any,
+) error {
+	m, err := omodel.NewOutboxMessage(eventType, payload)
+	if err != nil {
+		return errx.Wrap(err, "message", "failed to encode domain event", "type", eventType).
+			WithCode(errx.Internal)
+	}
+	if err := repo.Create(ctx, &m); err != nil {
+		return errx.Wrap(err, "message", "failed to store domain event", "type", eventType).
+			WithCode(errx.Internal)
+	}
+	return nil
+}
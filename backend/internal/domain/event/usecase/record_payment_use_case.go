@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	urepository "github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrRecordPaymentNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrRecordPaymentParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrRecordPaymentForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrRecordPaymentAmountNotPositive = cmodel.NewLocalizableError(
+		errx.NewSentinel("payment amount must be positive", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorPaymentAmountPositive())
+	ErrRecordPaymentMethodNotAccepted = cmodel.NewLocalizableError(
+		errx.NewSentinel("payment method not accepted by the organizer", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorPaymentMethodNotAccepted())
+	ErrRecordPaymentWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant is waitlisted", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+)
+
+type RecordPaymentInput struct {
+	EventID       string
+	ParticipantID string
+	Amount        int
+	// PaymentMethod is the type of the organizer's payment method the money came through,
+	// empty when it was paid outside of them, e.g. in cash.
+	PaymentMethod string
+	// PaidAt defaults to now.
+	PaidAt *time.Time
+	Note   string
+}
+
+type RecordPaymentOutput struct {
+	Participant model.EventParticipant
+	Payment     model.EventParticipantPayment
+}
+
+type RecordPayment interface {
+	Do(ctx context.Context, input RecordPaymentInput) (RecordPaymentOutput, error)
+}
+
+type recordPayment struct {
+	_                 RecordPayment                           `inject:"returns"`
+	_                 *di.Infra                               `inject:"param"`
+	writer            *database.Writer                        `inject:""`
+	eventRepo         repository.Event                        `inject:""`
+	participantRepo   repository.EventParticipant             `inject:""`
+	paymentRepo       repository.EventParticipantPayment      `inject:""`
+	statusRepo        repository.EventParticipantStatusChange `inject:""`
+	paymentMethodRepo urepository.UserPaymentMethod           `inject:""`
+	outboxRepo        orepository.OutboxMessage               `inject:""`
+}
+
+// Do adds a payment the organizer received to the participant's ledger, e.g. cash handed over at the event,
+// and moves the participant to partially paid or confirmed as their balance dictates.
+func (uc *recordPayment) Do(ctx context.Context, input RecordPaymentInput) (RecordPaymentOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.Amount <= 0 {
+		return RecordPaymentOutput{}, errx.Wrap(ErrRecordPaymentAmountNotPositive).
+			WithFieldViolation("amount", ErrRecordPaymentAmountNotPositive.LocalizeContext(ctx))
+	}
+	paidAt := time.Now()
+	if input.PaidAt != nil {
+		paidAt = *input.PaidAt
+	}
+
+	var out RecordPaymentOutput
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// payments recorded at the same time must both count toward the balance
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrRecordPaymentNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, input.EventID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if ev.UserID != userID {
+			return ErrRecordPaymentForbidden
+		}
+
+		participant, err := uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrRecordPaymentParticipantNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if participant.EventID != ev.ID {
+			return ErrRecordPaymentParticipantNotFound
+		}
+		if participant.IsWaitlisted() {
+			return ErrRecordPaymentWaitlisted
+		}
+
+		if input.PaymentMethod != "" {
+			methods, err := uc.paymentMethodRepo.WithTx(tx).ListByUserID(ctx, ev.UserID)
+			if err != nil {
+				return errx.Wrap(err, "message", "failed to list payment methods", "user_id", ev.UserID).
+					WithCode(errx.Internal)
+			}
+			if !slices.ContainsFunc(methods, func(m umodel.UserPaymentMethod) bool {
+				return m.Type == input.PaymentMethod
+			}) {
+				return errx.Wrap(ErrRecordPaymentMethodNotAccepted, "payment_method", input.PaymentMethod).
+					WithFieldViolation("payment_method_type", ErrRecordPaymentMethodNotAccepted.LocalizeContext(ctx))
+			}
+		}
+
+		payment := model.NewParticipantPayment(participant, input.Amount, input.PaymentMethod, input.Note, &userID, paidAt)
+		if err := uc.paymentRepo.WithTx(tx).Create(ctx, &payment); err != nil {
+			return errx.Wrap(err, "message", "failed to record payment", "participant_id", participant.ID).
+				WithCode(errx.Internal)
+		}
+
+		from := participant.Status
+		moved := participant.ApplyPayment(payment)
+		if err := uc.participantRepo.WithTx(tx).Update(ctx, &participant); err != nil {
+			return errx.Wrap(err, "message", "failed to update participant", "id", participant.ID).
+				WithCode(errx.Internal)
+		}
+		out = RecordPaymentOutput{Participant: participant, Payment: payment}
+		if !moved {
+			return nil
+		}
+
+		change := model.NewParticipantStatusChange(
+			participant, &from, model.ParticipantStatusActorOrganizer, &userID, "",
+		)
+		if err := uc.statusRepo.WithTx(tx).Create(ctx, &change); err != nil {
+			return errx.Wrap(err, "message", "failed to record participant status change", "id", participant.ID).
+				WithCode(errx.Internal)
+		}
+		if participant.Status == model.ParticipantStatusConfirmed {
+			return publishDomainEvent(ctx, uc.outboxRepo.WithTx(tx), model.DomainEventPaymentConfirmed, model.PaymentConfirmed{
+				EventID:       participant.EventID,
+				ParticipantID: participant.ID,
+			})
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return RecordPaymentOutput{}, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	ufixture "github.com/mickamy/sampay/internal/domain/user/fixture"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/lib/converter"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestRecordPayment_Do(t *testing.T) {
+	t.Parallel()
+
+	// seed creates an event with a participant who owes 5000.
+	seed := func(t *testing.T, infra *di.Infra, userID string) (model.Event, model.EventParticipant) {
+		t.Helper()
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = userID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Amount = 5000
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+		return ev, p
+	}
+
+	t.Run("partial payment in cash", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, p := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewRecordPayment(infra)
+		out, err := sut.Do(ctx, usecase.RecordPaymentInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Amount:        3000,
+			Note:          "at the venue",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, out.Participant.Status)
+		assert.Equal(t, 3000, out.Participant.PaidAmount)
+		assert.Equal(t, 2000, out.Participant.Outstanding())
+		assert.Nil(t, out.Payment.PaymentMethod)
+		assert.Equal(t, &endUser.UserID, out.Payment.RecordedByUserID)
+
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", p.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3000, persisted.PaidAmount)
+		changes := statusChanges(t, infra, p.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, changes[0].ToStatus)
+		assert.Empty(t, domainEvents[model.PaymentConfirmed](t, infra, model.DomainEventPaymentConfirmed))
+	})
+
+	t.Run("the rest confirms the participant", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, p := seed(t, infra, endUser.UserID)
+		pm := ufixture.UserPaymentMethod(func(m *umodel.UserPaymentMethod) {
+			m.UserID = endUser.UserID
+			m.Type = converter.PaymentMethodTypePayPay
+		})
+		require.NoError(t, uquery.UserPaymentMethods(infra.WriterDB).Create(t.Context(), &pm))
+
+		sut := usecase.NewRecordPayment(infra)
+		_, err := sut.Do(ctx, usecase.RecordPaymentInput{EventID: ev.ID, ParticipantID: p.ID, Amount: 3000})
+		require.NoError(t, err)
+		out, err := sut.Do(ctx, usecase.RecordPaymentInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Amount:        2000,
+			PaymentMethod: converter.PaymentMethodTypePayPay,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusConfirmed, out.Participant.Status)
+		assert.Equal(t, 5000, out.Participant.PaidAmount)
+		require.NotNil(t, out.Payment.PaymentMethod)
+		assert.Equal(t, converter.PaymentMethodTypePayPay, *out.Payment.PaymentMethod)
+		assert.Equal(t,
+			[]model.PaymentConfirmed{{EventID: ev.ID, ParticipantID: p.ID}},
+			domainEvents[model.PaymentConfirmed](t, infra, model.DomainEventPaymentConfirmed),
+		)
+	})
+
+	t.Run("payment method the organizer does not accept", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, p := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewRecordPayment(infra)
+		_, err := sut.Do(ctx, usecase.RecordPaymentInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Amount:        3000,
+			PaymentMethod: converter.PaymentMethodTypeKyash,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrRecordPaymentMethodNotAccepted)
+	})
+
+	t.Run("amount not positive", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, p := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewRecordPayment(infra)
+		_, err := sut.Do(ctx, usecase.RecordPaymentInput{EventID: ev.ID, ParticipantID: p.ID, Amount: 0})
+
+		require.ErrorIs(t, err, usecase.ErrRecordPaymentAmountNotPositive)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, p := seed(t, infra, owner.UserID)
+
+		sut := usecase.NewRecordPayment(infra)
+		_, err := sut.Do(ctx, usecase.RecordPaymentInput{EventID: ev.ID, ParticipantID: p.ID, Amount: 1000})
+
+		require.ErrorIs(t, err, usecase.ErrRecordPaymentForbidden)
+	})
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrRecordRefundNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrRecordRefundParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrRecordRefundForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrRecordRefundAmountNotPositive = cmodel.NewLocalizableError(
+		errx.NewSentinel("refund amount must be positive", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorPaymentAmountPositive())
+	ErrRecordRefundExceedsRefundable = cmodel.NewLocalizableError(
+		errx.NewSentinel("refund exceeds what is owed back", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorRefundExceedsRefundable())
+)
+
+type RecordRefundInput struct {
+	EventID       string
+	ParticipantID string
+	// Amount is what the organizer paid back, at most what the participant paid beyond what they owe.
+	Amount int
+	// RefundedAt defaults to now.
+	RefundedAt *time.Time
+	Note       string
+}
+
+type RecordRefundOutput struct {
+	Participant model.EventParticipant
+	// Payment is the ledger entry of the refund, with a negative Amount.
+	Payment model.EventParticipantPayment
+}
+
+type RecordRefund interface {
+	Do(ctx context.Context, input RecordRefundInput) (RecordRefundOutput, error)
+}
+
+type recordRefund struct {
+	_               RecordRefund                       `inject:"returns"`
+	_               *di.Infra                          `inject:"param"`
+	writer          *database.Writer                   `inject:""`
+	eventRepo       repository.Event                   `inject:""`
+	participantRepo repository.EventParticipant        `inject:""`
+	paymentRepo     repository.EventParticipantPayment `inject:""`
+}
+
+// Do records money the organizer paid back to a participant, e.g. what their deposit covered beyond the
+// actual cost, which takes it off the refunds ListRefunds has the organizer still to make.
+// It goes into the payments ledger as a negative entry, so PaidAmount stays what the participant net paid.
+func (uc *recordRefund) Do(ctx context.Context, input RecordRefundInput) (RecordRefundOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.Amount <= 0 {
+		return RecordRefundOutput{}, errx.Wrap(ErrRecordRefundAmountNotPositive).
+			WithFieldViolation("amount", ErrRecordRefundAmountNotPositive.LocalizeContext(ctx))
+	}
+	refundedAt := time.Now()
+	if input.RefundedAt != nil {
+		refundedAt = *input.RefundedAt
+	}
+
+	var out RecordRefundOutput
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// refunds recorded at the same time must not both take what is owed back
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrRecordRefundNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, input.EventID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if ev.UserID != userID {
+			return ErrRecordRefundForbidden
+		}
+
+		participant, err := uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrRecordRefundParticipantNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if participant.EventID != ev.ID {
+			return ErrRecordRefundParticipantNotFound
+		}
+		if refundable := participant.Refundable(); input.Amount > refundable {
+			return errx.Wrap(ErrRecordRefundExceedsRefundable, "amount", input.Amount, "refundable", refundable).
+				WithFieldViolation("amount", ErrRecordRefundExceedsRefundable.LocalizeContext(ctx))
+		}
+
+		payment := model.NewParticipantPayment(participant, -input.Amount, "", input.Note, &userID, refundedAt)
+		if err := uc.paymentRepo.WithTx(tx).Create(ctx, &payment); err != nil {
+			return errx.Wrap(err, "message", "failed to record refund", "participant_id", participant.ID).
+				WithCode(errx.Internal)
+		}
+
+		// the participant still paid at least what they owe, so their status stands
+		participant.PaidAmount += payment.Amount
+		if err := uc.participantRepo.WithTx(tx).Update(ctx, &participant); err != nil {
+			return errx.Wrap(err, "message", "failed to update participant", "id", participant.ID).
+				WithCode(errx.Internal)
+		}
+		out = RecordRefundOutput{Participant: participant, Payment: payment}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return RecordRefundOutput{}, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,105 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestRecordRefund_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		overpay(t, infra, &participants[0], 1000)
+
+		sut := usecase.NewRecordRefund(infra)
+		out, err := sut.Do(ctx, usecase.RecordRefundInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Amount:        1000,
+			Note:          "bank transfer",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, -1000, out.Payment.Amount)
+		assert.Equal(t, 3000, out.Participant.PaidAmount)
+		assert.Zero(t, out.Participant.Refundable())
+		assert.Equal(t, model.ParticipantStatusConfirmed, out.Participant.Status)
+
+		payments, err := query.EventParticipantPayments(infra.ReaderDB).
+			Where("participant_id = ?", participants[0].ID).
+			All(t.Context())
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		assert.Equal(t, -1000, payments[0].Amount)
+	})
+
+	t.Run("exceeds what is owed back", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		overpay(t, infra, &participants[0], 1000)
+
+		sut := usecase.NewRecordRefund(infra)
+		_, err := sut.Do(ctx, usecase.RecordRefundInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Amount:        1001,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrRecordRefundExceedsRefundable)
+	})
+
+	t.Run("non-positive amount", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+
+		sut := usecase.NewRecordRefund(infra)
+		_, err := sut.Do(ctx, usecase.RecordRefundInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrRecordRefundAmountNotPositive)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ev, participants := seedRoster(t, infra, owner.UserID, 3)
+		overpay(t, infra, &participants[0], 1000)
+
+		sut := usecase.NewRecordRefund(infra)
+		_, err := sut.Do(ctx, usecase.RecordRefundInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Amount:        1000,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrRecordRefundForbidden)
+	})
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrReissueParticipantTokenNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrReissueParticipantTokenForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrReissueParticipantTokenEventMismatch = cmodel.NewLocalizableError(
+		errx.NewSentinel("event_id mismatch", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorEventMismatch())
+)
+
+type ReissueParticipantTokenInput struct {
+	EventID       string
+	ParticipantID string
+}
+
+type ReissueParticipantTokenOutput struct {
+	Participant model.EventParticipant
+	// Token replaces whatever token the participant had, which stops working.
+	Token string
+}
+
+type ReissueParticipantToken interface {
+	Do(ctx context.Context, input ReissueParticipantTokenInput) (ReissueParticipantTokenOutput, error)
+}
+
+type reissueParticipantToken struct {
+	_               ReissueParticipantToken     `inject:"returns"`
+	_               *di.Infra                   `inject:"param"`
+	writer          *database.Writer            `inject:""`
+	eventRepo       repository.Event            `inject:""`
+	participantRepo repository.EventParticipant `inject:""`
+}
+
+func (uc *reissueParticipantToken) Do(
+	ctx context.Context, input ReissueParticipantTokenInput,
+) (ReissueParticipantTokenOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	var participant model.EventParticipant
+	var token string
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		var err error
+		participant, err = uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrReissueParticipantTokenNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+
+		if participant.EventID != input.EventID {
+			return ErrReissueParticipantTokenEventMismatch
+		}
+
+		// edits to the roster save every participant as they were read under this lock,
+		// which would bring back the token this replaces
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, participant.EventID); err != nil {
+			return errx.Wrap(err, "message", "failed to lock event", "id", participant.EventID).
+				WithCode(errx.Internal)
+		}
+		participant, err = uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrReissueParticipantTokenNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+
+		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, participant.EventID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", participant.EventID).
+				WithCode(errx.Internal)
+		}
+
+		if ev.UserID != userID {
+			return ErrReissueParticipantTokenForbidden
+		}
+
+		token, err = participant.IssueToken()
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to issue participant token").
+				WithCode(errx.Internal)
+		}
+		if err := uc.participantRepo.WithTx(tx).Update(ctx, &participant); err != nil {
+			return errx.Wrap(err, "message", "failed to update participant token", "id", participant.ID).
+				WithCode(errx.Internal)
+		}
+
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return ReissueParticipantTokenOutput{}, err
+	}
+
+	return ReissueParticipantTokenOutput{Participant: participant, Token: token}, nil
+}
@@ -0,0 +1,118 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestReissueParticipantToken_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		oldToken, err := p.IssueToken()
+		require.NoError(t, err)
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewReissueParticipantToken(infra)
+		out, err := sut.Do(ctx, usecase.ReissueParticipantTokenInput{EventID: ev.ID, ParticipantID: p.ID})
+
+		require.NoError(t, err)
+		require.NotEmpty(t, out.Token)
+
+		got, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", p.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.True(t, got.VerifyToken(out.Token))
+		assert.False(t, got.VerifyToken(oldToken))
+	})
+
+	t.Run("participant without token", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewReissueParticipantToken(infra)
+		out, err := sut.Do(ctx, usecase.ReissueParticipantTokenInput{EventID: ev.ID, ParticipantID: p.ID})
+
+		require.NoError(t, err)
+		assert.True(t, out.Participant.VerifyToken(out.Token))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = owner.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewReissueParticipantToken(infra)
+		_, err := sut.Do(ctx, usecase.ReissueParticipantTokenInput{EventID: ev.ID, ParticipantID: p.ID})
+
+		require.ErrorIs(t, err, usecase.ErrReissueParticipantTokenForbidden)
+	})
+
+	t.Run("event mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewReissueParticipantToken(infra)
+		_, err := sut.Do(ctx, usecase.ReissueParticipantTokenInput{EventID: "other", ParticipantID: p.ID})
+
+		require.ErrorIs(t, err, usecase.ErrReissueParticipantTokenEventMismatch)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		sut := usecase.NewReissueParticipantToken(infra)
+		_, err := sut.Do(ctx, usecase.ReissueParticipantTokenInput{EventID: "any", ParticipantID: "nonexistent"})
+
+		require.ErrorIs(t, err, usecase.ErrReissueParticipantTokenNotFound)
+	})
+}
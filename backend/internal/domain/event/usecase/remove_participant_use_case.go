@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrRemoveParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrRemoveParticipantParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrRemoveParticipantForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrRemoveParticipantLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorLocked())
+	ErrRemoveParticipantHasExpenses = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant has expenses", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorParticipantHasExpenses())
+)
+
+type RemoveParticipantInput struct {
+	EventID       string
+	ParticipantID string
+}
+
+type RemoveParticipantOutput struct {
+	Event model.Event
+}
+
+type RemoveParticipant interface {
+	Do(ctx context.Context, input RemoveParticipantInput) (RemoveParticipantOutput, error)
+}
+
+type removeParticipant struct {
+	_               RemoveParticipant                       `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	expenseRepo     repository.EventExpense                 `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+}
+
+// Do removes the participant from the roster and hands their spot to the waitlist.
+// Participants involved in an expense are kept, as removing them would take the expense with them.
+func (uc *removeParticipant) Do(
+	ctx context.Context, input RemoveParticipantInput,
+) (RemoveParticipantOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	var ev model.Event
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// the freed spot goes to the waitlist, so joins must not take it meanwhile
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrRemoveParticipantNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		if ev.UserID != userID {
+			return ErrRemoveParticipantForbidden
+		}
+		if ev.AmountsLocked() {
+			return ErrRemoveParticipantLocked
+		}
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrRemoveParticipantParticipantNotFound
+		}
+
+		hasExpenses, err := uc.expenseRepo.WithTx(tx).ExistsForParticipant(ctx, input.ParticipantID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to check participant expenses", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		if hasExpenses {
+			return ErrRemoveParticipantHasExpenses
+		}
+
+		if err := uc.participantRepo.WithTx(tx).Delete(ctx, input.ParticipantID); err != nil {
+			return errx.Wrap(err, "message", "failed to delete participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+		ev.Participants = slices.Delete(ev.Participants, idx, idx+1)
+
+		promoted := ev.PromoteWaitlisted()
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		return recordPromotions(ctx, uc.statusRepo.WithTx(tx), promoted)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return RemoveParticipantOutput{}, err
+	}
+
+	return RemoveParticipantOutput{Event: ev}, nil
+}
@@ -0,0 +1,99 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestRemoveParticipant_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		waiting := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusWaitlisted
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &waiting))
+
+		sut := usecase.NewRemoveParticipant(infra)
+		out, err := sut.Do(ctx, usecase.RemoveParticipantInput{EventID: ev.ID, ParticipantID: participants[0].ID})
+
+		require.NoError(t, err)
+		assert.Len(t, out.Event.Participants, 3)
+		exists, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).Exists(t.Context())
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		promoted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", waiting.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, promoted.Status)
+		assert.Equal(t, 3000, promoted.Amount)
+	})
+
+	t.Run("has expenses", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2)
+		expense := model.NewEventExpense(ev.ID, participants[1].ID, "taxi", 2000, []string{participants[0].ID})
+		require.NoError(t, repository.NewEventExpense(infra.WriterDB.DB).Create(t.Context(), &expense))
+
+		sut := usecase.NewRemoveParticipant(infra)
+		_, err := sut.Do(ctx, usecase.RemoveParticipantInput{EventID: ev.ID, ParticipantID: participants[0].ID})
+
+		require.ErrorIs(t, err, usecase.ErrRemoveParticipantHasExpenses)
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2)
+		participants[1].Status = model.ParticipantStatusConfirmed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[1]))
+
+		sut := usecase.NewRemoveParticipant(infra)
+		_, err := sut.Do(ctx, usecase.RemoveParticipantInput{EventID: ev.ID, ParticipantID: participants[0].ID})
+
+		require.ErrorIs(t, err, usecase.ErrRemoveParticipantLocked)
+	})
+
+	t.Run("participant not found", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 1)
+
+		sut := usecase.NewRemoveParticipant(infra)
+		_, err := sut.Do(ctx, usecase.RemoveParticipantInput{EventID: ev.ID, ParticipantID: "nonexistent"})
+
+		require.ErrorIs(t, err, usecase.ErrRemoveParticipantParticipantNotFound)
+	})
+}
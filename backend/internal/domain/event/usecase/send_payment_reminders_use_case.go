@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	urepository "github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/logger"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+	"github.com/mickamy/sampay/internal/misc/i18n"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+type SendPaymentRemindersInput struct {
+	Now time.Time
+	// Offsets are how long after HeldAt each reminder goes out, e.g. a day, three days and a week.
+	Offsets []time.Duration
+}
+
+type SendPaymentRemindersOutput struct {
+	// Reminded are the events reminders went out for.
+	Reminded []model.Event
+}
+
+type SendPaymentReminders interface {
+	Do(ctx context.Context, input SendPaymentRemindersInput) (SendPaymentRemindersOutput, error)
+}
+
+type sendPaymentReminders struct {
+	_            SendPaymentReminders            `inject:"returns"`
+	_            *di.Infra                       `inject:"param"`
+	reader       *database.Reader                `inject:""`
+	writer       *database.Writer                `inject:""`
+	eventRepo    repository.Event                `inject:""`
+	reminderRepo repository.EventReminder        `inject:""`
+	settingRepo  urepository.NotificationSetting `inject:""`
+	notifier     notifier.Notifier               `inject:""`
+}
+
+func (uc *sendPaymentReminders) Do(
+	ctx context.Context, input SendPaymentRemindersInput,
+) (SendPaymentRemindersOutput, error) {
+	if len(input.Offsets) == 0 {
+		return SendPaymentRemindersOutput{}, nil
+	}
+	offsets := slices.Sorted(slices.Values(input.Offsets))
+
+	var events []model.Event
+	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
+		var err error
+		events, err = uc.eventRepo.WithTx(tx).ListAwaitingPayment(
+			ctx, input.Now.Add(-offsets[0]), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to list events awaiting payment").
+				WithCode(errx.Internal)
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return SendPaymentRemindersOutput{}, err
+	}
+
+	var out SendPaymentRemindersOutput
+	var errs []error
+	for _, ev := range events {
+		step := model.DueReminderStep(ev.HeldAt, input.Now, offsets)
+		recorded, err := uc.record(ctx, ev.ID, step)
+		if err != nil {
+			return out, err
+		}
+		if !recorded {
+			continue
+		}
+
+		// the step is already recorded, so a failed delivery is not retried; the next step will go out as usual
+		if err := uc.notify(ctx, ev); err != nil {
+			logger.Error(ctx, "failed to send payment reminder", "event_id", ev.ID, "step", step, "err", err)
+			errs = append(errs, err)
+		}
+		out.Reminded = append(out.Reminded, ev)
+	}
+
+	if len(errs) > 0 {
+		return out, errx.Wrap(errors.Join(errs...), "message", "failed to send some payment reminders").
+			WithCode(errx.Internal)
+	}
+	return out, nil
+}
+
+// record claims the step for the event, returning false when it is not newer than what was sent.
+// Steps missed while the worker was down are skipped in favor of the latest one.
+func (uc *sendPaymentReminders) record(ctx context.Context, eventID string, step int) (bool, error) {
+	var recorded bool
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		latest, err := uc.reminderRepo.WithTx(tx).LatestStep(ctx, eventID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get latest reminder step", "event_id", eventID).
+				WithCode(errx.Internal)
+		}
+		if step <= latest {
+			return nil
+		}
+
+		recorded, err = uc.reminderRepo.WithTx(tx).Record(ctx, &model.EventReminder{
+			ID:      ulid.New(),
+			EventID: eventID,
+			Step:    step,
+		})
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to record reminder", "event_id", eventID, "step", step).
+				WithCode(errx.Internal)
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return false, err
+	}
+	return recorded, nil
+}
+
+// notify sends the organizer a digest of who has not paid, and nudges unpaid participants linked to an account.
+func (uc *sendPaymentReminders) notify(ctx context.Context, ev model.Event) error {
+	lang := i18n.DefaultLanguage
+
+	var unpaid, claimed []string
+	var nudges []notifier.Notification
+	for _, p := range ev.Participants {
+		switch p.Status {
+		case model.ParticipantStatusUnpaid, model.ParticipantStatusPartiallyPaid:
+			unpaid = append(unpaid, p.Name)
+			if p.EndUserID != nil && *p.EndUserID != ev.UserID {
+				amount := i18n.FormatAmount(lang, ev.PaymentCurrency(), ev.SettlementOutstanding(p))
+				nudges = append(nudges, notifier.Notification{
+					EndUserID: *p.EndUserID,
+					Title:     i18n.Localize(lang, messages.MessagingPaymentReminderNudgeTitle(ev.Title)),
+					Body:      i18n.Localize(lang, messages.MessagingPaymentReminderNudge(ev.Title, amount)),
+				})
+			}
+		case model.ParticipantStatusClaimed:
+			claimed = append(claimed, p.Name)
+		}
+	}
+
+	separator := i18n.Localize(lang, messages.MessagingListSeparator())
+	var lines []string
+	if len(unpaid) > 0 {
+		lines = append(lines, i18n.Localize(lang,
+			messages.MessagingPaymentReminderUnpaid(len(unpaid), strings.Join(unpaid, separator)),
+		))
+	}
+	if len(claimed) > 0 {
+		lines = append(lines, i18n.Localize(lang,
+			messages.MessagingPaymentReminderClaimed(len(claimed), strings.Join(claimed, separator)),
+		))
+	}
+
+	notifications := append([]notifier.Notification{{
+		EndUserID: ev.UserID,
+		Title:     i18n.Localize(lang, messages.MessagingPaymentReminderDigestTitle(ev.Title)),
+		Body:      strings.Join(lines, "\n"),
+	}}, nudges...)
+
+	var errs []error
+	for _, n := range notifications {
+		if err := uc.address(ctx, &n); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := uc.notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// address fills in the channels the recipient wants to be notified through.
+func (uc *sendPaymentReminders) address(ctx context.Context, n *notifier.Notification) error {
+	return uc.reader.Transaction(ctx, func(tx *database.DB) error { //nolint:wrapcheck // wrapped inside
+		r, err := uc.settingRepo.WithTx(tx).GetRecipient(ctx, n.EndUserID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get notification recipient", "user_id", n.EndUserID).
+				WithCode(errx.Internal)
+		}
+		n.LINEUserID = r.LINEUserID
+		n.Email = r.Email
+		return nil
+	})
+}
@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestSendPaymentReminders_Do(t *testing.T) {
+	t.Parallel()
+
+	offsets := []time.Duration{24 * time.Hour, 72 * time.Hour}
+
+	setup := func(t *testing.T, heldAt time.Time) (*di.Infra, *notifier.Recorder, model.Event) {
+		t.Helper()
+
+		recorder := notifier.NewRecorder()
+		infra := newInfra(t, func(infra *di.Infra) { infra.Notifier = recorder })
+		organizer := tseed.EndUser(t, infra.WriterDB)
+
+		ev := fixture.Event(func(e *model.Event) {
+			e.UserID = organizer.UserID
+			e.HeldAt = heldAt
+		})
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		return infra, recorder, ev
+	}
+
+	t.Run("sends a digest to the organizer once per step", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		infra, recorder, ev := setup(t, now.Add(-25*time.Hour))
+		unpaid := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusUnpaid
+		})
+		paid := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusConfirmed
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).
+			CreateAll(t.Context(), []*model.EventParticipant{&unpaid, &paid}))
+
+		sut := usecase.NewSendPaymentReminders(infra)
+		out, err := sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now, Offsets: offsets})
+		require.NoError(t, err)
+		require.Len(t, out.Reminded, 1)
+		assert.Equal(t, ev.ID, out.Reminded[0].ID)
+
+		notifications := recorder.Notifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, ev.UserID, notifications[0].EndUserID)
+		assert.Contains(t, notifications[0].Body, unpaid.Name)
+		assert.NotContains(t, notifications[0].Body, paid.Name)
+
+		// the same step is not sent again
+		out, err = sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now.Add(time.Hour), Offsets: offsets})
+		require.NoError(t, err)
+		assert.Empty(t, out.Reminded)
+		assert.Len(t, recorder.Notifications(), 1)
+
+		// the next step is
+		out, err = sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now.Add(48 * time.Hour), Offsets: offsets})
+		require.NoError(t, err)
+		assert.Len(t, out.Reminded, 1)
+		assert.Len(t, recorder.Notifications(), 2)
+	})
+
+	t.Run("nudges unpaid participants linked to an account", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		infra, recorder, ev := setup(t, now.Add(-25*time.Hour))
+		member := tseed.EndUser(t, infra.WriterDB)
+		linked := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.EndUserID = &member.UserID
+			p.Status = model.ParticipantStatusUnpaid
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &linked))
+
+		sut := usecase.NewSendPaymentReminders(infra)
+		_, err := sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now, Offsets: offsets})
+		require.NoError(t, err)
+
+		recipients := make([]string, 0, 2)
+		for _, n := range recorder.Notifications() {
+			recipients = append(recipients, n.EndUserID)
+		}
+		assert.ElementsMatch(t, []string{ev.UserID, member.UserID}, recipients)
+	})
+
+	t.Run("addresses the organizer through their notification setting", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		infra, recorder, ev := setup(t, now.Add(-25*time.Hour))
+		setting := umodel.NotificationSetting{
+			EndUserID:    ev.UserID,
+			EmailEnabled: true,
+			Email:        ptr.Of("organizer@example.com"),
+		}
+		require.NoError(t, uquery.NotificationSettings(infra.WriterDB).Create(t.Context(), &setting))
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusUnpaid
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewSendPaymentReminders(infra)
+		_, err := sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now, Offsets: offsets})
+		require.NoError(t, err)
+
+		notifications := recorder.Notifications()
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "organizer@example.com", notifications[0].Email)
+	})
+
+	t.Run("skips events not yet due", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		infra, recorder, ev := setup(t, now.Add(-time.Hour))
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusUnpaid
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewSendPaymentReminders(infra)
+		out, err := sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now, Offsets: offsets})
+		require.NoError(t, err)
+		assert.Empty(t, out.Reminded)
+		assert.Empty(t, recorder.Notifications())
+	})
+
+	t.Run("skips events everyone has paid for", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		infra, recorder, ev := setup(t, now.Add(-25*time.Hour))
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusConfirmed
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewSendPaymentReminders(infra)
+		out, err := sut.Do(t.Context(), usecase.SendPaymentRemindersInput{Now: now, Offsets: offsets})
+		require.NoError(t, err)
+		assert.Empty(t, out.Reminded)
+		assert.Empty(t, recorder.Notifications())
+	})
+}
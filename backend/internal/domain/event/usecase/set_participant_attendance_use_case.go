@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrSetParticipantAttendanceNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrSetParticipantAttendanceParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrSetParticipantAttendanceForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrSetParticipantAttendanceWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("waitlisted participants do not attend", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+	ErrSetParticipantAttendanceInvalid = cmodel.NewLocalizableError(
+		errx.NewSentinel("left_at must be after arrived_at", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorAttendanceInvalid())
+)
+
+type SetParticipantAttendanceInput struct {
+	EventID       string
+	ParticipantID string
+	// ArrivedAt is nil when the participant was there from the start.
+	ArrivedAt *time.Time
+	// LeftAt is nil when the participant stayed until the end.
+	LeftAt *time.Time
+	// Attendance overrides the share worked out from ArrivedAt and LeftAt, e.g. 0.5 for half the event.
+	Attendance *model.Attendance
+	// AdjustmentNote is kept on the adjustments made for participants whose amount is locked.
+	AdjustmentNote string
+}
+
+type SetParticipantAttendanceOutput struct {
+	Event model.Event
+	// Adjustments are the additional charges and refunds the change made.
+	Adjustments []model.EventParticipantAdjustment
+}
+
+type SetParticipantAttendance interface {
+	Do(ctx context.Context, input SetParticipantAttendanceInput) (SetParticipantAttendanceOutput, error)
+}
+
+type setParticipantAttendance struct {
+	_               SetParticipantAttendance                `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	adjustmentRepo  repository.EventParticipantAdjustment   `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+	outboxRepo      orepository.OutboxMessage               `inject:""`
+}
+
+// Do records when a participant arrived and left, or how much of the event they attended, on the organizer's
+// behalf. Under an attendance split it recalculates what everyone owes; as late arrivals are usually only
+// known on the night, what changes for participants whose amount is locked is recorded as adjustments.
+func (uc *setParticipantAttendance) Do(
+	ctx context.Context, input SetParticipantAttendanceInput,
+) (SetParticipantAttendanceOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.ArrivedAt != nil && input.LeftAt != nil && !input.LeftAt.After(*input.ArrivedAt) {
+		return SetParticipantAttendanceOutput{}, errx.Wrap(ErrSetParticipantAttendanceInvalid,
+			"arrived_at", *input.ArrivedAt, "left_at", *input.LeftAt,
+		).WithFieldViolation("attendance", ErrSetParticipantAttendanceInvalid.LocalizeContext(ctx))
+	}
+
+	var ev model.Event
+	var adjustments []model.EventParticipantAdjustment
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// the attendance moves everyone's share, so joins must wait
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrSetParticipantAttendanceNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if ev.UserID != userID {
+			return ErrSetParticipantAttendanceForbidden
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrSetParticipantAttendanceParticipantNotFound
+		}
+		if ev.Participants[idx].IsWaitlisted() {
+			return ErrSetParticipantAttendanceWaitlisted
+		}
+
+		locked := ev.LockedAmounts()
+		ev.Participants[idx].ArrivedAt = input.ArrivedAt
+		ev.Participants[idx].LeftAt = input.LeftAt
+		ev.Participants[idx].Attendance = input.Attendance
+
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+		adjustments = ev.AdjustLockedAmounts(locked, input.AdjustmentNote, &userID)
+		if err := recordAdjustments(
+			ctx, &ev, adjustments, model.ParticipantStatusActorOrganizer, &userID,
+			uc.adjustmentRepo.WithTx(tx), uc.statusRepo.WithTx(tx), uc.outboxRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+
+		return saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return SetParticipantAttendanceOutput{}, err
+	}
+
+	return SetParticipantAttendanceOutput{Event: ev, Adjustments: adjustments}, nil
+}
@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestSetParticipantAttendance_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		splitByAttendance(t, infra, &ev)
+
+		sut := usecase.NewSetParticipantAttendance(infra)
+		out, err := sut.Do(ctx, usecase.SetParticipantAttendanceInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[2].ID,
+			ArrivedAt:     ptr.Of(ev.HeldAt.Add(2 * time.Hour)),
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, out.Adjustments)
+		amounts := map[string]int{}
+		for _, p := range out.Event.Participants {
+			amounts[p.ID] = p.Amount
+		}
+		assert.Equal(t, map[string]int{
+			participants[0].ID: 3600, participants[1].ID: 3600, participants[2].ID: 1800,
+		}, amounts)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[2].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 1800, persisted.Amount)
+		require.NotNil(t, persisted.ArrivedAt)
+	})
+
+	t.Run("attendance overrides times", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		splitByAttendance(t, infra, &ev)
+
+		sut := usecase.NewSetParticipantAttendance(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantAttendanceInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[2].ID,
+			ArrivedAt:     ptr.Of(ev.HeldAt.Add(2 * time.Hour)),
+			Attendance:    ptr.Of(model.Attendance(0)),
+		})
+
+		require.NoError(t, err)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[2].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, persisted.Amount)
+		require.NotNil(t, persisted.Attendance)
+		assert.Equal(t, model.Attendance(0), *persisted.Attendance)
+	})
+
+	t.Run("adjusts a participant who already paid", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		splitByAttendance(t, infra, &ev)
+		participants[0].Status = model.ParticipantStatusConfirmed
+		participants[0].PaidAmount = 3000
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewSetParticipantAttendance(infra)
+		out, err := sut.Do(ctx, usecase.SetParticipantAttendanceInput{
+			EventID:        ev.ID,
+			ParticipantID:  participants[2].ID,
+			LeftAt:         ptr.Of(ev.HeldAt.Add(2 * time.Hour)),
+			AdjustmentNote: "left early",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Adjustments, 1)
+		assert.Equal(t, participants[0].ID, out.Adjustments[0].ParticipantID)
+		assert.Equal(t, 600, out.Adjustments[0].Amount)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3000, persisted.Amount)
+		assert.Equal(t, 600, persisted.AdjustmentAmount)
+	})
+
+	t.Run("left before arriving", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+
+		sut := usecase.NewSetParticipantAttendance(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantAttendanceInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			ArrivedAt:     ptr.Of(ev.HeldAt.Add(2 * time.Hour)),
+			LeftAt:        ptr.Of(ev.HeldAt.Add(time.Hour)),
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantAttendanceInvalid)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ev, participants := seedRoster(t, infra, owner.UserID, 3)
+
+		sut := usecase.NewSetParticipantAttendance(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantAttendanceInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Attendance:    ptr.Of(model.Attendance(500)),
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantAttendanceForbidden)
+	})
+}
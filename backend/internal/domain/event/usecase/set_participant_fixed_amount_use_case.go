@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrSetParticipantFixedAmountNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrSetParticipantFixedAmountParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrSetParticipantFixedAmountForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrSetParticipantFixedAmountLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorLocked())
+	ErrSetParticipantFixedAmountWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("waitlisted participants owe nothing", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+	ErrSetParticipantFixedAmountNegative = cmodel.NewLocalizableError(
+		errx.NewSentinel("fixed_amount must not be negative", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorFixedAmountNegative())
+	ErrSetParticipantFixedAmountExceedsTotal = cmodel.NewLocalizableError(
+		errx.NewSentinel("fixed amounts exceed total_amount", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorFixedAmountExceedsTotal())
+)
+
+type SetParticipantFixedAmountInput struct {
+	EventID       string
+	ParticipantID string
+	// FixedAmount pins the participant's amount; nil puts them back on their tier's share.
+	FixedAmount *int
+}
+
+type SetParticipantFixedAmountOutput struct {
+	Event model.Event
+}
+
+type SetParticipantFixedAmount interface {
+	Do(ctx context.Context, input SetParticipantFixedAmountInput) (SetParticipantFixedAmountOutput, error)
+}
+
+type setParticipantFixedAmount struct {
+	_               SetParticipantFixedAmount   `inject:"returns"`
+	_               *di.Infra                   `inject:"param"`
+	writer          *database.Writer            `inject:""`
+	eventRepo       repository.Event            `inject:""`
+	roundRepo       repository.EventRound       `inject:""`
+	tierRepo        repository.EventTier        `inject:""`
+	participantRepo repository.EventParticipant `inject:""`
+}
+
+func (uc *setParticipantFixedAmount) Do(
+	ctx context.Context, input SetParticipantFixedAmountInput,
+) (SetParticipantFixedAmountOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.FixedAmount != nil && *input.FixedAmount < 0 {
+		return SetParticipantFixedAmountOutput{}, errx.Wrap(ErrSetParticipantFixedAmountNegative).
+			WithFieldViolation("fixed_amount", ErrSetParticipantFixedAmountNegative.LocalizeContext(ctx))
+	}
+
+	var ev model.Event
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// every share depends on the pins, so joins must wait until they are recalculated
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrSetParticipantFixedAmountNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		if ev.UserID != userID {
+			return ErrSetParticipantFixedAmountForbidden
+		}
+
+		if ev.AmountsLocked() {
+			return ErrSetParticipantFixedAmountLocked
+		}
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrSetParticipantFixedAmountParticipantNotFound
+		}
+		if ev.Participants[idx].IsWaitlisted() {
+			return ErrSetParticipantFixedAmountWaitlisted
+		}
+
+		ev.Participants[idx].FixedAmount = input.FixedAmount
+		if ev.FixedTotal() > ev.TotalAmount {
+			return errx.Wrap(ErrSetParticipantFixedAmountExceedsTotal,
+				"fixed_total", ev.FixedTotal(), "total_amount", ev.TotalAmount,
+			).WithFieldViolation("fixed_amount", ErrSetParticipantFixedAmountExceedsTotal.LocalizeContext(ctx))
+		}
+
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		return saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return SetParticipantFixedAmountOutput{}, err
+	}
+
+	return SetParticipantFixedAmountOutput{Event: ev}, nil
+}
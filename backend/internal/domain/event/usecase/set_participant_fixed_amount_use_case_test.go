@@ -0,0 +1,173 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestSetParticipantFixedAmount_Do(t *testing.T) {
+	t.Parallel()
+
+	// seed creates a one-tier event of 10000 yen for 3 people, all of whom have joined.
+	seed := func(t *testing.T, infra *di.Infra, userID string) (model.Event, []model.EventParticipant) {
+		t.Helper()
+
+		ev := fixture.Event(func(e *model.Event) {
+			e.UserID = userID
+			e.TotalAmount = 10000
+			e.TierCount = 1
+		})
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		tier := fixture.EventTier(func(m *model.EventTier) {
+			m.EventID = ev.ID
+			m.Tier = 1
+			m.Count = 3
+			m.Amount = 3333
+		})
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+
+		participants := make([]model.EventParticipant, 3)
+		for i := range participants {
+			participants[i] = fixture.EventParticipant(func(p *model.EventParticipant) {
+				p.EventID = ev.ID
+				p.Amount = 3333
+			})
+			require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &participants[i]))
+		}
+		return ev, participants
+	}
+
+	t.Run("exempt a participant", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewSetParticipantFixedAmount(infra)
+		out, err := sut.Do(ctx, usecase.SetParticipantFixedAmountInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			FixedAmount:   ptr.Of(0),
+		})
+
+		require.NoError(t, err)
+		sum := 0
+		for _, p := range out.Event.Participants {
+			sum += p.Amount
+			if p.ID == participants[0].ID {
+				assert.Equal(t, 0, p.Amount)
+			} else {
+				assert.Equal(t, 5000, p.Amount)
+			}
+		}
+		assert.Equal(t, 10000, sum+out.Event.Remainder)
+
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, persisted.FixedAmount)
+		assert.Equal(t, 0, *persisted.FixedAmount)
+	})
+
+	t.Run("unpin a participant", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewSetParticipantFixedAmount(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantFixedAmountInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			FixedAmount:   ptr.Of(3000),
+		})
+		require.NoError(t, err)
+
+		out, err := sut.Do(ctx, usecase.SetParticipantFixedAmountInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+		})
+
+		require.NoError(t, err)
+		for _, p := range out.Event.Participants {
+			assert.Nil(t, p.FixedAmount)
+			assert.Equal(t, 3333, p.Amount)
+		}
+		assert.Equal(t, 1, out.Event.Remainder)
+	})
+
+	t.Run("exceeds total", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewSetParticipantFixedAmount(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantFixedAmountInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			FixedAmount:   ptr.Of(10001),
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantFixedAmountExceedsTotal)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seed(t, infra, endUser.UserID)
+
+		sut := usecase.NewSetParticipantFixedAmount(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantFixedAmountInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			FixedAmount:   ptr.Of(-1),
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantFixedAmountNegative)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seed(t, infra, owner.UserID)
+
+		sut := usecase.NewSetParticipantFixedAmount(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantFixedAmountInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			FixedAmount:   ptr.Of(0),
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantFixedAmountForbidden)
+	})
+}
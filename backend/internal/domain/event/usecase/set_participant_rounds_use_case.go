@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrSetParticipantRoundsNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrSetParticipantRoundsParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrSetParticipantRoundsForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrSetParticipantRoundsWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("waitlisted participants cannot join later rounds", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+	ErrSetParticipantRoundsRoundNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("round not found", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorRoundNotFound())
+	ErrSetParticipantRoundsRoundFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("round tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+)
+
+type SetParticipantRoundsInput struct {
+	EventID       string
+	ParticipantID string
+	// Rounds are the later rounds the participant takes part in; the ones left out are dropped.
+	Rounds []int
+	// AdjustmentNote is kept on the adjustments made for participants whose amount is locked.
+	AdjustmentNote string
+}
+
+type SetParticipantRoundsOutput struct {
+	Event model.Event
+	// Adjustments are the additional charges and refunds the change made.
+	Adjustments []model.EventParticipantAdjustment
+}
+
+type SetParticipantRounds interface {
+	Do(ctx context.Context, input SetParticipantRoundsInput) (SetParticipantRoundsOutput, error)
+}
+
+type setParticipantRounds struct {
+	_                    SetParticipantRounds                    `inject:"returns"`
+	_                    *di.Infra                               `inject:"param"`
+	writer               *database.Writer                        `inject:""`
+	eventRepo            repository.Event                        `inject:""`
+	roundRepo            repository.EventRound                   `inject:""`
+	tierRepo             repository.EventTier                    `inject:""`
+	participantRepo      repository.EventParticipant             `inject:""`
+	roundParticipantRepo repository.EventRoundParticipant        `inject:""`
+	adjustmentRepo       repository.EventParticipantAdjustment   `inject:""`
+	statusRepo           repository.EventParticipantStatusChange `inject:""`
+	outboxRepo           orepository.OutboxMessage               `inject:""`
+}
+
+// Do sets the later rounds a participant takes part in, on the organizer's behalf.
+// Unlike the participant's own ChangeRounds it works after payments were made, as who went on to the
+// next venue is often only settled on the night: what changes for participants whose amount is locked
+// is recorded as adjustments, as UpdateEvent does.
+func (uc *setParticipantRounds) Do(
+	ctx context.Context, input SetParticipantRoundsInput,
+) (SetParticipantRoundsOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	var ev model.Event
+	var adjustments []model.EventParticipantAdjustment
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// a seat in a later round is taken here, so joins must wait
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrSetParticipantRoundsNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if ev.UserID != userID {
+			return ErrSetParticipantRoundsForbidden
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrSetParticipantRoundsParticipantNotFound
+		}
+		if ev.Participants[idx].IsWaitlisted() {
+			return ErrSetParticipantRoundsWaitlisted
+		}
+
+		locked := ev.LockedAmounts()
+		attendance, err := optIntoRounds(&ev, ev.Participants[idx], input.Rounds)
+		if errors.Is(err, errRoundNotFound) {
+			return errx.Wrap(ErrSetParticipantRoundsRoundNotFound, "rounds", input.Rounds).
+				WithFieldViolation("rounds", ErrSetParticipantRoundsRoundNotFound.LocalizeContext(ctx))
+		}
+		if errors.Is(err, errRoundFull) {
+			return ErrSetParticipantRoundsRoundFull
+		}
+
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+		adjustments = ev.AdjustLockedAmounts(locked, input.AdjustmentNote, &userID)
+		if err := recordAdjustments(
+			ctx, &ev, adjustments, model.ParticipantStatusActorOrganizer, &userID,
+			uc.adjustmentRepo.WithTx(tx), uc.statusRepo.WithTx(tx), uc.outboxRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		return attendance.save(ctx, uc.roundParticipantRepo.WithTx(tx))
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return SetParticipantRoundsOutput{}, err
+	}
+
+	return SetParticipantRoundsOutput{Event: ev, Adjustments: adjustments}, nil
+}
@@ -0,0 +1,155 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestSetParticipantRounds_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		round := seedRound(t, infra, ev, 4000, 2, participants[0])
+
+		sut := usecase.NewSetParticipantRounds(infra)
+		out, err := sut.Do(ctx, usecase.SetParticipantRoundsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[1].ID,
+			Rounds:        []int{2},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, out.Adjustments)
+		assert.Equal(t, []string{participants[0].ID, participants[1].ID}, roundParticipantIDs(t, infra, round.ID))
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[1].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 5000, persisted.Amount, "3000 for the first round and 2000 for the second")
+		assert.Equal(t, 13000, out.Event.GrandTotal())
+	})
+
+	t.Run("opts out", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		round := seedRound(t, infra, ev, 4000, 2, participants[0])
+
+		sut := usecase.NewSetParticipantRounds(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantRoundsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, roundParticipantIDs(t, infra, round.ID))
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3000, persisted.Amount)
+	})
+
+	t.Run("adjusts a participant who already paid", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		seedRound(t, infra, ev, 4000, 2)
+		participants[0].Status = model.ParticipantStatusConfirmed
+		participants[0].PaidAmount = 3000
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewSetParticipantRounds(infra)
+		out, err := sut.Do(ctx, usecase.SetParticipantRoundsInput{
+			EventID:        ev.ID,
+			ParticipantID:  participants[0].ID,
+			Rounds:         []int{2},
+			AdjustmentNote: "went on to the second venue",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Adjustments, 1)
+		assert.Equal(t, 2000, out.Adjustments[0].Amount)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3000, persisted.Amount)
+		assert.Equal(t, 2000, persisted.AdjustmentAmount)
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, persisted.Status)
+	})
+
+	t.Run("round not found", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		seedRound(t, infra, ev, 4000, 2)
+
+		sut := usecase.NewSetParticipantRounds(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantRoundsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Rounds:        []int{3},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantRoundsRoundNotFound)
+	})
+
+	t.Run("round full", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2)
+		seedRound(t, infra, ev, 4000, 1, participants[0])
+
+		sut := usecase.NewSetParticipantRounds(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantRoundsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[1].ID,
+			Rounds:        []int{2},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantRoundsRoundFull)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		organizer := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ev, participants := seedRoster(t, infra, organizer.UserID, 1)
+		seedRound(t, infra, ev, 4000, 2)
+
+		sut := usecase.NewSetParticipantRounds(infra)
+		_, err := sut.Do(ctx, usecase.SetParticipantRoundsInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Rounds:        []int{2},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrSetParticipantRoundsForbidden)
+	})
+}
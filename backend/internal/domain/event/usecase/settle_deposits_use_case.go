@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrSettleDepositsNotFound     = ErrFinalizeEventNotFound
+	ErrSettleDepositsForbidden    = ErrFinalizeEventForbidden
+	ErrSettleDepositsNotCollected = cmodel.NewLocalizableError(
+		errx.NewSentinel("event does not collect a deposit", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorDepositNotCollected())
+	ErrSettleDepositsAlreadySettled = cmodel.NewLocalizableError(
+		errx.NewSentinel("deposits are already settled", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorDepositAlreadySettled())
+	ErrSettleDepositsFixedAmountExceedsTotal = ErrFinalizeEventFixedAmountExceedsTotal
+)
+
+type SettleDepositsInput struct {
+	EventID string
+	// TotalAmount is the actual cost of the first round, replacing the estimate.
+	TotalAmount int
+	// AdjustmentNote is kept on the additional charges and refunds made for participants who paid their deposit.
+	AdjustmentNote string
+}
+
+type SettleDepositsOutput struct {
+	Event model.Event
+	// Adjustments are the additional charges and refunds the settlement made.
+	Adjustments []model.EventParticipantAdjustment
+}
+
+type SettleDeposits interface {
+	Do(ctx context.Context, input SettleDepositsInput) (SettleDepositsOutput, error)
+}
+
+type settleDeposits struct {
+	_               SettleDeposits                          `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	adjustmentRepo  repository.EventParticipantAdjustment   `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+	outboxRepo      orepository.OutboxMessage               `inject:""`
+}
+
+// Do enters the actual total of an event that collected deposits. It is FinalizeEvent, which settles the
+// deposits up, refusing events that collect none or were settled already.
+func (uc *settleDeposits) Do(ctx context.Context, input SettleDepositsInput) (SettleDepositsOutput, error) {
+	finalize := finalizeEvent{
+		writer:          uc.writer,
+		eventRepo:       uc.eventRepo,
+		roundRepo:       uc.roundRepo,
+		tierRepo:        uc.tierRepo,
+		participantRepo: uc.participantRepo,
+		adjustmentRepo:  uc.adjustmentRepo,
+		statusRepo:      uc.statusRepo,
+		outboxRepo:      uc.outboxRepo,
+	}
+	out, err := finalize.finalize(ctx, FinalizeEventInput{
+		EventID:        input.EventID,
+		TotalAmount:    input.TotalAmount,
+		AdjustmentNote: input.AdjustmentNote,
+	}, func(ev model.Event) error {
+		if ev.DepositAmount == nil {
+			return ErrSettleDepositsNotCollected
+		}
+		if ev.DepositSettledAt != nil {
+			return ErrSettleDepositsAlreadySettled
+		}
+		return nil
+	})
+	if err != nil {
+		return SettleDepositsOutput{}, err
+	}
+
+	return SettleDepositsOutput{Event: out.Event, Adjustments: out.Adjustments}, nil
+}
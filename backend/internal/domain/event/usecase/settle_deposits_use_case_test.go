@@ -0,0 +1,120 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestSettleDeposits_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		collectDeposits(t, infra, &ev, participants, 4000)
+		participants[0].Status = model.ParticipantStatusConfirmed
+		participants[0].PaidAmount = 4000
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewSettleDeposits(infra)
+		out, err := sut.Do(ctx, usecase.SettleDepositsInput{
+			EventID:        ev.ID,
+			TotalAmount:    6000,
+			AdjustmentNote: "actual cost",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, out.Event.DepositSettledAt)
+		assert.Equal(t, 6000, out.Event.TotalAmount)
+		require.Len(t, out.Adjustments, 1)
+		assert.Equal(t, -2000, out.Adjustments[0].Amount)
+
+		paid, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2000, paid.Refundable())
+		assert.Equal(t, model.ParticipantStatusConfirmed, paid.Status)
+		unpaid, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[1].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2000, unpaid.Amount)
+
+		finalized := domainEvents[model.TotalFinalized](t, infra, model.DomainEventTotalFinalized)
+		require.Len(t, finalized, 1)
+		assert.Equal(t, 6000, finalized[0].TotalAmount)
+	})
+
+	t.Run("already settled", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		collectDeposits(t, infra, &ev, participants, 4000)
+
+		sut := usecase.NewSettleDeposits(infra)
+		_, err := sut.Do(ctx, usecase.SettleDepositsInput{EventID: ev.ID, TotalAmount: 6000})
+		require.NoError(t, err)
+		_, err = sut.Do(ctx, usecase.SettleDepositsInput{EventID: ev.ID, TotalAmount: 6000})
+
+		require.ErrorIs(t, err, usecase.ErrSettleDepositsAlreadySettled)
+	})
+
+	t.Run("no deposit", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+
+		sut := usecase.NewSettleDeposits(infra)
+		_, err := sut.Do(ctx, usecase.SettleDepositsInput{EventID: ev.ID, TotalAmount: 6000})
+
+		require.ErrorIs(t, err, usecase.ErrSettleDepositsNotCollected)
+	})
+
+	t.Run("non-positive total", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		collectDeposits(t, infra, &ev, participants, 4000)
+
+		sut := usecase.NewSettleDeposits(infra)
+		_, err := sut.Do(ctx, usecase.SettleDepositsInput{EventID: ev.ID})
+
+		require.ErrorIs(t, err, usecase.ErrValidateEventNonPositiveTotalAmount)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		owner := tseed.EndUser(t, infra.WriterDB)
+		other := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), other.UserID)
+		ev, participants := seedRoster(t, infra, owner.UserID, 3)
+		collectDeposits(t, infra, &ev, participants, 4000)
+
+		sut := usecase.NewSettleDeposits(infra)
+		_, err := sut.Do(ctx, usecase.SettleDepositsInput{EventID: ev.ID, TotalAmount: 6000})
+
+		require.ErrorIs(t, err, usecase.ErrSettleDepositsForbidden)
+	})
+}
@@ -11,7 +11,10 @@ import (
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/money"
+	"github.com/mickamy/sampay/internal/lib/ptr"
 	"github.com/mickamy/sampay/internal/lib/slicex"
 	"github.com/mickamy/sampay/internal/lib/ulid"
 	"github.com/mickamy/sampay/internal/misc/contexts"
@@ -25,26 +28,57 @@ var (
 	ErrUpdateEventForbidden = cmodel.NewLocalizableError(
 		errx.NewSentinel("forbidden", errx.PermissionDenied),
 	).WithMessages(messages.EventUseCaseErrorForbidden())
-	ErrUpdateEventLocked = cmodel.NewLocalizableError(
-		errx.NewSentinel("event is locked", errx.FailedPrecondition),
-	).WithMessages(messages.EventUseCaseErrorLocked())
+	ErrUpdateEventCurrencyLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("currency is locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorCurrencyLocked())
 	ErrUpdateEventInvalidTierUpdate = cmodel.NewLocalizableError(
 		errx.NewSentinel("invalid tier update", errx.InvalidArgument),
 	).WithMessages(messages.EventUseCaseErrorInvalidTierUpdate())
+	ErrUpdateEventTierCapacityTooSmall = cmodel.NewLocalizableError(
+		errx.NewSentinel("tier capacity too small", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorTierCapacityTooSmall())
+	ErrUpdateEventFixedAmountExceedsTotal = cmodel.NewLocalizableError(
+		errx.NewSentinel("fixed amounts exceed total_amount", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorFixedAmountExceedsTotal())
+	ErrUpdateEventDepositSettled = cmodel.NewLocalizableError(
+		errx.NewSentinel("deposits are already settled", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorDepositAlreadySettled())
 )
 
 type UpdateEventInput struct {
 	ID          string
 	Title       string
 	Description string
+	// TotalAmount revises the estimate while the event's total is estimated; only FinalizeEvent
+	// enters the actual one.
 	TotalAmount int
-	TierCount   int
-	HeldAt      time.Time
-	Tiers       []TierConfig
+	// Bill, when set, itemizes the first round's bill and TotalAmount is computed from it instead.
+	Bill      *model.Bill
+	TierCount int
+	HeldAt    time.Time
+	Tiers     []TierConfig
+	// RemainderPolicy is left as it is when empty.
+	RemainderPolicy model.RemainderPolicy
+	SplitMode       model.SplitMode
+	// EndsAt is needed for participants' arrival and departure times to count under an attendance split.
+	EndsAt *time.Time
+	// DepositAmount has participants pay a fixed amount up front, TotalAmount being an estimate until
+	// FinalizeEvent enters the actual one. Nil collects no deposit.
+	DepositAmount *int
+	// Currency is left as it is when empty, and so is the settlement unless Settlement is given.
+	Currency money.Currency
+	// Settlement is nil when participants pay in Currency.
+	Settlement *Settlement
+	// Rounds replace the later rounds. Participants of a round that is kept under the same number stay in it.
+	Rounds []RoundConfig
+	// AdjustmentNote is kept on the adjustments made for participants whose amount is locked.
+	AdjustmentNote string
 }
 
 type UpdateEventOutput struct {
 	Event model.Event
+	// Adjustments are the additional charges and refunds the update made.
+	Adjustments []model.EventParticipantAdjustment
 }
 
 type UpdateEvent interface {
@@ -52,33 +86,65 @@ type UpdateEvent interface {
 }
 
 type updateEvent struct {
-	_               UpdateEvent                 `inject:"returns"`
-	_               *di.Infra                   `inject:"param"`
-	writer          *database.Writer            `inject:""`
-	eventRepo       repository.Event            `inject:""`
-	tierRepo        repository.EventTier        `inject:""`
-	participantRepo repository.EventParticipant `inject:""`
+	_               UpdateEvent                             `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+	adjustmentRepo  repository.EventParticipantAdjustment   `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	roundTierRepo   repository.EventRoundTier               `inject:""`
+	outboxRepo      orepository.OutboxMessage               `inject:""`
 }
 
+// Do changes the event and recalculates what participants owe.
+// Participants who already paid or claimed keep the Amount they were asked for, and the difference from the
+// recalculated one is recorded as an adjustment instead: an additional charge or a refund owed.
+// Only the currency cannot change once amounts are locked, as payments were made in it, and the deposit
+// cannot change once it was settled against the actual total.
 func (uc *updateEvent) Do(ctx context.Context, input UpdateEventInput) (UpdateEventOutput, error) {
 	userID := contexts.MustAuthenticatedUserID(ctx)
 
+	if err := validateBill(ctx, input.Bill); err != nil {
+		return UpdateEventOutput{}, err
+	}
+	if input.Bill != nil {
+		input.TotalAmount = input.Bill.Total()
+	}
 	if err := validateEventInput(
 		ctx, input.Title, input.TotalAmount, input.TierCount, input.HeldAt, input.Tiers,
 	); err != nil {
 		return UpdateEventOutput{}, err
 	}
+	if err := validateEventSchedule(ctx, input.HeldAt, input.EndsAt); err != nil {
+		return UpdateEventOutput{}, err
+	}
+	if err := validateDeposit(ctx, input.DepositAmount); err != nil {
+		return UpdateEventOutput{}, err
+	}
+	if err := validateRounds(ctx, input.Rounds, input.TierCount); err != nil {
+		return UpdateEventOutput{}, err
+	}
 
 	var ev model.Event
+	var adjustments []model.EventParticipantAdjustment
 	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// tier capacities are changed here, so joins must wait until this is done
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.ID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrUpdateEventNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.ID).
+				WithCode(errx.Internal)
+		}
+
 		var err error
 		ev, err = uc.eventRepo.WithTx(tx).Get(
 			ctx, input.ID, repository.EventPreloadParticipants(),
 		)
 		if err != nil {
-			if errors.Is(err, database.ErrNotFound) {
-				return ErrUpdateEventNotFound
-			}
 			return errx.Wrap(err, "message", "failed to get event", "id", input.ID).
 				WithCode(errx.Internal)
 		}
@@ -86,12 +152,23 @@ func (uc *updateEvent) Do(ctx context.Context, input UpdateEventInput) (UpdateEv
 		if ev.UserID != userID {
 			return ErrUpdateEventForbidden
 		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
 
-		for _, p := range ev.Participants {
-			if p.Status != model.ParticipantStatusUnpaid {
-				return ErrUpdateEventLocked
-			}
+		currency, settlement := currencyOrEvent(ev, input.Currency, input.Settlement)
+		if err := validateEventCurrency(ctx, currency, settlement); err != nil {
+			return err
 		}
+		if ev.AmountsLocked() && !sameCurrency(ev, currency, settlement) {
+			return errx.Wrap(ErrUpdateEventCurrencyLocked, "id", ev.ID).
+				WithFieldViolation("currency", ErrUpdateEventCurrencyLocked.LocalizeContext(ctx))
+		}
+		if ev.DepositSettledAt != nil && ptr.Unwrap(input.DepositAmount) != ptr.Unwrap(ev.DepositAmount) {
+			return errx.Wrap(ErrUpdateEventDepositSettled, "id", ev.ID).
+				WithFieldViolation("deposit_amount", ErrUpdateEventDepositSettled.LocalizeContext(ctx))
+		}
+		locked := ev.LockedAmounts()
 
 		tiers := make([]model.EventTier, len(input.Tiers))
 		for i, tc := range input.Tiers {
@@ -99,6 +176,8 @@ func (uc *updateEvent) Do(ctx context.Context, input UpdateEventInput) (UpdateEv
 				ID:      ulid.New(),
 				EventID: ev.ID,
 				Tier:    tc.Tier,
+				Name:    tc.Name,
+				Weight:  tc.weight(),
 				Count:   tc.Count,
 			}
 		}
@@ -113,13 +192,48 @@ func (uc *updateEvent) Do(ctx context.Context, input UpdateEventInput) (UpdateEv
 				return ErrUpdateEventInvalidTierUpdate
 			}
 		}
+		active := make(map[int]int, len(input.Tiers))
+		for _, p := range ev.Participants {
+			if !p.IsWaitlisted() {
+				active[p.Tier]++
+			}
+		}
+		for _, tc := range input.Tiers {
+			if tc.Count < active[tc.Tier] {
+				return ErrUpdateEventTierCapacityTooSmall
+			}
+		}
+
+		if fixed := ev.FixedTotal(); fixed > input.TotalAmount {
+			return errx.Wrap(ErrUpdateEventFixedAmountExceedsTotal,
+				"fixed_total", fixed, "total_amount", input.TotalAmount,
+			).WithFieldViolation("total_amount", ErrUpdateEventFixedAmountExceedsTotal.LocalizeContext(ctx))
+		}
 
 		ev.Title = input.Title
 		ev.Description = input.Description
 		ev.TotalAmount = input.TotalAmount
+		ev.ApplyBill(input.Bill)
+		if ev.EstimatedTotalAmount != nil && ev.FinalizedAt == nil {
+			ev.EstimatedTotalAmount = ptr.Of(input.TotalAmount)
+		}
 		ev.TierCount = input.TierCount
 		ev.HeldAt = input.HeldAt
+		if input.RemainderPolicy != "" {
+			ev.RemainderPolicy = input.RemainderPolicy
+		}
+		ev.SplitMode = splitModeOrDefault(input.SplitMode)
+		ev.EndsAt = input.EndsAt
+		ev.DepositAmount = input.DepositAmount
+		applyCurrency(&ev, currency, settlement)
 		ev.Tiers = tiers
+		previousRounds := ev.Rounds
+		ev.Rounds = buildRounds(ev.ID, input.Rounds, previousRounds)
+		if !ev.RoundsWithinCapacity() {
+			return ErrUpdateEventTierCapacityTooSmall
+		}
+		// spots added by growing a tier go to the waitlist first, who share the total under an attendance split
+		promoted := ev.PromoteWaitlisted()
 		ev.CalcTierAmounts()
 
 		if err := uc.eventRepo.WithTx(tx).Update(ctx, &ev); err != nil {
@@ -137,14 +251,33 @@ func (uc *updateEvent) Do(ctx context.Context, input UpdateEventInput) (UpdateEv
 				WithCode(errx.Internal)
 		}
 
+		if err := saveRounds(ctx, &ev, previousRounds, uc.roundRepo.WithTx(tx), uc.roundTierRepo.WithTx(tx)); err != nil {
+			return err
+		}
+
+		ev.AssignParticipantAmounts()
+		adjustments = ev.AdjustLockedAmounts(locked, input.AdjustmentNote, &userID)
+		if err := recordAdjustments(
+			ctx, &ev, adjustments, model.ParticipantStatusActorOrganizer, &userID,
+			uc.adjustmentRepo.WithTx(tx), uc.statusRepo.WithTx(tx), uc.outboxRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+
 		for i := range ev.Participants {
-			ev.Participants[i].Amount = ev.TierAmount(ev.Participants[i].Tier)
+			if ev.Participants[i].IsWaitlisted() {
+				continue
+			}
 			if err := uc.participantRepo.WithTx(tx).Update(ctx, &ev.Participants[i]); err != nil {
 				return errx.Wrap(err, "message", "failed to update participant amount").
 					WithCode(errx.Internal)
 			}
 		}
 
+		if err := recordPromotions(ctx, uc.statusRepo.WithTx(tx), promoted); err != nil {
+			return err
+		}
+
 		ev.Tiers = tiers
 		return nil
 	}); err != nil {
@@ -152,5 +285,5 @@ func (uc *updateEvent) Do(ctx context.Context, input UpdateEventInput) (UpdateEv
 		return UpdateEventOutput{}, err
 	}
 
-	return UpdateEventOutput{Event: ev}, nil
+	return UpdateEventOutput{Event: ev, Adjustments: adjustments}, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/query"
 	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/lib/money"
 	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/test/tseed"
 )
@@ -54,7 +55,195 @@ func TestUpdateEvent_Do(t *testing.T) {
 		require.Len(t, out.Event.Tiers, 5)
 	})
 
-	t.Run("locked (participant claimed)", func(t *testing.T) {
+	t.Run("itemized bill", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ev, _ := seedRoster(t, infra, endUser.UserID, 3)
+
+		input := usecase.UpdateEventInput{
+			ID:          ev.ID,
+			Title:       ev.Title,
+			TotalAmount: ev.TotalAmount,
+			Bill:        &model.Bill{Subtotal: 10000, TaxRate: 1000, ServiceChargeRate: 1000},
+			TierCount:   1,
+			HeldAt:      ev.HeldAt,
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 3}},
+		}
+		sut := usecase.NewUpdateEvent(infra)
+		out, err := sut.Do(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12100, out.Event.TotalAmount)
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, got.SubtotalAmount)
+		assert.Equal(t, 10000, *got.SubtotalAmount)
+		assert.Equal(t, model.Percent(1000), got.TaxRate)
+		assert.Equal(t, 1100, got.TaxAmount)
+
+		input.Bill = nil
+		input.TotalAmount = 9000
+		out, err = sut.Do(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, 9000, out.Event.TotalAmount)
+		assert.Nil(t, out.Event.SubtotalAmount, "a total entered as is clears the breakdown")
+	})
+
+	t.Run("keeps the remainder policy when unspecified", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		ev := fixture.Event(func(e *model.Event) {
+			e.UserID = endUser.UserID
+			e.RemainderPolicy = model.RemainderPolicyRoundUp100
+		})
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		sut := usecase.NewUpdateEvent(infra)
+		out, err := sut.Do(ctx, usecase.UpdateEventInput{
+			ID:          ev.ID,
+			Title:       "title",
+			TotalAmount: 10007,
+			TierCount:   1,
+			HeldAt:      time.Now().Add(48 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 3}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.RemainderPolicyRoundUp100, out.Event.RemainderPolicy)
+		assert.Equal(t, 3400*3-10007, out.Event.Surplus)
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.RemainderPolicyRoundUp100, got.RemainderPolicy)
+		assert.Equal(t, 3400*3-10007, got.Surplus)
+	})
+
+	t.Run("keeps the currency and the snapshotted rate", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+
+		rateAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+		ev := fixture.Event(func(e *model.Event) {
+			e.UserID = endUser.UserID
+			e.Currency = money.USD
+			e.SettlementCurrency = money.JPY
+			e.ExchangeRate = 150 * money.RateScale
+			e.ExchangeRateAt = &rateAt
+		})
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		input := usecase.UpdateEventInput{
+			ID:          ev.ID,
+			Title:       "renamed",
+			TotalAmount: 30000,
+			TierCount:   1,
+			HeldAt:      time.Now().Add(48 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 3}},
+		}
+		sut := usecase.NewUpdateEvent(infra)
+		_, err := sut.Do(ctx, input)
+		require.NoError(t, err)
+
+		input.Currency = money.USD
+		input.Settlement = &usecase.Settlement{Currency: money.JPY, Rate: 150 * money.RateScale}
+		_, err = sut.Do(ctx, input)
+		require.NoError(t, err)
+
+		got, err := query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, money.USD, got.Currency)
+		assert.Equal(t, money.JPY, got.SettlementCurrency)
+		assert.Equal(t, money.Rate(150*money.RateScale), got.ExchangeRate)
+		require.NotNil(t, got.ExchangeRateAt)
+		assert.True(t, rateAt.Equal(*got.ExchangeRateAt), "the rate is not quoted again")
+		assert.Equal(t, 45000, got.SettlementTotalAmount)
+
+		input.Settlement.Rate = 155 * money.RateScale
+		_, err = sut.Do(ctx, input)
+		require.NoError(t, err)
+
+		got, err = query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
+		require.NoError(t, err)
+		require.NotNil(t, got.ExchangeRateAt)
+		assert.True(t, got.ExchangeRateAt.After(rateAt), "a new rate is snapshotted")
+
+		input.Settlement = nil
+		_, err = sut.Do(ctx, input)
+		require.NoError(t, err)
+
+		got, err = query.Events(infra.ReaderDB).Where("id = ?", ev.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, money.USD, got.Currency)
+		assert.Empty(t, got.SettlementCurrency, "a currency given without a settlement is paid in as is")
+	})
+
+	t.Run("adjusts participants who already paid or claimed", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3)
+		paid, claimed, unpaid := participants[0], participants[1], participants[2]
+		paid.Status = model.ParticipantStatusConfirmed
+		paid.PaidAmount = 3000
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &paid))
+		claimed.Status = model.ParticipantStatusClaimed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &claimed))
+
+		sut := usecase.NewUpdateEvent(infra)
+		out, err := sut.Do(ctx, usecase.UpdateEventInput{
+			ID:             ev.ID,
+			Title:          "title",
+			TotalAmount:    10200,
+			TierCount:      1,
+			HeldAt:         time.Now().Add(48 * time.Hour),
+			Tiers:          []usecase.TierConfig{{Tier: 1, Count: 3}},
+			AdjustmentNote: "dessert",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, out.Adjustments, 2)
+		for _, a := range out.Adjustments {
+			assert.Equal(t, 400, a.Amount)
+		}
+		stored, err := query.EventParticipantAdjustments(infra.ReaderDB).Where("event_id = ?", ev.ID).All(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, stored, 2)
+
+		got, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", paid.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3000, got.Amount)
+		assert.Equal(t, 400, got.AdjustmentAmount)
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, got.Status)
+		changes := statusChanges(t, infra, paid.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, changes[0].ToStatus)
+
+		got, err = query.EventParticipants(infra.ReaderDB).Where("id = ?", claimed.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusClaimed, got.Status)
+		assert.Equal(t, 3400, got.Due())
+
+		got, err = query.EventParticipants(infra.ReaderDB).Where("id = ?", unpaid.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 3400, got.Amount)
+		assert.Zero(t, got.AdjustmentAmount)
+	})
+
+	t.Run("currency locked (participant claimed)", func(t *testing.T) {
 		t.Parallel()
 
 		infra := newInfra(t)
@@ -79,10 +268,11 @@ func TestUpdateEvent_Do(t *testing.T) {
 			TierCount:   1,
 			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 5}},
 			HeldAt:      time.Now(),
+			Currency:    money.USD,
 		})
 
 		require.Error(t, err)
-		require.ErrorIs(t, err, usecase.ErrUpdateEventLocked)
+		require.ErrorIs(t, err, usecase.ErrUpdateEventCurrencyLocked)
 	})
 
 	t.Run("not found", func(t *testing.T) {
@@ -132,4 +322,71 @@ func TestUpdateEvent_Do(t *testing.T) {
 		require.Error(t, err)
 		require.ErrorIs(t, err, usecase.ErrUpdateEventForbidden)
 	})
+
+	t.Run("promotes waitlisted participants when a tier grows", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		active := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &active))
+		waiting := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusWaitlisted
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &waiting))
+
+		sut := usecase.NewUpdateEvent(infra)
+		_, err := sut.Do(ctx, usecase.UpdateEventInput{
+			ID:          ev.ID,
+			Title:       "title",
+			TotalAmount: 10000,
+			TierCount:   1,
+			HeldAt:      time.Now().Add(48 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 2}},
+		})
+
+		require.NoError(t, err)
+		got, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", waiting.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, got.Status)
+		assert.Equal(t, 5000, got.Amount)
+		changes := statusChanges(t, infra, waiting.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusActorSystem, changes[0].Actor)
+		assert.Equal(t, model.ParticipantStatusUnpaid, changes[0].ToStatus)
+	})
+
+	t.Run("tier capacity below participants", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+		for range 2 {
+			p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+			require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+		}
+
+		sut := usecase.NewUpdateEvent(infra)
+		_, err := sut.Do(ctx, usecase.UpdateEventInput{
+			ID:          ev.ID,
+			Title:       "title",
+			TotalAmount: 10000,
+			TierCount:   1,
+			HeldAt:      time.Now().Add(48 * time.Hour),
+			Tiers:       []usecase.TierConfig{{Tier: 1, Count: 1}},
+		})
+
+		require.ErrorIs(t, err, usecase.ErrUpdateEventTierCapacityTooSmall)
+	})
 }
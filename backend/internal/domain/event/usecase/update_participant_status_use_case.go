@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mickamy/errx"
 
@@ -10,6 +11,7 @@ import (
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
 	"github.com/mickamy/sampay/internal/domain/event/model"
 	"github.com/mickamy/sampay/internal/domain/event/repository"
+	orepository "github.com/mickamy/sampay/internal/domain/outbox/repository"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
 	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
@@ -25,12 +27,20 @@ var (
 	ErrUpdateParticipantStatusEventMismatch = cmodel.NewLocalizableError(
 		errx.NewSentinel("event_id mismatch", errx.InvalidArgument),
 	).WithMessages(messages.EventUseCaseErrorEventMismatch())
+	ErrUpdateParticipantStatusWaitlisted = cmodel.NewLocalizableError(
+		errx.NewSentinel("waitlist is managed by tier capacity", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorWaitlisted())
+	ErrUpdateParticipantStatusInvalidTransition = cmodel.NewLocalizableError(
+		errx.NewSentinel("status transition not allowed", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorInvalidStatusTransition())
 )
 
 type UpdateParticipantStatusInput struct {
 	EventID       string
 	ParticipantID string
 	Status        model.ParticipantStatus
+	// Reason is kept in the status history, e.g. why a claim was sent back. It is optional.
+	Reason string
 }
 
 type UpdateParticipantStatusOutput struct {
@@ -42,11 +52,14 @@ type UpdateParticipantStatus interface {
 }
 
 type updateParticipantStatus struct {
-	_               UpdateParticipantStatus     `inject:"returns"`
-	_               *di.Infra                   `inject:"param"`
-	writer          *database.Writer            `inject:""`
-	eventRepo       repository.Event            `inject:""`
-	participantRepo repository.EventParticipant `inject:""`
+	_               UpdateParticipantStatus                 `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	paymentRepo     repository.EventParticipantPayment      `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+	outboxRepo      orepository.OutboxMessage               `inject:""`
 }
 
 func (uc *updateParticipantStatus) Do(
@@ -71,6 +84,20 @@ func (uc *updateParticipantStatus) Do(
 			return ErrUpdateParticipantStatusEventMismatch
 		}
 
+		// confirming adds to the payments ledger, so it must not race RecordPayment for the balance
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, participant.EventID); err != nil {
+			return errx.Wrap(err, "message", "failed to lock event", "id", participant.EventID).
+				WithCode(errx.Internal)
+		}
+		participant, err = uc.participantRepo.WithTx(tx).Get(ctx, input.ParticipantID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrUpdateParticipantStatusNotFound
+			}
+			return errx.Wrap(err, "message", "failed to get participant", "id", input.ParticipantID).
+				WithCode(errx.Internal)
+		}
+
 		ev, err := uc.eventRepo.WithTx(tx).Get(ctx, participant.EventID)
 		if err != nil {
 			return errx.Wrap(err, "message", "failed to get event", "id", participant.EventID).
@@ -81,12 +108,55 @@ func (uc *updateParticipantStatus) Do(
 			return ErrUpdateParticipantStatusForbidden
 		}
 
-		participant.Status = input.Status
+		// the waitlist follows tier capacity, so organizers can neither put people on it nor skip them past it
+		if participant.IsWaitlisted() || input.Status == model.ParticipantStatusWaitlisted {
+			return ErrUpdateParticipantStatusWaitlisted
+		}
+
+		from := participant.Status
+		to := input.Status
+		switch {
+		case to == model.ParticipantStatusPartiallyPaid:
+			// partially paid follows from the payments ledger, which RecordPayment adds to
+			return errx.Wrap(ErrUpdateParticipantStatusInvalidTransition, "from", from, "to", to)
+		case to == model.ParticipantStatusUnpaid && participant.PaidAmount > 0:
+			// sending a claim back does not undo the payments already recorded
+			to = model.ParticipantStatusPartiallyPaid
+		}
+
+		now := time.Now()
+		if !participant.TransitionTo(to, now) {
+			return errx.Wrap(ErrUpdateParticipantStatusInvalidTransition, "from", from, "to", to)
+		}
+
+		// confirming takes whatever is still owed as paid, so the ledger adds up to what was asked
+		if outstanding := participant.Outstanding(); to == model.ParticipantStatusConfirmed && outstanding > 0 {
+			payment := model.NewParticipantPayment(participant, outstanding, "", "", &userID, now)
+			if err := uc.paymentRepo.WithTx(tx).Create(ctx, &payment); err != nil {
+				return errx.Wrap(err, "message", "failed to record payment", "participant_id", participant.ID).
+					WithCode(errx.Internal)
+			}
+			participant.PaidAmount += payment.Amount
+		}
 		if err := uc.participantRepo.WithTx(tx).Update(ctx, &participant); err != nil {
 			return errx.Wrap(err, "message", "failed to update participant status").
 				WithCode(errx.Internal)
 		}
 
+		change := model.NewParticipantStatusChange(
+			participant, &from, model.ParticipantStatusActorOrganizer, &userID, input.Reason,
+		)
+		if err := uc.statusRepo.WithTx(tx).Create(ctx, &change); err != nil {
+			return errx.Wrap(err, "message", "failed to record participant status change").
+				WithCode(errx.Internal)
+		}
+
+		if participant.Status == model.ParticipantStatusConfirmed {
+			return publishDomainEvent(ctx, uc.outboxRepo.WithTx(tx), model.DomainEventPaymentConfirmed, model.PaymentConfirmed{
+				EventID:       participant.EventID,
+				ParticipantID: participant.ID,
+			})
+		}
 		return nil
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
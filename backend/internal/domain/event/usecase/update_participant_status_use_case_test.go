@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,8 @@ func TestUpdateParticipantStatus_Do(t *testing.T) {
 
 		p := fixture.EventParticipant(func(p *model.EventParticipant) {
 			p.EventID = ev.ID
+			p.Amount = 5000
+			p.PaidAmount = 2000
 			p.Status = model.ParticipantStatusClaimed
 		})
 		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
@@ -44,6 +47,141 @@ func TestUpdateParticipantStatus_Do(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, model.ParticipantStatusConfirmed, out.Participant.Status)
+		assert.NotNil(t, out.Participant.ConfirmedAt)
+		assert.Equal(t, 5000, out.Participant.PaidAmount)
+		payments, err := query.EventParticipantPayments(infra.ReaderDB).Where("participant_id = ?", p.ID).All(t.Context())
+		require.NoError(t, err)
+		require.Len(t, payments, 1, "the rest is recorded as paid")
+		assert.Equal(t, 3000, payments[0].Amount)
+		changes := statusChanges(t, infra, p.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusActorOrganizer, changes[0].Actor)
+		assert.Equal(t, &endUser.UserID, changes[0].ActorUserID)
+		assert.Equal(t,
+			[]model.PaymentConfirmed{{EventID: ev.ID, ParticipantID: p.ID}},
+			domainEvents[model.PaymentConfirmed](t, infra, model.DomainEventPaymentConfirmed),
+		)
+	})
+
+	t.Run("sends a claim back with a reason", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		claimedAt := time.Now().Add(-time.Hour)
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusClaimed
+			p.ClaimedAt = &claimedAt
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewUpdateParticipantStatus(infra)
+		out, err := sut.Do(ctx, usecase.UpdateParticipantStatusInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Status:        model.ParticipantStatusUnpaid,
+			Reason:        "not received yet",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, out.Participant.Status)
+		assert.Nil(t, out.Participant.ClaimedAt)
+		changes := statusChanges(t, infra, p.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusClaimed, *changes[0].FromStatus)
+		require.NotNil(t, changes[0].Reason)
+		assert.Equal(t, "not received yet", *changes[0].Reason)
+		assert.Empty(t, domainEvents[model.PaymentConfirmed](t, infra, model.DomainEventPaymentConfirmed))
+	})
+
+	t.Run("sends a claim back to partially paid", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Amount = 5000
+			p.PaidAmount = 3000
+			p.Status = model.ParticipantStatusClaimed
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewUpdateParticipantStatus(infra)
+		out, err := sut.Do(ctx, usecase.UpdateParticipantStatusInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Status:        model.ParticipantStatusUnpaid,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusPartiallyPaid, out.Participant.Status)
+		assert.Equal(t, 3000, out.Participant.PaidAmount)
+	})
+
+	t.Run("rejects setting partially paid directly", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) { p.EventID = ev.ID })
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewUpdateParticipantStatus(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantStatusInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Status:        model.ParticipantStatusPartiallyPaid,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrUpdateParticipantStatusInvalidTransition)
+	})
+
+	t.Run("rejects changing a confirmed payment", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+
+		ev := fixture.Event(func(e *model.Event) { e.UserID = endUser.UserID })
+		require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+		p := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusConfirmed
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+
+		sut := usecase.NewUpdateParticipantStatus(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantStatusInput{
+			EventID:       ev.ID,
+			ParticipantID: p.ID,
+			Status:        model.ParticipantStatusUnpaid,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrUpdateParticipantStatusInvalidTransition)
+		assert.Empty(t, statusChanges(t, infra, p.ID))
 	})
 
 	t.Run("not found", func(t *testing.T) {
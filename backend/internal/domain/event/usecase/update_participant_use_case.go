@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+var (
+	ErrUpdateParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("event not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorNotFound())
+	ErrUpdateParticipantParticipantNotFound = cmodel.NewLocalizableError(
+		errx.NewSentinel("participant not found", errx.NotFound),
+	).WithMessages(messages.EventUseCaseErrorParticipantNotFound())
+	ErrUpdateParticipantForbidden = cmodel.NewLocalizableError(
+		errx.NewSentinel("forbidden", errx.PermissionDenied),
+	).WithMessages(messages.EventUseCaseErrorForbidden())
+	ErrUpdateParticipantEmptyName = cmodel.NewLocalizableError(
+		errx.NewSentinel("name is required", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorNameRequired())
+	ErrUpdateParticipantInvalidTier = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid tier", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorInvalidTier())
+	ErrUpdateParticipantLocked = cmodel.NewLocalizableError(
+		errx.NewSentinel("event is locked", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorLocked())
+	ErrUpdateParticipantTierFull = cmodel.NewLocalizableError(
+		errx.NewSentinel("tier is full", errx.FailedPrecondition),
+	).WithMessages(messages.EventUseCaseErrorTierFull())
+)
+
+type UpdateParticipantInput struct {
+	EventID       string
+	ParticipantID string
+	Name          string
+	Tier          int
+}
+
+type UpdateParticipantOutput struct {
+	Event model.Event
+}
+
+type UpdateParticipant interface {
+	Do(ctx context.Context, input UpdateParticipantInput) (UpdateParticipantOutput, error)
+}
+
+type updateParticipant struct {
+	_               UpdateParticipant                       `inject:"returns"`
+	_               *di.Infra                               `inject:"param"`
+	writer          *database.Writer                        `inject:""`
+	eventRepo       repository.Event                        `inject:""`
+	roundRepo       repository.EventRound                   `inject:""`
+	tierRepo        repository.EventTier                    `inject:""`
+	participantRepo repository.EventParticipant             `inject:""`
+	statusRepo      repository.EventParticipantStatusChange `inject:""`
+}
+
+// Do renames the participant and moves them to another tier.
+// Renaming is always allowed, but moving changes amounts and so is locked like UpdateEvent.
+// A waitlisted participant stays on the waitlist of the new tier unless it has a free spot.
+func (uc *updateParticipant) Do(
+	ctx context.Context, input UpdateParticipantInput,
+) (UpdateParticipantOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	if input.Name == "" {
+		return UpdateParticipantOutput{}, errx.Wrap(ErrUpdateParticipantEmptyName).
+			WithFieldViolation("name", ErrUpdateParticipantEmptyName.LocalizeContext(ctx))
+	}
+
+	var ev model.Event
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		// moving frees a spot in one tier and takes one in another, so joins must wait
+		if err := uc.eventRepo.WithTx(tx).Lock(ctx, input.EventID); err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return ErrUpdateParticipantNotFound
+			}
+			return errx.Wrap(err, "message", "failed to lock event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+
+		var err error
+		ev, err = uc.eventRepo.WithTx(tx).Get(
+			ctx, input.EventID, repository.EventPreloadTiers(), repository.EventPreloadParticipants(),
+		)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get event", "id", input.EventID).
+				WithCode(errx.Internal)
+		}
+		if err := loadRounds(ctx, uc.roundRepo.WithTx(tx), &ev); err != nil {
+			return err
+		}
+
+		if ev.UserID != userID {
+			return ErrUpdateParticipantForbidden
+		}
+		idx := slices.IndexFunc(ev.Participants, func(p model.EventParticipant) bool {
+			return p.ID == input.ParticipantID
+		})
+		if idx < 0 {
+			return ErrUpdateParticipantParticipantNotFound
+		}
+
+		participant := &ev.Participants[idx]
+		participant.Name = input.Name
+		if participant.Tier == input.Tier {
+			if err := uc.participantRepo.WithTx(tx).Update(ctx, participant); err != nil {
+				return errx.Wrap(err, "message", "failed to update participant", "id", participant.ID).
+					WithCode(errx.Internal)
+			}
+			return nil
+		}
+
+		if ev.AmountsLocked() {
+			return ErrUpdateParticipantLocked
+		}
+		if input.Tier < 1 || input.Tier > ev.TierCount {
+			return ErrUpdateParticipantInvalidTier
+		}
+		if !participant.IsWaitlisted() && !ev.HasVacancy(input.Tier) {
+			return ErrUpdateParticipantTierFull
+		}
+		participant.Tier = input.Tier
+		// they keep their later rounds, so those must have a spot in the new tier too
+		if !ev.RoundsWithinCapacity() {
+			return ErrUpdateParticipantTierFull
+		}
+
+		// the spot left behind goes to whoever waits for it
+		promoted := ev.PromoteWaitlisted()
+		ev.CalcTierAmounts()
+		ev.AssignParticipantAmounts()
+
+		if err := saveParticipantAmounts(
+			ctx, &ev, uc.eventRepo.WithTx(tx), uc.tierRepo.WithTx(tx), uc.participantRepo.WithTx(tx),
+		); err != nil {
+			return err
+		}
+		return recordPromotions(ctx, uc.statusRepo.WithTx(tx), promoted)
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return UpdateParticipantOutput{}, err
+	}
+
+	return UpdateParticipantOutput{Event: ev}, nil
+}
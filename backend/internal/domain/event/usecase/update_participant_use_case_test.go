@@ -0,0 +1,142 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestUpdateParticipant_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("move to another tier", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 3, 1)
+		waiting := fixture.EventParticipant(func(p *model.EventParticipant) {
+			p.EventID = ev.ID
+			p.Status = model.ParticipantStatusWaitlisted
+		})
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &waiting))
+
+		sut := usecase.NewUpdateParticipant(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Name:          participants[0].Name,
+			Tier:          2,
+		})
+
+		require.NoError(t, err)
+		moved, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, 2, moved.Tier)
+
+		promoted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", waiting.ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, model.ParticipantStatusUnpaid, promoted.Status, "the freed spot goes to the waitlist")
+		changes := statusChanges(t, infra, waiting.ID)
+		require.Len(t, changes, 1)
+		assert.Equal(t, model.ParticipantStatusActorSystem, changes[0].Actor)
+	})
+
+	t.Run("rename when locked", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+		participants[0].Status = model.ParticipantStatusConfirmed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[0]))
+
+		sut := usecase.NewUpdateParticipant(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Name:          "Renamed",
+			Tier:          1,
+		})
+
+		require.NoError(t, err)
+		persisted, err := query.EventParticipants(infra.ReaderDB).Where("id = ?", participants[0].ID).First(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", persisted.Name)
+		assert.Equal(t, 3000, persisted.Amount)
+	})
+
+	t.Run("move when locked", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 2, 0)
+		participants[1].Status = model.ParticipantStatusClaimed
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[1]))
+
+		sut := usecase.NewUpdateParticipant(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Name:          participants[0].Name,
+			Tier:          2,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrUpdateParticipantLocked)
+	})
+
+	t.Run("tier full", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1, 3)
+
+		sut := usecase.NewUpdateParticipant(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Name:          participants[0].Name,
+			Tier:          2,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrUpdateParticipantTierFull)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), endUser.UserID)
+		ctx = contexts.SetLanguage(ctx, language.Japanese)
+		ev, participants := seedRoster(t, infra, endUser.UserID, 1)
+
+		sut := usecase.NewUpdateParticipant(infra)
+		_, err := sut.Do(ctx, usecase.UpdateParticipantInput{
+			EventID:       ev.ID,
+			ParticipantID: participants[0].ID,
+			Tier:          1,
+		})
+
+		require.ErrorIs(t, err, usecase.ErrUpdateParticipantEmptyName)
+	})
+}
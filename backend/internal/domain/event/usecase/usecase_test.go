@@ -1,11 +1,21 @@
 package usecase_test
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/fixture"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/query"
+	oquery "github.com/mickamy/sampay/internal/domain/outbox/query"
 	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/lib/ulid"
 	"github.com/mickamy/sampay/internal/test/itest"
 )
 
@@ -41,3 +51,171 @@ func newInfra(t *testing.T, opts ...func(*di.Infra)) *di.Infra {
 	}
 	return infra
 }
+
+// domainEvents decodes the payloads of the outbox messages of the type, oldest first.
+func domainEvents[T any](t *testing.T, infra *di.Infra, eventType model.DomainEventType) []T {
+	t.Helper()
+
+	messages, err := oquery.OutboxMessages(infra.ReaderDB).
+		Where("type = ?", eventType.String()).
+		OrderBy("created_at").
+		All(t.Context())
+	require.NoError(t, err)
+
+	payloads := make([]T, 0, len(messages))
+	for _, m := range messages {
+		var payload T
+		require.NoError(t, json.Unmarshal([]byte(m.Payload), &payload))
+		payloads = append(payloads, payload)
+	}
+	return payloads
+}
+
+// statusChanges returns the participant's status history, oldest first.
+func statusChanges(t *testing.T, infra *di.Infra, participantID string) []model.EventParticipantStatusChange {
+	t.Helper()
+
+	changes, err := query.EventParticipantStatusChanges(infra.ReaderDB).
+		Where("participant_id = ?", participantID).
+		OrderBy("created_at").
+		All(t.Context())
+	require.NoError(t, err)
+	return changes
+}
+
+// seedRoster creates an event of 3000 per person with a tier of capacity 3 for each element of joined,
+// which is how many unpaid participants that tier already has.
+func seedRoster(t *testing.T, infra *di.Infra, userID string, joined ...int) (model.Event, []model.EventParticipant) {
+	t.Helper()
+
+	ev := fixture.Event(func(e *model.Event) {
+		e.UserID = userID
+		e.TotalAmount = 9000 * len(joined)
+		e.TierCount = len(joined)
+	})
+	require.NoError(t, query.Events(infra.WriterDB).Create(t.Context(), &ev))
+
+	var participants []model.EventParticipant
+	for i, n := range joined {
+		tier := fixture.EventTier(func(m *model.EventTier) {
+			m.EventID = ev.ID
+			m.Tier = i + 1
+			m.Count = 3
+			m.Amount = 3000
+		})
+		require.NoError(t, query.EventTiers(infra.WriterDB).Create(t.Context(), &tier))
+
+		for range n {
+			p := fixture.EventParticipant(func(p *model.EventParticipant) {
+				p.EventID = ev.ID
+				p.Tier = i + 1
+				p.Amount = 3000
+			})
+			require.NoError(t, query.EventParticipants(infra.WriterDB).Create(t.Context(), &p))
+			participants = append(participants, p)
+		}
+	}
+	return ev, participants
+}
+
+// issueToken gives the seeded participant a token to act on their own behalf.
+func issueToken(t *testing.T, infra *di.Infra, p *model.EventParticipant) string {
+	t.Helper()
+
+	token, err := p.IssueToken()
+	require.NoError(t, err)
+	require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), p))
+	return token
+}
+
+// seedRound adds a second round of totalAmount to an event seeded by seedRoster, with count seats for tier 1,
+// which the given participants already took.
+func seedRound(
+	t *testing.T, infra *di.Infra, ev model.Event, totalAmount, count int, participants ...model.EventParticipant,
+) model.EventRound {
+	t.Helper()
+
+	round := model.EventRound{
+		ID:          ulid.New(),
+		EventID:     ev.ID,
+		Round:       2,
+		Title:       "second round",
+		TotalAmount: totalAmount,
+	}
+	require.NoError(t, query.EventRounds(infra.WriterDB).Create(t.Context(), &round))
+
+	tier := model.EventRoundTier{
+		ID:      ulid.New(),
+		EventID: ev.ID,
+		RoundID: round.ID,
+		Tier:    1,
+		Weight:  model.WeightScale,
+		Count:   count,
+		Amount:  totalAmount / count,
+	}
+	require.NoError(t, query.EventRoundTiers(infra.WriterDB).Create(t.Context(), &tier))
+	round.Tiers = []model.EventRoundTier{tier}
+
+	for _, p := range participants {
+		rp := model.EventRoundParticipant{ID: ulid.New(), EventID: ev.ID, RoundID: round.ID, ParticipantID: p.ID}
+		require.NoError(t, query.EventRoundParticipants(infra.WriterDB).Create(t.Context(), &rp))
+		round.Participants = append(round.Participants, rp)
+	}
+	return round
+}
+
+// splitByAttendance switches an event seeded by seedRoster to an attendance split over four hours.
+func splitByAttendance(t *testing.T, infra *di.Infra, ev *model.Event) {
+	t.Helper()
+
+	ev.SplitMode = model.SplitModeAttendance
+	ev.EndsAt = ptr.Of(ev.HeldAt.Add(4 * time.Hour))
+	require.NoError(t, query.Events(infra.WriterDB).Update(t.Context(), ev))
+}
+
+// collectDeposits has an event seeded by seedRoster collect the deposit from its participants instead.
+func collectDeposits(
+	t *testing.T, infra *di.Infra, ev *model.Event, participants []model.EventParticipant, deposit int,
+) {
+	t.Helper()
+
+	ev.DepositAmount = &deposit
+	require.NoError(t, query.Events(infra.WriterDB).Update(t.Context(), ev))
+	for i := range participants {
+		participants[i].Amount = deposit
+		require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), &participants[i]))
+	}
+}
+
+// estimate marks the total of an event seeded by seedRoster as the organizer's estimate.
+func estimate(t *testing.T, infra *di.Infra, ev *model.Event) {
+	t.Helper()
+
+	ev.EstimatedTotalAmount = ptr.Of(ev.TotalAmount)
+	require.NoError(t, query.Events(infra.WriterDB).Update(t.Context(), ev))
+}
+
+// overpay has the participant pay refund more than they owe, leaving the organizer to pay it back.
+func overpay(t *testing.T, infra *di.Infra, p *model.EventParticipant, refund int) {
+	t.Helper()
+
+	p.Status = model.ParticipantStatusConfirmed
+	p.PaidAmount = p.Amount + refund
+	require.NoError(t, query.EventParticipants(infra.WriterDB).Update(t.Context(), p))
+}
+
+// roundParticipantIDs returns the IDs of the participants who opted into the round.
+func roundParticipantIDs(t *testing.T, infra *di.Infra, roundID string) []string {
+	t.Helper()
+
+	rows, err := query.EventRoundParticipants(infra.ReaderDB).
+		Where("round_id = ?", roundID).
+		OrderBy("created_at").
+		All(t.Context())
+	require.NoError(t, err)
+	ids := make([]string, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ParticipantID
+	}
+	return ids
+}
@@ -2,11 +2,14 @@ package usecase
 
 import (
 	"context"
+	"math"
 	"time"
 
 	"github.com/mickamy/errx"
 
 	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/money"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 )
 
@@ -18,7 +21,7 @@ var (
 		errx.NewSentinel("total_amount must be positive", errx.InvalidArgument),
 	).WithMessages(messages.EventUseCaseErrorTotalAmountPositive())
 	ErrValidateEventInvalidTierCount = cmodel.NewLocalizableError(
-		errx.NewSentinel("tier_count must be 1, 3, or 5", errx.InvalidArgument),
+		errx.NewSentinel("tier_count must be between 1 and 10", errx.InvalidArgument),
 	).WithMessages(messages.EventUseCaseErrorTierCountInvalid())
 	ErrValidateEventTiersRequired = cmodel.NewLocalizableError(
 		errx.NewSentinel("tiers are required", errx.InvalidArgument),
@@ -29,12 +32,46 @@ var (
 	ErrValidateEventHeldAtRequired = cmodel.NewLocalizableError(
 		errx.NewSentinel("held_at is required", errx.InvalidArgument),
 	).WithMessages(messages.EventUseCaseErrorHeldAtRequired())
+	ErrValidateEventUnsupportedCurrency = cmodel.NewLocalizableError(
+		errx.NewSentinel("unsupported currency", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorCurrencyUnsupported())
+	ErrValidateEventInvalidExchangeRate = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid settlement currency or exchange rate", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorExchangeRateInvalid())
+	ErrValidateEventInvalidRound = cmodel.NewLocalizableError(
+		errx.NewSentinel("invalid round config", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorInvalidRound())
+	ErrValidateEventInvalidEndsAt = cmodel.NewLocalizableError(
+		errx.NewSentinel("ends_at must be after held_at", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorEndsAtInvalid())
+	ErrValidateEventInvalidDeposit = cmodel.NewLocalizableError(
+		errx.NewSentinel("deposit_amount must be positive", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorDepositInvalid())
+	ErrValidateEventNonPositiveSubtotal = cmodel.NewLocalizableError(
+		errx.NewSentinel("subtotal_amount must be positive", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorSubtotalAmountPositive())
+	ErrValidateEventBillTotalTooLarge = cmodel.NewLocalizableError(
+		errx.NewSentinel("bill total is too large", errx.InvalidArgument),
+	).WithMessages(messages.EventUseCaseErrorBillTotalTooLarge())
 )
 
+// maxTierCount caps how many tiers an event can be split into.
+const maxTierCount = 10
+
 // TierConfig represents a tier configuration input (tier number + count of people).
+// A zero Weight falls back to the tier number, which is how tiers were weighted before.
 type TierConfig struct {
-	Tier  int
-	Count int
+	Tier   int
+	Name   string
+	Weight model.Weight
+	Count  int
+}
+
+func (tc TierConfig) weight() model.Weight {
+	if tc.Weight == 0 {
+		return model.Weight(tc.Tier * model.WeightScale)
+	}
+	return tc.Weight
 }
 
 func validateEventInput(
@@ -48,10 +85,7 @@ func validateEventInput(
 		return errx.Wrap(ErrValidateEventNonPositiveTotalAmount, "total_amount", totalAmount).
 			WithFieldViolation("total_amount", ErrValidateEventNonPositiveTotalAmount.LocalizeContext(ctx))
 	}
-	switch tierCount {
-	case 1, 3, 5:
-		// valid
-	default:
+	if tierCount < 1 || tierCount > maxTierCount {
 		return errx.Wrap(ErrValidateEventInvalidTierCount, "tier_count", tierCount).
 			WithFieldViolation("tier_count", ErrValidateEventInvalidTierCount.LocalizeContext(ctx))
 	}
@@ -68,8 +102,8 @@ func validateEventInput(
 
 	seen := make(map[int]bool, tierCount)
 	for _, tc := range tiers {
-		if tc.Tier < 1 || tc.Tier > tierCount || tc.Count <= 0 || seen[tc.Tier] {
-			return errx.Wrap(ErrValidateEventInvalidTierConfig, "tier", tc.Tier, "count", tc.Count).
+		if tc.Tier < 1 || tc.Tier > tierCount || tc.Count <= 0 || tc.Weight < 0 || seen[tc.Tier] {
+			return errx.Wrap(ErrValidateEventInvalidTierConfig, "tier", tc.Tier, "count", tc.Count, "weight", tc.Weight).
 				WithFieldViolation("tiers", ErrValidateEventInvalidTierConfig.LocalizeContext(ctx))
 		}
 		seen[tc.Tier] = true
@@ -77,3 +111,167 @@ func validateEventInput(
 
 	return nil
 }
+
+// validateEventSchedule checks the event ends after it starts, when it has an end at all.
+func validateEventSchedule(ctx context.Context, heldAt time.Time, endsAt *time.Time) error {
+	if endsAt != nil && !endsAt.After(heldAt) {
+		return errx.Wrap(ErrValidateEventInvalidEndsAt, "held_at", heldAt, "ends_at", *endsAt).
+			WithFieldViolation("ends_at", ErrValidateEventInvalidEndsAt.LocalizeContext(ctx))
+	}
+	return nil
+}
+
+// validateDeposit checks the per-person deposit, when the event collects one, is positive.
+func validateDeposit(ctx context.Context, depositAmount *int) error {
+	if depositAmount != nil && *depositAmount <= 0 {
+		return errx.Wrap(ErrValidateEventInvalidDeposit, "deposit_amount", *depositAmount).
+			WithFieldViolation("deposit_amount", ErrValidateEventInvalidDeposit.LocalizeContext(ctx))
+	}
+	return nil
+}
+
+// validateBill checks the bill's subtotal and that what it comes to fits in the total amount;
+// its rates are bounded by model.ParsePercent already.
+func validateBill(ctx context.Context, bill *model.Bill) error {
+	if bill == nil {
+		return nil
+	}
+	if bill.Subtotal <= 0 {
+		return errx.Wrap(ErrValidateEventNonPositiveSubtotal, "subtotal_amount", bill.Subtotal).
+			WithFieldViolation("subtotal_amount", ErrValidateEventNonPositiveSubtotal.LocalizeContext(ctx))
+	}
+	if total := bill.Total(); total > math.MaxInt32 {
+		return errx.Wrap(ErrValidateEventBillTotalTooLarge, "subtotal_amount", bill.Subtotal, "total", total).
+			WithFieldViolation("subtotal_amount", ErrValidateEventBillTotalTooLarge.LocalizeContext(ctx))
+	}
+	return nil
+}
+
+// RoundConfig is the input for a later round of an event, e.g. the second round (2次会).
+// Tiers must configure every tier of the event; their names are taken from the event's tiers.
+type RoundConfig struct {
+	Round       int
+	Title       string
+	TotalAmount int
+	Tiers       []TierConfig
+}
+
+// validateRounds checks the later rounds are numbered from 2 up in order and each configures every tier.
+func validateRounds(ctx context.Context, rounds []RoundConfig, tierCount int) error {
+	for i, rc := range rounds {
+		if rc.Round != i+2 || rc.Title == "" || rc.TotalAmount <= 0 || len(rc.Tiers) != tierCount {
+			return errx.Wrap(ErrValidateEventInvalidRound, "round", rc.Round, "total_amount", rc.TotalAmount).
+				WithFieldViolation("rounds", ErrValidateEventInvalidRound.LocalizeContext(ctx))
+		}
+		seen := make(map[int]bool, tierCount)
+		for _, tc := range rc.Tiers {
+			if tc.Tier < 1 || tc.Tier > tierCount || tc.Count <= 0 || tc.Weight < 0 || seen[tc.Tier] {
+				return errx.Wrap(ErrValidateEventInvalidRound, "round", rc.Round, "tier", tc.Tier, "count", tc.Count).
+					WithFieldViolation("rounds", ErrValidateEventInvalidRound.LocalizeContext(ctx))
+			}
+			seen[tc.Tier] = true
+		}
+	}
+	return nil
+}
+
+// Settlement has participants pay in another currency than the event's, at a rate the organizer
+// entered or imported.
+type Settlement struct {
+	Currency money.Currency
+	Rate     money.Rate
+	// RateAt is when the rate was quoted. It defaults to now.
+	RateAt time.Time
+}
+
+func validateEventCurrency(ctx context.Context, currency money.Currency, settlement *Settlement) error {
+	currency = currencyOrDefault(currency)
+	if !currency.IsSupported() {
+		return errx.Wrap(ErrValidateEventUnsupportedCurrency, "currency", currency).
+			WithFieldViolation("currency", ErrValidateEventUnsupportedCurrency.LocalizeContext(ctx))
+	}
+	if settlement == nil {
+		return nil
+	}
+	if !settlement.Currency.IsSupported() || settlement.Currency == currency || settlement.Rate <= 0 {
+		return errx.Wrap(ErrValidateEventInvalidExchangeRate,
+			"currency", currency, "settlement_currency", settlement.Currency, "exchange_rate", settlement.Rate,
+		).WithFieldViolation("exchange_rate", ErrValidateEventInvalidExchangeRate.LocalizeContext(ctx))
+	}
+	return nil
+}
+
+func currencyOrDefault(c money.Currency) money.Currency {
+	if c == "" {
+		return money.DefaultCurrency
+	}
+	return c
+}
+
+// applyCurrency sets the currency and the settlement rate on the event, clearing the rate without a settlement.
+// The rate is snapshotted when it is quoted; resending the one the event already has keeps its snapshot.
+func applyCurrency(ev *model.Event, currency money.Currency, settlement *Settlement) {
+	currency = currencyOrDefault(currency)
+	if settlement == nil {
+		ev.Currency = currency
+		ev.SettlementCurrency = ""
+		ev.ExchangeRate = 0
+		ev.ExchangeRateAt = nil
+		return
+	}
+
+	rateAt := settlement.RateAt
+	unchanged := currency == ev.Currency &&
+		settlement.Currency == ev.SettlementCurrency && settlement.Rate == ev.ExchangeRate
+	switch {
+	case !rateAt.IsZero():
+	case unchanged && ev.ExchangeRateAt != nil:
+		rateAt = *ev.ExchangeRateAt
+	default:
+		rateAt = time.Now()
+	}
+	ev.Currency = currency
+	ev.SettlementCurrency = settlement.Currency
+	ev.ExchangeRate = settlement.Rate
+	ev.ExchangeRateAt = &rateAt
+}
+
+// currencyOrEvent resolves the currency and settlement of an update against the event. An empty currency
+// keeps the event's, along with its settlement unless another one is given.
+func currencyOrEvent(ev model.Event, currency money.Currency, settlement *Settlement) (money.Currency, *Settlement) {
+	if currency != "" {
+		return currency, settlement
+	}
+	if settlement == nil && ev.HasSettlementCurrency() {
+		settlement = &Settlement{Currency: ev.SettlementCurrency, Rate: ev.ExchangeRate}
+	}
+	return ev.Currency, settlement
+}
+
+// sameCurrency reports whether applying the currency and settlement would leave the currencies of the event,
+// the ones participants are asked to pay in, as they are. Only the exchange rate may differ.
+func sameCurrency(ev model.Event, currency money.Currency, settlement *Settlement) bool {
+	if currencyOrDefault(currency) != ev.Currency {
+		return false
+	}
+	if settlement == nil {
+		return !ev.HasSettlementCurrency()
+	}
+	return settlement.Currency == ev.SettlementCurrency
+}
+
+// remainderPolicyOrDefault keeps callers that do not choose a policy on the organizer absorbing the remainder.
+func remainderPolicyOrDefault(p model.RemainderPolicy) model.RemainderPolicy {
+	if p == "" {
+		return model.RemainderPolicyOrganizer
+	}
+	return p
+}
+
+// splitModeOrDefault keeps callers that do not choose a mode on splitting by tier.
+func splitModeOrDefault(m model.SplitMode) model.SplitMode {
+	if m == "" {
+		return model.SplitModeTier
+	}
+	return m
+}
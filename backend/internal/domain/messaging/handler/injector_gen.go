@@ -0,0 +1,26 @@
+// Code generated by injector. DO NOT EDIT.
+
+package handler
+
+import (
+	di "github.com/mickamy/sampay/internal/di"
+	usecase "github.com/mickamy/sampay/internal/domain/messaging/usecase"
+)
+
+// NewLINEWebhook initializes dependencies and constructs LINEWebhook.
+func NewLINEWebhook(infra *di.Infra) *LINEWebhook {
+	handleLINEWebhook := usecase.NewHandleLINEWebhook(infra)
+
+	return &LINEWebhook{
+		handleLINEWebhook: handleLINEWebhook,
+	}
+}
+
+// MustNewLINEWebhook initializes dependencies and constructs LINEWebhook or panics on failure.
+func MustNewLINEWebhook(infra *di.Infra) *LINEWebhook {
+	handleLINEWebhook := usecase.NewHandleLINEWebhook(infra)
+
+	return &LINEWebhook{
+		handleLINEWebhook: handleLINEWebhook,
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/mickamy/sampay/config"
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/messaging/usecase"
+	"github.com/mickamy/sampay/internal/lib/line"
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// maxWebhookBodyBytes is far above what LINE sends; anything larger is not from LINE.
+const maxWebhookBodyBytes = 1 << 20
+
+var _ http.Handler = (*LINEWebhook)(nil)
+
+// LINEWebhook receives LINE Messaging API webhooks.
+// Requests are authenticated by their signature with the Messaging API channel's secret, not by a session.
+type LINEWebhook struct {
+	_                 *di.Infra                 `inject:"param"`
+	handleLINEWebhook usecase.HandleLINEWebhook `inject:""`
+}
+
+func (h *LINEWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodyBytes))
+	if err != nil {
+		logger.Warn(ctx, "failed to read LINE webhook body", "err", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !line.VerifySignature(config.Notifier().LINEChannelSecret, body, r.Header.Get(line.SignatureHeader)) {
+		logger.Warn(ctx, "rejected LINE webhook with invalid signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	webhook, err := line.ParseWebhook(body)
+	if err != nil {
+		logger.Warn(ctx, "failed to parse LINE webhook", "err", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.handleLINEWebhook.Do(ctx, usecase.HandleLINEWebhookInput{Events: webhook.Events}); err != nil {
+		// LINE redelivers failed webhooks when redelivery is enabled for the channel
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
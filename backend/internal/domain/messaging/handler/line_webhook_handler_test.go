@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/config"
+	"github.com/mickamy/sampay/internal/domain/auth/fixture"
+	amodel "github.com/mickamy/sampay/internal/domain/auth/model"
+	aquery "github.com/mickamy/sampay/internal/domain/auth/query"
+	"github.com/mickamy/sampay/internal/domain/messaging/handler"
+	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/queue"
+	"github.com/mickamy/sampay/internal/job"
+	"github.com/mickamy/sampay/internal/lib/line"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+func TestLINEWebhook_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	// a recorded delivery: follow, message, then unfollow by the same user
+	body, err := os.ReadFile("testdata/line_webhook.json")
+	require.NoError(t, err)
+
+	newRequest := func(signature string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/line", bytes.NewReader(body))
+		req.Header.Set(line.SignatureHeader, signature)
+		return req
+	}
+
+	t.Run("applies signed webhook", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		endUser := tseed.EndUser(t, infra.WriterDB)
+		account := fixture.OAuthAccount(func(m *amodel.OAuthAccount) {
+			m.EndUserID = endUser.UserID
+			m.UID = "U4af4980629abcdef0123456789abcdef"
+		})
+		require.NoError(t, aquery.OAuthAccounts(infra.WriterDB).Create(t.Context(), &account))
+
+		// act
+		rec := httptest.NewRecorder()
+		handler.NewLINEWebhook(infra).ServeHTTP(rec, newRequest(line.Sign(config.Notifier().LINEChannelSecret, body)))
+
+		// assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		friendship, err := uquery.LineFriendships(infra.ReaderDB).
+			Where("end_user_id = ?", endUser.UserID).
+			First(t.Context())
+		require.NoError(t, err)
+		assert.False(t, friendship.IsFriend)
+		jobs := infra.Queue.(*queue.Recorder).Jobs()
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.LINEMessageReceived.String(), jobs[0].Type)
+	})
+
+	t.Run("rejects webhook signed with the LINE Login channel secret", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		require.NotEqual(t, config.OAuth().LINEChannelSecret, config.Notifier().LINEChannelSecret)
+
+		// act
+		rec := httptest.NewRecorder()
+		handler.NewLINEWebhook(infra).ServeHTTP(rec, newRequest(line.Sign(config.OAuth().LINEChannelSecret, body)))
+
+		// assert
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Empty(t, infra.Queue.(*queue.Recorder).Jobs())
+	})
+
+	t.Run("rejects invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+
+		// act
+		rec := httptest.NewRecorder()
+		handler.NewLINEWebhook(infra).ServeHTTP(rec, newRequest(line.Sign("not-the-channel-secret", body)))
+
+		// assert
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Empty(t, infra.Queue.(*queue.Recorder).Jobs())
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+
+		// act
+		rec := httptest.NewRecorder()
+		handler.NewLINEWebhook(infra).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/line", nil))
+
+		// assert
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
@@ -0,0 +1,13 @@
+package messaging
+
+import (
+	"net/http"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/messaging/handler"
+)
+
+// Route mounts webhooks from messaging platforms. They are plain HTTP, not Connect.
+func Route(mux *http.ServeMux, infra *di.Infra) {
+	mux.Handle("/line", handler.NewLINEWebhook(infra))
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	amodel "github.com/mickamy/sampay/internal/domain/auth/model"
+	arepository "github.com/mickamy/sampay/internal/domain/auth/repository"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	urepository "github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/queue"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/job"
+	"github.com/mickamy/sampay/internal/lib/line"
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+type HandleLINEWebhookInput struct {
+	Events []line.Event
+}
+
+type HandleLINEWebhookOutput struct{}
+
+type HandleLINEWebhook interface {
+	Do(ctx context.Context, input HandleLINEWebhookInput) (HandleLINEWebhookOutput, error)
+}
+
+type handleLINEWebhook struct {
+	_                  HandleLINEWebhook          `inject:"returns"`
+	_                  *di.Infra                  `inject:"param"`
+	writer             *database.Writer           `inject:""`
+	oauthAccountRepo   arepository.OAuthAccount   `inject:""`
+	lineFriendshipRepo urepository.LineFriendship `inject:""`
+	queue              queue.Queue                `inject:""`
+}
+
+func (uc *handleLINEWebhook) Do(ctx context.Context, input HandleLINEWebhookInput) (HandleLINEWebhookOutput, error) {
+	var received []job.LINEMessageReceivedPayload
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		for _, ev := range input.Events {
+			// group and room events may hide the sender, and there is nobody to attribute them to
+			if ev.Source.UserID == "" {
+				continue
+			}
+
+			endUserID, err := uc.endUserID(ctx, tx, ev.Source.UserID)
+			if err != nil {
+				return err
+			}
+
+			switch ev.Type {
+			case line.EventTypeFollow, line.EventTypeUnfollow:
+				// friends who never logged in have no row to update; their first login records it
+				if endUserID == "" {
+					continue
+				}
+				friendship := umodel.LineFriendship{
+					EndUserID: endUserID,
+					IsFriend:  ev.Type == line.EventTypeFollow,
+				}
+				if err := uc.lineFriendshipRepo.WithTx(tx).Upsert(ctx, &friendship); err != nil {
+					return errx.Wrap(err, "message", "failed to save line friendship", "end_user_id", endUserID).
+						WithCode(errx.Internal)
+				}
+			case line.EventTypeMessage:
+				if ev.Message == nil {
+					continue
+				}
+				received = append(received, job.LINEMessageReceivedPayload{
+					WebhookEventID: ev.WebhookEventID,
+					LINEUserID:     ev.Source.UserID,
+					EndUserID:      endUserID,
+					MessageType:    ev.Message.Type,
+					Text:           ev.Message.Text,
+					ReplyToken:     ev.ReplyToken,
+				})
+			default:
+				logger.Debug(ctx, "ignoring LINE webhook event", "type", ev.Type)
+			}
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return HandleLINEWebhookOutput{}, err
+	}
+
+	for _, payload := range received {
+		if err := uc.queue.Enqueue(ctx, job.LINEMessageReceived, payload); err != nil {
+			return HandleLINEWebhookOutput{}, errx.Wrap(err,
+				"message", "failed to enqueue LINE message", "webhook_event_id", payload.WebhookEventID,
+			).WithCode(errx.Internal)
+		}
+	}
+
+	return HandleLINEWebhookOutput{}, nil
+}
+
+// endUserID returns who the LINE user is in sampay, or empty if they have never logged in with LINE.
+func (uc *handleLINEWebhook) endUserID(ctx context.Context, tx *database.DB, lineUserID string) (string, error) {
+	account, err := uc.oauthAccountRepo.WithTx(tx).GetByProviderAndUID(ctx, amodel.OAuthProviderLINE, lineUserID)
+	if errors.Is(err, database.ErrNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errx.Wrap(err, "message", "failed to get oauth account", "uid", lineUserID).
+			WithCode(errx.Internal)
+	}
+	return account.EndUserID, nil
+}
@@ -0,0 +1,125 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/auth/fixture"
+	amodel "github.com/mickamy/sampay/internal/domain/auth/model"
+	aquery "github.com/mickamy/sampay/internal/domain/auth/query"
+	"github.com/mickamy/sampay/internal/domain/messaging/usecase"
+	umodel "github.com/mickamy/sampay/internal/domain/user/model"
+	uquery "github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/queue"
+	"github.com/mickamy/sampay/internal/job"
+	"github.com/mickamy/sampay/internal/lib/line"
+	"github.com/mickamy/sampay/internal/test/tseed"
+)
+
+const lineUserID = "U4af4980629abcdef0123456789abcdef"
+
+func seedLINEUser(t *testing.T, infra *di.Infra) umodel.EndUser {
+	t.Helper()
+	endUser := tseed.EndUser(t, infra.WriterDB)
+	account := fixture.OAuthAccount(func(m *amodel.OAuthAccount) {
+		m.EndUserID = endUser.UserID
+		m.Provider = string(amodel.OAuthProviderLINE)
+		m.UID = lineUserID
+	})
+	require.NoError(t, aquery.OAuthAccounts(infra.WriterDB).Create(t.Context(), &account))
+	return endUser
+}
+
+func userEvent(typ line.EventType) line.Event {
+	return line.Event{
+		Type:   typ,
+		Source: line.Source{Type: "user", UserID: lineUserID},
+	}
+}
+
+func TestHandleLINEWebhook_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("follow then unfollow", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := seedLINEUser(t, infra)
+		sut := usecase.NewHandleLINEWebhook(infra)
+
+		_, err := sut.Do(t.Context(), usecase.HandleLINEWebhookInput{
+			Events: []line.Event{userEvent(line.EventTypeFollow)},
+		})
+		require.NoError(t, err)
+		got, err := uquery.LineFriendships(infra.ReaderDB).Where("end_user_id = ?", endUser.UserID).First(t.Context())
+		require.NoError(t, err)
+		assert.True(t, got.IsFriend)
+
+		_, err = sut.Do(t.Context(), usecase.HandleLINEWebhookInput{
+			Events: []line.Event{userEvent(line.EventTypeUnfollow)},
+		})
+		require.NoError(t, err)
+		got, err = uquery.LineFriendships(infra.ReaderDB).Where("end_user_id = ?", endUser.UserID).First(t.Context())
+		require.NoError(t, err)
+		assert.False(t, got.IsFriend)
+	})
+
+	t.Run("unknown LINE user", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		sut := usecase.NewHandleLINEWebhook(infra)
+
+		_, err := sut.Do(t.Context(), usecase.HandleLINEWebhookInput{
+			Events: []line.Event{userEvent(line.EventTypeFollow)},
+		})
+
+		require.NoError(t, err)
+		count, err := uquery.LineFriendships(infra.ReaderDB).Count(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("message is queued", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		endUser := seedLINEUser(t, infra)
+		ev := userEvent(line.EventTypeMessage)
+		ev.WebhookEventID = "01HQ6Z5A6B7C8D9E0F1G2H3J4K"
+		ev.ReplyToken = "reply-token"
+		ev.Message = &line.Message{ID: "1", Type: "text", Text: "hello"}
+
+		sut := usecase.NewHandleLINEWebhook(infra)
+		_, err := sut.Do(t.Context(), usecase.HandleLINEWebhookInput{Events: []line.Event{ev}})
+
+		require.NoError(t, err)
+		jobs := infra.Queue.(*queue.Recorder).Jobs()
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.LINEMessageReceived.String(), jobs[0].Type)
+		assert.Equal(t, job.LINEMessageReceivedPayload{
+			WebhookEventID: ev.WebhookEventID,
+			LINEUserID:     lineUserID,
+			EndUserID:      endUser.UserID,
+			MessageType:    "text",
+			Text:           "hello",
+			ReplyToken:     "reply-token",
+		}, jobs[0].Payload)
+	})
+
+	t.Run("events without a user are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		infra := newInfra(t)
+		ev := line.Event{Type: line.EventTypeMessage, Source: line.Source{Type: "group"}, Message: &line.Message{Type: "text"}}
+
+		sut := usecase.NewHandleLINEWebhook(infra)
+		_, err := sut.Do(t.Context(), usecase.HandleLINEWebhookInput{Events: []line.Event{ev}})
+
+		require.NoError(t, err)
+		assert.Empty(t, infra.Queue.(*queue.Recorder).Jobs())
+	})
+}
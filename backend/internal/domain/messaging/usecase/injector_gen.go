@@ -0,0 +1,35 @@
+// Code generated by injector. DO NOT EDIT.
+
+package usecase
+
+import (
+	di "github.com/mickamy/sampay/internal/di"
+	repository "github.com/mickamy/sampay/internal/domain/auth/repository"
+	repository2 "github.com/mickamy/sampay/internal/domain/user/repository"
+)
+
+// NewHandleLINEWebhook initializes dependencies and constructs handleLINEWebhook.
+func NewHandleLINEWebhook(infra *di.Infra) HandleLINEWebhook {
+	oAuthAccount := repository.NewOAuthAccount(infra.DB)
+	lineFriendship := repository2.NewLineFriendship(infra.DB)
+
+	return &handleLINEWebhook{
+		writer:             infra.WriterDB,
+		oauthAccountRepo:   oAuthAccount,
+		lineFriendshipRepo: lineFriendship,
+		queue:              infra.Queue,
+	}
+}
+
+// MustNewHandleLINEWebhook initializes dependencies and constructs handleLINEWebhook or panics on failure.
+func MustNewHandleLINEWebhook(infra *di.Infra) HandleLINEWebhook {
+	oAuthAccount := repository.NewOAuthAccount(infra.DB)
+	lineFriendship := repository2.NewLineFriendship(infra.DB)
+
+	return &handleLINEWebhook{
+		writer:             infra.WriterDB,
+		oauthAccountRepo:   oAuthAccount,
+		lineFriendshipRepo: lineFriendship,
+		queue:              infra.Queue,
+	}
+}
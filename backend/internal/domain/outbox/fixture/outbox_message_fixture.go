@@ -0,0 +1,18 @@
+package fixture
+
+import (
+	"github.com/mickamy/sampay/internal/domain/outbox/model"
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+func OutboxMessage(setter func(m *model.OutboxMessage)) model.OutboxMessage {
+	m := model.OutboxMessage{
+		ID:      ulid.New(),
+		Type:    "PaymentClaimed",
+		Payload: `{"event_id":"` + ulid.New() + `","participant_id":"` + ulid.New() + `"}`,
+	}
+	if setter != nil {
+		setter(&m)
+	}
+	return m
+}
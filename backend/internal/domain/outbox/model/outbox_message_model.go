@@ -0,0 +1,36 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mickamy/sampay/internal/lib/ulid"
+)
+
+// OutboxMessage is a domain event stored in the same transaction as the change it describes,
+// waiting for the relay to forward it to the worker queue.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type OutboxMessage struct {
+	ID string
+	// Type is the worker job type the message is delivered as.
+	Type string
+	// Payload is the JSON-encoded job payload.
+	Payload     string
+	CreatedAt   time.Time
+	PublishedAt *time.Time
+}
+
+// NewOutboxMessage encodes payload as a message for the job type.
+func NewOutboxMessage(msgType fmt.Stringer, payload any) (OutboxMessage, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return OutboxMessage{}, fmt.Errorf("model: failed to marshal outbox payload: %w", err)
+	}
+	return OutboxMessage{
+		ID:      ulid.New(),
+		Type:    msgType.String(),
+		Payload: string(b),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/outbox/model"
+)
+
+type testType string
+
+func (t testType) String() string { return string(t) }
+
+func TestNewOutboxMessage(t *testing.T) {
+	t.Parallel()
+
+	got, err := model.NewOutboxMessage(testType("PaymentClaimed"), struct {
+		EventID string `json:"event_id"`
+	}{EventID: "ev1"})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, got.ID)
+	assert.Equal(t, "PaymentClaimed", got.Type)
+	assert.JSONEq(t, `{"event_id":"ev1"}`, got.Payload)
+	assert.Nil(t, got.PublishedAt)
+}
@@ -0,0 +1,66 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/sampay/internal/domain/outbox/model"
+)
+
+// OutboxMessages returns a new Query for the outbox_messages table.
+func OutboxMessages(db orm.Querier) *orm.Query[model.OutboxMessage] {
+	q := orm.NewQuery[model.OutboxMessage](
+		db, orm.ResolveTableName[model.OutboxMessage]("outbox_messages"), outboxMessagesColumns, "id",
+		scanOutboxMessage, outboxMessageColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		[]string{"created_at"},
+		setOutboxMessageCreatedAt,
+		nil,
+		nil,
+	)
+	return q
+}
+
+var outboxMessagesColumns = []string{"id", "type", "payload", "created_at", "published_at"}
+
+func scanOutboxMessage(rows *sql.Rows) (model.OutboxMessage, error) {
+	cols, _ := rows.Columns()
+	var v model.OutboxMessage
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "id":
+			dest[i] = &v.ID
+		case "type":
+			dest[i] = &v.Type
+		case "payload":
+			dest[i] = &v.Payload
+		case "created_at":
+			dest[i] = &v.CreatedAt
+		case "published_at":
+			dest[i] = &v.PublishedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func outboxMessageColumnValuePairs(v *model.OutboxMessage, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"id", "type", "payload", "created_at", "published_at"},
+			[]any{v.ID, v.Type, v.Payload, v.CreatedAt, v.PublishedAt}
+	}
+	return []string{"type", "payload", "created_at", "published_at"},
+		[]any{v.Type, v.Payload, v.CreatedAt, v.PublishedAt}
+}
+
+func setOutboxMessageCreatedAt(v *model.OutboxMessage, now time.Time) {
+	if v.CreatedAt.IsZero() {
+		v.CreatedAt = now
+	}
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mickamy/sampay/internal/domain/outbox/model"
+	"github.com/mickamy/sampay/internal/domain/outbox/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type OutboxMessage interface {
+	Create(ctx context.Context, m *model.OutboxMessage) error
+	// LockUnpublished returns up to limit unpublished messages, oldest first, locking them until the transaction ends.
+	// Rows locked by another relay are skipped, so relays can run side by side.
+	LockUnpublished(ctx context.Context, limit int) ([]model.OutboxMessage, error)
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+	WithTx(tx *database.DB) OutboxMessage
+}
+
+type outboxMessage struct {
+	db *database.DB
+}
+
+func NewOutboxMessage(db *database.DB) OutboxMessage {
+	return &outboxMessage{db: db}
+}
+
+func (repo *outboxMessage) Create(ctx context.Context, m *model.OutboxMessage) error {
+	if err := query.OutboxMessages(repo.db).Create(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *outboxMessage) LockUnpublished(ctx context.Context, limit int) ([]model.OutboxMessage, error) {
+	messages, err := query.OutboxMessages(repo.db).
+		Where(
+			"id IN (SELECT id FROM outbox_messages WHERE published_at IS NULL ORDER BY id LIMIT ? FOR UPDATE SKIP LOCKED)",
+			limit,
+		).
+		OrderBy("id").
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repository: %w", err)
+	}
+	return messages, nil
+}
+
+func (repo *outboxMessage) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	if err := query.OutboxMessages(repo.db).
+		Where("id = ?", id).
+		Updates(ctx, map[string]any{"published_at": at}); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *outboxMessage) WithTx(tx *database.DB) OutboxMessage {
+	return &outboxMessage{db: tx}
+}
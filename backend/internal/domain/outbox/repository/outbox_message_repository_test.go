@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/outbox/fixture"
+	"github.com/mickamy/sampay/internal/domain/outbox/model"
+	"github.com/mickamy/sampay/internal/domain/outbox/query"
+	"github.com/mickamy/sampay/internal/domain/outbox/repository"
+)
+
+func TestOutboxMessage_Create(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	db := newReadWriter(t)
+	m := fixture.OutboxMessage(nil)
+
+	// act
+	sut := repository.NewOutboxMessage(db.Writer.DB)
+	err := sut.Create(t.Context(), &m)
+
+	// assert
+	require.NoError(t, err)
+	got, err := query.OutboxMessages(db.Reader.DB).Where("id = ?", m.ID).First(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, m.Type, got.Type)
+	assert.JSONEq(t, m.Payload, got.Payload)
+	assert.Nil(t, got.PublishedAt)
+}
+
+func TestOutboxMessage_LockUnpublished(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	db := newReadWriter(t)
+	published := fixture.OutboxMessage(func(m *model.OutboxMessage) {
+		now := time.Now()
+		m.PublishedAt = &now
+	})
+	first := fixture.OutboxMessage(nil)
+	second := fixture.OutboxMessage(nil)
+	third := fixture.OutboxMessage(nil)
+	require.NoError(t, query.OutboxMessages(db.Writer.DB).
+		CreateAll(t.Context(), []*model.OutboxMessage{&published, &first, &second, &third}))
+
+	// act
+	sut := repository.NewOutboxMessage(db.Writer.DB)
+	got, err := sut.LockUnpublished(t.Context(), 2)
+
+	// assert
+	// ULIDs generated within the same millisecond are not ordered, so compare against sorted IDs
+	ids := []string{first.ID, second.ID, third.ID}
+	slices.Sort(ids)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, ids[0], got[0].ID)
+	assert.Equal(t, ids[1], got[1].ID)
+}
+
+func TestOutboxMessage_MarkPublished(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	db := newReadWriter(t)
+	m := fixture.OutboxMessage(nil)
+	require.NoError(t, query.OutboxMessages(db.Writer.DB).Create(t.Context(), &m))
+	at := time.Now().Truncate(time.Microsecond)
+
+	// act
+	sut := repository.NewOutboxMessage(db.Writer.DB)
+	err := sut.MarkPublished(t.Context(), m.ID, at)
+
+	// assert
+	require.NoError(t, err)
+	got, err := query.OutboxMessages(db.Reader.DB).Where("id = ?", m.ID).First(t.Context())
+	require.NoError(t, err)
+	require.NotNil(t, got.PublishedAt)
+	assert.True(t, at.Equal(*got.PublishedAt))
+}
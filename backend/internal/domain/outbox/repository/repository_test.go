@@ -0,0 +1,26 @@
+package repository_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/test/itest"
+)
+
+var databaseDSN itest.DatabaseDSN
+
+func TestMain(m *testing.M) {
+	dsn, cleanup := itest.NewDB()
+	databaseDSN = dsn
+
+	code := m.Run()
+	cleanup()
+	os.Exit(code)
+}
+
+func newReadWriter(t *testing.T) *database.ReadWriter {
+	t.Helper()
+	txdb := itest.OpenTXDB(t, string(databaseDSN.Writer))
+	return &database.ReadWriter{Reader: &database.Reader{DB: txdb}, Writer: &database.Writer{DB: txdb}}
+}
@@ -0,0 +1,30 @@
+// Code generated by injector. DO NOT EDIT.
+
+package usecase
+
+import (
+	di "github.com/mickamy/sampay/internal/di"
+	repository "github.com/mickamy/sampay/internal/domain/outbox/repository"
+)
+
+// NewRelayOutboxMessages initializes dependencies and constructs relayOutboxMessages.
+func NewRelayOutboxMessages(infra *di.Infra) RelayOutboxMessages {
+	outboxMessage := repository.NewOutboxMessage(infra.DB)
+
+	return &relayOutboxMessages{
+		writer:     infra.WriterDB,
+		outboxRepo: outboxMessage,
+		queue:      infra.Queue,
+	}
+}
+
+// MustNewRelayOutboxMessages initializes dependencies and constructs relayOutboxMessages or panics on failure.
+func MustNewRelayOutboxMessages(infra *di.Infra) RelayOutboxMessages {
+	outboxMessage := repository.NewOutboxMessage(infra.DB)
+
+	return &relayOutboxMessages{
+		writer:     infra.WriterDB,
+		outboxRepo: outboxMessage,
+		queue:      infra.Queue,
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/outbox/repository"
+	"github.com/mickamy/sampay/internal/infra/queue"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type RelayOutboxMessagesInput struct {
+	// Limit caps how many messages are forwarded in one pass.
+	Limit int
+}
+
+type RelayOutboxMessagesOutput struct {
+	Relayed int
+}
+
+type RelayOutboxMessages interface {
+	Do(ctx context.Context, input RelayOutboxMessagesInput) (RelayOutboxMessagesOutput, error)
+}
+
+type relayOutboxMessages struct {
+	_          RelayOutboxMessages      `inject:"returns"`
+	_          *di.Infra                `inject:"param"`
+	writer     *database.Writer         `inject:""`
+	outboxRepo repository.OutboxMessage `inject:""`
+	queue      queue.Queue              `inject:""`
+}
+
+// Do forwards pending messages to the queue in order and marks them published.
+// A message is marked only after the queue accepted it, so a crash in between delivers it again;
+// the queue drops such duplicates by message ID once the first one was processed.
+func (uc *relayOutboxMessages) Do(
+	ctx context.Context, input RelayOutboxMessagesInput,
+) (RelayOutboxMessagesOutput, error) {
+	var out RelayOutboxMessagesOutput
+	var enqueueErr error
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		messages, err := uc.outboxRepo.WithTx(tx).LockUnpublished(ctx, input.Limit)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to lock outbox messages").
+				WithCode(errx.Internal)
+		}
+
+		for _, m := range messages {
+			if err := uc.queue.EnqueueEncoded(ctx, m.ID, m.Type, []byte(m.Payload)); err != nil {
+				// keep what was sent so far; the rest is retried on the next pass, still in order
+				enqueueErr = errx.Wrap(err, "message", "failed to enqueue outbox message", "id", m.ID).
+					WithCode(errx.Internal)
+				return nil
+			}
+			if err := uc.outboxRepo.WithTx(tx).MarkPublished(ctx, m.ID, time.Now()); err != nil {
+				return errx.Wrap(err, "message", "failed to mark outbox message published", "id", m.ID).
+					WithCode(errx.Internal)
+			}
+			out.Relayed++
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return RelayOutboxMessagesOutput{}, err
+	}
+
+	return out, enqueueErr
+}
@@ -0,0 +1,85 @@
+package usecase_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/outbox/fixture"
+	"github.com/mickamy/sampay/internal/domain/outbox/model"
+	"github.com/mickamy/sampay/internal/domain/outbox/query"
+	"github.com/mickamy/sampay/internal/domain/outbox/usecase"
+	"github.com/mickamy/sampay/internal/infra/queue"
+)
+
+func TestRelayOutboxMessages_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := queue.NewRecorder()
+		infra := newInfra(t, func(infra *di.Infra) { infra.Queue = recorder })
+		first, second := outboxMessagesInOrder(t, infra)
+
+		sut := usecase.NewRelayOutboxMessages(infra)
+		out, err := sut.Do(t.Context(), usecase.RelayOutboxMessagesInput{Limit: 10})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, out.Relayed)
+		jobs := recorder.Jobs()
+		require.Len(t, jobs, 2)
+		assert.Equal(t, first.ID, jobs[0].Key)
+		assert.Equal(t, first.Type, jobs[0].Type)
+		payload, ok := jobs[0].Payload.(json.RawMessage)
+		require.True(t, ok)
+		assert.JSONEq(t, first.Payload, string(payload))
+		assert.Equal(t, second.ID, jobs[1].Key)
+		assert.Equal(t, second.Type, jobs[1].Type)
+
+		// verify persisted
+		unpublished, err := query.OutboxMessages(infra.ReaderDB).Where("published_at IS NULL").Count(t.Context())
+		require.NoError(t, err)
+		assert.Zero(t, unpublished)
+
+		// nothing is sent twice
+		out, err = sut.Do(t.Context(), usecase.RelayOutboxMessagesInput{Limit: 10})
+		require.NoError(t, err)
+		assert.Zero(t, out.Relayed)
+		assert.Len(t, recorder.Jobs(), 2)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := queue.NewRecorder()
+		infra := newInfra(t, func(infra *di.Infra) { infra.Queue = recorder })
+		first, _ := outboxMessagesInOrder(t, infra)
+
+		sut := usecase.NewRelayOutboxMessages(infra)
+		out, err := sut.Do(t.Context(), usecase.RelayOutboxMessagesInput{Limit: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, out.Relayed)
+		require.Len(t, recorder.Jobs(), 1)
+		assert.Equal(t, first.ID, recorder.Jobs()[0].Key)
+	})
+}
+
+// outboxMessagesInOrder stores two messages and returns them in relay order.
+// ULIDs generated within the same millisecond are not ordered, hence the sort.
+func outboxMessagesInOrder(t *testing.T, infra *di.Infra) (model.OutboxMessage, model.OutboxMessage) {
+	t.Helper()
+
+	first := fixture.OutboxMessage(nil)
+	second := fixture.OutboxMessage(func(m *model.OutboxMessage) { m.Type = "EventArchived" })
+	require.NoError(t, query.OutboxMessages(infra.WriterDB).
+		CreateAll(t.Context(), []*model.OutboxMessage{&first, &second}))
+	if second.ID < first.ID {
+		first, second = second, first
+	}
+	return first, second
+}
@@ -52,11 +52,15 @@ func NewUserService(infra *di.Infra) *UserService {
 	getMe := usecase.NewGetMe(infra)
 	updateSlug := usecase.NewUpdateSlug(infra)
 	checkSlugAvailability := usecase.NewCheckSlugAvailability(infra)
+	getNotificationSetting := usecase.NewGetNotificationSetting(infra)
+	updateNotificationSetting := usecase.NewUpdateNotificationSetting(infra)
 
 	return &UserService{
-		getMe:                 getMe,
-		updateSlug:            updateSlug,
-		checkSlugAvailability: checkSlugAvailability,
+		getMe:                     getMe,
+		updateSlug:                updateSlug,
+		checkSlugAvailability:     checkSlugAvailability,
+		getNotificationSetting:    getNotificationSetting,
+		updateNotificationSetting: updateNotificationSetting,
 	}
 }
 
@@ -65,10 +69,14 @@ func MustNewUserService(infra *di.Infra) *UserService {
 	getMe := usecase.NewGetMe(infra)
 	updateSlug := usecase.NewUpdateSlug(infra)
 	checkSlugAvailability := usecase.NewCheckSlugAvailability(infra)
+	getNotificationSetting := usecase.NewGetNotificationSetting(infra)
+	updateNotificationSetting := usecase.NewUpdateNotificationSetting(infra)
 
 	return &UserService{
-		getMe:                 getMe,
-		updateSlug:            updateSlug,
-		checkSlugAvailability: checkSlugAvailability,
+		getMe:                     getMe,
+		updateSlug:                updateSlug,
+		checkSlugAvailability:     checkSlugAvailability,
+		getNotificationSetting:    getNotificationSetting,
+		updateNotificationSetting: updateNotificationSetting,
 	}
 }
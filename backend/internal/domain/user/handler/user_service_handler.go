@@ -20,10 +20,12 @@ import (
 var _ userv1connect.UserServiceHandler = (*UserService)(nil)
 
 type UserService struct {
-	_                     *di.Infra                     `inject:"param"`
-	getMe                 usecase.GetMe                 `inject:""`
-	updateSlug            usecase.UpdateSlug            `inject:""`
-	checkSlugAvailability usecase.CheckSlugAvailability `inject:""`
+	_                         *di.Infra                         `inject:"param"`
+	getMe                     usecase.GetMe                     `inject:""`
+	updateSlug                usecase.UpdateSlug                `inject:""`
+	checkSlugAvailability     usecase.CheckSlugAvailability     `inject:""`
+	getNotificationSetting    usecase.GetNotificationSetting    `inject:""`
+	updateNotificationSetting usecase.UpdateNotificationSetting `inject:""`
 }
 
 func (h *UserService) GetMe(
@@ -36,9 +38,13 @@ func (h *UserService) GetMe(
 	}
 
 	user := mapper.ToV1User(out.User)
-	return connect.NewResponse(&v1.GetMeResponse{
+	res := &v1.GetMeResponse{
 		User: &user,
-	}), nil
+	}
+	if out.LineFriendship != nil {
+		res.LineFriend = &out.LineFriendship.IsFriend
+	}
+	return connect.NewResponse(res), nil
 }
 
 func (h *UserService) UpdateSlug(
@@ -78,3 +84,41 @@ func (h *UserService) CheckSlugAvailability(
 		Available: out.Available,
 	}), nil
 }
+
+func (h *UserService) GetNotificationSetting(
+	ctx context.Context, _ *connect.Request[v1.GetNotificationSettingRequest],
+) (*connect.Response[v1.GetNotificationSettingResponse], error) {
+	out, err := h.getNotificationSetting.Do(ctx, usecase.GetNotificationSettingInput{})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&v1.GetNotificationSettingResponse{
+		Setting: mapper.ToV1NotificationSetting(out.Setting),
+	}), nil
+}
+
+func (h *UserService) UpdateNotificationSetting(
+	ctx context.Context, r *connect.Request[v1.UpdateNotificationSettingRequest],
+) (*connect.Response[v1.UpdateNotificationSettingResponse], error) {
+	setting := r.Msg.GetSetting()
+	out, err := h.updateNotificationSetting.Do(ctx, usecase.UpdateNotificationSettingInput{
+		LINEEnabled:  setting.GetLineEnabled(),
+		EmailEnabled: setting.GetEmailEnabled(),
+		Email:        setting.GetEmail(),
+	})
+	if err != nil {
+		logger.Error(ctx, "failed to execute use-case", "err", err)
+		var localizable *cmodel.LocalizableError
+		if errors.As(err, &localizable) {
+			return nil, errx.Wrap(err).
+				WithFieldViolation("setting", localizable.LocalizeContext(ctx))
+		}
+		return nil, err //nolint:wrapcheck // use-case errors are already wrapped with errx
+	}
+
+	return connect.NewResponse(&v1.UpdateNotificationSettingResponse{
+		Setting: mapper.ToV1NotificationSetting(out.Setting),
+	}), nil
+}
@@ -200,3 +200,85 @@ func TestUserService_CheckSlugAvailability(t *testing.T) {
 		assert.False(t, out.GetAvailable())
 	})
 }
+
+func TestUserService_GetNotificationSetting(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns default setting", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		_, authHeader := ctest.UserSession(t, infra)
+
+		// act
+		var out userv1.GetNotificationSettingResponse
+		ct := contest.NewWith(t,
+			contest.Bind(userv1connect.NewUserServiceHandler)(handler.NewUserService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(userv1connect.UserServiceGetNotificationSettingProcedure).
+			Header("Authorization", authHeader).
+			In(&userv1.GetNotificationSettingRequest{}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusOK).Out(&out)
+		assert.True(t, out.GetSetting().GetLineEnabled())
+		assert.False(t, out.GetSetting().GetEmailEnabled())
+	})
+}
+
+func TestUserService_UpdateNotificationSetting(t *testing.T) {
+	t.Parallel()
+
+	t.Run("updates setting successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		_, authHeader := ctest.UserSession(t, infra)
+
+		// act
+		var out userv1.UpdateNotificationSettingResponse
+		ct := contest.NewWith(t,
+			contest.Bind(userv1connect.NewUserServiceHandler)(handler.NewUserService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(userv1connect.UserServiceUpdateNotificationSettingProcedure).
+			Header("Authorization", authHeader).
+			In(&userv1.UpdateNotificationSettingRequest{Setting: &userv1.NotificationSetting{
+				LineEnabled:  false,
+				EmailEnabled: true,
+				Email:        "alice@example.com",
+			}}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusOK).Out(&out)
+		assert.False(t, out.GetSetting().GetLineEnabled())
+		assert.True(t, out.GetSetting().GetEmailEnabled())
+		assert.Equal(t, "alice@example.com", out.GetSetting().GetEmail())
+	})
+
+	t.Run("returns error for email without address", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		_, authHeader := ctest.UserSession(t, infra)
+
+		// act
+		ct := contest.NewWith(t,
+			contest.Bind(userv1connect.NewUserServiceHandler)(handler.NewUserService(infra)),
+			connect.WithInterceptors(interceptor.NewInterceptors(infra)...),
+		).
+			Procedure(userv1connect.UserServiceUpdateNotificationSettingProcedure).
+			Header("Authorization", authHeader).
+			In(&userv1.UpdateNotificationSettingRequest{Setting: &userv1.NotificationSetting{EmailEnabled: true}}).
+			Do()
+
+		// assert
+		ct.ExpectStatus(http.StatusBadRequest)
+	})
+}
@@ -0,0 +1,17 @@
+package mapper
+
+import (
+	userv1 "github.com/mickamy/sampay/gen/user/v1"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+)
+
+func ToV1NotificationSetting(src model.NotificationSetting) *userv1.NotificationSetting {
+	s := &userv1.NotificationSetting{
+		LineEnabled:  src.LINEEnabled,
+		EmailEnabled: src.EmailEnabled,
+	}
+	if src.Email != nil {
+		s.Email = *src.Email
+	}
+	return s
+}
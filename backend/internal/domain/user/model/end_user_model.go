@@ -15,3 +15,13 @@ type EndUser struct {
 
 	OAuthAccounts []amodel.OAuthAccount `rel:"has_many,foreign_key:end_user_id"`
 }
+
+// LINEUserID returns the user's LINE user ID, empty unless OAuthAccounts is loaded and has a LINE account.
+func (m EndUser) LINEUserID() string {
+	for _, a := range m.OAuthAccounts {
+		if a.Provider == amodel.OAuthProviderLINE.String() {
+			return a.UID
+		}
+	}
+	return ""
+}
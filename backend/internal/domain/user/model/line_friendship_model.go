@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// LineFriendship records whether the end user has added the official LINE account as a friend,
+// as last reported by LINE when they logged in. We can only message users who have.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type LineFriendship struct {
+	EndUserID string `db:",primaryKey"`
+	IsFriend  bool
+	UpdatedAt time.Time
+}
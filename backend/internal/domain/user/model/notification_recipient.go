@@ -0,0 +1,8 @@
+package model
+
+// NotificationRecipient is where to notify a user. Empty fields are channels not to use.
+type NotificationRecipient struct {
+	EndUserID  string
+	LINEUserID string
+	Email      string
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/mickamy/errx"
+
+	cmodel "github.com/mickamy/sampay/internal/domain/common/model"
+	"github.com/mickamy/sampay/internal/misc/i18n"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
+)
+
+// NotificationSetting is how the end user wants to be notified.
+// Users who never changed it have no row and get DefaultNotificationSetting.
+//
+//go:generate go tool ormgen -source=$GOFILE -destination=../query
+type NotificationSetting struct {
+	EndUserID    string `db:",primaryKey"`
+	LINEEnabled  bool
+	EmailEnabled bool
+	Email        *string
+	UpdatedAt    time.Time
+}
+
+// DefaultNotificationSetting notifies through LINE only; email needs an address the user gives us.
+func DefaultNotificationSetting(endUserID string) NotificationSetting {
+	return NotificationSetting{
+		EndUserID:   endUserID,
+		LINEEnabled: true,
+	}
+}
+
+// Validate returns localised error messages, or nil when the setting is valid.
+func (s NotificationSetting) Validate() *cmodel.LocalizableError {
+	var msgs []i18n.Message
+	if s.Email != nil {
+		if _, err := mail.ParseAddress(*s.Email); err != nil {
+			msgs = append(msgs, messages.UserModelNotificationSettingErrorInvalidEmail())
+		}
+	}
+	if s.EmailEnabled && s.Email == nil {
+		msgs = append(msgs, messages.UserModelNotificationSettingErrorEmailRequired())
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return cmodel.NewLocalizableError(errNotificationSettingValidation).WithMessages(msgs...)
+}
+
+// Recipient tells where to reach the user. lineUserID is empty when they have never logged in with LINE,
+// and lineFriend tells whether they added the official account, without which LINE refuses to push to them.
+func (s NotificationSetting) Recipient(lineUserID string, lineFriend bool) NotificationRecipient {
+	r := NotificationRecipient{EndUserID: s.EndUserID}
+	if s.LINEEnabled && lineFriend {
+		r.LINEUserID = lineUserID
+	}
+	if s.EmailEnabled && s.Email != nil {
+		r.Email = *s.Email
+	}
+	return r
+}
+
+var errNotificationSettingValidation = cmodel.NewLocalizableError(
+	errx.NewSentinel("notification setting validation failed", errx.InvalidArgument),
+)
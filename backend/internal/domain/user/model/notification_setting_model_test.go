@@ -0,0 +1,99 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+func TestNotificationSetting_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		setting model.NotificationSetting
+		wantErr bool
+	}{
+		{name: "default", setting: model.DefaultNotificationSetting("user"), wantErr: false},
+		{
+			name:    "email enabled with address",
+			setting: model.NotificationSetting{EmailEnabled: true, Email: ptr.Of("alice@example.com")},
+			wantErr: false,
+		},
+		{
+			name:    "email disabled keeps address",
+			setting: model.NotificationSetting{Email: ptr.Of("alice@example.com")},
+			wantErr: false,
+		},
+		{name: "email enabled without address", setting: model.NotificationSetting{EmailEnabled: true}, wantErr: true},
+		{name: "invalid address", setting: model.NotificationSetting{Email: ptr.Of("alice")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.setting.Validate()
+			if tt.wantErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationSetting_Recipient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setting    model.NotificationSetting
+		lineFriend bool
+		want       model.NotificationRecipient
+	}{
+		{
+			name:       "line friend",
+			setting:    model.DefaultNotificationSetting("user"),
+			lineFriend: true,
+			want:       model.NotificationRecipient{EndUserID: "user", LINEUserID: "U123"},
+		},
+		{
+			name:       "not a line friend",
+			setting:    model.DefaultNotificationSetting("user"),
+			lineFriend: false,
+			want:       model.NotificationRecipient{EndUserID: "user"},
+		},
+		{
+			name:       "line disabled",
+			setting:    model.NotificationSetting{EndUserID: "user"},
+			lineFriend: true,
+			want:       model.NotificationRecipient{EndUserID: "user"},
+		},
+		{
+			name: "email enabled",
+			setting: model.NotificationSetting{
+				EndUserID: "user", EmailEnabled: true, Email: ptr.Of("alice@example.com"),
+			},
+			lineFriend: true,
+			want:       model.NotificationRecipient{EndUserID: "user", Email: "alice@example.com"},
+		},
+		{
+			name:       "email disabled",
+			setting:    model.NotificationSetting{EndUserID: "user", Email: ptr.Of("alice@example.com")},
+			lineFriend: true,
+			want:       model.NotificationRecipient{EndUserID: "user"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.setting.Recipient("U123", tt.lineFriend))
+		})
+	}
+}
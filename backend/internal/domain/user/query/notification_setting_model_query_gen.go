@@ -0,0 +1,64 @@
+// Code generated by ormgen; DO NOT EDIT.
+package query
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/mickamy/ormgen/orm"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+)
+
+// NotificationSettings returns a new Query for the notification_settings table.
+func NotificationSettings(db orm.Querier) *orm.Query[model.NotificationSetting] {
+	q := orm.NewQuery[model.NotificationSetting](
+		db, orm.ResolveTableName[model.NotificationSetting]("notification_settings"), notificationSettingsColumns, "end_user_id",
+		scanNotificationSetting, notificationSettingColumnValuePairs, nil,
+	)
+	q.RegisterTimestamps(
+		nil,
+		nil,
+		[]string{"updated_at"},
+		setNotificationSettingUpdatedAt,
+	)
+	return q
+}
+
+var notificationSettingsColumns = []string{"end_user_id", "line_enabled", "email_enabled", "email", "updated_at"}
+
+func scanNotificationSetting(rows *sql.Rows) (model.NotificationSetting, error) {
+	cols, _ := rows.Columns()
+	var v model.NotificationSetting
+	dest := make([]any, len(cols))
+	for i, col := range cols {
+		switch col {
+		case "end_user_id":
+			dest[i] = &v.EndUserID
+		case "line_enabled":
+			dest[i] = &v.LINEEnabled
+		case "email_enabled":
+			dest[i] = &v.EmailEnabled
+		case "email":
+			dest[i] = &v.Email
+		case "updated_at":
+			dest[i] = &v.UpdatedAt
+		default:
+			dest[i] = new(any)
+		}
+	}
+	err := rows.Scan(dest...)
+	return v, err
+}
+
+func notificationSettingColumnValuePairs(v *model.NotificationSetting, includesPK bool) ([]string, []any) {
+	if includesPK {
+		return []string{"end_user_id", "line_enabled", "email_enabled", "email", "updated_at"},
+			[]any{v.EndUserID, v.LINEEnabled, v.EmailEnabled, v.Email, v.UpdatedAt}
+	}
+	return []string{"line_enabled", "email_enabled", "email", "updated_at"},
+		[]any{v.LINEEnabled, v.EmailEnabled, v.Email, v.UpdatedAt}
+}
+
+func setNotificationSettingUpdatedAt(v *model.NotificationSetting, now time.Time) {
+	v.UpdatedAt = now
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mickamy/ormgen/orm"
+
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type LineFriendship interface {
+	Get(ctx context.Context, endUserID string) (model.LineFriendship, error)
+	Upsert(ctx context.Context, m *model.LineFriendship) error
+	WithTx(tx *database.DB) LineFriendship
+}
+
+type lineFriendship struct {
+	db *database.DB
+}
+
+func NewLineFriendship(db *database.DB) LineFriendship {
+	return &lineFriendship{db: db}
+}
+
+func (repo *lineFriendship) Get(ctx context.Context, endUserID string) (model.LineFriendship, error) {
+	m, err := query.LineFriendships(repo.db).Where("end_user_id = ?", endUserID).First(ctx)
+	if errors.Is(err, orm.ErrNotFound) {
+		return model.LineFriendship{}, database.ErrNotFound
+	}
+	if err != nil {
+		return m, fmt.Errorf("repository: %w", err)
+	}
+	return m, nil
+}
+
+func (repo *lineFriendship) Upsert(ctx context.Context, m *model.LineFriendship) error {
+	if err := query.LineFriendships(repo.db).Upsert(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *lineFriendship) WithTx(tx *database.DB) LineFriendship {
+	return &lineFriendship{db: tx}
+}
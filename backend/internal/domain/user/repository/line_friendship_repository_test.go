@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/user/fixture"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+func TestLineFriendship_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		db := newReadWriter(t)
+
+		// act
+		sut := repository.NewLineFriendship(db.Reader.DB)
+		_, err := sut.Get(t.Context(), "nonexistent")
+
+		// assert
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
+
+func TestLineFriendship_Upsert(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	db := newReadWriter(t)
+	user := fixture.User(nil)
+	require.NoError(t, query.Users(db.Writer.DB).Create(t.Context(), &user))
+	endUser := fixture.EndUser(func(m *model.EndUser) { m.UserID = user.ID })
+	require.NoError(t, query.EndUsers(db.Writer.DB).Create(t.Context(), &endUser))
+	sut := repository.NewLineFriendship(db.Writer.DB)
+
+	// act
+	require.NoError(t, sut.Upsert(t.Context(), &model.LineFriendship{EndUserID: user.ID, IsFriend: true}))
+	require.NoError(t, sut.Upsert(t.Context(), &model.LineFriendship{EndUserID: user.ID, IsFriend: false}))
+
+	// assert
+	got, err := repository.NewLineFriendship(db.Reader.DB).Get(t.Context(), user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, got.EndUserID)
+	assert.False(t, got.IsFriend)
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mickamy/ormgen/orm"
+
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+)
+
+type NotificationSetting interface {
+	// Get returns the user's setting, or model.DefaultNotificationSetting if they never changed it.
+	Get(ctx context.Context, endUserID string) (model.NotificationSetting, error)
+	// GetRecipient returns where to notify the user, following their setting.
+	GetRecipient(ctx context.Context, endUserID string) (model.NotificationRecipient, error)
+	Upsert(ctx context.Context, m *model.NotificationSetting) error
+	WithTx(tx *database.DB) NotificationSetting
+}
+
+type notificationSetting struct {
+	db *database.DB
+}
+
+func NewNotificationSetting(db *database.DB) NotificationSetting {
+	return &notificationSetting{db: db}
+}
+
+func (repo *notificationSetting) Get(ctx context.Context, endUserID string) (model.NotificationSetting, error) {
+	m, err := query.NotificationSettings(repo.db).Where("end_user_id = ?", endUserID).First(ctx)
+	if errors.Is(err, orm.ErrNotFound) {
+		return model.DefaultNotificationSetting(endUserID), nil
+	}
+	if err != nil {
+		return m, fmt.Errorf("repository: %w", err)
+	}
+	return m, nil
+}
+
+func (repo *notificationSetting) GetRecipient(
+	ctx context.Context, endUserID string,
+) (model.NotificationRecipient, error) {
+	setting, err := repo.Get(ctx, endUserID)
+	if err != nil {
+		return model.NotificationRecipient{}, err
+	}
+
+	endUser, err := query.EndUsers(repo.db).
+		Preload("OAuthAccounts").
+		Where("user_id = ?", endUserID).
+		First(ctx)
+	if errors.Is(err, orm.ErrNotFound) {
+		return model.NotificationRecipient{}, database.ErrNotFound
+	}
+	if err != nil {
+		return model.NotificationRecipient{}, fmt.Errorf("repository: %w", err)
+	}
+
+	friendship, err := query.LineFriendships(repo.db).Where("end_user_id = ?", endUserID).First(ctx)
+	if err != nil && !errors.Is(err, orm.ErrNotFound) {
+		return model.NotificationRecipient{}, fmt.Errorf("repository: %w", err)
+	}
+
+	return setting.Recipient(endUser.LINEUserID(), friendship.IsFriend), nil
+}
+
+func (repo *notificationSetting) Upsert(ctx context.Context, m *model.NotificationSetting) error {
+	if err := query.NotificationSettings(repo.db).Upsert(ctx, m); err != nil {
+		return fmt.Errorf("repository: %w", err)
+	}
+	return nil
+}
+
+func (repo *notificationSetting) WithTx(tx *database.DB) NotificationSetting {
+	return &notificationSetting{db: tx}
+}
@@ -0,0 +1,134 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	afixture "github.com/mickamy/sampay/internal/domain/auth/fixture"
+	amodel "github.com/mickamy/sampay/internal/domain/auth/model"
+	aquery "github.com/mickamy/sampay/internal/domain/auth/query"
+	"github.com/mickamy/sampay/internal/domain/user/fixture"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+)
+
+func TestNotificationSetting_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns default when never saved", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		db := newReadWriter(t)
+
+		// act
+		sut := repository.NewNotificationSetting(db.Reader.DB)
+		got, err := sut.Get(t.Context(), "nonexistent")
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, model.DefaultNotificationSetting("nonexistent"), got)
+	})
+}
+
+func TestNotificationSetting_Upsert(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	db := newReadWriter(t)
+	endUser := createEndUser(t, db)
+	sut := repository.NewNotificationSetting(db.Writer.DB)
+
+	// act
+	require.NoError(t, sut.Upsert(t.Context(), &model.NotificationSetting{EndUserID: endUser.UserID, LINEEnabled: true}))
+	require.NoError(t, sut.Upsert(t.Context(), &model.NotificationSetting{
+		EndUserID:    endUser.UserID,
+		EmailEnabled: true,
+		Email:        ptr.Of("alice@example.com"),
+	}))
+
+	// assert
+	got, err := repository.NewNotificationSetting(db.Reader.DB).Get(t.Context(), endUser.UserID)
+	require.NoError(t, err)
+	assert.False(t, got.LINEEnabled)
+	assert.True(t, got.EmailEnabled)
+	assert.Equal(t, ptr.Of("alice@example.com"), got.Email)
+}
+
+func TestNotificationSetting_GetRecipient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reaches line friends through LINE", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		db := newReadWriter(t)
+		endUser := createEndUser(t, db)
+		account := afixture.OAuthAccount(func(m *amodel.OAuthAccount) { m.EndUserID = endUser.UserID })
+		require.NoError(t, aquery.OAuthAccounts(db.Writer.DB).Create(t.Context(), &account))
+		friendship := model.LineFriendship{EndUserID: endUser.UserID, IsFriend: true}
+		require.NoError(t, query.LineFriendships(db.Writer.DB).Create(t.Context(), &friendship))
+
+		// act
+		sut := repository.NewNotificationSetting(db.Reader.DB)
+		got, err := sut.GetRecipient(t.Context(), endUser.UserID)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, model.NotificationRecipient{EndUserID: endUser.UserID, LINEUserID: account.UID}, got)
+	})
+
+	t.Run("skips LINE for users who are not friends", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		db := newReadWriter(t)
+		endUser := createEndUser(t, db)
+		account := afixture.OAuthAccount(func(m *amodel.OAuthAccount) { m.EndUserID = endUser.UserID })
+		require.NoError(t, aquery.OAuthAccounts(db.Writer.DB).Create(t.Context(), &account))
+		setting := model.NotificationSetting{
+			EndUserID:    endUser.UserID,
+			LINEEnabled:  true,
+			EmailEnabled: true,
+			Email:        ptr.Of("alice@example.com"),
+		}
+		require.NoError(t, query.NotificationSettings(db.Writer.DB).Create(t.Context(), &setting))
+
+		// act
+		sut := repository.NewNotificationSetting(db.Reader.DB)
+		got, err := sut.GetRecipient(t.Context(), endUser.UserID)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, model.NotificationRecipient{EndUserID: endUser.UserID, Email: "alice@example.com"}, got)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		db := newReadWriter(t)
+
+		// act
+		sut := repository.NewNotificationSetting(db.Reader.DB)
+		_, err := sut.GetRecipient(t.Context(), "nonexistent")
+
+		// assert
+		require.ErrorIs(t, err, database.ErrNotFound)
+	})
+}
+
+func createEndUser(t *testing.T, db *database.ReadWriter) model.EndUser {
+	t.Helper()
+
+	user := fixture.User(nil)
+	require.NoError(t, query.Users(db.Writer.DB).Create(t.Context(), &user))
+	endUser := fixture.EndUser(func(m *model.EndUser) { m.UserID = user.ID })
+	require.NoError(t, query.EndUsers(db.Writer.DB).Create(t.Context(), &endUser))
+	return endUser
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 
 	"github.com/mickamy/errx"
 
@@ -16,6 +17,8 @@ type GetMeInput struct{}
 
 type GetMeOutput struct {
 	User model.EndUser
+	// LineFriendship is nil until LINE has told us whether the user added the official account.
+	LineFriendship *model.LineFriendship
 }
 
 type GetMe interface {
@@ -23,10 +26,11 @@ type GetMe interface {
 }
 
 type getMe struct {
-	_           GetMe              `inject:"returns"`
-	_           *di.Infra          `inject:"param"`
-	reader      *database.Reader   `inject:""`
-	endUserRepo repository.EndUser `inject:""`
+	_                  GetMe                     `inject:"returns"`
+	_                  *di.Infra                 `inject:"param"`
+	reader             *database.Reader          `inject:""`
+	endUserRepo        repository.EndUser        `inject:""`
+	lineFriendshipRepo repository.LineFriendship `inject:""`
 }
 
 func (uc *getMe) Do(
@@ -36,6 +40,7 @@ func (uc *getMe) Do(
 	userID := contexts.MustAuthenticatedUserID(ctx)
 
 	var endUser model.EndUser
+	var friendship *model.LineFriendship
 	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
 		var err error
 		endUser, err = uc.endUserRepo.WithTx(tx).Get(ctx, userID)
@@ -43,11 +48,20 @@ func (uc *getMe) Do(
 			return errx.Wrap(err, "message", "failed to get end user", "user_id", userID).
 				WithCode(errx.Internal)
 		}
+
+		f, err := uc.lineFriendshipRepo.WithTx(tx).Get(ctx, userID)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return errx.Wrap(err, "message", "failed to get line friendship", "user_id", userID).
+				WithCode(errx.Internal)
+		}
+		if err == nil {
+			friendship = &f
+		}
 		return nil
 	}); err != nil {
 		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
 		return GetMeOutput{}, err
 	}
 
-	return GetMeOutput{User: endUser}, nil
+	return GetMeOutput{User: endUser, LineFriendship: friendship}, nil
 }
@@ -38,5 +38,29 @@ func TestGetMe_Do(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, user.ID, out.User.UserID)
 		assert.Equal(t, "my-slug", out.User.Slug)
+		assert.Nil(t, out.LineFriendship)
+	})
+
+	t.Run("returns line friendship", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		user := fixture.User(nil)
+		require.NoError(t, query.Users(infra.WriterDB).Create(t.Context(), &user))
+		endUser := fixture.EndUser(func(m *model.EndUser) { m.UserID = user.ID })
+		require.NoError(t, query.EndUsers(infra.WriterDB).Create(t.Context(), &endUser))
+		friendship := model.LineFriendship{EndUserID: user.ID, IsFriend: true}
+		require.NoError(t, query.LineFriendships(infra.WriterDB).Create(t.Context(), &friendship))
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), user.ID)
+
+		// act
+		sut := usecase.NewGetMe(infra)
+		out, err := sut.Do(ctx, usecase.GetMeInput{})
+
+		// assert
+		require.NoError(t, err)
+		require.NotNil(t, out.LineFriendship)
+		assert.True(t, out.LineFriendship.IsFriend)
 	})
 }
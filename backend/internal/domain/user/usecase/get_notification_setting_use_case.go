@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+type GetNotificationSettingInput struct{}
+
+type GetNotificationSettingOutput struct {
+	Setting model.NotificationSetting
+}
+
+type GetNotificationSetting interface {
+	Do(ctx context.Context, input GetNotificationSettingInput) (GetNotificationSettingOutput, error)
+}
+
+type getNotificationSetting struct {
+	_                       GetNotificationSetting         `inject:"returns"`
+	_                       *di.Infra                      `inject:"param"`
+	reader                  *database.Reader               `inject:""`
+	notificationSettingRepo repository.NotificationSetting `inject:""`
+}
+
+func (uc *getNotificationSetting) Do(
+	ctx context.Context,
+	_ GetNotificationSettingInput,
+) (GetNotificationSettingOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	var setting model.NotificationSetting
+	if err := uc.reader.Transaction(ctx, func(tx *database.DB) error {
+		var err error
+		setting, err = uc.notificationSettingRepo.WithTx(tx).Get(ctx, userID)
+		if err != nil {
+			return errx.Wrap(err, "message", "failed to get notification setting", "user_id", userID).
+				WithCode(errx.Internal)
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return GetNotificationSettingOutput{}, err
+	}
+
+	return GetNotificationSettingOutput{Setting: setting}, nil
+}
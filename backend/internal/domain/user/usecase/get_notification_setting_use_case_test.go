@@ -0,0 +1,63 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/user/fixture"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/domain/user/usecase"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+func TestGetNotificationSetting_Do(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns default when never saved", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		user := fixture.User(nil)
+		require.NoError(t, query.Users(infra.WriterDB).Create(t.Context(), &user))
+		endUser := fixture.EndUser(func(m *model.EndUser) { m.UserID = user.ID })
+		require.NoError(t, query.EndUsers(infra.WriterDB).Create(t.Context(), &endUser))
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), user.ID)
+
+		// act
+		sut := usecase.NewGetNotificationSetting(infra)
+		out, err := sut.Do(ctx, usecase.GetNotificationSettingInput{})
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, model.DefaultNotificationSetting(user.ID), out.Setting)
+	})
+
+	t.Run("returns saved setting", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		infra := newInfra(t)
+		user := fixture.User(nil)
+		require.NoError(t, query.Users(infra.WriterDB).Create(t.Context(), &user))
+		endUser := fixture.EndUser(func(m *model.EndUser) { m.UserID = user.ID })
+		require.NoError(t, query.EndUsers(infra.WriterDB).Create(t.Context(), &endUser))
+		setting := model.NotificationSetting{EndUserID: user.ID, EmailEnabled: true, Email: ptr.Of("alice@example.com")}
+		require.NoError(t, query.NotificationSettings(infra.WriterDB).Create(t.Context(), &setting))
+		ctx := contexts.SetAuthenticatedUserID(t.Context(), user.ID)
+
+		// act
+		sut := usecase.NewGetNotificationSetting(infra)
+		out, err := sut.Do(ctx, usecase.GetNotificationSettingInput{})
+
+		// assert
+		require.NoError(t, err)
+		assert.False(t, out.Setting.LINEEnabled)
+		assert.True(t, out.Setting.EmailEnabled)
+		assert.Equal(t, ptr.Of("alice@example.com"), out.Setting.Email)
+	})
+}
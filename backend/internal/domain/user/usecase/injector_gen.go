@@ -31,20 +31,44 @@ func MustNewCheckSlugAvailability(infra *di.Infra) CheckSlugAvailability {
 // NewGetMe initializes dependencies and constructs getMe.
 func NewGetMe(infra *di.Infra) GetMe {
 	endUser := repository.NewEndUser(infra.DB)
+	lineFriendship := repository.NewLineFriendship(infra.DB)
 
 	return &getMe{
-		reader:      infra.ReaderDB,
-		endUserRepo: endUser,
+		reader:             infra.ReaderDB,
+		endUserRepo:        endUser,
+		lineFriendshipRepo: lineFriendship,
 	}
 }
 
 // MustNewGetMe initializes dependencies and constructs getMe or panics on failure.
 func MustNewGetMe(infra *di.Infra) GetMe {
 	endUser := repository.NewEndUser(infra.DB)
+	lineFriendship := repository.NewLineFriendship(infra.DB)
 
 	return &getMe{
-		reader:      infra.ReaderDB,
-		endUserRepo: endUser,
+		reader:             infra.ReaderDB,
+		endUserRepo:        endUser,
+		lineFriendshipRepo: lineFriendship,
+	}
+}
+
+// NewGetNotificationSetting initializes dependencies and constructs getNotificationSetting.
+func NewGetNotificationSetting(infra *di.Infra) GetNotificationSetting {
+	notificationSetting := repository.NewNotificationSetting(infra.DB)
+
+	return &getNotificationSetting{
+		reader:                  infra.ReaderDB,
+		notificationSettingRepo: notificationSetting,
+	}
+}
+
+// MustNewGetNotificationSetting initializes dependencies and constructs getNotificationSetting or panics on failure.
+func MustNewGetNotificationSetting(infra *di.Infra) GetNotificationSetting {
+	notificationSetting := repository.NewNotificationSetting(infra.DB)
+
+	return &getNotificationSetting{
+		reader:                  infra.ReaderDB,
+		notificationSettingRepo: notificationSetting,
 	}
 }
 
@@ -116,6 +140,26 @@ func MustNewSavePaymentMethods(infra *di.Infra) SavePaymentMethods {
 	}
 }
 
+// NewUpdateNotificationSetting initializes dependencies and constructs updateNotificationSetting.
+func NewUpdateNotificationSetting(infra *di.Infra) UpdateNotificationSetting {
+	notificationSetting := repository.NewNotificationSetting(infra.DB)
+
+	return &updateNotificationSetting{
+		writer:                  infra.WriterDB,
+		notificationSettingRepo: notificationSetting,
+	}
+}
+
+// MustNewUpdateNotificationSetting initializes dependencies and constructs updateNotificationSetting or panics on failure.
+func MustNewUpdateNotificationSetting(infra *di.Infra) UpdateNotificationSetting {
+	notificationSetting := repository.NewNotificationSetting(infra.DB)
+
+	return &updateNotificationSetting{
+		writer:                  infra.WriterDB,
+		notificationSettingRepo: notificationSetting,
+	}
+}
+
 // NewUpdateSlug initializes dependencies and constructs updateSlug.
 func NewUpdateSlug(infra *di.Infra) UpdateSlug {
 	endUser := repository.NewEndUser(infra.DB)
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mickamy/errx"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/repository"
+	"github.com/mickamy/sampay/internal/infra/storage/database"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+type UpdateNotificationSettingInput struct {
+	LINEEnabled  bool
+	EmailEnabled bool
+	// Email is kept while email notifications are off, so they can be turned back on; empty clears it.
+	Email string
+}
+
+type UpdateNotificationSettingOutput struct {
+	Setting model.NotificationSetting
+}
+
+type UpdateNotificationSetting interface {
+	Do(ctx context.Context, input UpdateNotificationSettingInput) (UpdateNotificationSettingOutput, error)
+}
+
+type updateNotificationSetting struct {
+	_                       UpdateNotificationSetting      `inject:"returns"`
+	_                       *di.Infra                      `inject:"param"`
+	writer                  *database.Writer               `inject:""`
+	notificationSettingRepo repository.NotificationSetting `inject:""`
+}
+
+func (uc *updateNotificationSetting) Do(
+	ctx context.Context,
+	input UpdateNotificationSettingInput,
+) (UpdateNotificationSettingOutput, error) {
+	userID := contexts.MustAuthenticatedUserID(ctx)
+
+	setting := model.NotificationSetting{
+		EndUserID:    userID,
+		LINEEnabled:  input.LINEEnabled,
+		EmailEnabled: input.EmailEnabled,
+	}
+	if input.Email != "" {
+		setting.Email = &input.Email
+	}
+	if err := setting.Validate(); err != nil {
+		return UpdateNotificationSettingOutput{}, errx.Wrap(err, "message", "notification setting validation failed").
+			WithCode(errx.InvalidArgument)
+	}
+
+	if err := uc.writer.Transaction(ctx, func(tx *database.DB) error {
+		if err := uc.notificationSettingRepo.WithTx(tx).Upsert(ctx, &setting); err != nil {
+			return errx.Wrap(err, "message", "failed to save notification setting", "user_id", userID).
+				WithCode(errx.Internal)
+		}
+		return nil
+	}); err != nil {
+		//nolint:wrapcheck // errors from transaction callback are already wrapped inside
+		return UpdateNotificationSettingOutput{}, err
+	}
+
+	return UpdateNotificationSettingOutput{Setting: setting}, nil
+}
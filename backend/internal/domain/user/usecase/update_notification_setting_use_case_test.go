@@ -0,0 +1,83 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/mickamy/errx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/domain/user/fixture"
+	"github.com/mickamy/sampay/internal/domain/user/model"
+	"github.com/mickamy/sampay/internal/domain/user/query"
+	"github.com/mickamy/sampay/internal/domain/user/usecase"
+	"github.com/mickamy/sampay/internal/lib/ptr"
+	"github.com/mickamy/sampay/internal/misc/contexts"
+)
+
+func TestUpdateNotificationSetting_Do(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    usecase.UpdateNotificationSettingInput
+		wantErr  bool
+		wantMail *string
+	}{
+		{
+			name:     "enables email",
+			input:    usecase.UpdateNotificationSettingInput{LINEEnabled: true, EmailEnabled: true, Email: "a@example.com"},
+			wantMail: ptr.Of("a@example.com"),
+		},
+		{
+			name:     "keeps address while email is off",
+			input:    usecase.UpdateNotificationSettingInput{LINEEnabled: true, Email: "a@example.com"},
+			wantMail: ptr.Of("a@example.com"),
+		},
+		{
+			name:  "clears address",
+			input: usecase.UpdateNotificationSettingInput{LINEEnabled: true},
+		},
+		{
+			name:    "rejects email without address",
+			input:   usecase.UpdateNotificationSettingInput{EmailEnabled: true},
+			wantErr: true,
+		},
+		{
+			name:    "rejects invalid address",
+			input:   usecase.UpdateNotificationSettingInput{EmailEnabled: true, Email: "not-an-address"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// arrange
+			infra := newInfra(t)
+			user := fixture.User(nil)
+			require.NoError(t, query.Users(infra.WriterDB).Create(t.Context(), &user))
+			endUser := fixture.EndUser(func(m *model.EndUser) { m.UserID = user.ID })
+			require.NoError(t, query.EndUsers(infra.WriterDB).Create(t.Context(), &endUser))
+			ctx := contexts.SetAuthenticatedUserID(t.Context(), user.ID)
+
+			// act
+			sut := usecase.NewUpdateNotificationSetting(infra)
+			_, err := sut.Do(ctx, tt.input)
+
+			// assert
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errx.IsCode(err, errx.InvalidArgument))
+				return
+			}
+			require.NoError(t, err)
+			got, err := query.NotificationSettings(infra.ReaderDB).Where("end_user_id = ?", user.ID).First(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, tt.input.LINEEnabled, got.LINEEnabled)
+			assert.Equal(t, tt.input.EmailEnabled, got.EmailEnabled)
+			assert.Equal(t, tt.wantMail, got.Email)
+		})
+	}
+}
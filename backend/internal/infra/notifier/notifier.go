@@ -0,0 +1,148 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// ErrPartiallyDelivered is returned when a notification reached the user through some of its channels only.
+// Sending it again would repeat it on the channels that worked.
+var ErrPartiallyDelivered = errors.New("notifier: notification partially delivered")
+
+// Notification is a message for one sampay user, delivered however the Notifier reaches them.
+type Notification struct {
+	EndUserID string `json:"end_user_id"`
+	// LINEUserID and Email are the channels to deliver through; empty ones are skipped.
+	LINEUserID string `json:"line_user_id,omitempty"`
+	Email      string `json:"email,omitempty"`
+	Title      string `json:"title"`
+	Body       string `json:"body"`
+}
+
+// Notifier delivers notifications to users.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+type logNotifier struct{}
+
+// NewLog returns a Notifier that only writes notifications to the log.
+func NewLog() Notifier {
+	return &logNotifier{}
+}
+
+func (l *logNotifier) Notify(ctx context.Context, n Notification) error {
+	logger.Info(ctx, "notification",
+		"end_user_id", n.EndUserID,
+		"line", n.LINEUserID != "",
+		"email", n.Email != "",
+		"title", n.Title,
+		"body", n.Body,
+	)
+	return nil
+}
+
+// LINEPusher pushes messages through the official LINE account.
+type LINEPusher interface {
+	PushText(ctx context.Context, to string, texts ...string) error
+}
+
+// Mailer sends plain-text mail.
+type Mailer interface {
+	Send(ctx context.Context, to, subject, body string) error
+}
+
+type sender struct {
+	line   LINEPusher
+	mailer Mailer
+}
+
+// NewSender returns a Notifier that delivers through LINE and email, to whichever the notification addresses.
+func NewSender(line LINEPusher, mailer Mailer) Notifier {
+	return &sender{line: line, mailer: mailer}
+}
+
+// Notify delivers through every channel the notification addresses, keeping on when one fails.
+// It returns ErrPartiallyDelivered along with the failures when another channel worked.
+func (s *sender) Notify(ctx context.Context, n Notification) error {
+	var errs []error
+	delivered := 0
+	if n.LINEUserID != "" {
+		if err := s.line.PushText(ctx, n.LINEUserID, n.Title+"\n\n"+n.Body); err != nil {
+			errs = append(errs, fmt.Errorf("notifier: failed to push to LINE: %w", err))
+		} else {
+			delivered++
+		}
+	}
+	if n.Email != "" {
+		if err := s.mailer.Send(ctx, n.Email, n.Title, n.Body); err != nil {
+			errs = append(errs, fmt.Errorf("notifier: failed to send email: %w", err))
+		} else {
+			delivered++
+		}
+	}
+	if len(errs) > 0 && delivered > 0 {
+		errs = append(errs, ErrPartiallyDelivered)
+	}
+	return errors.Join(errs...)
+}
+
+type fileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFile returns a Notifier that appends notifications to the file at path, one JSON object per line.
+func NewFile(path string) Notifier {
+	return &fileNotifier{path: path}
+}
+
+func (f *fileNotifier) Notify(_ context.Context, n Notification) error {
+	line, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("notifier: failed to encode notification: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("notifier: failed to open %s: %w", f.path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("notifier: failed to write %s: %w", f.path, err)
+	}
+	return nil
+}
+
+// Recorder keeps notifications in memory, for tests.
+type Recorder struct {
+	mu            sync.Mutex
+	notifications []Notification
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Notify(_ context.Context, n Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.notifications = append(r.notifications, n)
+	return nil
+}
+
+func (r *Recorder) Notifications() []Notification {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Notification(nil), r.notifications...)
+}
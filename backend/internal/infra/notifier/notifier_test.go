@@ -0,0 +1,140 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/infra/notifier"
+)
+
+func TestFile_Notify(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	sut := notifier.NewFile(path)
+
+	first := notifier.Notification{EndUserID: "u1", Title: "飲み会", Body: "未払い（1人）: 太郎"}
+	second := notifier.Notification{
+		EndUserID:  "u2",
+		LINEUserID: "U2",
+		Email:      "u2@example.com",
+		Title:      "BBQ",
+		Body:       "line one\nline two",
+	}
+	require.NoError(t, sut.Notify(t.Context(), first))
+	require.NoError(t, sut.Notify(t.Context(), second))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var got notifier.Notification
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	assert.Equal(t, first, got)
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &got))
+	assert.Equal(t, second, got)
+}
+
+type pushed struct {
+	to    string
+	texts []string
+}
+
+type linePusher struct {
+	pushed []pushed
+	err    error
+}
+
+func (p *linePusher) PushText(_ context.Context, to string, texts ...string) error {
+	p.pushed = append(p.pushed, pushed{to: to, texts: texts})
+	return p.err
+}
+
+type sent struct {
+	to, subject, body string
+}
+
+type mailer struct {
+	sent []sent
+}
+
+func (m *mailer) Send(_ context.Context, to, subject, body string) error {
+	m.sent = append(m.sent, sent{to: to, subject: subject, body: body})
+	return nil
+}
+
+func TestSender_Notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("delivers to every channel set", func(t *testing.T) {
+		t.Parallel()
+
+		line, mail := &linePusher{}, &mailer{}
+		sut := notifier.NewSender(line, mail)
+
+		err := sut.Notify(t.Context(), notifier.Notification{
+			EndUserID:  "u1",
+			LINEUserID: "U1",
+			Email:      "u1@example.com",
+			Title:      "title",
+			Body:       "body",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []pushed{{to: "U1", texts: []string{"title\n\nbody"}}}, line.pushed)
+		assert.Equal(t, []sent{{to: "u1@example.com", subject: "title", body: "body"}}, mail.sent)
+	})
+
+	t.Run("skips channels not set", func(t *testing.T) {
+		t.Parallel()
+
+		line, mail := &linePusher{}, &mailer{}
+		sut := notifier.NewSender(line, mail)
+
+		err := sut.Notify(t.Context(), notifier.Notification{EndUserID: "u1", Title: "title", Body: "body"})
+
+		require.NoError(t, err)
+		assert.Empty(t, line.pushed)
+		assert.Empty(t, mail.sent)
+	})
+
+	t.Run("keeps delivering when a channel fails", func(t *testing.T) {
+		t.Parallel()
+
+		line, mail := &linePusher{err: errors.New("not a friend")}, &mailer{}
+		sut := notifier.NewSender(line, mail)
+
+		err := sut.Notify(t.Context(), notifier.Notification{
+			EndUserID:  "u1",
+			LINEUserID: "U1",
+			Email:      "u1@example.com",
+			Title:      "title",
+			Body:       "body",
+		})
+
+		require.ErrorIs(t, err, notifier.ErrPartiallyDelivered)
+		assert.Len(t, mail.sent, 1)
+	})
+
+	t.Run("fails without partial delivery when every channel fails", func(t *testing.T) {
+		t.Parallel()
+
+		line, mail := &linePusher{err: errors.New("not a friend")}, &mailer{}
+		sut := notifier.NewSender(line, mail)
+
+		err := sut.Notify(t.Context(), notifier.Notification{EndUserID: "u1", LINEUserID: "U1", Title: "title"})
+
+		require.Error(t, err)
+		require.NotErrorIs(t, err, notifier.ErrPartiallyDelivered)
+		assert.Empty(t, mail.sent)
+	})
+}
@@ -0,0 +1,95 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mickamy/go-sqs-worker/message"
+	"github.com/mickamy/go-sqs-worker/producer"
+)
+
+// Queue hands jobs to the worker.
+type Queue interface {
+	Enqueue(ctx context.Context, jobType fmt.Stringer, payload any) error
+	// EnqueueEncoded enqueues a payload that is already JSON under a message ID derived from key,
+	// so the worker skips a redelivered key it has already processed.
+	EnqueueEncoded(ctx context.Context, key string, jobType string, payload []byte) error
+}
+
+type sqsQueue struct {
+	producer *producer.Producer
+}
+
+// NewSQS returns a Queue backed by the worker's SQS queue.
+func NewSQS(p *producer.Producer) Queue {
+	return &sqsQueue{producer: p}
+}
+
+func (q *sqsQueue) Enqueue(ctx context.Context, jobType fmt.Stringer, payload any) error {
+	msg, err := message.New(ctx, jobType, payload)
+	if err != nil {
+		return fmt.Errorf("queue: failed to create message: %w", err)
+	}
+	if err := q.producer.Do(ctx, msg); err != nil {
+		return fmt.Errorf("queue: failed to enqueue %s: %w", jobType, err)
+	}
+	return nil
+}
+
+func (q *sqsQueue) EnqueueEncoded(ctx context.Context, key string, jobType string, payload []byte) error {
+	now := time.Now()
+	msg := message.Message{
+		ID:        uuid.NewSHA1(uuid.NameSpaceOID, []byte(key)),
+		Type:      jobType,
+		Payload:   string(payload),
+		Status:    message.Queued,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := q.producer.Do(ctx, msg); err != nil {
+		return fmt.Errorf("queue: failed to enqueue %s: %w", jobType, err)
+	}
+	return nil
+}
+
+// Job is a job captured by Recorder.
+type Job struct {
+	// Key is set for jobs enqueued with EnqueueEncoded.
+	Key     string
+	Type    string
+	Payload any
+}
+
+// Recorder keeps jobs in memory instead of sending them anywhere, for tests.
+type Recorder struct {
+	mu   sync.Mutex
+	jobs []Job
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) Enqueue(_ context.Context, jobType fmt.Stringer, payload any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs = append(r.jobs, Job{Type: jobType.String(), Payload: payload})
+	return nil
+}
+
+func (r *Recorder) EnqueueEncoded(_ context.Context, key string, jobType string, payload []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs = append(r.jobs, Job{Key: key, Type: jobType, Payload: json.RawMessage(payload)})
+	return nil
+}
+
+func (r *Recorder) Jobs() []Job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Job(nil), r.jobs...)
+}
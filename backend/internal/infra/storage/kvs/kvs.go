@@ -222,6 +222,45 @@ func (c *KVS) Set(ctx context.Context, key string, value string, exp time.Durati
 	return nil
 }
 
+// SetNX sets key only when it does not exist yet, and reports whether it did.
+func (c *KVS) SetNX(ctx context.Context, key string, value string, exp time.Duration) (bool, error) {
+	err := c.client.Do(ctx, c.client.B().Set().Key(key).Value(value).Nx().Px(exp).Build()).Error()
+	if valkey.IsValkeyNil(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to set key %s if not exists: %w", key, err)
+	}
+	return true, nil
+}
+
+// TTL returns how long key has left to live, ErrKeyNotFound when it does not exist and zero when it never expires.
+func (c *KVS) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ms, err := c.client.Do(ctx, c.client.B().Pttl().Key(key).Build()).AsInt64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ttl of key %s: %w", key, err)
+	}
+	switch ms {
+	case -2:
+		return 0, ErrKeyNotFound
+	case -1:
+		return 0, nil
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// GetDel atomically returns the value of key and deletes it, so that the value can be consumed only once.
+func (c *KVS) GetDel(ctx context.Context, key string) (string, error) {
+	v, err := c.client.Do(ctx, c.client.B().Getdel().Key(key).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return "", ErrKeyNotFound
+		}
+		return "", fmt.Errorf("failed to getdel key %s: %w", key, err)
+	}
+	return v, nil
+}
+
 func (c *KVS) Del(ctx context.Context, keys ...string) error {
 	if err := c.client.Do(ctx, c.client.B().Del().Key(keys...).Build()).Error(); err != nil {
 		return fmt.Errorf("failed to delete keys: %w", err)
@@ -229,6 +268,33 @@ func (c *KVS) Del(ctx context.Context, keys ...string) error {
 	return nil
 }
 
+// SAdd adds members to the set stored at key and (re)sets its expiration.
+func (c *KVS) SAdd(ctx context.Context, key string, exp time.Duration, members ...string) error {
+	if err := c.client.Do(ctx, c.client.B().Sadd().Key(key).Member(members...).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to add members to set %s: %w", key, err)
+	}
+	expire := c.client.B().Pexpire().Key(key).Milliseconds(exp.Milliseconds()).Build()
+	if err := c.client.Do(ctx, expire).Error(); err != nil {
+		return fmt.Errorf("failed to set expiration of set %s: %w", key, err)
+	}
+	return nil
+}
+
+func (c *KVS) SMembers(ctx context.Context, key string) ([]string, error) {
+	members, err := c.client.Do(ctx, c.client.B().Smembers().Key(key).Build()).AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of set %s: %w", key, err)
+	}
+	return members, nil
+}
+
+func (c *KVS) SRem(ctx context.Context, key string, members ...string) error {
+	if err := c.client.Do(ctx, c.client.B().Srem().Key(key).Member(members...).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to remove members from set %s: %w", key, err)
+	}
+	return nil
+}
+
 func (c *KVS) Exists(ctx context.Context, keys ...string) (bool, error) {
 	n, err := c.client.Do(ctx, c.client.B().Exists().Key(keys...).Build()).AsInt64()
 	if err != nil {
@@ -49,6 +49,55 @@ func TestGet_KeyNotFound(t *testing.T) {
 	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
 }
 
+func TestGetDel(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	k := itest.NewKVS(t)
+
+	require.NoError(t, k.Set(ctx, "key1", "once", time.Minute))
+
+	got, err := k.GetDel(ctx, "key1")
+	require.NoError(t, err)
+	assert.Equal(t, "once", got)
+
+	// second call: the key has already been consumed
+	_, err = k.GetDel(ctx, "key1")
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
+}
+
+func TestTTL(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	k := itest.NewKVS(t)
+
+	require.NoError(t, k.Set(ctx, "key1", "value", time.Minute))
+
+	got, err := k.TTL(ctx, "key1")
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute, got, float64(time.Second))
+
+	_, err = k.TTL(ctx, "nonexistent")
+	assert.ErrorIs(t, err, kvs.ErrKeyNotFound)
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	k := itest.NewKVS(t)
+
+	require.NoError(t, k.SAdd(ctx, "set1", time.Minute, "a", "b"))
+	require.NoError(t, k.SAdd(ctx, "set1", time.Minute, "b", "c"))
+	require.NoError(t, k.SRem(ctx, "set1", "a"))
+
+	got, err := k.SMembers(ctx, "set1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"b", "c"}, got)
+
+	empty, err := k.SMembers(ctx, "nonexistent")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
 type testData struct {
 	Name  string `json:"name"`
 	Value int    `json:"value"`
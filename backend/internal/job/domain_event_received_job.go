@@ -0,0 +1,27 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mickamy/go-sqs-worker/job"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// domainEventReceived only records a domain event relayed from the outbox.
+// Features reacting to an event replace it with a job of their own.
+type domainEventReceived struct {
+	eventType model.DomainEventType
+}
+
+func (j *domainEventReceived) Execute(ctx context.Context, payloadStr string) error {
+	if !json.Valid([]byte(payloadStr)) {
+		return fmt.Errorf("%w: invalid %s payload", job.ErrNonRetryable, j.eventType)
+	}
+
+	logger.Info(ctx, "received domain event", "type", j.eventType, "payload", payloadStr)
+	return nil
+}
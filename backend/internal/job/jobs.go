@@ -6,9 +6,32 @@ import (
 	"sort"
 
 	"github.com/mickamy/go-sqs-worker/job"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
 )
 
 type Jobs struct {
+	LINEMessageReceived  job.Job
+	SendPaymentReminders job.Job
+	ParticipantJoined    job.Job
+	PaymentClaimed       job.Job
+	PaymentConfirmed     job.Job
+	EventArchived        job.Job
+	TotalFinalized       job.Job
+}
+
+func NewJobs(infra *di.Infra) *Jobs {
+	return &Jobs{
+		LINEMessageReceived:  &lineMessageReceived{},
+		SendPaymentReminders: &sendPaymentReminders{uc: usecase.NewSendPaymentReminders(infra)},
+		ParticipantJoined:    &domainEventReceived{eventType: model.DomainEventParticipantJoined},
+		PaymentClaimed:       &paymentClaimed{uc: usecase.NewNotifyPaymentClaimed(infra)},
+		PaymentConfirmed:     &domainEventReceived{eventType: model.DomainEventPaymentConfirmed},
+		EventArchived:        &domainEventReceived{eventType: model.DomainEventEventArchived},
+		TotalFinalized:       &totalFinalized{uc: usecase.NewNotifyTotalFinalized(infra)},
+	}
 }
 
 //go:generate go tool stringer -type=Type
@@ -16,6 +39,14 @@ type Type int
 
 const (
 	first Type = iota
+	LINEMessageReceived
+	SendPaymentReminders
+	// domain events relayed from the outbox; the names match model.DomainEventType
+	ParticipantJoined
+	PaymentClaimed
+	PaymentConfirmed
+	EventArchived
+	TotalFinalized
 	last
 )
 
@@ -33,6 +64,20 @@ func Get(s string, jobs *Jobs) (job.Job, error) {
 	switch types[idx] {
 	case first, last:
 		return nil, errors.New("type `first` and `last` should not be used")
+	case LINEMessageReceived:
+		return jobs.LINEMessageReceived, nil
+	case SendPaymentReminders:
+		return jobs.SendPaymentReminders, nil
+	case ParticipantJoined:
+		return jobs.ParticipantJoined, nil
+	case PaymentClaimed:
+		return jobs.PaymentClaimed, nil
+	case PaymentConfirmed:
+		return jobs.PaymentConfirmed, nil
+	case EventArchived:
+		return jobs.EventArchived, nil
+	case TotalFinalized:
+		return jobs.TotalFinalized, nil
 	default:
 		return nil, fmt.Errorf("unknown job type: [%s]", types[idx])
 	}
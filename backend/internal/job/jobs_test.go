@@ -0,0 +1,44 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/di"
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/job"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	jobs := job.NewJobs(&di.Infra{})
+
+	got, err := job.Get(job.LINEMessageReceived.String(), jobs)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.LINEMessageReceived, got)
+
+	got, err = job.Get(job.SendPaymentReminders.String(), jobs)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.SendPaymentReminders, got)
+
+	// outbox messages are delivered under their domain event type
+	for _, eventType := range []model.DomainEventType{
+		model.DomainEventParticipantJoined,
+		model.DomainEventPaymentClaimed,
+		model.DomainEventPaymentConfirmed,
+		model.DomainEventEventArchived,
+		model.DomainEventTotalFinalized,
+	} {
+		got, err := job.Get(eventType.String(), jobs)
+		require.NoError(t, err, eventType)
+		assert.NotNil(t, got, eventType)
+	}
+
+	for _, s := range []string{"first", "last", "unknown"} {
+		_, err := job.Get(s, jobs)
+		assert.Error(t, err, s)
+	}
+}
@@ -0,0 +1,39 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mickamy/go-sqs-worker/job"
+
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// LINEMessageReceivedPayload is a message a user sent to the official LINE account.
+type LINEMessageReceivedPayload struct {
+	WebhookEventID string `json:"webhook_event_id"`
+	LINEUserID     string `json:"line_user_id"`
+	// EndUserID is empty when the sender has never logged in to sampay.
+	EndUserID   string `json:"end_user_id"`
+	MessageType string `json:"message_type"`
+	Text        string `json:"text"`
+	ReplyToken  string `json:"reply_token"`
+}
+
+type lineMessageReceived struct{}
+
+// Execute only records the message for now; the official account does not chat back yet.
+func (j *lineMessageReceived) Execute(ctx context.Context, payloadStr string) error {
+	var payload LINEMessageReceivedPayload
+	if err := json.Unmarshal([]byte(payloadStr), &payload); err != nil {
+		return fmt.Errorf("%w: failed to decode payload: %w", job.ErrNonRetryable, err)
+	}
+
+	logger.Info(ctx, "received LINE message",
+		"webhook_event_id", payload.WebhookEventID,
+		"end_user_id", payload.EndUserID,
+		"message_type", payload.MessageType,
+	)
+	return nil
+}
@@ -0,0 +1,39 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mickamy/go-sqs-worker/job"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// paymentClaimed tells the organizer that a participant says they have paid.
+type paymentClaimed struct {
+	uc usecase.NotifyPaymentClaimed
+}
+
+func (j *paymentClaimed) Execute(ctx context.Context, payloadStr string) error {
+	var claim model.PaymentClaimed
+	if err := json.Unmarshal([]byte(payloadStr), &claim); err != nil {
+		return fmt.Errorf("%w: invalid %s payload: %w", job.ErrNonRetryable, model.DomainEventPaymentClaimed, err)
+	}
+
+	out, err := j.uc.Do(ctx, usecase.NotifyPaymentClaimedInput{Claim: claim})
+	if errors.Is(err, notifier.ErrPartiallyDelivered) {
+		// retrying would send the organizer the message again on the channels that worked
+		return fmt.Errorf("%w: notified payment claim only partially: %w", job.ErrNonRetryable, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to notify payment claim: %w", err)
+	}
+
+	logger.Info(ctx, "handled payment claim", "participant_id", claim.ParticipantID, "notified", out.Notified)
+	return nil
+}
@@ -0,0 +1,31 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mickamy/sampay/config"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// SendPaymentRemindersPayload carries nothing; the job looks up whatever is due when it runs.
+type SendPaymentRemindersPayload struct{}
+
+type sendPaymentReminders struct {
+	uc usecase.SendPaymentReminders
+}
+
+func (j *sendPaymentReminders) Execute(ctx context.Context, _ string) error {
+	out, err := j.uc.Do(ctx, usecase.SendPaymentRemindersInput{
+		Now:     time.Now(),
+		Offsets: config.Reminder().Offsets,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send payment reminders: %w", err)
+	}
+
+	logger.Info(ctx, "sent payment reminders", "events", len(out.Reminded))
+	return nil
+}
@@ -0,0 +1,39 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mickamy/go-sqs-worker/job"
+
+	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/domain/event/usecase"
+	"github.com/mickamy/sampay/internal/infra/notifier"
+	"github.com/mickamy/sampay/internal/lib/logger"
+)
+
+// totalFinalized tells participants their exact amount once the organizer replaced an estimated total.
+type totalFinalized struct {
+	uc usecase.NotifyTotalFinalized
+}
+
+func (j *totalFinalized) Execute(ctx context.Context, payloadStr string) error {
+	var finalized model.TotalFinalized
+	if err := json.Unmarshal([]byte(payloadStr), &finalized); err != nil {
+		return fmt.Errorf("%w: invalid %s payload: %w", job.ErrNonRetryable, model.DomainEventTotalFinalized, err)
+	}
+
+	out, err := j.uc.Do(ctx, usecase.NotifyTotalFinalizedInput{Finalized: finalized})
+	if errors.Is(err, notifier.ErrPartiallyDelivered) {
+		// retrying would tell participants who got the message the same thing again
+		return fmt.Errorf("%w: notified finalized total only partially: %w", job.ErrNonRetryable, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to notify finalized total: %w", err)
+	}
+
+	logger.Info(ctx, "handled finalized total", "event_id", finalized.EventID, "notified", out.Notified)
+	return nil
+}
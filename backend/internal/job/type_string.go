@@ -9,12 +9,19 @@ func _() {
 	// Re-run the stringer command to generate them again.
 	var x [1]struct{}
 	_ = x[first-0]
-	_ = x[last-1]
+	_ = x[LINEMessageReceived-1]
+	_ = x[SendPaymentReminders-2]
+	_ = x[ParticipantJoined-3]
+	_ = x[PaymentClaimed-4]
+	_ = x[PaymentConfirmed-5]
+	_ = x[EventArchived-6]
+	_ = x[TotalFinalized-7]
+	_ = x[last-8]
 }
 
-const _Type_name = "firstlast"
+const _Type_name = "firstLINEMessageReceivedSendPaymentRemindersParticipantJoinedPaymentClaimedPaymentConfirmedEventArchivedTotalFinalizedlast"
 
-var _Type_index = [...]uint8{0, 5, 9}
+var _Type_index = [...]uint8{0, 5, 24, 44, 61, 75, 91, 104, 118, 122}
 
 func (i Type) String() string {
 	idx := int(i) - 0
@@ -11,6 +11,7 @@ import (
 	eventv1 "github.com/mickamy/sampay/gen/event/v1"
 	userv1 "github.com/mickamy/sampay/gen/user/v1"
 	"github.com/mickamy/sampay/internal/domain/event/model"
+	"github.com/mickamy/sampay/internal/lib/money"
 )
 
 func init() {
@@ -18,10 +19,20 @@ func init() {
 	automapper.RegisterFrom[string, *string](StringToPtr)
 	automapper.RegisterFrom[int32, int](Int32ToInt)
 	automapper.RegisterFrom[int, int32](IntToInt32)
+	automapper.RegisterFrom[*int, *int32](PtrIntToPtrInt32)
 	automapper.RegisterFrom[time.Time, *timestamppb.Timestamp](TimeToTimestamppb)
 	automapper.RegisterFrom[*time.Time, *timestamppb.Timestamp](PtrTimeToTimestamppb)
 	automapper.RegisterFrom[model.ParticipantStatus, eventv1.ParticipantStatus](ToV1ParticipantStatus)
 	automapper.RegisterFromE[eventv1.ParticipantStatus, model.ParticipantStatus](FromV1ParticipantStatus)
+	automapper.RegisterFrom[model.RemainderPolicy, eventv1.RemainderPolicy](ToV1RemainderPolicy)
+	automapper.RegisterFrom[model.Weight, string](WeightToString)
+	automapper.RegisterFrom[money.Currency, string](CurrencyToString)
+	automapper.RegisterFrom[money.Rate, string](RateToString)
+	automapper.RegisterFromE[eventv1.RemainderPolicy, model.RemainderPolicy](FromV1RemainderPolicy)
+	automapper.RegisterFrom[model.SplitMode, eventv1.SplitMode](ToV1SplitMode)
+	automapper.RegisterFromE[eventv1.SplitMode, model.SplitMode](FromV1SplitMode)
+	automapper.RegisterFrom[*model.Attendance, string](PtrAttendanceToString)
+	automapper.RegisterFrom[model.Percent, string](PercentToString)
 }
 
 func StringToPtr(s string) *string {
@@ -39,6 +50,22 @@ func IntToInt32(i int) int32 {
 	return int32(i) //nolint:gosec // values are reasonable small positive integers
 }
 
+func PtrIntToPtrInt32(i *int) *int32 {
+	if i == nil {
+		return nil
+	}
+	v := IntToInt32(*i)
+	return &v
+}
+
+func PtrInt32ToPtrInt(i *int32) *int {
+	if i == nil {
+		return nil
+	}
+	v := Int32ToInt(*i)
+	return &v
+}
+
 func TimeToTimestamppb(t time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(t)
 }
@@ -50,6 +77,14 @@ func PtrTimeToTimestamppb(t *time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(*t)
 }
 
+func TimestamppbToPtrTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
 func ToV1ParticipantStatus(s model.ParticipantStatus) eventv1.ParticipantStatus {
 	switch s {
 	case model.ParticipantStatusUnpaid:
@@ -58,6 +93,10 @@ func ToV1ParticipantStatus(s model.ParticipantStatus) eventv1.ParticipantStatus
 		return eventv1.ParticipantStatus_PARTICIPANT_STATUS_CLAIMED
 	case model.ParticipantStatusConfirmed:
 		return eventv1.ParticipantStatus_PARTICIPANT_STATUS_CONFIRMED
+	case model.ParticipantStatusWaitlisted:
+		return eventv1.ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED
+	case model.ParticipantStatusPartiallyPaid:
+		return eventv1.ParticipantStatus_PARTICIPANT_STATUS_PARTIALLY_PAID
 	default:
 		return eventv1.ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED
 	}
@@ -71,9 +110,117 @@ func FromV1ParticipantStatus(s eventv1.ParticipantStatus) (model.ParticipantStat
 		return model.ParticipantStatusClaimed, nil
 	case eventv1.ParticipantStatus_PARTICIPANT_STATUS_CONFIRMED:
 		return model.ParticipantStatusConfirmed, nil
+	case eventv1.ParticipantStatus_PARTICIPANT_STATUS_WAITLISTED:
+		return model.ParticipantStatusWaitlisted, nil
+	case eventv1.ParticipantStatus_PARTICIPANT_STATUS_PARTIALLY_PAID:
+		return model.ParticipantStatusPartiallyPaid, nil
 	case eventv1.ParticipantStatus_PARTICIPANT_STATUS_UNSPECIFIED:
 		return "", errors.New("unspecified participant status")
 	default:
 		return "", fmt.Errorf("unknown participant status: %v", s)
 	}
 }
+
+func ToV1ParticipantStatusActor(a model.ParticipantStatusActor) eventv1.ParticipantStatusActor {
+	switch a {
+	case model.ParticipantStatusActorOrganizer:
+		return eventv1.ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_ORGANIZER
+	case model.ParticipantStatusActorParticipant:
+		return eventv1.ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_PARTICIPANT
+	case model.ParticipantStatusActorSystem:
+		return eventv1.ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_SYSTEM
+	default:
+		return eventv1.ParticipantStatusActor_PARTICIPANT_STATUS_ACTOR_UNSPECIFIED
+	}
+}
+
+func ToV1RemainderPolicy(p model.RemainderPolicy) eventv1.RemainderPolicy {
+	switch p {
+	case model.RemainderPolicyOrganizer:
+		return eventv1.RemainderPolicy_REMAINDER_POLICY_ORGANIZER
+	case model.RemainderPolicyRoundUp10:
+		return eventv1.RemainderPolicy_REMAINDER_POLICY_ROUND_UP_10
+	case model.RemainderPolicyRoundUp100:
+		return eventv1.RemainderPolicy_REMAINDER_POLICY_ROUND_UP_100
+	case model.RemainderPolicyRoundUp500:
+		return eventv1.RemainderPolicy_REMAINDER_POLICY_ROUND_UP_500
+	case model.RemainderPolicyDistribute:
+		return eventv1.RemainderPolicy_REMAINDER_POLICY_DISTRIBUTE
+	default:
+		return eventv1.RemainderPolicy_REMAINDER_POLICY_UNSPECIFIED
+	}
+}
+
+// FromV1RemainderPolicy treats UNSPECIFIED as ORGANIZER, which is how events behaved before the policy existed.
+func FromV1RemainderPolicy(p eventv1.RemainderPolicy) (model.RemainderPolicy, error) {
+	switch p {
+	case eventv1.RemainderPolicy_REMAINDER_POLICY_UNSPECIFIED, eventv1.RemainderPolicy_REMAINDER_POLICY_ORGANIZER:
+		return model.RemainderPolicyOrganizer, nil
+	case eventv1.RemainderPolicy_REMAINDER_POLICY_ROUND_UP_10:
+		return model.RemainderPolicyRoundUp10, nil
+	case eventv1.RemainderPolicy_REMAINDER_POLICY_ROUND_UP_100:
+		return model.RemainderPolicyRoundUp100, nil
+	case eventv1.RemainderPolicy_REMAINDER_POLICY_ROUND_UP_500:
+		return model.RemainderPolicyRoundUp500, nil
+	case eventv1.RemainderPolicy_REMAINDER_POLICY_DISTRIBUTE:
+		return model.RemainderPolicyDistribute, nil
+	default:
+		return "", fmt.Errorf("unknown remainder policy: %v", p)
+	}
+}
+
+func ToV1SplitMode(m model.SplitMode) eventv1.SplitMode {
+	switch m {
+	case model.SplitModeTier:
+		return eventv1.SplitMode_SPLIT_MODE_TIER
+	case model.SplitModeAttendance:
+		return eventv1.SplitMode_SPLIT_MODE_ATTENDANCE
+	case model.SplitModeAttendanceTier:
+		return eventv1.SplitMode_SPLIT_MODE_ATTENDANCE_TIER
+	default:
+		return eventv1.SplitMode_SPLIT_MODE_UNSPECIFIED
+	}
+}
+
+// FromV1SplitMode treats UNSPECIFIED as TIER, which is how events were split before the mode existed.
+func FromV1SplitMode(m eventv1.SplitMode) (model.SplitMode, error) {
+	switch m {
+	case eventv1.SplitMode_SPLIT_MODE_UNSPECIFIED, eventv1.SplitMode_SPLIT_MODE_TIER:
+		return model.SplitModeTier, nil
+	case eventv1.SplitMode_SPLIT_MODE_ATTENDANCE:
+		return model.SplitModeAttendance, nil
+	case eventv1.SplitMode_SPLIT_MODE_ATTENDANCE_TIER:
+		return model.SplitModeAttendanceTier, nil
+	default:
+		return "", fmt.Errorf("unknown split mode: %v", m)
+	}
+}
+
+func PtrAttendanceToString(a *model.Attendance) string {
+	if a == nil {
+		return ""
+	}
+	return a.String()
+}
+
+func PercentToString(p model.Percent) string {
+	if p == 0 {
+		return ""
+	}
+	return p.String()
+}
+
+func WeightToString(w model.Weight) string {
+	return w.String()
+}
+
+func CurrencyToString(c money.Currency) string {
+	return string(c)
+}
+
+func RateToString(r money.Rate) string {
+	if r == 0 {
+		return ""
+	}
+	return r.String()
+}
@@ -8,6 +8,7 @@ import (
 var (
 	ErrNoAccessToken  = errors.New("cookie: no access token")
 	ErrNoRefreshToken = errors.New("cookie: no refresh token")
+	ErrNoOAuthState   = errors.New("cookie: no oauth state")
 )
 
 func ParseAccessToken(cookie string) (string, error) {
@@ -28,6 +29,15 @@ func ParseRefreshToken(cookie string) (string, error) {
 	return token, nil
 }
 
+func ParseOAuthState(cookie string) (string, error) {
+	state := parse(cookie, "oauth_state")
+	if state == "" {
+		return "", ErrNoOAuthState
+	}
+
+	return state, nil
+}
+
 func parse(cookie string, key string) string {
 	parts := strings.Split(cookie, ";")
 	cookieKey := key + "="
@@ -8,6 +8,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/mickamy/sampay/config"
+	"github.com/mickamy/sampay/internal/lib/ulid"
 )
 
 const (
@@ -17,7 +18,8 @@ const (
 	accessTokenExpiresIn  = time.Hour
 	refreshTokenExpiresIn = week * 2
 
-	idKey = "id"
+	idKey  = "id"
+	jtiKey = "jti"
 )
 
 var (
@@ -62,9 +64,20 @@ func New(id string) (Tokens, error) {
 	return Tokens{Access: access, Refresh: refresh}, nil
 }
 
+func MustNew(id string) Tokens {
+	tokens, err := New(id)
+	if err != nil {
+		panic(err)
+	}
+	return tokens
+}
+
 func generateToken(id string, expiresIn time.Duration) (Token, error) {
 	claims := jwt.MapClaims{}
 	claims[idKey] = id
+	// jti keeps tokens issued for the same user within the same second distinct,
+	// which refresh token rotation relies on.
+	claims[jtiKey] = ulid.New()
 	exp := time.Now().Add(expiresIn)
 	claims["exp"] = exp.Unix()
 
@@ -85,3 +85,20 @@ func TestJWT_ExtractID(t *testing.T) {
 		})
 	}
 }
+
+func TestJWT_New_Unique(t *testing.T) {
+	t.Parallel()
+
+	// arrange
+	id := uuid.NewString()
+
+	// act
+	first, err := jwt.New(id)
+	require.NoError(t, err)
+	second, err := jwt.New(id)
+	require.NoError(t, err)
+
+	// assert
+	assert.NotEqual(t, first.Access.Value, second.Access.Value)
+	assert.NotEqual(t, first.Refresh.Value, second.Refresh.Value)
+}
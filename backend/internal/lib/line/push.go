@@ -0,0 +1,74 @@
+package line
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// APIBaseURL is the Messaging API origin.
+const APIBaseURL = "https://api.line.me"
+
+// Client calls the Messaging API as the official account.
+type Client struct {
+	httpClient  *http.Client
+	baseURL     string
+	accessToken string
+}
+
+func NewClient(baseURL, accessToken string) *Client {
+	return &Client{
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		baseURL:     baseURL,
+		accessToken: accessToken,
+	}
+}
+
+type pushRequest struct {
+	To       string        `json:"to"`
+	Messages []textMessage `json:"messages"`
+}
+
+type textMessage struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// PushText sends texts to the user, one message bubble each.
+// LINE rejects the push unless the user has added the official account as a friend.
+// See https://developers.line.biz/en/reference/messaging-api/#send-push-message.
+func (c *Client) PushText(ctx context.Context, to string, texts ...string) error {
+	req := pushRequest{To: to}
+	for _, text := range texts {
+		req.Messages = append(req.Messages, textMessage{Type: "text", Text: text})
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("line: failed to encode push request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, c.baseURL+"/v2/bot/message/push", bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("line: failed to create push request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	res, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("line: failed to push message: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+		return fmt.Errorf("line: push message failed with status %d: %s", res.StatusCode, detail)
+	}
+	return nil
+}
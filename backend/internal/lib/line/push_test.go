@@ -0,0 +1,62 @@
+package line_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/lib/line"
+)
+
+func TestClient_PushText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		var got struct {
+			To       string `json:"to"`
+			Messages []struct {
+				Type string `json:"type"`
+				Text string `json:"text"`
+			} `json:"messages"`
+		}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v2/bot/message/push", r.URL.Path)
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(srv.Close)
+
+		sut := line.NewClient(srv.URL, "token")
+		err := sut.PushText(t.Context(), "U123", "hello", "world")
+
+		require.NoError(t, err)
+		assert.Equal(t, "U123", got.To)
+		require.Len(t, got.Messages, 2)
+		assert.Equal(t, "text", got.Messages[0].Type)
+		assert.Equal(t, "hello", got.Messages[0].Text)
+		assert.Equal(t, "world", got.Messages[1].Text)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"Failed to send messages"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		sut := line.NewClient(srv.URL, "token")
+		err := sut.PushText(t.Context(), "U123", "hello")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "400")
+	})
+}
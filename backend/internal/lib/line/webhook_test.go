@@ -0,0 +1,76 @@
+package line_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/lib/line"
+)
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"destination":"U0123","events":[]}`)
+	signature := line.Sign("secret", body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: "secret", body: body, signature: signature, want: true},
+		{name: "wrong secret", secret: "other", body: body, signature: signature},
+		{name: "tampered body", secret: "secret", body: []byte(`{"destination":"U0124","events":[]}`), signature: signature},
+		{name: "empty signature", secret: "secret", body: body},
+		{name: "empty secret", body: body, signature: line.Sign("", body)},
+		{name: "not base64", secret: "secret", body: body, signature: "%%%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, line.VerifySignature(tt.secret, tt.body, tt.signature))
+		})
+	}
+}
+
+func TestParseWebhook(t *testing.T) {
+	t.Parallel()
+
+	body, err := os.ReadFile("testdata/webhook.json")
+	require.NoError(t, err)
+
+	got, err := line.ParseWebhook(body)
+
+	require.NoError(t, err)
+	require.Len(t, got.Events, 3)
+
+	follow := got.Events[0]
+	assert.Equal(t, line.EventTypeFollow, follow.Type)
+	assert.Equal(t, "U4af4980629abcdef0123456789abcdef", follow.Source.UserID)
+	assert.Equal(t, int64(1772323200000), follow.Time().UnixMilli())
+	assert.Nil(t, follow.Message)
+
+	message := got.Events[1]
+	assert.Equal(t, line.EventTypeMessage, message.Type)
+	assert.True(t, message.DeliveryContext.IsRedelivery)
+	require.NotNil(t, message.Message)
+	assert.Equal(t, "text", message.Message.Type)
+	assert.Equal(t, "支払いました", message.Message.Text)
+
+	assert.Equal(t, line.EventTypeUnfollow, got.Events[2].Type)
+}
+
+func TestParseWebhook_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := line.ParseWebhook([]byte("not json"))
+
+	require.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTP sends plain-text mail through an SMTP server.
+type SMTP struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTP returns an SMTP sender for the server at addr (host:port).
+// Authentication is skipped when username is empty, e.g. for a local catch-all server.
+func NewSMTP(addr, username, password, from string) *SMTP {
+	s := &SMTP{addr: addr, from: from}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		s.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return s
+}
+
+// Send delivers the mail. net/smtp has no context support, so ctx is only checked before sending.
+func (s *SMTP) Send(ctx context.Context, to, subject, body string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	msg := Compose(s.from, to, subject, body, time.Now())
+	if err := smtp.SendMail(s.addr, s.auth, s.from, []string{to}, msg); err != nil {
+		return fmt.Errorf("mail: failed to send mail: %w", err)
+	}
+	return nil
+}
+
+// Compose builds a UTF-8 plain-text message. The subject is MIME-encoded, since it is often Japanese.
+func Compose(from, to, subject, body string, date time.Time) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + from + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	b.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return []byte(b.String())
+}
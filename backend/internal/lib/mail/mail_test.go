@@ -0,0 +1,27 @@
+package mail_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mickamy/sampay/internal/lib/mail"
+)
+
+func TestCompose(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2026, 3, 1, 19, 0, 0, 0, time.UTC)
+	got := string(mail.Compose("no-reply@example.com", "taro@example.com", "支払い申告", "line 1\nline 2", date))
+
+	header, body, found := strings.Cut(got, "\r\n\r\n")
+	assert.True(t, found)
+	assert.Contains(t, header, "From: no-reply@example.com\r\n")
+	assert.Contains(t, header, "To: taro@example.com\r\n")
+	assert.Contains(t, header, "Subject: =?UTF-8?b?")
+	assert.Contains(t, header, "Date: Sun, 01 Mar 2026 19:00:00 +0000")
+	assert.Contains(t, header, "Content-Type: text/plain; charset=UTF-8")
+	assert.Equal(t, "line 1\r\nline 2", body)
+}
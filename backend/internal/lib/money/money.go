@@ -0,0 +1,140 @@
+// Package money handles amounts stored as integers in the minor unit of their currency, e.g. cents for USD.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 code. Amounts in a currency are integers in its minor unit.
+type Currency string
+
+const (
+	JPY Currency = "JPY"
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	KRW Currency = "KRW"
+)
+
+// DefaultCurrency is what amounts were in before currencies were explicit.
+const DefaultCurrency = JPY
+
+var minorDigits = map[Currency]int{
+	JPY: 0,
+	USD: 2,
+	EUR: 2,
+	KRW: 0,
+}
+
+var ErrUnsupportedCurrency = errors.New("unsupported currency")
+
+func ParseCurrency(s string) (Currency, error) {
+	c := Currency(strings.ToUpper(s))
+	if !c.IsSupported() {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedCurrency, s)
+	}
+	return c, nil
+}
+
+func (c Currency) IsSupported() bool {
+	_, ok := minorDigits[c]
+	return ok
+}
+
+// MinorDigits returns how many decimal places the currency has, e.g. 2 for USD and 0 for JPY.
+func (c Currency) MinorDigits() int {
+	return minorDigits[c]
+}
+
+// MinorScale returns how many minor units make one major unit, e.g. 100 for USD and 1 for JPY.
+func (c Currency) MinorScale() int {
+	scale := 1
+	for range c.MinorDigits() {
+		scale *= 10
+	}
+	return scale
+}
+
+// FormatNumber formats an amount in minor units as a plain number with thousands separators, e.g. "1,234.50".
+func (c Currency) FormatNumber(amount int) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	scale := c.MinorScale()
+	s := sign + groupThousands(strconv.Itoa(amount/scale))
+	if digits := c.MinorDigits(); digits > 0 {
+		s += fmt.Sprintf(".%0*d", digits, amount%scale)
+	}
+	return s
+}
+
+func groupThousands(digits string) string {
+	var b strings.Builder
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// RateScale is how many Rate units make a rate of 1.
+const RateScale = 1_000_000
+
+// Rate is how many major units of one currency a major unit of another is worth, in millionths.
+// E.g. 1 USD = 151.25 JPY is 151250000.
+type Rate int64
+
+var (
+	ErrInvalidRate = errors.New("rate must be a positive decimal with at most 6 fractional digits")
+
+	ratePattern = regexp.MustCompile(`^\d{1,9}(\.\d{1,6})?$`)
+)
+
+// ParseRate parses a decimal string such as "151.25" or "0.0066".
+func ParseRate(s string) (Rate, error) {
+	if !ratePattern.MatchString(s) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+
+	whole, frac, _ := strings.Cut(s, ".")
+	r, _ := strconv.ParseInt(whole+frac+strings.Repeat("0", 6-len(frac)), 10, 64)
+	if r <= 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+	return Rate(r), nil
+}
+
+// String formats the rate as the shortest decimal, e.g. "151.25".
+func (r Rate) String() string {
+	s := strconv.FormatInt(int64(r)/RateScale, 10)
+	if frac := int64(r) % RateScale; frac != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%06d", frac), "0")
+	}
+	return s
+}
+
+// Convert converts an amount in minor units of from into minor units of to, rounding half up.
+func (r Rate) Convert(amount int, from, to Currency) int {
+	// amount * rate / RateScale * to.MinorScale / from.MinorScale
+	num := new(big.Int).Mul(big.NewInt(int64(amount)), big.NewInt(int64(r)))
+	num.Mul(num, big.NewInt(int64(to.MinorScale())))
+	den := big.NewInt(int64(RateScale) * int64(from.MinorScale()))
+
+	// round half away from zero
+	half := new(big.Int).Quo(den, big.NewInt(2))
+	if num.Sign() < 0 {
+		num.Sub(num, half)
+	} else {
+		num.Add(num, half)
+	}
+	return int(num.Quo(num, den).Int64())
+}
@@ -0,0 +1,96 @@
+package money_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mickamy/sampay/internal/lib/money"
+)
+
+func TestParseCurrency(t *testing.T) {
+	t.Parallel()
+
+	c, err := money.ParseCurrency("usd")
+	require.NoError(t, err)
+	assert.Equal(t, money.USD, c)
+
+	_, err = money.ParseCurrency("XYZ")
+	require.ErrorIs(t, err, money.ErrUnsupportedCurrency)
+}
+
+func TestCurrency_FormatNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		currency money.Currency
+		amount   int
+		want     string
+	}{
+		{currency: money.JPY, amount: 3000, want: "3,000"},
+		{currency: money.JPY, amount: 999, want: "999"},
+		{currency: money.KRW, amount: 1234567, want: "1,234,567"},
+		{currency: money.USD, amount: 123450, want: "1,234.50"},
+		{currency: money.EUR, amount: 5, want: "0.05"},
+		{currency: money.USD, amount: -1999, want: "-19.99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.currency.FormatNumber(tt.amount))
+		})
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	t.Parallel()
+
+	r, err := money.ParseRate("151.25")
+	require.NoError(t, err)
+	assert.Equal(t, money.Rate(151_250_000), r)
+	assert.Equal(t, "151.25", r.String())
+
+	r, err = money.ParseRate("0.0066")
+	require.NoError(t, err)
+	assert.Equal(t, money.Rate(6600), r)
+	assert.Equal(t, "0.0066", r.String())
+
+	for _, s := range []string{"", "0", "-1", "1.0000001", "abc", ".5"} {
+		_, err := money.ParseRate(s)
+		require.ErrorIs(t, err, money.ErrInvalidRate, s)
+	}
+}
+
+func TestRate_Convert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rate     string
+		amount   int
+		from, to money.Currency
+		want     int
+	}{
+		// $12.34 at 151.25 JPY/USD = 1866.425 yen
+		{name: "USD to JPY", rate: "151.25", amount: 1234, from: money.USD, to: money.JPY, want: 1866},
+		// 10000 yen at 0.0066 USD/JPY = $66.00
+		{name: "JPY to USD", rate: "0.0066", amount: 10000, from: money.JPY, to: money.USD, want: 6600},
+		// 10000 won at 0.1125 JPY/KRW = 1125 yen
+		{name: "KRW to JPY", rate: "0.1125", amount: 10000, from: money.KRW, to: money.JPY, want: 1125},
+		// €0.01 at 150 JPY/EUR = 1.5 yen, rounded half up
+		{name: "rounds half up", rate: "150", amount: 1, from: money.EUR, to: money.JPY, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := money.ParseRate(tt.rate)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.Convert(tt.amount, tt.from, tt.to))
+		})
+	}
+}
@@ -5,15 +5,18 @@ import (
 	"encoding/base64"
 	"fmt"
 
+	"golang.org/x/oauth2"
+
 	"github.com/mickamy/sampay/internal/lib/random"
 )
 
 type Client interface {
-	AuthenticationURL() (string, error)
-	Callback(ctx context.Context, code string) (Payload, error)
+	AuthenticationURL(state string, codeVerifier string) (string, error)
+	Callback(ctx context.Context, code string, codeVerifier string) (Payload, error)
 }
 
-func generateState(provider Provider) (string, error) {
+// NewState generates a random state in the form of `provider:nonce`.
+func NewState(provider Provider) (string, error) {
 	bytes, err := random.NewBytes(16)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate random state: %w", err)
@@ -21,3 +24,8 @@ func generateState(provider Provider) (string, error) {
 	nonce := base64.URLEncoding.EncodeToString(bytes)
 	return string(provider) + ":" + nonce, nil
 }
+
+// NewCodeVerifier generates a PKCE code verifier (RFC 7636).
+func NewCodeVerifier() string {
+	return oauth2.GenerateVerifier()
+}
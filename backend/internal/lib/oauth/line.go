@@ -3,6 +3,7 @@ package oauth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -35,17 +36,21 @@ func NewLINE(cfg config.OAuthConfig) Client {
 	}
 }
 
-func (c *lineClient) AuthenticationURL() (string, error) {
-	s, err := generateState(ProviderLINE)
-	if err != nil {
-		return "", fmt.Errorf("oauth: failed to generate state: %w", err)
+func (c *lineClient) AuthenticationURL(state string, codeVerifier string) (string, error) {
+	if state == "" || codeVerifier == "" {
+		return "", errors.New("oauth: state and code verifier are required")
 	}
-	authURL := c.cfg.AuthCodeURL(s, oauth2.AccessTypeOffline, oauth2.SetAuthURLParam("bot_prompt", "aggressive"))
+	authURL := c.cfg.AuthCodeURL(
+		state,
+		oauth2.AccessTypeOffline,
+		oauth2.S256ChallengeOption(codeVerifier),
+		oauth2.SetAuthURLParam("bot_prompt", "aggressive"),
+	)
 	return authURL, nil
 }
 
-func (c *lineClient) Callback(ctx context.Context, code string) (Payload, error) {
-	token, err := c.cfg.Exchange(ctx, code)
+func (c *lineClient) Callback(ctx context.Context, code string, codeVerifier string) (Payload, error) {
+	token, err := c.cfg.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
 	if err != nil {
 		return Payload{}, fmt.Errorf("oauth: failed to exchange token: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 )
 
 type authenticatedUserIDKey struct{}
+type authenticatedSessionIDKey struct{}
 type executionIDKey struct{}
 type systemUserIDKey struct{}
 type languageKey struct{}
@@ -36,6 +37,20 @@ func MustAuthenticatedUserID(ctx context.Context) string {
 	return userID
 }
 
+// SetAuthenticatedSessionID sets the ID of the session the request was authenticated with in the context.
+func SetAuthenticatedSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, authenticatedSessionIDKey{}, sessionID)
+}
+
+// AuthenticatedSessionID retrieves the ID of the session the request was authenticated with from the context.
+func AuthenticatedSessionID(ctx context.Context) (string, error) {
+	sessionID, ok := ctx.Value(authenticatedSessionIDKey{}).(string)
+	if ok && sessionID != "" {
+		return sessionID, nil
+	}
+	return "", errors.New("contexts: no authenticated session ID found in context")
+}
+
 // SetExecutionID sets the execution ID in the context.
 func SetExecutionID(ctx context.Context, requestID uuid.UUID) context.Context {
 	return context.WithValue(ctx, executionIDKey{}, requestID)
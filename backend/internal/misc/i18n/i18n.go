@@ -7,7 +7,9 @@ import (
 	i18n "github.com/mickamy/go-typesafe-i18n"
 	"golang.org/x/text/language"
 
+	"github.com/mickamy/sampay/internal/lib/money"
 	"github.com/mickamy/sampay/internal/misc/contexts"
+	"github.com/mickamy/sampay/internal/misc/i18n/messages"
 )
 
 //go:generate go tool go-typesafe-i18n generate -base=ja -out=./messages/messages_gen.go ./locales
@@ -41,6 +43,27 @@ func Japanese(msg i18n.Message) string {
 	return Localize(language.Japanese, msg)
 }
 
+// FormatAmount formats an amount in minor units of the currency for the language, e.g. "3,000円" or "$12.50".
+func FormatAmount(tag language.Tag, currency money.Currency, amount int) string {
+	number := currency.FormatNumber(amount)
+	switch currency {
+	case money.JPY:
+		return Localize(tag, messages.CurrencyFormatJpy(number))
+	case money.USD:
+		return Localize(tag, messages.CurrencyFormatUsd(number))
+	case money.EUR:
+		return Localize(tag, messages.CurrencyFormatEur(number))
+	case money.KRW:
+		return Localize(tag, messages.CurrencyFormatKrw(number))
+	default:
+		return number + " " + string(currency)
+	}
+}
+
+func FormatAmountContext(ctx context.Context, currency money.Currency, amount int) string {
+	return FormatAmount(contexts.MustLanguage(ctx), currency, amount)
+}
+
 var supportedLanguages = []language.Tag{
 	language.Japanese,
 	language.English,
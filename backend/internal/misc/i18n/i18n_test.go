@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 
+	"github.com/mickamy/sampay/internal/lib/money"
 	"github.com/mickamy/sampay/internal/misc/contexts"
 	"github.com/mickamy/sampay/internal/misc/i18n"
 	"github.com/mickamy/sampay/internal/misc/i18n/messages"
@@ -90,3 +91,18 @@ func TestResolveLanguage(t *testing.T) {
 		})
 	}
 }
+
+//nolint:gosmopolitan // intentional i18n test
+func TestFormatAmount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "3,000円", i18n.FormatAmount(language.Japanese, money.JPY, 3000))
+	assert.Equal(t, "¥3,000", i18n.FormatAmount(language.English, money.JPY, 3000))
+	assert.Equal(t, "12.50ドル", i18n.FormatAmount(language.Japanese, money.USD, 1250))
+	assert.Equal(t, "$12.50", i18n.FormatAmount(language.English, money.USD, 1250))
+	assert.Equal(t, "€0.99", i18n.FormatAmount(language.English, money.EUR, 99))
+	assert.Equal(t, "₩15,000", i18n.FormatAmount(language.English, money.KRW, 15000))
+
+	msg := messages.MessagingClaimNotification("Alice", "trip", i18n.FormatAmount(language.English, money.USD, 4200))
+	assert.Equal(t, "Alice has reported payment of $42.00 for trip", i18n.Localize(language.English, msg))
+}
@@ -39,6 +39,12 @@ func AuthUseCaseErrorOauthCallbackFailed() i18n.Message {
 	return i18n.Message{ID: "auth.use_case.error.oauth_callback_failed"}
 }
 
+// AuthUseCaseErrorOauthStateInvalid returns a Message for "auth.use_case.error.oauth_state_invalid".
+// Template: ログインリクエストが無効か、有効期限が切れています。もう一度ログインしてください。
+func AuthUseCaseErrorOauthStateInvalid() i18n.Message {
+	return i18n.Message{ID: "auth.use_case.error.oauth_state_invalid"}
+}
+
 // AuthUseCaseErrorSessionNotFound returns a Message for "auth.use_case.error.session_not_found".
 // Template: 無効なセッションです。
 func AuthUseCaseErrorSessionNotFound() i18n.Message {
@@ -57,6 +63,12 @@ func AuthUseCaseErrorTokenInvalid() i18n.Message {
 	return i18n.Message{ID: "auth.use_case.error.token_invalid"}
 }
 
+// AuthUseCaseErrorTokenReused returns a Message for "auth.use_case.error.token_reused".
+// Template: このセッションは無効化されました。もう一度ログインしてください。
+func AuthUseCaseErrorTokenReused() i18n.Message {
+	return i18n.Message{ID: "auth.use_case.error.token_reused"}
+}
+
 // AuthUseCaseErrorUnsupportedOauthProvider returns a Message for "auth.use_case.error.unsupported_oauth_provider".
 // Template: サポートされていない OAuth プロバイダーです。
 func AuthUseCaseErrorUnsupportedOauthProvider() i18n.Message {
@@ -69,6 +81,50 @@ func CommonResponseErrorInternal() i18n.Message {
 	return i18n.Message{ID: "common.response.error.internal"}
 }
 
+// CurrencyFormatEur returns a Message for "currency.format.eur".
+// Template: {amount}ユーロ
+func CurrencyFormatEur(amount string) i18n.Message {
+	return i18n.Message{
+		ID: "currency.format.eur",
+		Args: map[string]any{
+			"amount": amount,
+		},
+	}
+}
+
+// CurrencyFormatJpy returns a Message for "currency.format.jpy".
+// Template: {amount}円
+func CurrencyFormatJpy(amount string) i18n.Message {
+	return i18n.Message{
+		ID: "currency.format.jpy",
+		Args: map[string]any{
+			"amount": amount,
+		},
+	}
+}
+
+// CurrencyFormatKrw returns a Message for "currency.format.krw".
+// Template: {amount}ウォン
+func CurrencyFormatKrw(amount string) i18n.Message {
+	return i18n.Message{
+		ID: "currency.format.krw",
+		Args: map[string]any{
+			"amount": amount,
+		},
+	}
+}
+
+// CurrencyFormatUsd returns a Message for "currency.format.usd".
+// Template: {amount}ドル
+func CurrencyFormatUsd(amount string) i18n.Message {
+	return i18n.Message{
+		ID: "currency.format.usd",
+		Args: map[string]any{
+			"amount": amount,
+		},
+	}
+}
+
 // EventUseCaseErrorAlreadyClaimed returns a Message for "event.use_case.error.already_claimed".
 // Template: すでに支払い申告済みです。
 func EventUseCaseErrorAlreadyClaimed() i18n.Message {
@@ -81,12 +137,102 @@ func EventUseCaseErrorArchived() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.archived"}
 }
 
+// EventUseCaseErrorAttendanceInvalid returns a Message for "event.use_case.error.attendance_invalid".
+// Template: 到着時刻は退出時刻より前にするか、出席率を0〜1で入力してください。
+func EventUseCaseErrorAttendanceInvalid() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.attendance_invalid"}
+}
+
+// EventUseCaseErrorBillTotalTooLarge returns a Message for "event.use_case.error.bill_total_too_large".
+// Template: 請求額の合計が大きすぎます。小計や各料率を見直してください。
+func EventUseCaseErrorBillTotalTooLarge() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.bill_total_too_large"}
+}
+
+// EventUseCaseErrorCurrencyLocked returns a Message for "event.use_case.error.currency_locked".
+// Template: 支払い済みまたは支払い申告済みの参加者がいるため、通貨は変更できません。
+func EventUseCaseErrorCurrencyLocked() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.currency_locked"}
+}
+
+// EventUseCaseErrorCurrencyUnsupported returns a Message for "event.use_case.error.currency_unsupported".
+// Template: この通貨には対応していません。
+func EventUseCaseErrorCurrencyUnsupported() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.currency_unsupported"}
+}
+
+// EventUseCaseErrorDepositAlreadySettled returns a Message for "event.use_case.error.deposit_already_settled".
+// Template: 預り金はすでに確定した合計金額で精算されています。
+func EventUseCaseErrorDepositAlreadySettled() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.deposit_already_settled"}
+}
+
+// EventUseCaseErrorDepositInvalid returns a Message for "event.use_case.error.deposit_invalid".
+// Template: 預り金は1以上で入力してください。
+func EventUseCaseErrorDepositInvalid() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.deposit_invalid"}
+}
+
+// EventUseCaseErrorDepositNotCollected returns a Message for "event.use_case.error.deposit_not_collected".
+// Template: このイベントは預り金を集めていません。
+func EventUseCaseErrorDepositNotCollected() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.deposit_not_collected"}
+}
+
+// EventUseCaseErrorEndsAtInvalid returns a Message for "event.use_case.error.ends_at_invalid".
+// Template: 終了日時は開催日時より後にしてください。
+func EventUseCaseErrorEndsAtInvalid() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.ends_at_invalid"}
+}
+
 // EventUseCaseErrorEventMismatch returns a Message for "event.use_case.error.event_mismatch".
 // Template: 参加者が指定されたイベントに属していません。
 func EventUseCaseErrorEventMismatch() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.event_mismatch"}
 }
 
+// EventUseCaseErrorExchangeRateInvalid returns a Message for "event.use_case.error.exchange_rate_invalid".
+// Template: 精算通貨にはイベントと異なる通貨を選び、為替レートを正しく入力してください。
+func EventUseCaseErrorExchangeRateInvalid() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.exchange_rate_invalid"}
+}
+
+// EventUseCaseErrorExpenseAmountPositive returns a Message for "event.use_case.error.expense_amount_positive".
+// Template: 金額は1以上で入力してください。
+func EventUseCaseErrorExpenseAmountPositive() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.expense_amount_positive"}
+}
+
+// EventUseCaseErrorExpenseNotFound returns a Message for "event.use_case.error.expense_not_found".
+// Template: 立替記録が見つかりません。
+func EventUseCaseErrorExpenseNotFound() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.expense_not_found"}
+}
+
+// EventUseCaseErrorExpenseParticipantInvalid returns a Message for "event.use_case.error.expense_participant_invalid".
+// Template: 支払者または対象の参加者が無効です。
+func EventUseCaseErrorExpenseParticipantInvalid() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.expense_participant_invalid"}
+}
+
+// EventUseCaseErrorExpenseParticipantsRequired returns a Message for "event.use_case.error.expense_participants_required".
+// Template: 対象の参加者を1人以上選択してください。
+func EventUseCaseErrorExpenseParticipantsRequired() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.expense_participants_required"}
+}
+
+// EventUseCaseErrorFixedAmountExceedsTotal returns a Message for "event.use_case.error.fixed_amount_exceeds_total".
+// Template: 固定金額の合計が合計金額を超えています。
+func EventUseCaseErrorFixedAmountExceedsTotal() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.fixed_amount_exceeds_total"}
+}
+
+// EventUseCaseErrorFixedAmountNegative returns a Message for "event.use_case.error.fixed_amount_negative".
+// Template: 固定金額は0以上で入力してください。
+func EventUseCaseErrorFixedAmountNegative() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.fixed_amount_negative"}
+}
+
 // EventUseCaseErrorForbidden returns a Message for "event.use_case.error.forbidden".
 // Template: このイベントにアクセスする権限がありません。
 func EventUseCaseErrorForbidden() i18n.Message {
@@ -99,6 +245,24 @@ func EventUseCaseErrorHeldAtRequired() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.held_at_required"}
 }
 
+// EventUseCaseErrorInvalidParticipantToken returns a Message for "event.use_case.error.invalid_participant_token".
+// Template: 参加者の確認ができませんでした。主催者に参加者用リンクの再発行を依頼してください。
+func EventUseCaseErrorInvalidParticipantToken() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.invalid_participant_token"}
+}
+
+// EventUseCaseErrorInvalidRound returns a Message for "event.use_case.error.invalid_round".
+// Template: 2次会以降には、タイトル、1以上の合計金額、すべてのティアの設定が必要です。
+func EventUseCaseErrorInvalidRound() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.invalid_round"}
+}
+
+// EventUseCaseErrorInvalidStatusTransition returns a Message for "event.use_case.error.invalid_status_transition".
+// Template: この支払いステータスには変更できません。確認済みの支払いは変更できません。
+func EventUseCaseErrorInvalidStatusTransition() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.invalid_status_transition"}
+}
+
 // EventUseCaseErrorInvalidTier returns a Message for "event.use_case.error.invalid_tier".
 // Template: ティアが無効です。
 func EventUseCaseErrorInvalidTier() i18n.Message {
@@ -117,12 +281,24 @@ func EventUseCaseErrorInvalidTierUpdate() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.invalid_tier_update"}
 }
 
+// EventUseCaseErrorJoinLocked returns a Message for "event.use_case.error.join_locked".
+// Template: 他の参加者が支払い申告済みのため、参加できません。主催者に連絡してください。
+func EventUseCaseErrorJoinLocked() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.join_locked"}
+}
+
 // EventUseCaseErrorLocked returns a Message for "event.use_case.error.locked".
 // Template: 参加者が支払い申告済みのため、このイベントは編集できません。
 func EventUseCaseErrorLocked() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.locked"}
 }
 
+// EventUseCaseErrorMergeSameParticipant returns a Message for "event.use_case.error.merge_same_participant".
+// Template: 統合する参加者には異なる2人を選んでください。
+func EventUseCaseErrorMergeSameParticipant() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.merge_same_participant"}
+}
+
 // EventUseCaseErrorNameRequired returns a Message for "event.use_case.error.name_required".
 // Template: 名前は必須です。
 func EventUseCaseErrorNameRequired() i18n.Message {
@@ -135,18 +311,72 @@ func EventUseCaseErrorNotFound() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.not_found"}
 }
 
+// EventUseCaseErrorParticipantHasExpenses returns a Message for "event.use_case.error.participant_has_expenses".
+// Template: この参加者が関わる立替記録があります。先に立替記録を削除するか、別の参加者に統合してください。
+func EventUseCaseErrorParticipantHasExpenses() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.participant_has_expenses"}
+}
+
+// EventUseCaseErrorParticipantLocked returns a Message for "event.use_case.error.participant_locked".
+// Template: 他の参加者が支払い申告済みのため、ティアの変更や参加の取り消しはできません。主催者に連絡してください。
+func EventUseCaseErrorParticipantLocked() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.participant_locked"}
+}
+
 // EventUseCaseErrorParticipantNotFound returns a Message for "event.use_case.error.participant_not_found".
 // Template: 参加者が見つかりません。
 func EventUseCaseErrorParticipantNotFound() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.participant_not_found"}
 }
 
+// EventUseCaseErrorPaymentAmountPositive returns a Message for "event.use_case.error.payment_amount_positive".
+// Template: 支払い金額は1以上で入力してください。
+func EventUseCaseErrorPaymentAmountPositive() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.payment_amount_positive"}
+}
+
+// EventUseCaseErrorPaymentMethodNotAccepted returns a Message for "event.use_case.error.payment_method_not_accepted".
+// Template: 主催者はこの支払い方法を受け付けていません。
+func EventUseCaseErrorPaymentMethodNotAccepted() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.payment_method_not_accepted"}
+}
+
+// EventUseCaseErrorRefundExceedsRefundable returns a Message for "event.use_case.error.refund_exceeds_refundable".
+// Template: 返金額が参加者に返すべき金額を超えています。
+func EventUseCaseErrorRefundExceedsRefundable() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.refund_exceeds_refundable"}
+}
+
+// EventUseCaseErrorRoundNotFound returns a Message for "event.use_case.error.round_not_found".
+// Template: 指定された会が見つかりません。
+func EventUseCaseErrorRoundNotFound() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.round_not_found"}
+}
+
+// EventUseCaseErrorSubtotalAmountPositive returns a Message for "event.use_case.error.subtotal_amount_positive".
+// Template: 小計は1以上で入力してください。
+func EventUseCaseErrorSubtotalAmountPositive() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.subtotal_amount_positive"}
+}
+
+// EventUseCaseErrorTierCapacityTooSmall returns a Message for "event.use_case.error.tier_capacity_too_small".
+// Template: ティアの人数を現在の参加者数より少なくすることはできません。
+func EventUseCaseErrorTierCapacityTooSmall() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.tier_capacity_too_small"}
+}
+
 // EventUseCaseErrorTierCountInvalid returns a Message for "event.use_case.error.tier_count_invalid".
-// Template: ティア段階数は1、3、5のいずれかで指定してください。
+// Template: ティア段階数は1〜10で指定してください。
 func EventUseCaseErrorTierCountInvalid() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.tier_count_invalid"}
 }
 
+// EventUseCaseErrorTierFull returns a Message for "event.use_case.error.tier_full".
+// Template: このティアは定員に達しています。
+func EventUseCaseErrorTierFull() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.tier_full"}
+}
+
 // EventUseCaseErrorTiersRequired returns a Message for "event.use_case.error.tiers_required".
 // Template: ティア構成は必須です。
 func EventUseCaseErrorTiersRequired() i18n.Message {
@@ -159,15 +389,27 @@ func EventUseCaseErrorTitleRequired() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.title_required"}
 }
 
+// EventUseCaseErrorTotalAlreadyFinal returns a Message for "event.use_case.error.total_already_final".
+// Template: このイベントの合計金額はすでに確定しています。
+func EventUseCaseErrorTotalAlreadyFinal() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.total_already_final"}
+}
+
 // EventUseCaseErrorTotalAmountPositive returns a Message for "event.use_case.error.total_amount_positive".
 // Template: 合計金額は1以上で入力してください。
 func EventUseCaseErrorTotalAmountPositive() i18n.Message {
 	return i18n.Message{ID: "event.use_case.error.total_amount_positive"}
 }
 
+// EventUseCaseErrorWaitlisted returns a Message for "event.use_case.error.waitlisted".
+// Template: キャンセル待ちの参加者の支払い状況は変更できません。
+func EventUseCaseErrorWaitlisted() i18n.Message {
+	return i18n.Message{ID: "event.use_case.error.waitlisted"}
+}
+
 // MessagingClaimNotification returns a Message for "messaging.claim_notification".
-// Template: {participant_name}さんが{event_title}の支払い（{amount:int}円）を申告しました
-func MessagingClaimNotification(participant_name string, event_title string, amount int) i18n.Message {
+// Template: {participant_name}さんが{event_title}の支払い（{amount}）を申告しました
+func MessagingClaimNotification(participant_name string, event_title string, amount string) i18n.Message {
 	return i18n.Message{
 		ID: "messaging.claim_notification",
 		Args: map[string]any{
@@ -178,12 +420,184 @@ func MessagingClaimNotification(participant_name string, event_title string, amo
 	}
 }
 
+// MessagingClaimNotificationConfirm returns a Message for "messaging.claim_notification_confirm".
+// Template: 確認する: {url}
+func MessagingClaimNotificationConfirm(url string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.claim_notification_confirm",
+		Args: map[string]any{
+			"url": url,
+		},
+	}
+}
+
+// MessagingClaimNotificationTitle returns a Message for "messaging.claim_notification_title".
+// Template: {participant_name}さんから支払い申告がありました
+func MessagingClaimNotificationTitle(participant_name string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.claim_notification_title",
+		Args: map[string]any{
+			"participant_name": participant_name,
+		},
+	}
+}
+
+// MessagingClaimNotificationVia returns a Message for "messaging.claim_notification_via".
+// Template: {participant_name}さんが{event_title}の支払い（{amount}）を{payment_method}で申告しました
+func MessagingClaimNotificationVia(participant_name string, event_title string, amount string, payment_method string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.claim_notification_via",
+		Args: map[string]any{
+			"participant_name": participant_name,
+			"event_title":      event_title,
+			"amount":           amount,
+			"payment_method":   payment_method,
+		},
+	}
+}
+
+// MessagingListSeparator returns a Message for "messaging.list_separator".
+// Template: 、
+func MessagingListSeparator() i18n.Message {
+	return i18n.Message{ID: "messaging.list_separator"}
+}
+
+// MessagingPaymentMethodKyash returns a Message for "messaging.payment_method.kyash".
+// Template: Kyash
+func MessagingPaymentMethodKyash() i18n.Message {
+	return i18n.Message{ID: "messaging.payment_method.kyash"}
+}
+
+// MessagingPaymentMethodMerpay returns a Message for "messaging.payment_method.merpay".
+// Template: メルペイ
+func MessagingPaymentMethodMerpay() i18n.Message {
+	return i18n.Message{ID: "messaging.payment_method.merpay"}
+}
+
+// MessagingPaymentMethodPaypay returns a Message for "messaging.payment_method.paypay".
+// Template: PayPay
+func MessagingPaymentMethodPaypay() i18n.Message {
+	return i18n.Message{ID: "messaging.payment_method.paypay"}
+}
+
+// MessagingPaymentMethodRakutenPay returns a Message for "messaging.payment_method.rakuten_pay".
+// Template: 楽天ペイ
+func MessagingPaymentMethodRakutenPay() i18n.Message {
+	return i18n.Message{ID: "messaging.payment_method.rakuten_pay"}
+}
+
+// MessagingPaymentReminderClaimed returns a Message for "messaging.payment_reminder.claimed".
+// Template: 支払い確認待ち（{count:int}人）: {names}
+func MessagingPaymentReminderClaimed(count int, names string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.payment_reminder.claimed",
+		Args: map[string]any{
+			"count": count,
+			"names": names,
+		},
+	}
+}
+
+// MessagingPaymentReminderDigestTitle returns a Message for "messaging.payment_reminder.digest_title".
+// Template: {event_title}の集金状況
+func MessagingPaymentReminderDigestTitle(event_title string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.payment_reminder.digest_title",
+		Args: map[string]any{
+			"event_title": event_title,
+		},
+	}
+}
+
+// MessagingPaymentReminderNudge returns a Message for "messaging.payment_reminder.nudge".
+// Template: {event_title}の支払い（{amount}）がまだ済んでいません。
+func MessagingPaymentReminderNudge(event_title string, amount string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.payment_reminder.nudge",
+		Args: map[string]any{
+			"event_title": event_title,
+			"amount":      amount,
+		},
+	}
+}
+
+// MessagingPaymentReminderNudgeTitle returns a Message for "messaging.payment_reminder.nudge_title".
+// Template: {event_title}の支払いのお願い
+func MessagingPaymentReminderNudgeTitle(event_title string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.payment_reminder.nudge_title",
+		Args: map[string]any{
+			"event_title": event_title,
+		},
+	}
+}
+
+// MessagingPaymentReminderUnpaid returns a Message for "messaging.payment_reminder.unpaid".
+// Template: 未払い（{count:int}人）: {names}
+func MessagingPaymentReminderUnpaid(count int, names string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.payment_reminder.unpaid",
+		Args: map[string]any{
+			"count": count,
+			"names": names,
+		},
+	}
+}
+
+// MessagingTotalFinalizedChanged returns a Message for "messaging.total_finalized.changed".
+// Template: {event_title}の合計金額が確定しました。お支払い額は見込みの{previous_amount}から{amount}になりました。
+func MessagingTotalFinalizedChanged(event_title string, previous_amount string, amount string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.total_finalized.changed",
+		Args: map[string]any{
+			"event_title":     event_title,
+			"previous_amount": previous_amount,
+			"amount":          amount,
+		},
+	}
+}
+
+// MessagingTotalFinalizedTitle returns a Message for "messaging.total_finalized.title".
+// Template: {event_title}の金額が確定しました
+func MessagingTotalFinalizedTitle(event_title string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.total_finalized.title",
+		Args: map[string]any{
+			"event_title": event_title,
+		},
+	}
+}
+
+// MessagingTotalFinalizedUnchanged returns a Message for "messaging.total_finalized.unchanged".
+// Template: {event_title}の合計金額が確定しました。お支払い額は見込みどおり{amount}です。
+func MessagingTotalFinalizedUnchanged(event_title string, amount string) i18n.Message {
+	return i18n.Message{
+		ID: "messaging.total_finalized.unchanged",
+		Args: map[string]any{
+			"event_title": event_title,
+			"amount":      amount,
+		},
+	}
+}
+
 // UserMapperErrorUnknownPaymentMethodType returns a Message for "user.mapper.error.unknown_payment_method_type".
 // Template: 不明な決済手段です。
 func UserMapperErrorUnknownPaymentMethodType() i18n.Message {
 	return i18n.Message{ID: "user.mapper.error.unknown_payment_method_type"}
 }
 
+// UserModelNotificationSettingErrorEmailRequired returns a Message for "user.model.notification_setting.error.email_required".
+// Template: メール通知を有効にするにはメールアドレスを入力してください。
+func UserModelNotificationSettingErrorEmailRequired() i18n.Message {
+	return i18n.Message{ID: "user.model.notification_setting.error.email_required"}
+}
+
+// UserModelNotificationSettingErrorInvalidEmail returns a Message for "user.model.notification_setting.error.invalid_email".
+// Template: メールアドレスの形式が正しくありません。
+func UserModelNotificationSettingErrorInvalidEmail() i18n.Message {
+	return i18n.Message{ID: "user.model.notification_setting.error.invalid_email"}
+}
+
 // UserModelSlugErrorAlreadyTaken returns a Message for "user.model.slug.error.already_taken".
 // Template: このスラッグは既に使用されています。
 func UserModelSlugErrorAlreadyTaken() i18n.Message {
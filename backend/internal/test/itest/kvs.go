@@ -22,6 +22,22 @@ func NewKVS(t *testing.T) *kvs.KVS {
 	return newKVS(t, cfg)
 }
 
+// NewKVSWithServer is NewKVS which also returns the server behind it, e.g. to fast-forward expirations.
+func NewKVSWithServer(t *testing.T) (*kvs.KVS, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("could not start miniredis: %s", err)
+	}
+	t.Cleanup(mr.Close)
+
+	return newKVS(t, config.KVSConfig{
+		Host: mr.Host(),
+		Port: either.Must(strconv.Atoi(mr.Port())),
+	}), mr
+}
+
 func newKVS(t *testing.T, cfg config.KVSConfig) *kvs.KVS {
 	t.Helper()
 
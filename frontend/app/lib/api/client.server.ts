@@ -6,6 +6,7 @@ import {
 } from "@connectrpc/connect";
 import { createConnectTransport } from "@connectrpc/connect-web";
 import {
+  createClientInterceptor,
   createI18NInterceptor,
   loggingInterceptor,
 } from "~/lib/api/interceptors.server";
@@ -29,6 +30,7 @@ export function getClient<T extends DescService>({
     baseUrl: API_BASE_URL,
     interceptors: [
       createI18NInterceptor(request),
+      createClientInterceptor(request),
       loggingInterceptor,
       ...interceptors,
     ],
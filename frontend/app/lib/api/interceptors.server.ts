@@ -1,9 +1,14 @@
 import type { Interceptor } from "@connectrpc/connect";
 import { setAuthenticatedSession } from "~/lib/cookie/authenticated-cookie.server";
-import { parseSetCookie } from "~/lib/cookie/parser";
+import { parseCookie, parseSetCookie } from "~/lib/cookie/parser";
 import logger from "~/lib/logger";
 
-const SENSITIVE_HEADERS = new Set(["authorization", "cookie", "set-cookie"]);
+const SENSITIVE_HEADERS = new Set([
+  "authorization",
+  "cookie",
+  "set-cookie",
+  "x-ssr-secret",
+]);
 
 function redactHeaders(headers: Headers): Record<string, string> {
   const redacted: Record<string, string> = {};
@@ -57,9 +62,58 @@ export const sessionExchangeInterceptor: Interceptor =
       tokens: { access, refresh },
     });
     res.header.set("set-cookie", setCookie);
+    // pass on the rest, such as the cleared oauth_state
+    for (const cookie of cookies) {
+      if (
+        !cookie.startsWith("access_token=") &&
+        !cookie.startsWith("refresh_token=")
+      ) {
+        res.header.append("set-cookie", cookie);
+      }
+    }
     return res;
   };
 
+// createOAuthStateInterceptor forwards the browser's oauth_state cookie, which the API set when
+// the login started, so that the callback is bound to the browser that started it.
+export function createOAuthStateInterceptor(request: Request): Interceptor {
+  return (next) => async (req) => {
+    const state = parseCookie(request.headers.get("cookie"), "oauth_state");
+    if (state != null) {
+      req.header.set("Cookie", `oauth_state=${state}`);
+    }
+    return next(req);
+  };
+}
+
+const ssrSecret = process.env.SSR_SECRET;
+
+// clientIP returns the address the reverse proxy in front of this server appended to X-Forwarded-For,
+// as entries before it are up to the browser.
+function clientIP(request: Request): string {
+  const forwarded = request.headers.get("X-Forwarded-For");
+  if (!forwarded) {
+    return "";
+  }
+  return forwarded.split(",").at(-1)?.trim() ?? "";
+}
+
+// createClientInterceptor relays the browser's details, which the API would otherwise take from this server,
+// along with the secret that makes the API trust them.
+export function createClientInterceptor(request: Request): Interceptor {
+  return (next) => async (req) => {
+    if (ssrSecret) {
+      req.header.set("X-SSR-Secret", ssrSecret);
+      req.header.set(
+        "X-Client-User-Agent",
+        request.headers.get("User-Agent") ?? "",
+      );
+      req.header.set("X-Client-IP", clientIP(request));
+    }
+    return next(req);
+  };
+}
+
 export function createI18NInterceptor(request: Request): Interceptor {
   return (next) => async (req) => {
     req.header.set(
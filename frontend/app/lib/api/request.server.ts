@@ -6,6 +6,7 @@ import { SessionService } from "~/gen/auth/v1/session_pb";
 import { API_BASE_URL } from "~/lib/api/client.server";
 import {
   createAuthenticateInterceptor,
+  createClientInterceptor,
   createI18NInterceptor,
   loggingInterceptor,
 } from "~/lib/api/interceptors.server";
@@ -61,6 +62,7 @@ export async function withAuthentication(
       baseUrl: API_BASE_URL,
       interceptors: [
         createI18NInterceptor(request),
+        createClientInterceptor(request),
         createAuthenticateInterceptor(session.tokens.access.value),
         loggingInterceptor,
       ],
@@ -104,7 +106,11 @@ async function refreshSession({
 }): Promise<Session> {
   const transport = createConnectTransport({
     baseUrl: API_BASE_URL,
-    interceptors: [createI18NInterceptor(request), loggingInterceptor],
+    interceptors: [
+      createI18NInterceptor(request),
+      createClientInterceptor(request),
+      loggingInterceptor,
+    ],
   });
   let responseHeaders: Headers | undefined;
   await createClient(SessionService, transport).refreshToken(
@@ -19,3 +19,19 @@ export function parseSetCookie(cookies: string[], name: string): Token | null {
   }
   return { value, expiresAt };
 }
+
+export function parseCookie(
+  cookieHeader: string | null,
+  name: string,
+): string | null {
+  if (!cookieHeader) {
+    return null;
+  }
+  for (const part of cookieHeader.split(";")) {
+    const [key, ...rest] = part.trim().split("=");
+    if (key === name) {
+      return rest.join("=");
+    }
+  }
+  return null;
+}
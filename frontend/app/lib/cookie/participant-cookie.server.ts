@@ -1,13 +1,40 @@
-import { createCookieSessionStorage } from "react-router";
+import { createCookie, createCookieSessionStorage } from "react-router";
 import { createLazySessionStorage } from "~/lib/cookie/helper";
 
 const DAY = 60 * 60 * 24;
 
-async function createStorage() {
+export interface ParticipantCredential {
+  id: string;
+  // token is what JoinEvent returned, empty for participants who joined
+  // before tokens were issued.
+  token: string;
+}
+
+function getSecret(): string {
   const secret = process.env.SESSION_SECRET;
   if (!secret) {
     throw new Error("SESSION_SECRET is not set");
   }
+  return secret;
+}
+
+// participantCookie keeps the participant of one event, sent only along with
+// that event's pages.
+function participantCookie(eventId: string) {
+  return createCookie(`__sampay_participant_${eventId}`, {
+    httpOnly: true,
+    maxAge: 30 * DAY,
+    path: `/e/${eventId}`,
+    sameSite: "lax",
+    secure: process.env.NODE_ENV !== "development",
+    secrets: [getSecret()],
+  });
+}
+
+// The session below kept just the participant ID of every event before tokens
+// were issued. It is only read to show those participants their entry; they
+// cannot act on it until the organizer hands over a reissued link.
+async function createStorage() {
   return createCookieSessionStorage({
     cookie: {
       name: "__sampay_participant",
@@ -17,7 +44,7 @@ async function createStorage() {
       sameSite: "lax",
       secure: process.env.NODE_ENV !== "development",
       isSigned: true,
-      secrets: [secret],
+      secrets: [getSecret()],
     },
   });
 }
@@ -28,20 +55,38 @@ function sessionKey(eventId: string): string {
   return `participant:${eventId}`;
 }
 
-export async function getParticipantId(
+export async function getParticipant(
   request: Request,
   eventId: string,
-): Promise<string | null> {
-  const session = await getSession(request.headers.get("cookie"));
-  return session.get(sessionKey(eventId)) ?? null;
+): Promise<ParticipantCredential | null> {
+  const cookieHeader = request.headers.get("cookie");
+  const value = await participantCookie(eventId).parse(cookieHeader);
+  if (value?.id) {
+    return { id: value.id, token: value.token ?? "" };
+  }
+
+  const session = await getSession(cookieHeader);
+  const id = session.get(sessionKey(eventId));
+  return id ? { id, token: "" } : null;
 }
 
-export async function setParticipantId(
-  request: Request,
+export async function setParticipant(
   eventId: string,
-  participantId: string,
+  participant: ParticipantCredential,
 ): Promise<string> {
+  return participantCookie(eventId).serialize(participant);
+}
+
+// clearParticipant returns the Set-Cookie headers forgetting the participant
+// of the event.
+export async function clearParticipant(
+  request: Request,
+  eventId: string,
+): Promise<string[]> {
   const session = await getSession(request.headers.get("cookie"));
-  session.set(sessionKey(eventId), participantId);
-  return commitSession(session);
+  session.unset(sessionKey(eventId));
+  return [
+    await participantCookie(eventId).serialize("", { maxAge: 0 }),
+    await commitSession(session),
+  ];
 }
@@ -13,8 +13,9 @@ import { ParticipantStatus } from "~/gen/event/v1/event_pb";
 import { EventProfileService } from "~/gen/event/v1/event_profile_service_pb";
 import { getClient } from "~/lib/api/client.server";
 import {
-  getParticipantId,
-  setParticipantId,
+  clearParticipant,
+  getParticipant,
+  setParticipant,
 } from "~/lib/cookie/participant-cookie.server";
 import { buildMeta } from "~/lib/meta";
 import { formatCurrency, formatEventDate } from "~/model/event-model";
@@ -33,6 +34,12 @@ interface SerializedEvent {
   tiers: { id: string; tier: number; count: number; amount: number }[];
 }
 
+interface SerializedRound {
+  round: number;
+  title: string;
+  participantIds: string[];
+}
+
 interface SerializedParticipant {
   id: string;
   name: string;
@@ -64,6 +71,22 @@ export function meta({ loaderData }: Route.MetaArgs) {
 
 export async function loader({ params, request }: Route.LoaderArgs) {
   const eventId = params.id;
+
+  // A link the organizer issued for a participant hands over their token,
+  // kept out of the URL once stored.
+  const searchParams = new URL(request.url).searchParams;
+  const handoverId = searchParams.get("participant");
+  const handoverToken = searchParams.get("token");
+  if (handoverId && handoverToken) {
+    const setCookie = await setParticipant(eventId, {
+      id: handoverId,
+      token: handoverToken,
+    });
+    return redirect(`/e/${eventId}`, {
+      headers: { "Set-Cookie": setCookie },
+    });
+  }
+
   const client = getClient({ service: EventProfileService, request });
 
   try {
@@ -71,6 +94,7 @@ export async function loader({ params, request }: Route.LoaderArgs) {
       event,
       paymentMethods: rawMethods,
       participants,
+      rounds,
     } = await client.getEvent({ id: eventId });
 
     if (!event) {
@@ -115,9 +139,18 @@ export async function loader({ params, request }: Route.LoaderArgs) {
       amount: p.amount,
     }));
 
-    const participantId = await getParticipantId(request, eventId);
-    const myParticipant = participantId
-      ? (serializedParticipants.find((p) => p.id === participantId) ?? null)
+    // the event itself is round 1; participants choose among the later ones
+    const serializedRounds: SerializedRound[] = (rounds ?? [])
+      .filter((r) => r.round > 1)
+      .map((r) => ({
+        round: r.round,
+        title: r.title,
+        participantIds: r.shares.map((s) => s.participantId),
+      }));
+
+    const participant = await getParticipant(request, eventId);
+    const myParticipant = participant
+      ? (serializedParticipants.find((p) => p.id === participant.id) ?? null)
       : null;
 
     const origin = new URL(request.url).origin;
@@ -126,6 +159,7 @@ export async function loader({ params, request }: Route.LoaderArgs) {
       event: serializedEvent,
       eventUrl: `${origin}/e/${eventId}`,
       paymentMethods,
+      rounds: serializedRounds,
       myParticipant,
     };
   } catch (e) {
@@ -147,18 +181,17 @@ export async function action({ params, request }: Route.ActionArgs) {
     const tier = Number(formData.get("tier")) || 1;
 
     try {
-      const { participant } = await client.joinEvent({
+      const { participant, participantToken } = await client.joinEvent({
         eventId,
         name,
         tier,
       });
 
       if (participant) {
-        const setCookie = await setParticipantId(
-          request,
-          eventId,
-          participant.id,
-        );
+        const setCookie = await setParticipant(eventId, {
+          id: participant.id,
+          token: participantToken,
+        });
         return redirect(`/e/${eventId}`, {
           headers: { "Set-Cookie": setCookie },
         });
@@ -172,17 +205,41 @@ export async function action({ params, request }: Route.ActionArgs) {
     }
   }
 
-  if (actionType === "claimPayment") {
-    const participantId = formData.get("participantId") as string;
-    try {
-      await client.claimPayment({ participantId });
-      return redirect(`/e/${eventId}`);
-    } catch (error) {
-      if (error instanceof ConnectError) {
-        return { error: error.message };
+  const participant = await getParticipant(request, eventId);
+  if (!participant) {
+    return redirect(`/e/${eventId}`);
+  }
+  const credential = {
+    participantId: participant.id,
+    participantToken: participant.token,
+  };
+
+  try {
+    if (actionType === "claimPayment") {
+      await client.claimPayment(credential);
+    } else if (actionType === "changeTier") {
+      await client.changeTier({
+        ...credential,
+        tier: Number(formData.get("tier")),
+      });
+    } else if (actionType === "changeRounds") {
+      await client.changeRounds({
+        ...credential,
+        rounds: formData.getAll("rounds").map(Number),
+      });
+    } else if (actionType === "leaveEvent") {
+      await client.leaveEvent(credential);
+      const headers = new Headers();
+      for (const setCookie of await clearParticipant(request, eventId)) {
+        headers.append("Set-Cookie", setCookie);
       }
-      throw error;
+      return redirect(`/e/${eventId}`, { headers });
     }
+  } catch (error) {
+    if (error instanceof ConnectError) {
+      return { error: error.message };
+    }
+    throw error;
   }
 
   return redirect(`/e/${eventId}`);
@@ -192,7 +249,7 @@ export default function EventPublicPage({
   loaderData,
   actionData,
 }: Route.ComponentProps) {
-  const { event, paymentMethods, myParticipant } = loaderData;
+  const { event, paymentMethods, rounds, myParticipant } = loaderData;
   const actionError =
     actionData && "error" in actionData ? (actionData.error as string) : null;
 
@@ -281,6 +338,8 @@ export default function EventPublicPage({
               <JoinForm event={event} />
             ) : myParticipant.status === ParticipantStatus.UNPAID ? (
               <UnpaidView
+                event={event}
+                rounds={rounds}
                 participant={myParticipant}
                 paymentMethods={paymentMethods}
               />
@@ -390,9 +449,13 @@ function JoinForm({ event }: { event: SerializedEvent }) {
 }
 
 function UnpaidView({
+  event,
+  rounds,
   participant,
   paymentMethods,
 }: {
+  event: SerializedEvent;
+  rounds: SerializedRound[];
   participant: SerializedParticipant;
   paymentMethods: PaymentMethodItem[];
 }) {
@@ -416,11 +479,90 @@ function UnpaidView({
 
       <Form method="post">
         <input type="hidden" name="_action" value="claimPayment" />
-        <input type="hidden" name="participantId" value={participant.id} />
         <Button type="submit" variant="outline" className="w-full">
           {m.event_public_claim_button()}
         </Button>
       </Form>
+
+      {event.tiers.length > 1 && (
+        <Card>
+          <CardContent className="py-4">
+            <Form method="post" className="space-y-4">
+              <input type="hidden" name="_action" value="changeTier" />
+              <Label>{m.event_public_tier_label()}</Label>
+              <RadioGroup
+                name="tier"
+                defaultValue={String(participant.tier)}
+                className="space-y-2"
+              >
+                {[...event.tiers].reverse().map((tier) => (
+                  <div key={tier.id} className="flex items-center space-x-2">
+                    <RadioGroupItem
+                      value={String(tier.tier)}
+                      id={`change-tier-${tier.tier}`}
+                    />
+                    <Label
+                      htmlFor={`change-tier-${tier.tier}`}
+                      className="font-normal"
+                    >
+                      {m.event_tier_table_rank()} {tier.tier} —{" "}
+                      {formatCurrency(tier.amount)}
+                    </Label>
+                  </div>
+                ))}
+              </RadioGroup>
+              <Button type="submit" variant="outline" className="w-full">
+                {m.event_public_change_tier_button()}
+              </Button>
+            </Form>
+          </CardContent>
+        </Card>
+      )}
+
+      {rounds.length > 0 && (
+        <Card>
+          <CardContent className="py-4">
+            <Form method="post" className="space-y-4">
+              <input type="hidden" name="_action" value="changeRounds" />
+              <Label>{m.event_public_rounds_label()}</Label>
+              <div className="space-y-2">
+                {rounds.map((round) => (
+                  <div
+                    key={round.round}
+                    className="flex items-center space-x-2"
+                  >
+                    <input
+                      type="checkbox"
+                      name="rounds"
+                      value={round.round}
+                      id={`round-${round.round}`}
+                      defaultChecked={round.participantIds.includes(
+                        participant.id,
+                      )}
+                    />
+                    <Label
+                      htmlFor={`round-${round.round}`}
+                      className="font-normal"
+                    >
+                      {round.title}
+                    </Label>
+                  </div>
+                ))}
+              </div>
+              <Button type="submit" variant="outline" className="w-full">
+                {m.event_public_change_rounds_button()}
+              </Button>
+            </Form>
+          </CardContent>
+        </Card>
+      )}
+
+      <Form method="post">
+        <input type="hidden" name="_action" value="leaveEvent" />
+        <Button type="submit" variant="ghost" className="w-full">
+          {m.event_public_leave_button()}
+        </Button>
+      </Form>
     </div>
   );
 }
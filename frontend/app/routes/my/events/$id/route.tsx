@@ -42,6 +42,14 @@ interface SerializedParticipant {
   amount: number;
 }
 
+// Handover is a link that lets a participant act on their own, e.g. one who
+// joined on another device or before participant tokens were issued.
+interface Handover {
+  participantId: string;
+  name: string;
+  url: string;
+}
+
 export async function loader({ request, params }: Route.LoaderArgs) {
   const eventId = params.id;
 
@@ -134,6 +142,21 @@ export async function action({ request, params }: Route.ActionArgs) {
         return Response.json({ ok: true });
       }
 
+      if (actionType === "reissueParticipantToken") {
+        const participantId = formData.get("participantId") as string;
+        const { participant, participantToken } =
+          await client.reissueParticipantToken({ eventId, participantId });
+        const url = new URL(`/e/${eventId}`, request.url);
+        url.searchParams.set("participant", participantId);
+        url.searchParams.set("token", participantToken);
+        const handover: Handover = {
+          participantId,
+          name: participant?.name ?? "",
+          url: url.toString(),
+        };
+        return Response.json({ handover });
+      }
+
       if (actionType === "deleteEvent") {
         await client.deleteEvent({ id: eventId });
         return redirect("/my");
@@ -159,8 +182,13 @@ export async function action({ request, params }: Route.ActionArgs) {
   return result.value;
 }
 
-export default function EventDetailPage({ loaderData }: Route.ComponentProps) {
+export default function EventDetailPage({
+  loaderData,
+  actionData,
+}: Route.ComponentProps) {
   const { event, participants, shareUrl } = loaderData;
+  const handover = (actionData as { handover?: Handover } | undefined)
+    ?.handover;
 
   const collected = participants
     .filter((p) => p.status === ParticipantStatus.CONFIRMED)
@@ -293,23 +321,54 @@ export default function EventDetailPage({ loaderData }: Route.ComponentProps) {
                       <ParticipantStatusBadge status={p.status} />
                     </div>
                   </div>
-                  {p.status === ParticipantStatus.CLAIMED && (
-                    <Form method="post">
-                      <input
-                        type="hidden"
-                        name="_action"
-                        value="confirmPayment"
-                      />
-                      <input type="hidden" name="participantId" value={p.id} />
-                      <Button type="submit" size="sm">
-                        {m.event_confirm_payment()}
-                      </Button>
-                    </Form>
-                  )}
+                  <div className="flex items-center gap-2">
+                    {!event.isArchived && (
+                      <Form method="post">
+                        <input
+                          type="hidden"
+                          name="_action"
+                          value="reissueParticipantToken"
+                        />
+                        <input
+                          type="hidden"
+                          name="participantId"
+                          value={p.id}
+                        />
+                        <Button type="submit" size="sm" variant="ghost">
+                          {m.event_handover_button()}
+                        </Button>
+                      </Form>
+                    )}
+                    {p.status === ParticipantStatus.CLAIMED && (
+                      <Form method="post">
+                        <input
+                          type="hidden"
+                          name="_action"
+                          value="confirmPayment"
+                        />
+                        <input
+                          type="hidden"
+                          name="participantId"
+                          value={p.id}
+                        />
+                        <Button type="submit" size="sm">
+                          {m.event_confirm_payment()}
+                        </Button>
+                      </Form>
+                    )}
+                  </div>
                 </div>
               ))}
             </div>
           )}
+          {handover && (
+            <div className="mt-4 space-y-2 rounded-md border p-3">
+              <p className="text-sm text-muted-foreground">
+                {m.event_handover_description({ name: handover.name })}
+              </p>
+              <ShareButton url={handover.url} name={event.title} />
+            </div>
+          )}
         </CardContent>
       </Card>
 
@@ -1,7 +1,10 @@
 import { type LoaderFunction, redirect } from "react-router";
 import { OAuthService } from "~/gen/auth/v1/oauth_pb";
 import { getClient } from "~/lib/api/client.server";
-import { sessionExchangeInterceptor } from "~/lib/api/interceptors.server";
+import {
+  createOAuthStateInterceptor,
+  sessionExchangeInterceptor,
+} from "~/lib/api/interceptors.server";
 import logger from "~/lib/logger";
 import { resolveProvider } from "~/lib/oauth/provider";
 
@@ -39,7 +42,10 @@ export const loader: LoaderFunction = async ({ request }) => {
   const client = getClient({
     service: OAuthService,
     request,
-    interceptors: [sessionExchangeInterceptor],
+    interceptors: [
+      createOAuthStateInterceptor(request),
+      sessionExchangeInterceptor,
+    ],
   });
   let setCookies: string[] = [];
   const { user, isNewUser } = await client.oAuthCallback(
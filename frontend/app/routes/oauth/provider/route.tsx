@@ -10,6 +10,20 @@ export const loader: LoaderFunction = async ({ request, params }) => {
   }
 
   const client = getClient({ service: OAuthService, request });
-  const { url } = await client.getOAuthURL({ provider });
-  return replace(url);
+  let setCookies: string[] = [];
+  const { url } = await client.getOAuthURL(
+    { provider },
+    {
+      onHeader(headers) {
+        setCookies = headers.getSetCookie();
+      },
+    },
+  );
+
+  // the oauth_state cookie binds the login to this browser; the callback hands it back to the API
+  const headers = new Headers();
+  for (const cookie of setCookies) {
+    headers.append("set-cookie", cookie);
+  }
+  return replace(url, { headers });
 };
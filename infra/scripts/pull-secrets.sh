@@ -36,10 +36,13 @@ KVS_PASSWORD=$(echo "$KVS_JSON" | jq -r .KVS_PASSWORD)
 sed -i '/^KVS_PASSWORD=/d' "$ENV_FILE"
 echo "KVS_PASSWORD=${KVS_PASSWORD}" >> "$ENV_FILE"
 
-# --- Session secret -> .env (append) ---
+# --- Session and SSR secrets -> .env (append) ---
 APP_JSON=$(get_secret "${SM_PREFIX}/app")
 SESSION_SECRET=$(echo "$APP_JSON" | jq -r .SESSION_SECRET)
 sed -i '/^SESSION_SECRET=/d' "$ENV_FILE"
 echo "SESSION_SECRET=${SESSION_SECRET}" >> "$ENV_FILE"
+SSR_SECRET=$(echo "$APP_JSON" | jq -r .SSR_SECRET)
+sed -i '/^SSR_SECRET=/d' "$ENV_FILE"
+echo "SSR_SECRET=${SSR_SECRET}" >> "$ENV_FILE"
 
 chmod 600 "$ENV_FILE" "$ENV_POSTGRES_FILE"
@@ -23,6 +23,11 @@ resource "random_password" "session" {
   special = false
 }
 
+resource "random_password" "ssr" {
+  length  = 64
+  special = false
+}
+
 resource "random_password" "jwt" {
   length  = 64
   special = false
@@ -43,6 +48,7 @@ resource "aws_secretsmanager_secret_version" "app" {
     # Auto-generated
     SESSION_SECRET     = random_password.session.result
     JWT_SIGNING_SECRET = random_password.jwt.result
+    SSR_SECRET         = random_password.ssr.result
 
     # DB connection
     DB_HOST            = "postgres"
@@ -73,6 +79,7 @@ resource "aws_secretsmanager_secret_version" "app" {
     OAUTH_REDIRECT_URL            = "https://${local.app_domain}/oauth/callback"
     LINE_CHANNEL_ID               = var.line_channel_id
     LINE_CHANNEL_SECRET           = var.line_channel_secret
+    LINE_MESSAGING_CHANNEL_SECRET = var.line_messaging_channel_secret
 
     # Misc
     EMAIL_FROM = var.email_from != "" ? var.email_from : "noreply@${var.domain}"
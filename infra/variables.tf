@@ -22,6 +22,12 @@ variable "line_channel_secret" {
   sensitive   = true
 }
 
+variable "line_messaging_channel_secret" {
+  type        = string
+  description = "LINE Messaging API channel secret, which webhooks are signed with"
+  sensitive   = true
+}
+
 variable "email_from" {
   type        = string
   description = "Sender email address"